@@ -27,7 +27,9 @@ import (
 type APIClient interface {
 	Get(url string) (*http.Response, error)
 	Post(url, body string) (*http.Response, error)
+	PostContent(url, contentType string, body io.Reader) (*http.Response, error)
 	PutJSON(url string, o interface{}) (*http.Response, error)
+	Delete(url string) (*http.Response, error)
 }
 
 type apiClient struct {
@@ -149,10 +151,23 @@ func (c *apiClient) Post(url, body string) (*http.Response, error) {
 	return c.RequestString(url, "POST", body)
 }
 
+func (c *apiClient) PostContent(url, contentType string, body io.Reader) (*http.Response, error) {
+	request, err := http.NewRequest("POST", c.Endpoint()+"rest/"+url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	return c.Do(request)
+}
+
 func (c *apiClient) PutJSON(url string, o interface{}) (*http.Response, error) {
 	return c.RequestJSON(url, "PUT", o)
 }
 
+func (c *apiClient) Delete(url string) (*http.Response, error) {
+	return c.RequestString(url, "DELETE", "")
+}
+
 var errNotFound = errors.New("invalid endpoint or API call")
 
 func checkResponse(response *http.Response) error {
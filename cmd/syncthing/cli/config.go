@@ -7,12 +7,19 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 
 	"github.com/AudriusButkevicius/recli"
 	"github.com/pkg/errors"
+	"github.com/syncthing/syncthing/lib/auditlog"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/urfave/cli"
 )
@@ -41,6 +48,23 @@ func getConfigCommand(f *apiClientFactory) (cli.Command, error) {
 		return cli.Command{}, fmt.Errorf("config reflect: %w", err)
 	}
 
+	commands = append(commands, cli.Command{
+		Name:      "apply",
+		Usage:     "Apply a partial configuration in JSON or YAML from a file, or - for stdin",
+		ArgsUsage: "PATH",
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Only show the changes that would be made",
+			},
+			cli.StringFlag{
+				Name:  "revision",
+				Usage: "Only apply if the configuration is at this revision",
+			},
+		},
+		Action: expects(1, h.applyConfig),
+	})
+
 	return cli.Command{
 		Name:        "config",
 		HideHelp:    true,
@@ -51,6 +75,97 @@ func getConfigCommand(f *apiClientFactory) (cli.Command, error) {
 	}, nil
 }
 
+type applyResponse struct {
+	Revision string            `json:"revision"`
+	Changes  []auditlog.Change `json:"changes"`
+	Applied  bool              `json:"applied"`
+}
+
+// applyConfig shows the changes the partial configuration makes, then
+// applies it unless asked not to. Applying is conditional on the
+// revision that the changes were computed against, so that what's
+// applied is what was shown.
+func (h *configHandler) applyConfig(c *cli.Context) error {
+	path := c.Args()[0]
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	contentType := "application/json"
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		contentType = "application/yaml"
+	}
+
+	post := func(query url.Values) (applyResponse, error) {
+		var res applyResponse
+		resp, err := h.client.PostContent("config/apply?"+query.Encode(), contentType, bytes.NewReader(data))
+		if err != nil {
+			return res, err
+		}
+		bs, err := responseToBArray(resp)
+		if err != nil {
+			return res, err
+		}
+		return res, json.Unmarshal(bs, &res)
+	}
+
+	query := url.Values{"dryrun": []string{"true"}}
+	if rev := c.String("revision"); rev != "" {
+		query.Set("revision", rev)
+	}
+	res, err := post(query)
+	if err != nil {
+		return err
+	}
+	if len(res.Changes) == 0 {
+		fmt.Println("No changes")
+		return nil
+	}
+	for _, change := range res.Changes {
+		fmt.Println(formatChange(change))
+	}
+	if c.Bool("dry-run") {
+		return nil
+	}
+
+	query.Del("dryrun")
+	query.Set("revision", res.Revision)
+	res, err = post(query)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Applied, new revision", res.Revision)
+	return nil
+}
+
+func formatChange(change auditlog.Change) string {
+	switch {
+	case change.Redacted:
+		return fmt.Sprintf("~ %s (redacted)", change.Path)
+	case change.Old == nil:
+		return fmt.Sprintf("+ %s: %s", change.Path, formatValue(change.New))
+	case change.New == nil:
+		return fmt.Sprintf("- %s: %s", change.Path, formatValue(change.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", change.Path, formatValue(change.Old), formatValue(change.New))
+	}
+}
+
+func formatValue(v interface{}) string {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(bs)
+}
+
 func (h *configHandler) configBefore(c *cli.Context) error {
 	for _, arg := range c.Args() {
 		if arg == "--help" || arg == "-h" {
@@ -104,6 +104,7 @@ func runInternal(c preCli, cliArgs []string) error {
 			configCommand,
 			showCommand,
 			operationCommand,
+			selectionCommand,
 			errorsCommand,
 			debugCommand,
 			{
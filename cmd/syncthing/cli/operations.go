@@ -10,6 +10,7 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"net/url"
 	"path/filepath"
 
 	"github.com/syncthing/syncthing/lib/config"
@@ -43,6 +44,12 @@ var operationCommand = cli.Command{
 			ArgsUsage: "FOLDER-ID",
 			Action:    expects(1, foldersOverride),
 		},
+		{
+			Name:      "fetch",
+			Usage:     "Fetch the contents of placeholder files at or below the given paths in a folder",
+			ArgsUsage: "FOLDER-ID PATH...",
+			Action:    foldersFetch,
+		},
 		{
 			Name:      "default-ignores",
 			Usage:     "Set the default ignores (config) from a file",
@@ -86,6 +93,26 @@ func foldersOverride(c *cli.Context) error {
 	return fmt.Errorf("Folder %q not found", rid)
 }
 
+func foldersFetch(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return errors.New("expected a folder ID and at least one path")
+	}
+	client, err := getClientFactory(c).getClient()
+	if err != nil {
+		return err
+	}
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	for _, path := range c.Args()[1:] {
+		query.Add("file", normalizePath(path))
+	}
+	_, err = client.Post("db/fetch?"+query.Encode(), "")
+	if errors.Is(err, errNotFound) {
+		return errors.New("folder or placeholders not found")
+	}
+	return err
+}
+
 func setDefaultIgnores(c *cli.Context) error {
 	client, err := getClientFactory(c).getClient()
 	if err != nil {
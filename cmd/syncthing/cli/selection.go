@@ -0,0 +1,73 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/urfave/cli"
+)
+
+var selectionCommand = cli.Command{
+	Name:     "selection",
+	HideHelp: true,
+	Usage:    "Selective sync command group",
+	Subcommands: []cli.Command{
+		{
+			Name:      "show",
+			Usage:     "Show the paths selected for syncing in a folder",
+			ArgsUsage: "FOLDER-ID",
+			Action: expects(1, func(c *cli.Context) error {
+				return indexDumpOutput("folder/selection?" + selectionQuery(c).Encode())(c)
+			}),
+		},
+		{
+			Name:      "add",
+			Usage:     "Select paths for syncing in a folder",
+			ArgsUsage: "FOLDER-ID PATH...",
+			Action:    selectionModify(true),
+		},
+		{
+			Name:      "remove",
+			Usage:     "Stop syncing paths in a folder. Already synced items are kept on disk.",
+			ArgsUsage: "FOLDER-ID PATH...",
+			Action:    selectionModify(false),
+		},
+	},
+}
+
+func selectionQuery(c *cli.Context) url.Values {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	for _, path := range c.Args()[1:] {
+		query.Add("path", normalizePath(path))
+	}
+	return query
+}
+
+func selectionModify(add bool) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if c.NArg() < 2 {
+			return errors.New("expected a folder ID and at least one path")
+		}
+		client, err := getClientFactory(c).getClient()
+		if err != nil {
+			return err
+		}
+		u := "folder/selection?" + selectionQuery(c).Encode()
+		if add {
+			_, err = client.Post(u, "")
+		} else {
+			_, err = client.Delete(u)
+		}
+		if errors.Is(err, errNotFound) {
+			return errors.New("folder not found")
+		}
+		return err
+	}
+}
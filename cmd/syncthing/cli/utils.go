@@ -18,7 +18,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db/backend"
-	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/syncthing"
 	"github.com/urfave/cli"
 )
 
@@ -144,7 +144,14 @@ func prettyPrintResponse(response *http.Response) error {
 }
 
 func getDB() (backend.Backend, error) {
-	return backend.OpenLevelDBRO(locations.Get(locations.Database))
+	dbBackend, err := syncthing.ConfiguredDBBackend()
+	if err != nil {
+		return nil, err
+	}
+	if err := syncthing.CheckDBBackend(dbBackend); err != nil {
+		return nil, err
+	}
+	return backend.OpenRO(syncthing.DBLocation(dbBackend), backend.Type(dbBackend))
 }
 
 func nulString(bs []byte) string {
@@ -36,6 +36,7 @@ import (
 	"github.com/syncthing/syncthing/cmd/syncthing/cmdutil"
 	"github.com/syncthing/syncthing/cmd/syncthing/decrypt"
 	"github.com/syncthing/syncthing/cmd/syncthing/generate"
+	"github.com/syncthing/syncthing/cmd/syncthing/migratedb"
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
@@ -132,10 +133,11 @@ var (
 // commands and options here are top level commands to syncthing.
 // Cli is just a placeholder for the help text (see main).
 var entrypoint struct {
-	Serve    serveOptions `cmd:"" help:"Run Syncthing"`
-	Generate generate.CLI `cmd:"" help:"Generate key and config, then exit"`
-	Decrypt  decrypt.CLI  `cmd:"" help:"Decrypt or verify an encrypted folder"`
-	Cli      struct{}     `cmd:"" help:"Command line interface for Syncthing"`
+	Serve           serveOptions  `cmd:"" help:"Run Syncthing"`
+	Generate        generate.CLI  `cmd:"" help:"Generate key and config, then exit"`
+	Decrypt         decrypt.CLI   `cmd:"" help:"Decrypt or verify an encrypted folder"`
+	MigrateDatabase migratedb.CLI `cmd:"" help:"Migrate the database to another backend, then exit"`
+	Cli             struct{}      `cmd:"" help:"Command line interface for Syncthing"`
 }
 
 // serveOptions are the options for the `syncthing serve` command.
@@ -380,14 +382,21 @@ func (options serveOptions) Run() error {
 	if options.Upgrade {
 		release, err := checkUpgrade()
 		if err == nil {
-			// Use leveldb database locks to protect against concurrent upgrades
-			var ldb backend.Backend
-			ldb, err = syncthing.OpenDBBackend(locations.Get(locations.Database), config.TuningAuto)
-			if err != nil {
-				err = upgradeViaRest()
-			} else {
-				_ = ldb.Close()
-				err = upgrade.To(release)
+			// Use database locks to protect against concurrent upgrades
+			var dbBackend config.DatabaseBackend
+			dbBackend, err = syncthing.ConfiguredDBBackend()
+			if err == nil {
+				err = syncthing.CheckDBBackend(dbBackend)
+			}
+			if err == nil {
+				var ldb backend.Backend
+				ldb, err = syncthing.OpenDBBackend(syncthing.DBLocation(dbBackend), dbBackend, config.TuningAuto)
+				if err != nil {
+					err = upgradeViaRest()
+				} else {
+					_ = ldb.Close()
+					err = upgrade.To(release)
+				}
 			}
 		}
 		if err != nil {
@@ -584,8 +593,13 @@ func syncthingMain(options serveOptions) {
 		})
 	}
 
-	dbFile := locations.Get(locations.Database)
-	ldb, err := syncthing.OpenDBBackend(dbFile, cfgWrapper.Options().DatabaseTuning)
+	dbBackend := cfgWrapper.Options().DatabaseBackend
+	if err := syncthing.CheckDBBackend(dbBackend); err != nil {
+		l.Warnln("Error opening database:", err)
+		os.Exit(1)
+	}
+	dbFile := syncthing.DBLocation(dbBackend)
+	ldb, err := syncthing.OpenDBBackend(dbFile, dbBackend, cfgWrapper.Options().DatabaseTuning)
 	if err != nil {
 		l.Warnln("Error opening database:", err)
 		os.Exit(1)
@@ -764,7 +778,10 @@ func auditWriter(auditFile string) io.Writer {
 }
 
 func resetDB() error {
-	return os.RemoveAll(locations.Get(locations.Database))
+	if err := os.RemoveAll(locations.Get(locations.Database)); err != nil {
+		return err
+	}
+	return os.RemoveAll(locations.Get(locations.DatabasePebble))
 }
 
 func autoUpgradePossible(options serveOptions) bool {
@@ -0,0 +1,150 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package migratedb implements the `syncthing migrate-database` subcommand.
+package migratedb
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/syncthing/syncthing/cmd/syncthing/cmdutil"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/syncthing"
+)
+
+type CLI struct {
+	ConfDir  string `name:"config" placeholder:"PATH" help:"Set configuration directory (config and keys)"`
+	HomeDir  string `name:"home" placeholder:"PATH" help:"Set configuration and data directory"`
+	DataDir  string `name:"data" placeholder:"PATH" help:"Set data directory (database and logs)"`
+	To       string `required:"" enum:"leveldb,pebble" placeholder:"BACKEND" help:"Database backend to migrate to (leveldb or pebble)"`
+	Keep     bool   `help:"Keep the old database after migrating"`
+	NoConfig bool   `help:"Don't update the database backend in the configuration"`
+}
+
+func (c *CLI) Run() error {
+	log.SetFlags(0)
+
+	if err := cmdutil.SetConfigDataLocationsFromFlags(c.HomeDir, c.ConfDir, c.DataDir); err != nil {
+		return err
+	}
+
+	var to config.DatabaseBackend
+	if err := to.UnmarshalText([]byte(c.To)); err != nil {
+		return err
+	}
+
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	from := cfg.RawCopy().Options.DatabaseBackend
+	if from == to {
+		return fmt.Errorf("the database backend is already %v", to)
+	}
+
+	srcPath, dstPath := syncthing.DBLocation(from), syncthing.DBLocation(to)
+	if _, err := os.Stat(srcPath); err != nil {
+		return fmt.Errorf("source database: %w", err)
+	}
+	if _, err := os.Stat(dstPath); err == nil {
+		return fmt.Errorf("destination database %s already exists, remove it first", dstPath)
+	}
+
+	log.Printf("Migrating database from %v (%s) to %v (%s)", from, srcPath, to, dstPath)
+	n, err := migrate(srcPath, from, dstPath, to)
+	if err != nil {
+		// Don't leave a partial database behind to be picked up later
+		_ = os.RemoveAll(dstPath)
+		return fmt.Errorf("migrating database: %w", err)
+	}
+	log.Printf("Copied %d entries", n)
+
+	if c.NoConfig {
+		log.Printf("Set the database backend option to %q before starting Syncthing", c.To)
+	} else {
+		raw := cfg.RawCopy()
+		raw.Options.DatabaseBackend = to
+		if err := config.Wrap(cfg.ConfigPath(), raw, cfg.MyID(), events.NoopLogger).Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+		log.Println("Updated the database backend in the configuration")
+	}
+
+	if !c.Keep {
+		if err := os.RemoveAll(srcPath); err != nil {
+			return fmt.Errorf("removing old database: %w", err)
+		}
+		log.Println("Removed the old database")
+	}
+	return nil
+}
+
+func (c *CLI) loadConfig() (config.Wrapper, error) {
+	cert, err := tls.LoadX509KeyPair(locations.Get(locations.CertFile), locations.Get(locations.KeyFile))
+	if err != nil {
+		return nil, err
+	}
+	myID := protocol.NewDeviceID(cert.Certificate[0])
+	cfg, _, err := config.Load(locations.Get(locations.ConfigFile), myID, events.NoopLogger)
+	return cfg, err
+}
+
+// migrate copies all entries from the source to the destination database
+// and returns the number of entries copied. The source is opened read only
+// and thus fails if Syncthing is running.
+func migrate(srcPath string, srcType config.DatabaseBackend, dstPath string, dstType config.DatabaseBackend) (int, error) {
+	src, err := backend.OpenRO(srcPath, backend.Type(srcType))
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+
+	dst, err := backend.Open(dstPath, backend.Type(dstType), backend.TuningAuto)
+	if err != nil {
+		return 0, err
+	}
+	n, err := copyAll(src, dst)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+func copyAll(src, dst backend.Backend) (int, error) {
+	it, err := src.NewPrefixIterator(nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Release()
+
+	t, err := dst.NewWriteTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer t.Release()
+
+	n := 0
+	for it.Next() {
+		if err := t.Put(it.Key(), it.Value()); err != nil {
+			return n, err
+		}
+		n++
+		if n%100000 == 0 {
+			log.Printf("Copied %d entries...", n)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+	return n, t.Commit()
+}
@@ -14,7 +14,6 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
-	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
@@ -59,7 +58,9 @@ func monitorMain(options serveOptions) {
 			return newAutoclosedFile(name, logFileAutoCloseDelay, logFileMaxOpenTime)
 		}
 		if options.LogMaxSize > 0 {
-			fileDst, err = newRotatedFile(logFile, open, int64(options.LogMaxSize), options.LogMaxFiles)
+			fileDst, err = osutil.NewRotatedFile(logFile, open, int64(options.LogMaxSize), options.LogMaxFiles, func(err error) {
+				fmt.Println("LOG: Rotating logs:", err)
+			})
 		} else {
 			fileDst, err = open(logFile)
 		}
@@ -375,89 +376,6 @@ func restartMonitorWindows(binary string, args []string) error {
 	return cmd.Start()
 }
 
-// rotatedFile keeps a set of rotating logs. There will be the base file plus up
-// to maxFiles rotated ones, each ~ maxSize bytes large.
-type rotatedFile struct {
-	name        string
-	create      createFn
-	maxSize     int64 // bytes
-	maxFiles    int
-	currentFile io.WriteCloser
-	currentSize int64
-}
-
-type createFn func(name string) (io.WriteCloser, error)
-
-func newRotatedFile(name string, create createFn, maxSize int64, maxFiles int) (*rotatedFile, error) {
-	var size int64
-	if info, err := os.Lstat(name); err != nil {
-		if !os.IsNotExist(err) {
-			return nil, err
-		}
-		size = 0
-	} else {
-		size = info.Size()
-	}
-	writer, err := create(name)
-	if err != nil {
-		return nil, err
-	}
-	return &rotatedFile{
-		name:        name,
-		create:      create,
-		maxSize:     maxSize,
-		maxFiles:    maxFiles,
-		currentFile: writer,
-		currentSize: size,
-	}, nil
-}
-
-func (r *rotatedFile) Write(bs []byte) (int, error) {
-	// Check if we're about to exceed the max size, and if so close this
-	// file so we'll start on a new one.
-	if r.currentSize+int64(len(bs)) > r.maxSize {
-		r.currentFile.Close()
-		r.currentSize = 0
-		r.rotate()
-		f, err := r.create(r.name)
-		if err != nil {
-			return 0, err
-		}
-		r.currentFile = f
-	}
-
-	n, err := r.currentFile.Write(bs)
-	r.currentSize += int64(n)
-	return n, err
-}
-
-func (r *rotatedFile) rotate() {
-	// The files are named "name", "name.0", "name.1", ...
-	// "name.(r.maxFiles-1)". Increase the numbers on the
-	// suffixed ones.
-	for i := r.maxFiles - 1; i > 0; i-- {
-		from := numberedFile(r.name, i-1)
-		to := numberedFile(r.name, i)
-		err := os.Rename(from, to)
-		if err != nil && !os.IsNotExist(err) {
-			fmt.Println("LOG: Rotating logs:", err)
-		}
-	}
-
-	// Rename the base to base.0
-	err := os.Rename(r.name, numberedFile(r.name, 0))
-	if err != nil && !os.IsNotExist(err) {
-		fmt.Println("LOG: Rotating logs:", err)
-	}
-}
-
-// numberedFile adds the number between the file name and the extension.
-func numberedFile(name string, num int) string {
-	ext := filepath.Ext(name) // contains the dot
-	withoutExt := name[:len(name)-len(ext)]
-	return fmt.Sprintf("%s.%d%s", withoutExt, num, ext)
-}
-
 // An autoclosedFile is an io.WriteCloser that opens itself for appending on
 // Write() and closes itself after an interval of no writes (closeDelay) or
 // when the file has been open for too long (maxOpenTime). A call to Write()
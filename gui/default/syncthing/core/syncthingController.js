@@ -74,6 +74,9 @@ angular.module('syncthing.core')
             cleanupIntervalS: 3600,
             simpleKeep: 5,
             staggeredMaxAge: 365,
+            retentionMaxVersions: 20,
+            retentionMaxAge: 90,
+            retentionMaxSize: "",
             externalCommand: "",
         };
 
@@ -534,6 +537,7 @@ angular.module('syncthing.core')
                 && addr.substr(0, 1) !== "/"
                 && (!guiCfg.user || !guiCfg.password)
                 && guiCfg.authMode !== 'ldap'
+                && guiCfg.authMode !== 'oidc'
                 && !guiCfg.insecureAdminAccess;
 
             if (guiCfg.user && guiCfg.password) {
@@ -969,7 +973,7 @@ angular.module('syncthing.core')
             if (status === 'stopped' || status === 'outofsync' || status === 'error' || status === 'faileditems' || status === 'localunencrypted') {
                 return 'danger';
             }
-            if (status === 'unshared' || status === 'scan-waiting' || status === 'sync-waiting' || status === 'clean-waiting') {
+            if (status === 'unshared' || status === 'scan-waiting' || status === 'sync-waiting' || status === 'sync-scheduled' || status === 'clean-waiting') {
                 return 'warning';
             }
 
@@ -2077,9 +2081,19 @@ angular.module('syncthing.core')
                 $scope.currentFolder._guiVersioning.simpleKeep = +currentVersioning.params.keep;
                 $scope.currentFolder._guiVersioning.trashcanClean = +currentVersioning.params.cleanoutDays;
                 break;
+            case "dedup":
+                $scope.currentFolder._guiVersioning.simpleKeep = +currentVersioning.params.keep;
+                $scope.currentFolder._guiVersioning.trashcanClean = +currentVersioning.params.cleanoutDays;
+                $scope.currentFolder._guiVersioning.retentionMaxSize = currentVersioning.params.maxSize || "";
+                break;
             case "staggered":
                 $scope.currentFolder._guiVersioning.staggeredMaxAge = Math.floor(+currentVersioning.params.maxAge / 86400);
                 break;
+            case "retention":
+                $scope.currentFolder._guiVersioning.retentionMaxVersions = +currentVersioning.params.maxVersions;
+                $scope.currentFolder._guiVersioning.retentionMaxAge = Math.floor(+currentVersioning.params.maxAge / 86400);
+                $scope.currentFolder._guiVersioning.retentionMaxSize = currentVersioning.params.maxSize || "";
+                break;
             case "external":
                 $scope.currentFolder._guiVersioning.externalCommand = currentVersioning.params.command;
                 break;
@@ -2261,9 +2275,19 @@ angular.module('syncthing.core')
                 folderCfg.versioning.params.keep = '' + folderCfg._guiVersioning.simpleKeep,
                 folderCfg.versioning.params.cleanoutDays = '' + folderCfg._guiVersioning.trashcanClean;
                 break;
+            case "dedup":
+                folderCfg.versioning.params.keep = '' + folderCfg._guiVersioning.simpleKeep,
+                folderCfg.versioning.params.cleanoutDays = '' + folderCfg._guiVersioning.trashcanClean;
+                folderCfg.versioning.params.maxSize = '' + folderCfg._guiVersioning.retentionMaxSize;
+                break;
             case "staggered":
                 folderCfg.versioning.params.maxAge = '' + (folderCfg._guiVersioning.staggeredMaxAge * 86400);
                 break;
+            case "retention":
+                folderCfg.versioning.params.maxVersions = '' + folderCfg._guiVersioning.retentionMaxVersions;
+                folderCfg.versioning.params.maxAge = '' + (folderCfg._guiVersioning.retentionMaxAge * 86400);
+                folderCfg.versioning.params.maxSize = '' + folderCfg._guiVersioning.retentionMaxSize;
+                break;
             case "external":
                 folderCfg.versioning.params.command = '' + folderCfg._guiVersioning.externalCommand;
                 break;
@@ -38,6 +38,7 @@ import (
 	"golang.org/x/text/transform"
 	"golang.org/x/text/unicode/norm"
 
+	"github.com/syncthing/syncthing/lib/auditlog"
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections"
@@ -56,6 +57,7 @@ import (
 	"github.com/syncthing/syncthing/lib/tlsutil"
 	"github.com/syncthing/syncthing/lib/upgrade"
 	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/webhook"
 )
 
 const (
@@ -82,6 +84,8 @@ type service struct {
 	connectionsService   connections.Service
 	fss                  model.FolderSummaryService
 	urService            *ur.Service
+	webhooks             webhook.Service
+	auditLog             auditlog.Log
 	noUpgrade            bool
 	tlsDefaultCommonName string
 	configChanged        chan struct{} // signals intentional listener close due to config change
@@ -103,7 +107,7 @@ type Service interface {
 	WaitForStart() error
 }
 
-func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, errors, systemLog logger.Recorder, noUpgrade bool) Service {
+func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, webhooks webhook.Service, fss model.FolderSummaryService, errors, systemLog logger.Recorder, noUpgrade bool) Service {
 	return &service{
 		id:      id,
 		cfg:     cfg,
@@ -119,6 +123,8 @@ func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonNam
 		connectionsService:   connectionsService,
 		fss:                  fss,
 		urService:            urService,
+		webhooks:             webhooks,
+		auditLog:             auditlog.NewFileLog(locations.Get(locations.AdminAuditLog), auditLogMaxSize, auditLogMaxFiles),
 		guiErrors:            errors,
 		systemLog:            systemLog,
 		noUpgrade:            noUpgrade,
@@ -248,42 +254,55 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)             // [device] [folder]
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                         // folder file
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                   // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores/explain", s.getDBIgnoresExplain)    // folder file
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                         // folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)             // device folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)         // folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                     // folder
 	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                     // folder [prefix] [dirsonly] [levels]
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/conflicts", s.getFolderConflicts)       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/selection", s.getFolderSelection)       // folder
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
 	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
 	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/stream", s.getEventStream)              // [since] [events] [folder]
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
 	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                          // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                      // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)         // [length]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/bandwidth", s.getSystemBandwidth)       // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)             // current
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)   // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)       // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/paths", s.getSystemPaths)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                      // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/session", s.getSystemSession)           // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)             // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)           // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/webhooks", s.getSystemWebhooks)         // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
 	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
 
 	// The POST handlers
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/fetch", s.postDBFetch)                        // folder file...
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores/test", s.postDBIgnoresTest)           // folder <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
 	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/conflicts", s.postFolderConflictResolve)  // folder conflict keep
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/selection", s.postFolderSelection)        // folder path...
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/pause", s.makeFolderPauseHandler(true))   // [folder]
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/resume", s.makeFolderPauseHandler(false)) // [folder]
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
@@ -294,10 +313,13 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))   // [device]
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false)) // [device]
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                // [enable] [disable]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/bandwidth", s.postSystemBandwidth)        // profile [duration]
 
 	// The DELETE handlers
 	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices) // device
 	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/folders", s.deletePendingFolders) // folder [device]
+	restMux.HandlerFunc(http.MethodDelete, "/rest/system/bandwidth", s.deleteSystemBandwidth)       // -
+	restMux.HandlerFunc(http.MethodDelete, "/rest/folder/selection", s.deleteFolderSelection)       // folder path...
 
 	// Config endpoints
 
@@ -308,6 +330,9 @@ func (s *service) Serve(ctx context.Context) error {
 	}
 
 	configBuilder.registerConfig("/rest/config")
+	configBuilder.registerApply("/rest/config/apply")
+	configBuilder.registerHistory("/rest/config/history")
+	configBuilder.registerRollback("/rest/config/rollback")
 	configBuilder.registerConfigInsync("/rest/config/insync") // deprecated
 	configBuilder.registerConfigRequiresRestart("/rest/config/restart-required")
 	configBuilder.registerFolders("/rest/config/folders")
@@ -319,6 +344,7 @@ func (s *service) Serve(ctx context.Context) error {
 	configBuilder.registerDefaultIgnores("/rest/config/defaults/ignores")
 	configBuilder.registerOptions("/rest/config/options")
 	configBuilder.registerLDAP("/rest/config/ldap")
+	configBuilder.registerOIDC("/rest/config/oidc")
 	configBuilder.registerGUI("/rest/config/gui")
 
 	// Deprecated config endpoints
@@ -335,8 +361,9 @@ func (s *service) Serve(ctx context.Context) error {
 	debugMux.HandleFunc("/rest/debug/file", s.getDebugFile)
 	restMux.Handler(http.MethodGet, "/rest/debug/*method", s.whenDebugging(debugMux))
 
-	// A handler that disables caching
-	noCacheRestMux := noCacheMiddleware(metricsMiddleware(restMux))
+	// A handler that disables caching, and lets callers only do what their
+	// role allows
+	noCacheRestMux := noCacheMiddleware(metricsMiddleware(roleMiddleware(restMux)))
 
 	// The main routing handler
 	mux := http.NewServeMux()
@@ -351,16 +378,46 @@ func (s *service) Serve(ctx context.Context) error {
 
 	guiCfg := s.cfg.GUI()
 
+	// Prometheus metrics. These are not under /rest and hence not CSRF
+	// protected, so we require an API key unless the regular GUI
+	// authentication applies.
+	var metricsHandler = newMetricsHandler(s.cfg, s.model, s.connectionsService)
+	if !guiCfg.IsAuthEnabled() {
+		metricsHandler = apiKeyMiddleware(guiCfg, metricsHandler)
+	}
+	mux.Handle("/metrics", noCacheMiddleware(metricsHandler))
+
+	// Read only WebDAV access to the folders and their versions. Like the
+	// metrics this is outside of /rest, so requires an API key unless the
+	// regular GUI authentication applies.
+	if guiCfg.WebDAVEnabled {
+		webDAVHandler := newWebDAVHandler(s.cfg, s.model)
+		if !guiCfg.IsAuthEnabled() {
+			webDAVHandler = apiKeyMiddleware(guiCfg, webDAVHandler)
+		}
+		mux.Handle(webDAVPrefix+"/", noCacheMiddleware(webDAVHandler))
+	}
+
+	// Keep a record of administrative actions and the configuration
+	// changes they cause.
+	var handler http.Handler = auditMiddleware(s.auditLog, mux)
+
 	// Wrap everything in CSRF protection. The /rest prefix should be
 	// protected, other requests will grant cookies.
-	var handler http.Handler = newCsrfManager(s.id.String()[:5], "/rest", guiCfg, mux, locations.Get(locations.CsrfTokens))
+	handler = newCsrfManager(s.id.String()[:5], "/rest", guiCfg, handler, locations.Get(locations.CsrfTokens))
 
 	// Add our version and ID as a header to responses
 	handler = withDetailsMiddleware(s.id, handler)
 
-	// Wrap everything in basic auth, if user/password is set.
+	// Wrap everything in basic auth, if user/password is set, or send
+	// users to log in with the OpenID provider.
 	if guiCfg.IsAuthEnabled() {
-		handler = basicAuthAndSessionMiddleware("sessionid-"+s.id.String()[:5], guiCfg, s.cfg.LDAP(), handler, s.evLogger)
+		cookieName := "sessionid-" + s.id.String()[:5]
+		if guiCfg.AuthMode == config.AuthModeOIDC {
+			handler = oidcAuthMiddleware(cookieName, guiCfg, newOIDCAuthenticator(s.cfg.OIDC()), handler, s.evLogger)
+		} else {
+			handler = basicAuthAndSessionMiddleware(cookieName, guiCfg, s.cfg.LDAP(), handler, s.evLogger)
+		}
 	}
 
 	// Redirect to HTTPS if we are supposed to
@@ -469,7 +526,8 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	// No action required when this changes, so mask the fact that it changed at all.
 	from.GUI.Debugging = to.GUI.Debugging
 
-	if to.GUI == from.GUI {
+	// Copying makes nil and empty lists compare equal.
+	if reflect.DeepEqual(to.GUI.Copy(), from.GUI.Copy()) && reflect.DeepEqual(to.LDAP.Copy(), from.LDAP.Copy()) && reflect.DeepEqual(to.OIDC.Copy(), from.OIDC.Copy()) {
 		// No GUI changes, we're done here.
 		return true
 	}
@@ -907,6 +965,41 @@ func (s *service) getSystemConnections(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, s.model.ConnectionStats())
 }
 
+func (s *service) getSystemBandwidth(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.connectionsService.BandwidthStatus())
+}
+
+func (s *service) postSystemBandwidth(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	duration := time.Hour
+	if durStr := qs.Get("duration"); durStr != "" {
+		var err error
+		if duration, err = time.ParseDuration(durStr); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if err := s.connectionsService.OverrideBandwidthProfile(qs.Get("profile"), duration); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sendJSON(w, s.connectionsService.BandwidthStatus())
+}
+
+func (s *service) deleteSystemBandwidth(w http.ResponseWriter, _ *http.Request) {
+	s.connectionsService.ClearBandwidthOverride()
+	sendJSON(w, s.connectionsService.BandwidthStatus())
+}
+
+func (s *service) getSystemWebhooks(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.webhooks.Status())
+}
+
+// getSystemSession returns who the caller is logged in as.
+func (*service) getSystemSession(w http.ResponseWriter, r *http.Request) {
+	sendJSON(w, requestIdentity(r))
+}
+
 func (s *service) getDeviceStats(w http.ResponseWriter, _ *http.Request) {
 	stats, err := s.model.DeviceStatistics()
 	if err != nil {
@@ -1327,6 +1420,18 @@ func (s *service) getDBIgnores(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getDBIgnoresExplain(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	explanation, err := s.model.ExplainIgnore(qs.Get("folder"), qs.Get("file"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	sendJSON(w, explanation)
+}
+
 func (s *service) postDBIgnores(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 
@@ -1353,6 +1458,37 @@ func (s *service) postDBIgnores(w http.ResponseWriter, r *http.Request) {
 	s.getDBIgnores(w, r)
 }
 
+func (s *service) postDBIgnoresTest(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+
+	var data map[string][]string
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, perpage := getPagingParams(qs)
+	result, err := s.model.TestIgnores(qs.Get("folder"), data["ignore"], page, perpage)
+	if err != nil && !ignore.IsParseError(err) {
+		errStatus := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			errStatus = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+
+	sendJSON(w, map[string]interface{}{
+		"ignored":        result.Ignored,
+		"unignored":      result.Unignored,
+		"ignoredCount":   result.IgnoredCount,
+		"unignoredCount": result.UnignoredCount,
+		"page":           page,
+		"perpage":        perpage,
+		"error":          errorString(err),
+	})
+}
+
 func (s *service) getIndexEvents(w http.ResponseWriter, r *http.Request) {
 	mask := s.getEventMask(r.URL.Query().Get("events"))
 	sub := s.getEventSub(mask)
@@ -1394,7 +1530,7 @@ func (s *service) getEvents(w http.ResponseWriter, r *http.Request, eventSub eve
 		evs = evs[len(evs)-limit:]
 	}
 
-	sendJSON(w, evs)
+	sendJSON(w, redactedEvents(evs, requestRole(r)))
 }
 
 func (*service) getEventMask(evs string) events.EventType {
@@ -1500,7 +1636,7 @@ func (s *service) makeDevicePauseHandler(paused bool) http.HandlerFunc {
 
 		var msg string
 		var status int
-		_, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		_, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
 			if deviceStr == "" {
 				for i := range cfg.Devices {
 					cfg.Devices[i].Paused = paused
@@ -1533,6 +1669,38 @@ func (s *service) makeDevicePauseHandler(paused bool) http.HandlerFunc {
 	}
 }
 
+func (s *service) makeFolderPauseHandler(paused bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		folder := r.URL.Query().Get("folder")
+
+		var msg string
+		var status int
+		_, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
+			if folder == "" {
+				for i := range cfg.Folders {
+					cfg.Folders[i].Paused = paused
+				}
+				return
+			}
+
+			_, i, ok := cfg.Folder(folder)
+			if !ok {
+				msg = "not found"
+				status = http.StatusNotFound
+				return
+			}
+
+			cfg.Folders[i].Paused = paused
+		})
+
+		if msg != "" {
+			http.Error(w, msg, status)
+		} else if err != nil {
+			http.Error(w, err.Error(), 500)
+		}
+	}
+}
+
 func (s *service) postDBScan(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -1566,6 +1734,23 @@ func (s *service) postDBPrio(w http.ResponseWriter, r *http.Request) {
 	s.getDBNeed(w, r)
 }
 
+func (s *service) postDBFetch(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	files := qs["file"]
+	if len(files) == 0 {
+		http.Error(w, "no files given", http.StatusBadRequest)
+		return
+	}
+	if err := s.model.FetchPlaceholders(qs.Get("folder"), files); err != nil {
+		errStatus := http.StatusInternalServerError
+		if isFolderNotFound(err) || errors.Is(err, model.ErrPlaceholderMissing) {
+			errStatus = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+}
+
 func (*service) getQR(w http.ResponseWriter, r *http.Request) {
 	var qs = r.URL.Query()
 	var text = qs.Get("text")
@@ -1615,7 +1800,22 @@ func (s *service) getFolderVersions(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), 500)
 		return
 	}
-	sendJSON(w, versions)
+	if usage, _ := strconv.ParseBool(qs.Get("usage")); !usage {
+		sendJSON(w, versions)
+		return
+	}
+
+	// With usage requested the versions are wrapped in an object, to keep
+	// the plain response compatible.
+	usage, err := s.model.GetFolderVersionsUsage(qs.Get("folder"))
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"versions": versions,
+		"usage":    usage,
+	})
 }
 
 func (s *service) postFolderVersionsRestore(w http.ResponseWriter, r *http.Request) {
@@ -1643,6 +1843,96 @@ func (s *service) postFolderVersionsRestore(w http.ResponseWriter, r *http.Reque
 	sendJSON(w, errorStringMap(ferr))
 }
 
+func (s *service) getFolderConflicts(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	conflicts, err := s.model.Conflicts(qs.Get("folder"))
+	if err != nil {
+		errStatus := http.StatusInternalServerError
+		if isFolderNotFound(err) {
+			errStatus = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+	sendJSON(w, conflicts)
+}
+
+func (s *service) postFolderConflictResolve(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	err := s.model.ResolveConflict(qs.Get("folder"), qs.Get("conflict"), qs.Get("keep"))
+	if err != nil {
+		errStatus := http.StatusInternalServerError
+		switch {
+		case isFolderNotFound(err), errors.Is(err, model.ErrConflictMissing):
+			errStatus = http.StatusNotFound
+		case qs.Get("keep") != model.ConflictKeepOriginal && qs.Get("keep") != model.ConflictKeepConflict:
+			errStatus = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), errStatus)
+		return
+	}
+}
+
+type folderSelection struct {
+	SelectiveSync bool     `json:"selectiveSync"`
+	SelectedPaths []string `json:"selectedPaths"`
+}
+
+func (s *service) getFolderSelection(w http.ResponseWriter, r *http.Request) {
+	fcfg, ok := s.cfg.Folder(r.URL.Query().Get("folder"))
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	sendJSON(w, folderSelection{fcfg.SelectiveSync, fcfg.SelectedPaths})
+}
+
+func (s *service) postFolderSelection(w http.ResponseWriter, r *http.Request) {
+	s.modifyFolderSelection(w, r, true)
+}
+
+func (s *service) deleteFolderSelection(w http.ResponseWriter, r *http.Request) {
+	s.modifyFolderSelection(w, r, false)
+}
+
+// modifyFolderSelection adds paths to, or removes them from, the selection
+// of a folder for selective sync.
+func (s *service) modifyFolderSelection(w http.ResponseWriter, r *http.Request, add bool) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	paths := qs["path"]
+	if len(paths) == 0 {
+		http.Error(w, "no paths given", http.StatusBadRequest)
+		return
+	}
+
+	var sel folderSelection
+	found := false
+	waiter, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
+		fcfg, i, ok := cfg.Folder(folder)
+		if !ok {
+			return
+		}
+		found = true
+		if add {
+			fcfg.Select(paths...)
+		} else {
+			fcfg.Deselect(paths...)
+		}
+		cfg.Folders[i] = fcfg
+		sel = folderSelection{fcfg.SelectiveSync, fcfg.SelectedPaths}
+	})
+	if !found {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	waiter.Wait()
+	sendJSON(w, sel)
+}
+
 func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	folder := qs.Get("folder")
@@ -0,0 +1,129 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/auditlog"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	auditLogMaxSize  = 10 << 20 // bytes
+	auditLogMaxFiles = 5
+)
+
+// auditedRequest returns whether the request is an administrative action,
+// i.e. one that changes state, that should end up in the audit log.
+func auditedRequest(r *http.Request) bool {
+	if r.Method == "COPY" && strings.HasPrefix(r.URL.Path, webDAVPrefix+"/") {
+		// Restoring a version over WebDAV
+		return true
+	}
+	if !strings.HasPrefix(r.URL.Path, "/rest/") {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return requiredRole(r.Method, r.URL.Path) != config.RoleReadOnly
+}
+
+// auditMiddleware records administrative actions in the audit log, with
+// the changes they made to the configuration through modifyConfig.
+func auditMiddleware(log auditlog.Log, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !auditedRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		changes := &auditChanges{mut: sync.NewMutex()}
+		r = r.WithContext(context.WithValue(r.Context(), auditChangesContextKey{}, changes))
+		sw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		id := requestIdentity(r)
+		log.Record(auditlog.Entry{
+			Time:          time.Now().Truncate(time.Second),
+			User:          id.Username,
+			Role:          id.Role.String(),
+			RemoteAddress: r.RemoteAddr,
+			ForwardedFor:  r.Header.Get("X-Forwarded-For"),
+			Method:        r.Method,
+			Path:          r.URL.Path,
+			Query:         r.URL.RawQuery,
+			Status:        sw.status,
+			Changes:       changes.get(),
+		})
+	})
+}
+
+type auditChangesContextKey struct{}
+
+// auditChanges collects the configuration changes made by a request.
+type auditChanges struct {
+	mut     sync.Mutex
+	changes []auditlog.Change
+}
+
+func (c *auditChanges) add(changes []auditlog.Change) {
+	c.mut.Lock()
+	c.changes = append(c.changes, changes...)
+	c.mut.Unlock()
+}
+
+func (c *auditChanges) get() []auditlog.Change {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.changes
+}
+
+// modifyConfig modifies the configuration on behalf of the caller. The
+// changes are attributed to them in the history, and in the audit log if
+// the request is audited; changes made concurrently by others are not.
+func modifyConfig(r *http.Request, cfg config.Wrapper, fn config.ModifyFunction) (config.Waiter, error) {
+	return modifyConfigWithOrigin(r, cfg, requestOrigin(r), fn)
+}
+
+// modifyConfigWithOrigin is like modifyConfig, with the given origin in
+// the history.
+func modifyConfigWithOrigin(r *http.Request, cfg config.Wrapper, origin string, fn config.ModifyFunction) (config.Waiter, error) {
+	changes, ok := r.Context().Value(auditChangesContextKey{}).(*auditChanges)
+	if !ok {
+		return cfg.ModifyWithOrigin(origin, fn)
+	}
+	waiter, from, to, err := cfg.ModifyCommitted(origin, fn)
+	changes.add(auditlog.Diff(from, to))
+	return waiter, err
+}
+
+// statusResponseWriter remembers the status of the response.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusResponseWriter) Write(bs []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(bs)
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/auditlog"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type memoryAuditLog struct {
+	mut     sync.Mutex
+	entries []auditlog.Entry
+}
+
+func (m *memoryAuditLog) Record(e auditlog.Entry) {
+	m.mut.Lock()
+	m.entries = append(m.entries, e)
+	m.mut.Unlock()
+}
+
+func TestAuditedRequest(t *testing.T) {
+	cases := []struct {
+		method, path string
+		audited      bool
+	}{
+		{http.MethodGet, "/rest/config", false},
+		{http.MethodPut, "/rest/config/folders/default", true},
+		{http.MethodPatch, "/rest/config/devices/x", true},
+		{http.MethodDelete, "/rest/config/folders/default", true},
+		{http.MethodPost, "/rest/system/config", true},
+		{http.MethodPost, "/rest/db/override", true},
+		{http.MethodPost, "/rest/db/revert", true},
+		{http.MethodPost, "/rest/db/ignores", true},
+		{http.MethodPost, "/rest/system/reset", true},
+		{http.MethodPost, "/rest/system/pause", true},
+		{http.MethodPost, "/rest/folder/versions", true},
+		{http.MethodPost, "/rest/system/ping", false},
+		{"COPY", webDAVPrefix + "/default/.stversions/a~20200101-000000.txt", true},
+		{"PROPFIND", webDAVPrefix + "/default/", false},
+		{http.MethodPost, "/qr/", false},
+	}
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.method, tc.path, nil)
+		if audited := auditedRequest(r); audited != tc.audited {
+			t.Errorf("%s %s: audited %v, expected %v", tc.method, tc.path, audited, tc.audited)
+		}
+	}
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Folders = []config.FolderConfiguration{cfg.Defaults.Folder.Copy()}
+	cfg.Folders[0].ID = "default"
+	cfg.Folders[0].Path = "/old"
+	w := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Serve(ctx)
+
+	next := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/config/folders/default" {
+			http.Error(rw, "nope", http.StatusNotFound)
+			return
+		}
+		// Someone else changing the config meanwhile isn't attributed
+		// to this request.
+		waiter, err := w.Modify(func(cfg *config.Configuration) {
+			cfg.Options.MaxSendKbps = 100
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		waiter.Wait()
+		waiter, err = modifyConfig(r, w, func(cfg *config.Configuration) {
+			cfg.Folders[0].Path = "/new"
+			cfg.GUI.Password = "hunter2"
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		waiter.Wait()
+	})
+	log := new(memoryAuditLog)
+	handler := auditMiddleware(log, next)
+
+	r := httptest.NewRequest(http.MethodPatch, "/rest/config/folders/default", nil)
+	r.RemoteAddr = "192.0.2.42:1234"
+	r.Header.Set("X-Forwarded-For", "198.51.100.7")
+	handler.ServeHTTP(httptest.NewRecorder(), withIdentity(r, authIdentity{Username: "alice", Role: config.RoleAdmin}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/rest/config", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), withRole(httptest.NewRequest(http.MethodPost, "/rest/db/override?folder=default", nil), config.RoleOperator))
+
+	if len(log.entries) != 2 {
+		t.Fatalf("expected two entries, got %+v", log.entries)
+	}
+
+	e := log.entries[0]
+	if e.User != "alice" || e.Role != "admin" || e.RemoteAddress != "192.0.2.42:1234" || e.ForwardedFor != "198.51.100.7" || e.Status != http.StatusOK || e.Time.IsZero() {
+		t.Errorf("unexpected entry %+v", e)
+	}
+	expected := []auditlog.Change{
+		{Path: "folders[default].path", Old: "/old", New: "/new"},
+		{Path: "gui.password", Redacted: true},
+	}
+	if len(e.Changes) != len(expected) {
+		t.Fatalf("unexpected changes %+v", e.Changes)
+	}
+	for i := range expected {
+		if e.Changes[i] != expected[i] {
+			t.Errorf("change %d: %+v != expected %+v", i, e.Changes[i], expected[i])
+		}
+	}
+
+	e = log.entries[1]
+	if e.Role != "operator" || e.Path != "/rest/db/override" || e.Query != "folder=default" || e.Status != http.StatusNotFound || len(e.Changes) != 0 {
+		t.Errorf("unexpected entry %+v", e)
+	}
+}
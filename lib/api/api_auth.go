@@ -7,14 +7,11 @@
 package api
 
 import (
-	"crypto/tls"
-	"fmt"
-	"net"
+	"context"
 	"net/http"
 	"strings"
 	"time"
 
-	ldap "github.com/go-ldap/ldap/v3"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/rand"
@@ -22,7 +19,7 @@ import (
 )
 
 var (
-	sessions    = make(map[string]bool)
+	sessions    = make(map[string]authIdentity)
 	sessionsMut = sync.NewMutex()
 )
 
@@ -37,22 +34,88 @@ func emitLoginAttempt(success bool, username, address string, evLogger events.Lo
 	}
 }
 
+// apiKeyRoleFromHeader returns the role of the API key carried by the
+// request, either in the X-API-Key header or as a bearer token in the
+// Authorization header, if it is valid.
+func apiKeyRoleFromHeader(r *http.Request, validator apiKeyValidator) (config.Role, bool) {
+	if role, ok := validator.APIKeyRole(r.Header.Get("X-API-Key")); ok {
+		return role, true
+	}
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(strings.ToLower(auth), "bearer ") {
+		return validator.APIKeyRole(strings.TrimSpace(auth[len("bearer "):]))
+	}
+	return config.RoleReadOnly, false
+}
+
+// authIdentity describes who made a request, as far as we know.
+type authIdentity struct {
+	Username    string      `json:"username"`
+	DisplayName string      `json:"displayName"`
+	Role        config.Role `json:"role"`
+}
+
+type identityContextKey struct{}
+
+// withIdentity returns the request with the identity of the authenticated
+// caller attached.
+func withIdentity(r *http.Request, id authIdentity) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), identityContextKey{}, id))
+}
+
+// withRole is like withIdentity, for callers known only by their role such
+// as API keys.
+func withRole(r *http.Request, role config.Role) *http.Request {
+	return withIdentity(r, authIdentity{Role: role})
+}
+
+// requestIdentity returns the identity of the caller. Requests that weren't
+// authenticated as someone in particular got in because authentication is
+// disabled, and have full access.
+func requestIdentity(r *http.Request) authIdentity {
+	if id, ok := r.Context().Value(identityContextKey{}).(authIdentity); ok {
+		return id
+	}
+	return authIdentity{Role: config.RoleAdmin}
+}
+
+// requestOrigin describes the caller as the origin of configuration
+// changes, like "api:alice", or just "api" if we don't know who it is.
+func requestOrigin(r *http.Request) string {
+	if id := requestIdentity(r); id.Username != "" {
+		return "api:" + id.Username
+	}
+	return "api"
+}
+
+// requestRole returns the role of the caller.
+func requestRole(r *http.Request) config.Role {
+	return requestIdentity(r).Role
+}
+
+// apiKeyMiddleware rejects requests without a valid API key. It's used for
+// endpoints outside of /rest that aren't protected by CSRF tokens, when GUI
+// authentication is disabled.
+func apiKeyMiddleware(validator apiKeyValidator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, ok := apiKeyRoleFromHeader(r, validator)
+		if !ok {
+			http.Error(w, "Not Authorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, withRole(r, role))
+	})
+}
+
 func basicAuthAndSessionMiddleware(cookieName string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration, next http.Handler, evLogger events.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if guiCfg.IsValidAPIKey(r.Header.Get("X-API-Key")) {
-			next.ServeHTTP(w, r)
+		if role, ok := apiKeyRoleFromHeader(r, guiCfg); ok {
+			next.ServeHTTP(w, withRole(r, role))
 			return
 		}
 
-		cookie, err := r.Cookie(cookieName)
-		if err == nil && cookie != nil {
-			sessionsMut.Lock()
-			_, ok := sessions[cookie.Value]
-			sessionsMut.Unlock()
-			if ok {
-				next.ServeHTTP(w, r)
-				return
-			}
+		if id, ok := sessionFromCookie(r, cookieName); ok {
+			next.ServeHTTP(w, withIdentity(r, id))
+			return
 		}
 
 		l.Debugln("Sessionless HTTP request with authentication; this is expensive.")
@@ -69,11 +132,11 @@ func basicAuthAndSessionMiddleware(cookieName string, guiCfg config.GUIConfigura
 			return
 		}
 
-		authOk := auth(username, password, guiCfg, ldapCfg)
+		id, authOk := auth(username, password, guiCfg, ldapCfg)
 		if !authOk {
 			usernameIso := string(iso88591ToUTF8([]byte(username)))
 			passwordIso := string(iso88591ToUTF8([]byte(password)))
-			authOk = auth(usernameIso, passwordIso, guiCfg, ldapCfg)
+			id, authOk = auth(usernameIso, passwordIso, guiCfg, ldapCfg)
 			if authOk {
 				username = usernameIso
 			}
@@ -85,112 +148,68 @@ func basicAuthAndSessionMiddleware(cookieName string, guiCfg config.GUIConfigura
 			return
 		}
 
-		sessionid := rand.String(32)
-		sessionsMut.Lock()
-		sessions[sessionid] = true
-		sessionsMut.Unlock()
-
-		// Best effort detection of whether the connection is HTTPS --
-		// either directly to us, or as used by the client towards a reverse
-		// proxy who sends us headers.
-		connectionIsHTTPS := r.TLS != nil ||
-			strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
-			strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
-		// If the connection is HTTPS, or *should* be HTTPS, set the Secure
-		// bit in cookies.
-		useSecureCookie := connectionIsHTTPS || guiCfg.UseTLS()
-
-		http.SetCookie(w, &http.Cookie{
-			Name:   cookieName,
-			Value:  sessionid,
-			MaxAge: 0,
-			Secure: useSecureCookie,
-		})
-
+		startSession(w, r, cookieName, guiCfg, id)
 		emitLoginAttempt(true, username, r.RemoteAddr, evLogger)
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, withIdentity(r, id))
 	})
 }
 
-func auth(username string, password string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration) bool {
-	if guiCfg.AuthMode == config.AuthModeLDAP {
-		return authLDAP(username, password, ldapCfg)
-	} else {
-		return authStatic(username, password, guiCfg)
+// sessionFromCookie returns the identity of the session the request
+// belongs to, if any.
+func sessionFromCookie(r *http.Request, cookieName string) (authIdentity, bool) {
+	cookie, err := r.Cookie(cookieName)
+	if err != nil || cookie == nil {
+		return authIdentity{}, false
 	}
+	sessionsMut.Lock()
+	id, ok := sessions[cookie.Value]
+	sessionsMut.Unlock()
+	return id, ok
 }
 
-func authStatic(username string, password string, guiCfg config.GUIConfiguration) bool {
-	return guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User
+// startSession creates a new session for the given identity and sets the
+// cookie for it.
+func startSession(w http.ResponseWriter, r *http.Request, cookieName string, guiCfg config.GUIConfiguration, id authIdentity) {
+	sessionid := rand.String(32)
+	sessionsMut.Lock()
+	sessions[sessionid] = id
+	sessionsMut.Unlock()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:   cookieName,
+		Value:  sessionid,
+		Path:   "/",
+		MaxAge: 0,
+		Secure: useSecureCookie(r, guiCfg),
+	})
 }
 
-func authLDAP(username string, password string, cfg config.LDAPConfiguration) bool {
-	address := cfg.Address
-	hostname, _, err := net.SplitHostPort(address)
-	if err != nil {
-		hostname = address
-	}
-	var connection *ldap.Conn
-	if cfg.Transport == config.LDAPTransportTLS {
-		connection, err = ldap.DialTLS("tcp", address, &tls.Config{
-			ServerName:         hostname,
-			InsecureSkipVerify: cfg.InsecureSkipVerify,
-		})
-	} else {
-		connection, err = ldap.Dial("tcp", address)
-	}
-
-	if err != nil {
-		l.Warnln("LDAP Dial:", err)
-		return false
-	}
-
-	if cfg.Transport == config.LDAPTransportStartTLS {
-		err = connection.StartTLS(&tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify})
-		if err != nil {
-			l.Warnln("LDAP Start TLS:", err)
-			return false
-		}
-	}
-
-	defer connection.Close()
-
-	err = connection.Bind(fmt.Sprintf(cfg.BindDN, username), password)
-	if err != nil {
-		l.Warnln("LDAP Bind:", err)
-		return false
-	}
-
-	if cfg.SearchFilter == "" && cfg.SearchBaseDN == "" {
-		// We're done here.
-		return true
-	}
-
-	if cfg.SearchFilter == "" || cfg.SearchBaseDN == "" {
-		l.Warnln("LDAP configuration: both searchFilter and searchBaseDN must be set, or neither.")
-		return false
-	}
-
-	// If a search filter and search base is set we do an LDAP search for
-	// the user. If this matches precisely one user then we are good to go.
-	// The search filter uses the same %s interpolation as the bind DN.
+// useSecureCookie returns whether the connection is HTTPS, or *should* be
+// HTTPS, so that cookies should have the Secure bit set.
+func useSecureCookie(r *http.Request, guiCfg config.GUIConfiguration) bool {
+	return connectionIsHTTPS(r) || guiCfg.UseTLS()
+}
 
-	searchString := fmt.Sprintf(cfg.SearchFilter, username)
-	const sizeLimit = 2  // we search for up to two users -- we only want to match one, so getting any number >1 is a failure.
-	const timeLimit = 60 // Search for up to a minute...
-	searchReq := ldap.NewSearchRequest(cfg.SearchBaseDN, ldap.ScopeWholeSubtree, ldap.DerefFindingBaseObj, sizeLimit, timeLimit, false, searchString, nil, nil)
+// connectionIsHTTPS is a best effort detection of whether the connection
+// is HTTPS -- either directly to us, or as used by the client towards a
+// reverse proxy who sends us headers.
+func connectionIsHTTPS(r *http.Request) bool {
+	return r.TLS != nil ||
+		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
+		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
+}
 
-	res, err := connection.Search(searchReq)
-	if err != nil {
-		l.Warnln("LDAP Search:", err)
-		return false
-	}
-	if len(res.Entries) != 1 {
-		l.Infof("Wrong number of LDAP search results, %d != 1", len(res.Entries))
-		return false
+func auth(username string, password string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration) (authIdentity, bool) {
+	if guiCfg.AuthMode == config.AuthModeLDAP {
+		return authLDAP(username, password, ldapCfg)
+	} else {
+		return authStatic(username, password, guiCfg)
 	}
+}
 
-	return true
+func authStatic(username string, password string, guiCfg config.GUIConfiguration) (authIdentity, bool) {
+	role, ok := guiCfg.UserRole(username, password)
+	return authIdentity{Username: username, Role: role}, ok
 }
 
 // Convert an ISO-8859-1 encoded byte string to UTF-8. Works by the
@@ -13,6 +13,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/rand"
 	"github.com/syncthing/syncthing/lib/sync"
@@ -37,7 +38,7 @@ type csrfManager struct {
 }
 
 type apiKeyValidator interface {
-	IsValidAPIKey(key string) bool
+	APIKeyRole(key string) (config.Role, bool)
 }
 
 // Check for CSRF token on /rest/ URLs. If a correct one is not given, reject
@@ -59,11 +60,11 @@ func newCsrfManager(unique string, prefix string, apiKeyValidator apiKeyValidato
 
 func (m *csrfManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Allow requests carrying a valid API key
-	if m.apiKeyValidator.IsValidAPIKey(r.Header.Get("X-API-Key")) {
+	if role, ok := apiKeyRoleFromHeader(r, m.apiKeyValidator); ok {
 		// Set the access-control-allow-origin header for CORS requests
 		// since a valid API key has been provided
 		w.Header().Add("Access-Control-Allow-Origin", "*")
-		m.next.ServeHTTP(w, r)
+		m.next.ServeHTTP(w, withRole(r, role))
 		return
 	}
 
@@ -0,0 +1,124 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// How long to wait for events before sending something to keep the stream
+// alive, and to notice clients that went away.
+const eventStreamKeepalive = 15 * time.Second
+
+// eventsDropped is sent in place of events that the client missed, because
+// it was too slow to keep up or resumed from an event no longer buffered.
+type eventsDropped struct {
+	From  int `json:"from"`
+	To    int `json:"to"`
+	Count int `json:"count"`
+}
+
+// getEventStream streams events as server-sent events. Each event carries
+// its ID, so that clients reconnecting with Last-Event-ID (or since) resume
+// where they left off. Events are read from the same buffered subscription
+// as for long polling, so a slow client never makes us buffer more; when
+// it falls behind the buffer it gets an EventsDropped event covering the
+// gap instead.
+func (s *service) getEventStream(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	eventSub := s.getEventSub(s.getEventMask(qs.Get("events")))
+	folder := qs.Get("folder")
+	role := requestRole(r)
+
+	lastID, _ := strconv.Atoi(qs.Get("since"))
+	if id, err := strconv.Atoi(r.Header.Get("Last-Event-ID")); err == nil {
+		lastID = id
+	}
+	resuming := lastID > 0
+
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("X-Accel-Buffering", "no") // don't let proxies buffer the stream
+	fmt.Fprintf(w, "retry: %d\n\n", (2 * time.Second).Milliseconds())
+	f.Flush()
+
+	ctx := r.Context()
+	for {
+		if eventSub.Mask()&(events.FolderSummary|events.FolderCompletion) != 0 {
+			s.fss.OnEventRequest()
+		}
+
+		evs := redactedEvents(eventSub.Since(lastID, nil, eventStreamKeepalive), role)
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if len(evs) == 0 {
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			f.Flush()
+			continue
+		}
+
+		if first := evs[0].SubscriptionID; resuming && first > lastID+1 {
+			dropped := eventsDropped{From: lastID + 1, To: first - 1, Count: first - lastID - 1}
+			if err := writeServerSentEvent(w, 0, "EventsDropped", dropped); err != nil {
+				return
+			}
+		}
+		resuming = true
+
+		sentID := lastID
+		for _, ev := range evs {
+			lastID = ev.SubscriptionID
+			if folder != "" && ev.Folder() != folder {
+				continue
+			}
+			if err := writeServerSentEvent(w, ev.SubscriptionID, ev.Type.String(), ev); err != nil {
+				return
+			}
+			sentID = lastID
+		}
+		if sentID != lastID {
+			// An ID without data isn't dispatched by the client, but still
+			// moves its Last-Event-ID past the events we filtered out.
+			if _, err := fmt.Fprintf(w, "id: %d\n\n", lastID); err != nil {
+				return
+			}
+		}
+		f.Flush()
+	}
+}
+
+func writeServerSentEvent(w io.Writer, id int, typ string, data interface{}) error {
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	// JSON never contains raw newlines, so it fits on one data line.
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", typ, bs)
+	return err
+}
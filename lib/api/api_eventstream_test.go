@@ -0,0 +1,122 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	modelmocks "github.com/syncthing/syncthing/lib/model/mocks"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func TestEventStream(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	// A tiny buffer, so that the first event is dropped by the time we
+	// connect.
+	mask := events.StateChanged | events.LocalIndexUpdated
+	sub := events.NewBufferedSubscription(evLogger.Subscribe(mask), 3)
+	svc := &service{
+		evLogger:     evLogger,
+		eventSubs:    map[events.EventType]events.BufferedSubscription{mask: sub},
+		eventSubsMut: sync.NewMutex(),
+		fss:          new(modelmocks.FolderSummaryService),
+	}
+
+	for _, folder := range []string{"a", "b", "a", "a", "b"} {
+		evLogger.Log(events.StateChanged, map[string]interface{}{"folder": folder})
+	}
+	if evs := sub.Since(4, nil, time.Minute); len(evs) == 0 {
+		t.Fatal("timed out waiting for events")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(svc.getEventStream))
+	defer func() {
+		// The handler notices we're gone once it wakes up from waiting
+		// for events, so keep it busy until the server has closed.
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-time.After(10 * time.Millisecond):
+					evLogger.Log(events.StateChanged, nil)
+				}
+			}
+		}()
+		srv.Close()
+	}()
+
+	reqCtx, reqCancel := context.WithTimeout(ctx, time.Minute)
+	defer reqCancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, srv.URL+"?events=StateChanged,LocalIndexUpdated&folder=a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if scanner.Text() == "id: 5" {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	reqCancel()
+
+	expected := []string{
+		"retry: 2000",
+		"",
+		"event: EventsDropped",
+		`data: {"from":2,"to":2,"count":1}`,
+		"",
+		"id: 3",
+		"event: StateChanged",
+		"data: ",
+		"",
+		"id: 4",
+		"event: StateChanged",
+		"data: ",
+		"",
+		"id: 5",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("unexpected stream:\n%s", strings.Join(lines, "\n"))
+	}
+	for i := range expected {
+		if !strings.HasPrefix(lines[i], expected[i]) {
+			t.Errorf("line %d: got %q, expected %q", i, lines[i], expected[i])
+		}
+	}
+	if !strings.Contains(lines[7], `"folder":"a"`) {
+		t.Errorf("unexpected event data %q", lines[7])
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	ldap "github.com/go-ldap/ldap/v3"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// maxLDAPNestedGroups limits how many groups we look at when resolving
+// nested group membership, in case of loops or very deep hierarchies.
+const maxLDAPNestedGroups = 100
+
+// ldapConn is the part of *ldap.Conn that we use.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+// dialLDAP connects to the LDAP server. It's a variable so that tests can
+// replace the server with a stand-in.
+var dialLDAP = func(cfg config.LDAPConfiguration) (ldapConn, error) {
+	address := cfg.Address
+	hostname, _, err := net.SplitHostPort(address)
+	if err != nil {
+		hostname = address
+	}
+	var connection *ldap.Conn
+	if cfg.Transport == config.LDAPTransportTLS {
+		connection, err = ldap.DialTLS("tcp", address, &tls.Config{
+			ServerName:         hostname,
+			InsecureSkipVerify: cfg.InsecureSkipVerify,
+		})
+	} else {
+		connection, err = ldap.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, &ldapUnavailableError{fmt.Errorf("LDAP Dial: %w", err)}
+	}
+
+	if cfg.Transport == config.LDAPTransportStartTLS {
+		err = connection.StartTLS(&tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify})
+		if err != nil {
+			connection.Close()
+			return nil, fmt.Errorf("LDAP Start TLS: %w", err)
+		}
+	}
+
+	return connection, nil
+}
+
+// ldapUnavailableError means the LDAP server couldn't be asked, as opposed
+// to it having said no.
+type ldapUnavailableError struct {
+	err error
+}
+
+func (e *ldapUnavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ldapUnavailableError) Unwrap() error {
+	return e.err
+}
+
+// ldapError wraps an error returned by the LDAP server, marking it as
+// unavailable if it's a network problem or the server says so.
+func ldapError(op string, err error) error {
+	// IsErrorAnyOf doesn't unwrap, so classify before wrapping.
+	unavailable := ldap.IsErrorAnyOf(err, ldap.ErrorNetwork, ldap.LDAPResultBusy, ldap.LDAPResultUnavailable)
+	err = fmt.Errorf("LDAP %s: %w", op, err)
+	if unavailable {
+		return &ldapUnavailableError{err}
+	}
+	return err
+}
+
+func authLDAP(username string, password string, cfg config.LDAPConfiguration) (authIdentity, bool) {
+	id, err := ldapAuthenticate(username, password, cfg)
+	if err == nil {
+		ldapBinds.remember(cfg, username, password, id)
+		return id, true
+	}
+
+	var unavailable *ldapUnavailableError
+	if errors.As(err, &unavailable) {
+		if id, ok := ldapBinds.lookup(cfg, username, password); ok {
+			l.Infof("Accepting cached LDAP login for %q: %v", username, err)
+			return id, true
+		}
+	} else {
+		ldapBinds.forget(cfg, username)
+	}
+	l.Warnln(err)
+	return authIdentity{}, false
+}
+
+func ldapAuthenticate(username string, password string, cfg config.LDAPConfiguration) (authIdentity, error) {
+	connection, err := dialLDAP(cfg)
+	if err != nil {
+		return authIdentity{}, err
+	}
+	defer connection.Close()
+
+	if err := connection.Bind(fmt.Sprintf(cfg.BindDN, username), password); err != nil {
+		return authIdentity{}, ldapError("Bind", err)
+	}
+
+	id := authIdentity{Username: username, Role: config.RoleAdmin}
+
+	if cfg.SearchFilter == "" && cfg.SearchBaseDN == "" {
+		if len(cfg.GroupRoles) > 0 || cfg.RequiredGroup != "" {
+			return authIdentity{}, errors.New("LDAP configuration: searchFilter and searchBaseDN must be set to use group roles or a required group")
+		}
+		// We're done here.
+		return id, nil
+	}
+
+	if cfg.SearchFilter == "" || cfg.SearchBaseDN == "" {
+		return authIdentity{}, errors.New("LDAP configuration: both searchFilter and searchBaseDN must be set, or neither")
+	}
+
+	// If a search filter and search base is set we do an LDAP search for
+	// the user. If this matches precisely one user then we are good to go.
+	// The search filter uses the same %s interpolation as the bind DN.
+
+	searchString := fmt.Sprintf(cfg.SearchFilter, username)
+	const sizeLimit = 2  // we search for up to two users -- we only want to match one, so getting any number >1 is a failure.
+	const timeLimit = 60 // Search for up to a minute...
+	var attributes []string
+	if cfg.MemberAttribute != "" {
+		attributes = append(attributes, cfg.MemberAttribute)
+	}
+	if cfg.DisplayNameAttribute != "" {
+		attributes = append(attributes, cfg.DisplayNameAttribute)
+	}
+	searchReq := ldap.NewSearchRequest(cfg.SearchBaseDN, ldap.ScopeWholeSubtree, ldap.DerefFindingBaseObj, sizeLimit, timeLimit, false, searchString, attributes, nil)
+
+	res, err := connection.Search(searchReq)
+	if err != nil {
+		return authIdentity{}, ldapError("Search", err)
+	}
+	if len(res.Entries) != 1 {
+		return authIdentity{}, fmt.Errorf("wrong number of LDAP search results, %d != 1", len(res.Entries))
+	}
+	entry := res.Entries[0]
+	if cfg.DisplayNameAttribute != "" {
+		id.DisplayName = entry.GetAttributeValue(cfg.DisplayNameAttribute)
+	}
+
+	if len(cfg.GroupRoles) == 0 && cfg.RequiredGroup == "" {
+		return id, nil
+	}
+
+	groups, err := ldapNestedGroups(connection, cfg.MemberAttribute, entry.GetAttributeValues(cfg.MemberAttribute))
+	if err != nil {
+		return authIdentity{}, err
+	}
+
+	if cfg.RequiredGroup != "" && !containsFold(groups, cfg.RequiredGroup) {
+		return authIdentity{}, fmt.Errorf("LDAP user %q is not a member of the required group", username)
+	}
+
+	role, ok := cfg.GroupsRole(groups)
+	if !ok {
+		return authIdentity{}, fmt.Errorf("LDAP user %q is not a member of any group with a role", username)
+	}
+	id.Role = role
+	return id, nil
+}
+
+// ldapNestedGroups returns the given groups together with the groups they
+// are in turn members of, as given by the member attribute of each group.
+func ldapNestedGroups(connection ldapConn, memberAttribute string, groups []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(groups))
+	var all []string
+	queue := groups
+	for len(queue) > 0 && len(all) < maxLDAPNestedGroups {
+		group := queue[0]
+		queue = queue[1:]
+		key := strings.ToLower(group)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		all = append(all, group)
+
+		searchReq := ldap.NewSearchRequest(group, ldap.ScopeBaseObject, ldap.NeverDerefAliases, 1, 60, false, "(objectClass=*)", []string{memberAttribute}, nil)
+		res, err := connection.Search(searchReq)
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			// Groups may live somewhere we can't look; that's fine.
+			continue
+		} else if err != nil {
+			return nil, ldapError("Search", err)
+		}
+		for _, entry := range res.Entries {
+			queue = append(queue, entry.GetAttributeValues(memberAttribute)...)
+		}
+	}
+	return all, nil
+}
+
+func containsFold(ss []string, s string) bool {
+	for _, v := range ss {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ldapBindCache remembers successful LDAP logins for a while, so that they
+// can be accepted while the LDAP server is unreachable. Passwords are kept
+// only as a keyed hash, with a key that doesn't outlive the process.
+type ldapBindCache struct {
+	key     []byte
+	mut     sync.Mutex
+	entries map[string]ldapBindCacheEntry
+}
+
+type ldapBindCacheEntry struct {
+	cfg     config.LDAPConfiguration
+	mac     []byte
+	id      authIdentity
+	expires time.Time
+}
+
+var ldapBinds = newLDAPBindCache()
+
+func newLDAPBindCache() *ldapBindCache {
+	return &ldapBindCache{
+		key:     []byte(rand.String(32)),
+		mut:     sync.NewMutex(),
+		entries: make(map[string]ldapBindCacheEntry),
+	}
+}
+
+func (c *ldapBindCache) remember(cfg config.LDAPConfiguration, username, password string, id authIdentity) {
+	ttl := cfg.BindCacheTTL()
+	if ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[c.entryKey(cfg, username)] = ldapBindCacheEntry{
+		cfg:     cfg.Copy(),
+		mac:     c.mac(password),
+		id:      id,
+		expires: now.Add(ttl),
+	}
+}
+
+func (c *ldapBindCache) lookup(cfg config.LDAPConfiguration, username, password string) (authIdentity, bool) {
+	if cfg.BindCacheTTL() <= 0 {
+		return authIdentity{}, false
+	}
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	key := c.entryKey(cfg, username)
+	e, ok := c.entries[key]
+	if !ok {
+		return authIdentity{}, false
+	}
+	if time.Now().After(e.expires) || !reflect.DeepEqual(e.cfg, cfg.Copy()) {
+		// Expired, or logged in under rules that no longer apply.
+		delete(c.entries, key)
+		return authIdentity{}, false
+	}
+	if !hmac.Equal(e.mac, c.mac(password)) {
+		return authIdentity{}, false
+	}
+	return e.id, true
+}
+
+func (c *ldapBindCache) forget(cfg config.LDAPConfiguration, username string) {
+	c.mut.Lock()
+	delete(c.entries, c.entryKey(cfg, username))
+	c.mut.Unlock()
+}
+
+func (*ldapBindCache) entryKey(cfg config.LDAPConfiguration, username string) string {
+	return cfg.Address + "\x00" + username
+}
+
+func (c *ldapBindCache) mac(password string) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write([]byte(password))
+	return h.Sum(nil)
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ldap "github.com/go-ldap/ldap/v3"
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// fakeLDAP is an in-process stand-in for an LDAP directory. It knows
+// entries by DN and understands binds, base object searches and searches
+// for a filter of the form (attr=value).
+type fakeLDAP struct {
+	passwords map[string]string
+	entries   map[string]map[string][]string
+	down      bool
+	bindErr   error // returned by Bind, if set
+	searchErr error // returned by Search, if set
+}
+
+func (f *fakeLDAP) dial(config.LDAPConfiguration) (ldapConn, error) {
+	if f.down {
+		return nil, &ldapUnavailableError{errors.New("connection refused")}
+	}
+	return &fakeLDAPConn{f}, nil
+}
+
+type fakeLDAPConn struct {
+	*fakeLDAP
+}
+
+func (c *fakeLDAPConn) Bind(username, password string) error {
+	if c.bindErr != nil {
+		return c.bindErr
+	}
+	if pw, ok := c.passwords[strings.ToLower(username)]; !ok || pw != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	return nil
+}
+
+func (c *fakeLDAPConn) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	if c.searchErr != nil {
+		return nil, c.searchErr
+	}
+	res := &ldap.SearchResult{}
+	if req.Scope == ldap.ScopeBaseObject {
+		attrs, ok := c.entries[strings.ToLower(req.BaseDN)]
+		if !ok {
+			return nil, ldap.NewError(ldap.LDAPResultNoSuchObject, errors.New("no such object"))
+		}
+		res.Entries = append(res.Entries, ldap.NewEntry(req.BaseDN, attrs))
+		return res, nil
+	}
+	attr, value, _ := strings.Cut(strings.Trim(req.Filter, "()"), "=")
+	for dn, attrs := range c.entries {
+		for _, v := range attrs[attr] {
+			if strings.EqualFold(v, value) {
+				res.Entries = append(res.Entries, ldap.NewEntry(dn, attrs))
+			}
+		}
+	}
+	return res, nil
+}
+
+func (*fakeLDAPConn) Close() {}
+
+func newFakeLDAP(t *testing.T) *fakeLDAP {
+	f := &fakeLDAP{
+		passwords: map[string]string{
+			"uid=alice,ou=people,dc=example": "alice-pw",
+			"uid=bob,ou=people,dc=example":   "bob-pw",
+			"uid=carol,ou=people,dc=example": "carol-pw",
+		},
+		entries: map[string]map[string][]string{
+			"uid=alice,ou=people,dc=example": {
+				"uid":         {"alice"},
+				"displayName": {"Alice Admin"},
+				"memberOf":    {"cn=admins,ou=groups,dc=example"},
+			},
+			"uid=bob,ou=people,dc=example": {
+				"uid":         {"bob"},
+				"displayName": {"Bob Builder"},
+				"memberOf":    {"cn=builders,ou=groups,dc=example"},
+			},
+			"uid=carol,ou=people,dc=example": {
+				"uid":         {"carol"},
+				"displayName": {"Carol"},
+			},
+			"cn=admins,ou=groups,dc=example": {
+				"memberOf": {"cn=syncthing,ou=groups,dc=example"},
+			},
+			"cn=builders,ou=groups,dc=example": {
+				"memberOf": {"cn=operators,ou=groups,dc=example"},
+			},
+			"cn=operators,ou=groups,dc=example": {
+				// A loop, which must not trip us up.
+				"memberOf": {"cn=syncthing,ou=groups,dc=example", "cn=builders,ou=groups,dc=example"},
+			},
+			"cn=syncthing,ou=groups,dc=example": {},
+		},
+	}
+	oldDial, oldBinds := dialLDAP, ldapBinds
+	dialLDAP = f.dial
+	ldapBinds = newLDAPBindCache()
+	t.Cleanup(func() {
+		dialLDAP, ldapBinds = oldDial, oldBinds
+	})
+	return f
+}
+
+func testLDAPConfig() config.LDAPConfiguration {
+	return config.LDAPConfiguration{
+		Address:              "ldap.example:389",
+		BindDN:               "uid=%s,ou=people,dc=example",
+		SearchBaseDN:         "ou=people,dc=example",
+		SearchFilter:         "(uid=%s)",
+		MemberAttribute:      "memberOf",
+		DisplayNameAttribute: "displayName",
+	}
+}
+
+func TestLDAPRequiredGroup(t *testing.T) {
+	newFakeLDAP(t)
+	cfg := testLDAPConfig()
+	cfg.RequiredGroup = "CN=Syncthing,OU=Groups,DC=Example"
+	cfg.GroupRoles = []config.LDAPGroupRole{
+		{Group: "cn=admins,ou=groups,dc=example", Role: config.RoleAdmin},
+		{Group: "cn=operators,ou=groups,dc=example", Role: config.RoleOperator},
+	}
+
+	// Alice is in the required group through admins, and Bob through
+	// builders and operators. Carol isn't in any group.
+	cases := []struct {
+		user, password string
+		ok             bool
+		id             authIdentity
+	}{
+		{"alice", "alice-pw", true, authIdentity{Username: "alice", DisplayName: "Alice Admin", Role: config.RoleAdmin}},
+		{"bob", "bob-pw", true, authIdentity{Username: "bob", DisplayName: "Bob Builder", Role: config.RoleOperator}},
+		{"carol", "carol-pw", false, authIdentity{}},
+		{"alice", "wrong", false, authIdentity{}},
+	}
+	for _, tc := range cases {
+		id, ok := authLDAP(tc.user, tc.password, cfg)
+		if ok != tc.ok || id != tc.id {
+			t.Errorf("%s: got %+v, %v; expected %+v, %v", tc.user, id, ok, tc.id, tc.ok)
+		}
+	}
+
+	// Without a required group or group roles Carol gets in as well.
+	cfg.RequiredGroup = ""
+	cfg.GroupRoles = nil
+	if id, ok := authLDAP("carol", "carol-pw", cfg); !ok || id.Role != config.RoleAdmin || id.DisplayName != "Carol" {
+		t.Errorf("carol: got %+v, %v", id, ok)
+	}
+
+	// A required group without a search can't be checked.
+	cfg.RequiredGroup = "cn=syncthing,ou=groups,dc=example"
+	cfg.SearchBaseDN = ""
+	cfg.SearchFilter = ""
+	if _, ok := authLDAP("alice", "alice-pw", cfg); ok {
+		t.Error("required group should need a search")
+	}
+}
+
+func TestLDAPBindCache(t *testing.T) {
+	f := newFakeLDAP(t)
+	cfg := testLDAPConfig()
+
+	// Without a TTL nothing is cached.
+	if _, ok := authLDAP("alice", "alice-pw", cfg); !ok {
+		t.Fatal("alice should log in")
+	}
+	f.down = true
+	if _, ok := authLDAP("alice", "alice-pw", cfg); ok {
+		t.Fatal("alice shouldn't log in when the server is down and there's no cache")
+	}
+
+	cfg.BindCacheTTLS = 3600
+	f.down = false
+	if _, ok := authLDAP("alice", "alice-pw", cfg); !ok {
+		t.Fatal("alice should log in")
+	}
+
+	f.down = true
+	id, ok := authLDAP("alice", "alice-pw", cfg)
+	if !ok || id.DisplayName != "Alice Admin" {
+		t.Fatalf("cached login should succeed, got %+v, %v", id, ok)
+	}
+	if _, ok := authLDAP("alice", "wrong", cfg); ok {
+		t.Error("cached login with the wrong password should fail")
+	}
+	if _, ok := authLDAP("bob", "bob-pw", cfg); ok {
+		t.Error("bob never logged in and shouldn't get in")
+	}
+
+	// The connection may also fail after it was made, or the server may
+	// tell us it's too busy to answer.
+	f.down = false
+	for _, err := range []error{
+		ldap.NewError(ldap.ErrorNetwork, errors.New("connection reset")),
+		ldap.NewError(ldap.LDAPResultBusy, errors.New("busy")),
+		ldap.NewError(ldap.LDAPResultUnavailable, errors.New("unavailable")),
+	} {
+		f.bindErr = err
+		if _, ok := authLDAP("alice", "alice-pw", cfg); !ok {
+			t.Errorf("cached login should succeed when Bind fails with %v", err)
+		}
+		f.bindErr = nil
+		f.searchErr = err
+		if _, ok := authLDAP("alice", "alice-pw", cfg); !ok {
+			t.Errorf("cached login should succeed when Search fails with %v", err)
+		}
+		f.searchErr = nil
+	}
+	f.down = true
+	changed := cfg
+	changed.RequiredGroup = "cn=syncthing,ou=groups,dc=example"
+	if _, ok := authLDAP("alice", "alice-pw", changed); ok {
+		t.Error("cached login from before a configuration change should fail")
+	}
+
+	// The password was changed in the directory; once the server tells us
+	// so, the cached login is gone.
+	f.down = false
+	if _, ok := authLDAP("bob", "bob-pw", cfg); !ok {
+		t.Fatal("bob should log in")
+	}
+	f.passwords["uid=bob,ou=people,dc=example"] = "new-pw"
+	if _, ok := authLDAP("bob", "bob-pw", cfg); ok {
+		t.Fatal("bob's old password should be rejected")
+	}
+	f.down = true
+	if _, ok := authLDAP("bob", "bob-pw", cfg); ok {
+		t.Error("rejected login should have been removed from the cache")
+	}
+}
+
+func TestLDAPBindCacheExpiry(t *testing.T) {
+	c := newLDAPBindCache()
+	cfg := testLDAPConfig()
+	cfg.BindCacheTTLS = 3600
+	c.remember(cfg, "alice", "pw", authIdentity{Username: "alice"})
+	if _, ok := c.lookup(cfg, "alice", "pw"); !ok {
+		t.Fatal("should be cached")
+	}
+	e := c.entries[c.entryKey(cfg, "alice")]
+	e.expires = e.expires.Add(-2 * cfg.BindCacheTTL())
+	c.entries[c.entryKey(cfg, "alice")] = e
+	if _, ok := c.lookup(cfg, "alice", "pw"); ok {
+		t.Error("should have expired")
+	}
+}
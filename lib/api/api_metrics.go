@@ -0,0 +1,184 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/model"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	descFolderState = prometheus.NewDesc(
+		"syncthing_model_folder_state",
+		"Current folder state; the value is always one and the state is in the label",
+		[]string{"folder", "state"}, nil)
+	descFolderFiles = prometheus.NewDesc(
+		"syncthing_model_folder_files",
+		"Number of files, directories and symlinks, per folder ID and scope (global, local, need)",
+		[]string{"folder", "scope"}, nil)
+	descFolderDeleted = prometheus.NewDesc(
+		"syncthing_model_folder_deleted",
+		"Number of deleted items, per folder ID and scope (global, local, need)",
+		[]string{"folder", "scope"}, nil)
+	descFolderBytes = prometheus.NewDesc(
+		"syncthing_model_folder_bytes",
+		"Number of bytes, per folder ID and scope (global, local, need)",
+		[]string{"folder", "scope"}, nil)
+	descFolderSequence = prometheus.NewDesc(
+		"syncthing_model_folder_sequence",
+		"Current local sequence number, per folder ID",
+		[]string{"folder"}, nil)
+	descFolderCompletion = prometheus.NewDesc(
+		"syncthing_model_folder_completion_percent",
+		"Completion percentage of a folder on a remote device",
+		[]string{"folder", "device"}, nil)
+	descDeviceConnected = prometheus.NewDesc(
+		"syncthing_connections_device_connected",
+		"Whether the device is currently connected (1) or not (0)",
+		[]string{"device", "type"}, nil)
+	descDevicePaused = prometheus.NewDesc(
+		"syncthing_connections_device_paused",
+		"Whether the device is paused (1) or not (0)",
+		[]string{"device"}, nil)
+	descDeviceInBytes = prometheus.NewDesc(
+		"syncthing_connections_device_in_bytes_total",
+		"Total number of bytes received from the device on the current connection",
+		[]string{"device"}, nil)
+	descDeviceOutBytes = prometheus.NewDesc(
+		"syncthing_connections_device_out_bytes_total",
+		"Total number of bytes sent to the device on the current connection",
+		[]string{"device"}, nil)
+	descTotalInBytes = prometheus.NewDesc(
+		"syncthing_connections_in_bytes_total",
+		"Total number of bytes received from all devices",
+		nil, nil)
+	descTotalOutBytes = prometheus.NewDesc(
+		"syncthing_connections_out_bytes_total",
+		"Total number of bytes sent to all devices",
+		nil, nil)
+	descListenerOK = prometheus.NewDesc(
+		"syncthing_connections_listener_ok",
+		"Whether the listener is running without error (1) or not (0)",
+		[]string{"listener"}, nil)
+)
+
+// metricsCollector exports the state that is cheaper to compute at scrape
+// time than to keep updated: folder sizes from the database, completion
+// and connection statistics.
+type metricsCollector struct {
+	cfg                config.Wrapper
+	model              model.Model
+	connectionsService connections.Service
+}
+
+func newMetricsHandler(cfg config.Wrapper, m model.Model, cs connections.Service) http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&metricsCollector{
+		cfg:                cfg,
+		model:              m,
+		connectionsService: cs,
+	})
+	gatherers := prometheus.Gatherers{prometheus.DefaultGatherer, reg}
+	return promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})
+}
+
+func (c *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descFolderState
+	ch <- descFolderFiles
+	ch <- descFolderDeleted
+	ch <- descFolderBytes
+	ch <- descFolderSequence
+	ch <- descFolderCompletion
+	ch <- descDeviceConnected
+	ch <- descDevicePaused
+	ch <- descDeviceInBytes
+	ch <- descDeviceOutBytes
+	ch <- descTotalInBytes
+	ch <- descTotalOutBytes
+	ch <- descListenerOK
+}
+
+func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectFolders(ch)
+	c.collectConnections(ch)
+}
+
+func (c *metricsCollector) collectFolders(ch chan<- prometheus.Metric) {
+	for id, folderCfg := range c.cfg.Folders() {
+		state, _, err := c.model.State(id)
+		if err != nil {
+			// The folder is not running (yet), nothing to report.
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(descFolderState, prometheus.GaugeValue, 1, id, state)
+
+		snap, err := c.model.DBSnapshot(id)
+		if err != nil {
+			continue
+		}
+		for scope, counts := range map[string]db.Counts{
+			"global": snap.GlobalSize(),
+			"local":  snap.LocalSize(),
+			"need":   snap.NeedSize(protocol.LocalDeviceID),
+		} {
+			ch <- prometheus.MustNewConstMetric(descFolderFiles, prometheus.GaugeValue, float64(counts.Files+counts.Directories+counts.Symlinks), id, scope)
+			ch <- prometheus.MustNewConstMetric(descFolderDeleted, prometheus.GaugeValue, float64(counts.Deleted), id, scope)
+			ch <- prometheus.MustNewConstMetric(descFolderBytes, prometheus.GaugeValue, float64(counts.Bytes), id, scope)
+		}
+		ch <- prometheus.MustNewConstMetric(descFolderSequence, prometheus.GaugeValue, float64(snap.Sequence(protocol.LocalDeviceID)), id)
+		snap.Release()
+
+		for _, device := range folderCfg.DeviceIDs() {
+			if device == c.cfg.MyID() {
+				continue
+			}
+			comp, err := c.model.Completion(device, id)
+			if err != nil {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(descFolderCompletion, prometheus.GaugeValue, comp.CompletionPct, id, device.String())
+		}
+	}
+}
+
+func (c *metricsCollector) collectConnections(ch chan<- prometheus.Metric) {
+	stats := c.model.ConnectionStats()
+	if conns, ok := stats["connections"].(map[string]model.ConnectionInfo); ok {
+		for device, ci := range conns {
+			ch <- prometheus.MustNewConstMetric(descDeviceConnected, prometheus.GaugeValue, boolToFloat(ci.Connected), device, ci.Type)
+			ch <- prometheus.MustNewConstMetric(descDevicePaused, prometheus.GaugeValue, boolToFloat(ci.Paused), device)
+			ch <- prometheus.MustNewConstMetric(descDeviceInBytes, prometheus.CounterValue, float64(ci.InBytesTotal), device)
+			ch <- prometheus.MustNewConstMetric(descDeviceOutBytes, prometheus.CounterValue, float64(ci.OutBytesTotal), device)
+		}
+	}
+
+	in, out := protocol.TotalInOut()
+	ch <- prometheus.MustNewConstMetric(descTotalInBytes, prometheus.CounterValue, float64(in))
+	ch <- prometheus.MustNewConstMetric(descTotalOutBytes, prometheus.CounterValue, float64(out))
+
+	if c.connectionsService == nil {
+		return
+	}
+	for listener, status := range c.connectionsService.ListenerStatus() {
+		ch <- prometheus.MustNewConstMetric(descListenerOK, prometheus.GaugeValue, boolToFloat(status.Error == nil), listener)
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,672 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	oidcLoginPath    = "/oidc/login"
+	oidcCallbackPath = "/oidc/callback"
+
+	oidcLoginTimeout    = 10 * time.Minute // how long the user has to log in at the provider
+	oidcMetadataMaxAge  = time.Hour        // how long provider metadata and keys are cached
+	oidcKeysMinInterval = time.Minute      // how often unknown key IDs may trigger a refresh
+	oidcClockSkew       = time.Minute      // allowed clock difference to the provider
+	oidcMaxResponseSize = 1 << 20
+)
+
+// oidcAuthMiddleware authenticates users using OpenID Connect, with the
+// authorization code flow and PKCE. Users that are logged in get the same
+// kind of session as with basic authentication; others are sent to the
+// provider to log in.
+func oidcAuthMiddleware(cookieName string, guiCfg config.GUIConfiguration, oidc *oidcAuthenticator, next http.Handler, evLogger events.Logger) http.Handler {
+	stateCookieName := cookieName + "-oidc"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if role, ok := apiKeyRoleFromHeader(r, guiCfg); ok {
+			next.ServeHTTP(w, withRole(r, role))
+			return
+		}
+
+		if id, ok := sessionFromCookie(r, cookieName); ok {
+			next.ServeHTTP(w, withIdentity(r, id))
+			return
+		}
+
+		switch r.URL.Path {
+		case oidcLoginPath:
+			authURL, sealed, err := oidc.startLogin(r)
+			if err != nil {
+				l.Warnln("OIDC login:", err)
+				http.Error(w, "Login unavailable", http.StatusServiceUnavailable)
+				return
+			}
+			// The login in progress is kept by the browser that started
+			// it, so that nobody can log someone else in with their own
+			// account, and so that starting logins costs us nothing.
+			http.SetCookie(w, &http.Cookie{
+				Name:     stateCookieName,
+				Value:    sealed,
+				Path:     oidcCallbackPath,
+				MaxAge:   int(oidcLoginTimeout / time.Second),
+				Secure:   useSecureCookie(r, guiCfg),
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			})
+			http.Redirect(w, r, authURL, http.StatusSeeOther)
+			return
+
+		case oidcCallbackPath:
+			http.SetCookie(w, &http.Cookie{
+				Name:   stateCookieName,
+				Path:   oidcCallbackPath,
+				MaxAge: -1,
+			})
+			var sealed string
+			if cookie, err := r.Cookie(stateCookieName); err == nil {
+				sealed = cookie.Value
+			}
+			id, err := oidc.finishLogin(r, sealed)
+			if err != nil {
+				l.Infoln("OIDC login:", err)
+				emitLoginAttempt(false, id.Username, r.RemoteAddr, evLogger)
+				http.Error(w, "Not Authorized", http.StatusUnauthorized)
+				return
+			}
+			startSession(w, r, cookieName, guiCfg, id)
+			emitLoginAttempt(true, id.Username, r.RemoteAddr, evLogger)
+			http.Redirect(w, r, "/", http.StatusSeeOther)
+			return
+		}
+
+		// API requests can't follow a login flow, but browsers can.
+		if strings.HasPrefix(r.URL.Path, "/rest/") || r.Method != http.MethodGet {
+			http.Error(w, "Not Authorized", http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, oidcLoginPath, http.StatusSeeOther)
+	})
+}
+
+// oidcAuthenticator talks to the OpenID provider. Logins in progress are
+// kept in a cookie, sealed with a key that doesn't outlive the process.
+type oidcAuthenticator struct {
+	cfg      config.OIDCConfiguration
+	client   *http.Client
+	stateKey []byte
+
+	mut        sync.Mutex
+	metadata   *oidcProviderMetadata
+	metadataAt time.Time
+	keys       map[string]crypto.PublicKey
+	keysAt     time.Time
+	timeNow    func() time.Time
+}
+
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcPendingLogin struct {
+	State       string    `json:"state"`
+	Nonce       string    `json:"nonce"`
+	Verifier    string    `json:"verifier"`
+	RedirectURL string    `json:"redirectURL"`
+	Expires     time.Time `json:"expires"`
+}
+
+func newOIDCAuthenticator(cfg config.OIDCConfiguration) *oidcAuthenticator {
+	if len(cfg.AllowedUsers) == 0 && len(cfg.AllowedGroups) == 0 {
+		l.Warnln("OIDC configuration: no allowed users or groups are set, so nobody can log in.")
+	}
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		panic("random failure: " + err.Error())
+	}
+	return &oidcAuthenticator{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		stateKey: key,
+		mut:      sync.NewMutex(),
+		timeNow:  time.Now,
+	}
+}
+
+// startLogin returns the URL at the provider to send the user to, and the
+// login in progress sealed for keeping in the browser.
+func (a *oidcAuthenticator) startLogin(r *http.Request) (string, string, error) {
+	md, err := a.providerMetadata()
+	if err != nil {
+		return "", "", err
+	}
+
+	login := oidcPendingLogin{
+		State:       rand.String(32),
+		Nonce:       rand.String(32),
+		Verifier:    rand.String(64),
+		RedirectURL: a.redirectURL(r),
+		Expires:     a.timeNow().Add(oidcLoginTimeout),
+	}
+	sealed, err := a.sealLogin(login)
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", "", fmt.Errorf("authorization endpoint: %w", err)
+	}
+	q := authURL.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", a.cfg.ClientID)
+	q.Set("redirect_uri", login.RedirectURL)
+	q.Set("scope", strings.Join(a.cfg.RequestScopes(), " "))
+	q.Set("state", login.State)
+	q.Set("nonce", login.Nonce)
+	q.Set("code_challenge", pkceChallenge(login.Verifier))
+	q.Set("code_challenge_method", "S256")
+	authURL.RawQuery = q.Encode()
+	return authURL.String(), sealed, nil
+}
+
+// finishLogin handles the redirect back from the provider, returning the
+// identity of the user if they may log in. The sealed login is the one
+// stored in the browser when the login started.
+func (a *oidcAuthenticator) finishLogin(r *http.Request, sealed string) (authIdentity, error) {
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		return authIdentity{}, fmt.Errorf("provider returned error %q: %s", e, q.Get("error_description"))
+	}
+	login, err := a.openLogin(sealed)
+	if err != nil {
+		return authIdentity{}, err
+	}
+	if q.Get("state") != login.State {
+		return authIdentity{}, errors.New("state mismatch")
+	}
+	if a.timeNow().After(login.Expires) {
+		return authIdentity{}, errors.New("expired login")
+	}
+
+	rawToken, err := a.exchangeCode(q.Get("code"), login)
+	if err != nil {
+		return authIdentity{}, err
+	}
+	claims, err := a.verifyIDToken(rawToken, login.Nonce)
+	if err != nil {
+		return authIdentity{}, fmt.Errorf("ID token: %w", err)
+	}
+
+	var id authIdentity
+	id.Username, _ = claims[a.cfg.UsernameClaim].(string)
+	id.DisplayName, _ = claims["name"].(string)
+	if id.Username == "" {
+		return id, fmt.Errorf("ID token has no %q claim", a.cfg.UsernameClaim)
+	}
+	groups := claimStrings(claims[a.cfg.GroupsClaim])
+	if !a.cfg.IsAllowed(id.Username, groups) {
+		return id, fmt.Errorf("user %q is not allowed", id.Username)
+	}
+	id.Role = a.cfg.GroupsRole(groups)
+	return id, nil
+}
+
+// sealLogin encrypts the login in progress, so that it can be kept by the
+// browser without being read or tampered with.
+func (a *oidcAuthenticator) sealLogin(login oidcPendingLogin) (string, error) {
+	aead, err := chacha20poly1305.NewX(a.stateKey)
+	if err != nil {
+		// Can only fail if the key is the wrong length
+		panic("cipher failure: " + err.Error())
+	}
+	bs, err := json.Marshal(login)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(bs)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, bs, nil)), nil
+}
+
+// openLogin returns the login in progress sealed by sealLogin.
+func (a *oidcAuthenticator) openLogin(sealed string) (oidcPendingLogin, error) {
+	aead, err := chacha20poly1305.NewX(a.stateKey)
+	if err != nil {
+		// Can only fail if the key is the wrong length
+		panic("cipher failure: " + err.Error())
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(sealed)
+	if err != nil || len(bs) < aead.NonceSize() {
+		return oidcPendingLogin{}, errors.New("unknown login")
+	}
+	bs, err = aead.Open(nil, bs[:aead.NonceSize()], bs[aead.NonceSize():], nil)
+	if err != nil {
+		return oidcPendingLogin{}, errors.New("unknown login")
+	}
+	var login oidcPendingLogin
+	if err := json.Unmarshal(bs, &login); err != nil {
+		return oidcPendingLogin{}, errors.New("unknown login")
+	}
+	return login, nil
+}
+
+// redirectURL returns the URL the provider should send the user back to.
+func (a *oidcAuthenticator) redirectURL(r *http.Request) string {
+	if a.cfg.RedirectURL != "" {
+		return a.cfg.RedirectURL
+	}
+	scheme := "http"
+	if connectionIsHTTPS(r) {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + oidcCallbackPath
+}
+
+func (a *oidcAuthenticator) exchangeCode(code string, login oidcPendingLogin) (string, error) {
+	if code == "" {
+		return "", errors.New("no authorization code")
+	}
+	md, err := a.providerMetadata()
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {login.RedirectURL},
+		"client_id":     {a.cfg.ClientID},
+		"code_verifier": {login.Verifier},
+	}
+	req, err := http.NewRequest(http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if a.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(a.cfg.ClientID), url.QueryEscape(a.cfg.ClientSecret))
+	}
+
+	var res struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := a.doJSON(req, &res)
+	if err != nil {
+		return "", fmt.Errorf("token request: %w", err)
+	}
+	if status != http.StatusOK || res.Error != "" {
+		return "", fmt.Errorf("token request: status %d: %s %s", status, res.Error, res.ErrorDescription)
+	}
+	if res.IDToken == "" {
+		return "", errors.New("token response has no ID token")
+	}
+	return res.IDToken, nil
+}
+
+// verifyIDToken checks the signature and standard claims of the ID token,
+// returning its claims.
+func (a *oidcAuthenticator) verifyIDToken(raw, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	key, err := a.signingKey(header.Kid, header.Alg)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+
+	if iss, _ := claims["iss"].(string); iss != a.cfg.Issuer {
+		return nil, fmt.Errorf("issuer %q != %q", iss, a.cfg.Issuer)
+	}
+	aud := claimStrings(claims["aud"])
+	if !containsString(aud, a.cfg.ClientID) {
+		return nil, fmt.Errorf("audience %v doesn't include our client ID", aud)
+	}
+	if azp, ok := claims["azp"].(string); (ok || len(aud) > 1) && azp != a.cfg.ClientID {
+		return nil, fmt.Errorf("authorized party %q isn't us", azp)
+	}
+	now := a.timeNow()
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.Add(-oidcClockSkew).After(time.Unix(int64(exp), 0)) {
+		return nil, errors.New("expired")
+	}
+	if iat, ok := claims["iat"].(float64); ok && time.Unix(int64(iat), 0).After(now.Add(oidcClockSkew)) {
+		return nil, errors.New("issued in the future")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New("nonce mismatch")
+	}
+	return claims, nil
+}
+
+// signingKey returns the provider's key with the given ID, refreshing the
+// keys if it's unknown. Tokens without a key ID are accepted when the
+// provider has a single key of the right kind.
+func (a *oidcAuthenticator) signingKey(kid, alg string) (crypto.PublicKey, error) {
+	lookup := func() (crypto.PublicKey, bool) {
+		a.mut.Lock()
+		defer a.mut.Unlock()
+		if kid != "" {
+			key, ok := a.keys[kid]
+			return key, ok
+		}
+		var found crypto.PublicKey
+		n := 0
+		for _, key := range a.keys {
+			if keyMatchesAlg(key, alg) {
+				found = key
+				n++
+			}
+		}
+		return found, n == 1
+	}
+
+	a.mut.Lock()
+	stale := a.keys == nil || a.timeNow().Sub(a.keysAt) > oidcMetadataMaxAge
+	a.mut.Unlock()
+	if !stale {
+		if key, ok := lookup(); ok {
+			return key, nil
+		}
+		a.mut.Lock()
+		recent := a.timeNow().Sub(a.keysAt) < oidcKeysMinInterval
+		a.mut.Unlock()
+		if recent {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+	}
+
+	if err := a.refreshKeys(); err != nil {
+		return nil, err
+	}
+	if key, ok := lookup(); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (a *oidcAuthenticator) refreshKeys() error {
+	md, err := a.providerMetadata()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodGet, md.JWKSURI, nil)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := a.doJSON(req, &set)
+	if err != nil {
+		return fmt.Errorf("fetching keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("fetching keys: status %d", status)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for i, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			l.Debugf("OIDC: skipping key %q: %v", jwk.Kid, err)
+			continue
+		}
+		kid := jwk.Kid
+		if kid == "" {
+			kid = fmt.Sprintf("#%d", i)
+		}
+		keys[kid] = key
+	}
+
+	a.mut.Lock()
+	a.keys = keys
+	a.keysAt = a.timeNow()
+	a.mut.Unlock()
+	return nil
+}
+
+// providerMetadata returns the provider configuration, discovering it from
+// the issuer if we haven't recently.
+func (a *oidcAuthenticator) providerMetadata() (*oidcProviderMetadata, error) {
+	a.mut.Lock()
+	md, at := a.metadata, a.metadataAt
+	a.mut.Unlock()
+	if md != nil && a.timeNow().Sub(at) < oidcMetadataMaxAge {
+		return md, nil
+	}
+
+	if a.cfg.Issuer == "" || a.cfg.ClientID == "" {
+		return nil, errors.New("OIDC configuration: issuer and clientID must be set")
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(a.cfg.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	md = new(oidcProviderMetadata)
+	status, err := a.doJSON(req, md)
+	if err != nil {
+		return nil, fmt.Errorf("discovery: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery: status %d", status)
+	}
+	if md.Issuer != a.cfg.Issuer {
+		return nil, fmt.Errorf("discovery: issuer %q != %q", md.Issuer, a.cfg.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, errors.New("discovery: incomplete provider metadata")
+	}
+
+	a.mut.Lock()
+	a.metadata = md
+	a.metadataAt = a.timeNow()
+	a.mut.Unlock()
+	return md, nil
+}
+
+func (a *oidcAuthenticator) doJSON(req *http.Request, into interface{}) (int, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(resp.Body, oidcMaxResponseSize)).Decode(into); err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, err
+	}
+	return resp.StatusCode, nil
+}
+
+// jsonWebKey is a public key in JWK format (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("bad RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeJWKInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, errors.New("point not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(bs), nil
+}
+
+func keyMatchesAlg(key crypto.PublicKey, alg string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return alg == "RS256"
+	case *ecdsa.PublicKey:
+		return alg == "ES256"
+	default:
+		return false
+	}
+}
+
+// verifyJWTSignature checks the signature of a JWT. Only the asymmetric
+// algorithms that OpenID providers commonly use are supported; in
+// particular "none" and the HMAC algorithms are rejected.
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	if !keyMatchesAlg(key, alg) {
+		return fmt.Errorf("unsupported algorithm %q for key", alg)
+	}
+	hash := sha256.Sum256([]byte(signed))
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig); err != nil {
+			return errors.New("bad signature")
+		}
+	case *ecdsa.PublicKey:
+		if len(sig) != 64 {
+			return errors.New("bad signature")
+		}
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(key, hash[:], r, s) {
+			return errors.New("bad signature")
+		}
+	}
+	return nil
+}
+
+func decodeJWTPart(part string, into interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, into)
+}
+
+func pkceChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// claimStrings returns a claim that may be a string or a list of strings
+// as a list of strings.
+func claimStrings(claim interface{}) []string {
+	switch claim := claim.(type) {
+	case string:
+		return []string{claim}
+	case []interface{}:
+		ss := make([]string, 0, len(claim))
+		for _, v := range claim {
+			if s, ok := v.(string); ok {
+				ss = append(ss, s)
+			}
+		}
+		return ss
+	default:
+		return nil
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
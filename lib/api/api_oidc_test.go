@@ -0,0 +1,367 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// mockIssuer is a minimal OpenID provider. Tests play the part of the user
+// at the authorization endpoint by calling authorize.
+type mockIssuer struct {
+	*httptest.Server
+	t        *testing.T
+	clientID string
+	secret   string
+	kid      string
+	rsaKey   *rsa.PrivateKey
+	ecKey    *ecdsa.PrivateKey
+
+	mut   sync.Mutex
+	codes map[string]mockGrant
+}
+
+type mockGrant struct {
+	redirectURI string
+	challenge   string
+	claims      map[string]interface{}
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	rsaKey, err := rsa.GenerateKey(crand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIssuer{
+		t:        t,
+		clientID: "syncthing",
+		secret:   "s3cret",
+		kid:      "rsa1",
+		rsaKey:   rsaKey,
+		ecKey:    ecKey,
+		codes:    make(map[string]mockGrant),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, map[string]string{
+			"issuer":                 m.URL,
+			"authorization_endpoint": m.URL + "/authorize",
+			"token_endpoint":         m.URL + "/token",
+			"jwks_uri":               m.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		b64 := base64.RawURLEncoding.EncodeToString
+		sendJSON(w, map[string]interface{}{
+			"keys": []map[string]string{
+				{"kty": "RSA", "kid": "rsa1", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+				{"kty": "EC", "kid": "ec1", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())},
+				{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"},
+			},
+		})
+	})
+	mux.HandleFunc("/token", m.token)
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+	return m
+}
+
+// authorize approves the login described by the authorization URL, with
+// the given claims, and returns the URL the user is sent back to.
+func (m *mockIssuer) authorize(authURL string, claims map[string]interface{}) string {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("response_type") != "code" || q.Get("client_id") != m.clientID || q.Get("code_challenge_method") != "S256" || !strings.Contains(q.Get("scope"), "openid") {
+		m.t.Fatalf("unexpected authorization request %v", q)
+	}
+	full := map[string]interface{}{
+		"iss":   m.URL,
+		"aud":   m.clientID,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": q.Get("nonce"),
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(full, k)
+		} else {
+			full[k] = v
+		}
+	}
+	code := base64.RawURLEncoding.EncodeToString([]byte(q.Get("state")))
+	m.mut.Lock()
+	m.codes[code] = mockGrant{redirectURI: q.Get("redirect_uri"), challenge: q.Get("code_challenge"), claims: full}
+	m.mut.Unlock()
+	return q.Get("redirect_uri") + "?" + url.Values{"code": {code}, "state": {q.Get("state")}}.Encode()
+}
+
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, _ := r.BasicAuth()
+	if id != m.clientID || secret != m.secret {
+		w.WriteHeader(http.StatusUnauthorized)
+		sendJSON(w, map[string]string{"error": "invalid_client"})
+		return
+	}
+	m.mut.Lock()
+	grant, ok := m.codes[r.FormValue("code")]
+	delete(m.codes, r.FormValue("code"))
+	m.mut.Unlock()
+	if !ok || r.FormValue("grant_type") != "authorization_code" || r.FormValue("redirect_uri") != grant.redirectURI || pkceChallenge(r.FormValue("code_verifier")) != grant.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		sendJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	kid, _ := grant.claims["_kid"].(string)
+	if kid == "" {
+		kid = m.kid
+	}
+	delete(grant.claims, "_kid")
+	sendJSON(w, map[string]string{
+		"access_token": "unused",
+		"token_type":   "Bearer",
+		"id_token":     m.sign(kid, grant.claims),
+	})
+}
+
+func (m *mockIssuer) sign(kid string, claims map[string]interface{}) string {
+	alg := "RS256"
+	if kid == "ec1" {
+		alg = "ES256"
+	}
+	headerKid := kid
+	if kid == "forged" {
+		// Claims to be signed by our key, but isn't.
+		headerKid = m.kid
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": headerKid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(signed))
+
+	var sig []byte
+	var err error
+	switch kid {
+	case "ec1":
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(crand.Reader, m.ecKey, hash[:])
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	case "forged":
+		other, _ := rsa.GenerateKey(crand.Reader, 1024)
+		sig, err = rsa.SignPKCS1v15(crand.Reader, other, crypto.SHA256, hash[:])
+	default:
+		sig, err = rsa.SignPKCS1v15(crand.Reader, m.rsaKey, crypto.SHA256, hash[:])
+	}
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	issuer := newMockIssuer(t)
+	oidcCfg := config.OIDCConfiguration{
+		Issuer:        issuer.URL,
+		ClientID:      issuer.clientID,
+		ClientSecret:  issuer.secret,
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+		AllowedUsers:  []string{"alice"},
+		AllowedGroups: []string{"syncthing-admins", "syncthing-users"},
+		GroupRoles:    []config.OIDCGroupRole{{Group: "syncthing-admins", Role: config.RoleAdmin}},
+	}
+	guiCfg := config.GUIConfiguration{AuthMode: config.AuthModeOIDC, APIKey: "testapikey"}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, requestIdentity(r))
+	})
+	srv := httptest.NewServer(oidcAuthMiddleware("sessionid-test", guiCfg, newOIDCAuthenticator(oidcCfg), next, events.NoopLogger))
+	defer srv.Close()
+
+	newClient := func() *http.Client {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Client{
+			Jar: jar,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	get := func(cli *http.Client, u string) *http.Response {
+		t.Helper()
+		resp, err := cli.Get(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+	// login goes through the flow and returns the status of the callback,
+	// and the identity seen afterwards if logged in.
+	login := func(claims map[string]interface{}) (int, authIdentity) {
+		t.Helper()
+		cli := newClient()
+		resp := get(cli, srv.URL+"/")
+		if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != oidcLoginPath {
+			t.Fatalf("expected redirect to login, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+		}
+		resp = get(cli, srv.URL+oidcLoginPath)
+		if resp.StatusCode != http.StatusSeeOther {
+			t.Fatalf("expected redirect to provider, got %d", resp.StatusCode)
+		}
+		callback := issuer.authorize(resp.Header.Get("Location"), claims)
+		if !strings.HasPrefix(callback, srv.URL+oidcCallbackPath+"?") {
+			t.Fatalf("unexpected redirect URL %q", callback)
+		}
+		resp = get(cli, callback)
+		if resp.StatusCode != http.StatusSeeOther {
+			return resp.StatusCode, authIdentity{}
+		}
+
+		resp, err := cli.Get(srv.URL + "/rest/system/session")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var id authIdentity
+		if err := json.NewDecoder(resp.Body).Decode(&id); err != nil {
+			t.Fatal(err)
+		}
+		return http.StatusSeeOther, id
+	}
+
+	status, id := login(map[string]interface{}{"preferred_username": "alice", "name": "Alice A."})
+	if status != http.StatusSeeOther || id != (authIdentity{Username: "alice", DisplayName: "Alice A.", Role: config.RoleReadOnly}) {
+		t.Errorf("alice: got %d %+v", status, id)
+	}
+	status, id = login(map[string]interface{}{"preferred_username": "bob", "groups": []string{"users", "syncthing-admins"}, "_kid": "ec1"})
+	if status != http.StatusSeeOther || id.Username != "bob" || id.Role != config.RoleAdmin {
+		t.Errorf("bob: got %d %+v", status, id)
+	}
+	status, id = login(map[string]interface{}{"preferred_username": "carol", "groups": "syncthing-users"})
+	if status != http.StatusSeeOther || id.Username != "carol" || id.Role != config.RoleReadOnly {
+		t.Errorf("carol: got %d %+v", status, id)
+	}
+
+	rejected := map[string]map[string]interface{}{
+		"not allowed":      {"preferred_username": "mallory", "groups": []string{"users"}},
+		"no username":      {"groups": []string{"syncthing-admins"}},
+		"wrong audience":   {"preferred_username": "alice", "aud": "someone-else"},
+		"foreign azp":      {"preferred_username": "alice", "aud": []string{"syncthing", "other"}, "azp": "other"},
+		"wrong issuer":     {"preferred_username": "alice", "iss": "https://evil.example"},
+		"expired":          {"preferred_username": "alice", "exp": time.Now().Add(-time.Hour).Unix()},
+		"no expiry":        {"preferred_username": "alice", "exp": nil},
+		"wrong nonce":      {"preferred_username": "alice", "nonce": "abc"},
+		"bad signature":    {"preferred_username": "alice", "_kid": "forged"},
+		"unknown key":      {"preferred_username": "alice", "_kid": "nope"},
+		"symmetric key":    {"preferred_username": "alice", "_kid": "hmac"},
+		"issued in future": {"preferred_username": "alice", "iat": time.Now().Add(time.Hour).Unix()},
+	}
+	for name, claims := range rejected {
+		if status, _ := login(claims); status != http.StatusUnauthorized {
+			t.Errorf("%s: got status %d, expected rejection", name, status)
+		}
+	}
+
+	// The callback only works in the browser that started the login, once.
+	cli := newClient()
+	resp := get(cli, srv.URL+oidcLoginPath)
+	var sealed string
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "sessionid-test-oidc" {
+			sealed = cookie.Value
+		}
+	}
+	callback := issuer.authorize(resp.Header.Get("Location"), map[string]interface{}{"preferred_username": "alice"})
+	if resp := get(newClient(), callback); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("callback from another browser: got status %d", resp.StatusCode)
+	}
+	u, _ := url.Parse(callback)
+	callbackWithCookie := func(value string) int {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, callback, nil)
+		req.AddCookie(&http.Cookie{Name: "sessionid-test-oidc", Value: value})
+		resp, err := newClient().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if status := callbackWithCookie(u.Query().Get("state")); status != http.StatusUnauthorized {
+		t.Errorf("callback with forged state cookie: got status %d", status)
+	}
+	if status := callbackWithCookie(sealed[:len(sealed)-2] + "AA"); status != http.StatusUnauthorized {
+		t.Errorf("callback with tampered state cookie: got status %d", status)
+	}
+	if resp := get(cli, callback); resp.StatusCode != http.StatusSeeOther {
+		t.Errorf("callback: got status %d", resp.StatusCode)
+	}
+	if status := callbackWithCookie(sealed); status != http.StatusUnauthorized {
+		t.Errorf("replayed callback: got status %d", status)
+	}
+
+	// Starting logins doesn't use up anything, so it can't crowd out the
+	// logins of others.
+	cli = newClient()
+	resp = get(cli, srv.URL+oidcLoginPath)
+	callback = issuer.authorize(resp.Header.Get("Location"), map[string]interface{}{"preferred_username": "alice"})
+	for i := 0; i < 2000; i++ {
+		get(newClient(), srv.URL+oidcLoginPath)
+	}
+	if resp := get(cli, callback); resp.StatusCode != http.StatusSeeOther {
+		t.Errorf("callback after many other logins started: got status %d", resp.StatusCode)
+	}
+
+	// API requests get an error rather than a redirect, unless they carry
+	// an API key.
+	if resp := get(newClient(), srv.URL+"/rest/system/status"); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("API request without session: got status %d", resp.StatusCode)
+	}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+"/rest/system/status", nil)
+	req.Header.Set("X-API-Key", "testapikey")
+	resp, err := newClient().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("API request with key: got status %d", resp.StatusCode)
+	}
+}
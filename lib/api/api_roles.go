@@ -0,0 +1,121 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// Endpoints changing state that operators may use, in addition to reading
+// everything read-only users may read. Anything else changing state is
+// for administrators.
+var operatorEndpoints = map[string]struct{}{
+	http.MethodPost + " /rest/db/prio":       {},
+	http.MethodPost + " /rest/db/scan":       {},
+	http.MethodPost + " /rest/folder/pause":  {},
+	http.MethodPost + " /rest/folder/resume": {},
+	http.MethodPost + " /rest/system/pause":  {},
+	http.MethodPost + " /rest/system/resume": {},
+}
+
+// Endpoints that don't change state but are for administrators only
+// nonetheless, as they expose more than the state of syncing.
+var adminReadPrefixes = []string{
+	"/rest/debug/",
+	"/rest/system/browse",
+}
+
+// requiredRole returns the role needed to make the given request to a
+// /rest endpoint.
+func requiredRole(method, path string) config.Role {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		for _, prefix := range adminReadPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return config.RoleAdmin
+			}
+		}
+		return config.RoleReadOnly
+	}
+
+	if method == http.MethodPost && path == "/rest/system/ping" {
+		return config.RoleReadOnly
+	}
+	if _, ok := operatorEndpoints[method+" "+path]; ok {
+		return config.RoleOperator
+	}
+	return config.RoleAdmin
+}
+
+// roleMiddleware rejects requests from callers whose role doesn't allow
+// them.
+func roleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if role, need := requestRole(r), requiredRole(r.Method, r.URL.Path); !role.Includes(need) {
+			l.Debugf("Denying %s %s to %v, requires %v", r.Method, r.URL.Path, role, need)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// redactedConfig returns the config as it may be shown to the caller, i.e.
+// without secrets unless they're an administrator.
+func redactedConfig(cfg config.Configuration, role config.Role) config.Configuration {
+	if role.Includes(config.RoleAdmin) {
+		return cfg
+	}
+	return cfg.Redacted()
+}
+
+func redactedFolder(folder config.FolderConfiguration, role config.Role) config.FolderConfiguration {
+	if role.Includes(config.RoleAdmin) {
+		return folder
+	}
+	return folder.Redacted()
+}
+
+func redactedFolders(folders []config.FolderConfiguration, role config.Role) []config.FolderConfiguration {
+	for i := range folders {
+		folders[i] = redactedFolder(folders[i], role)
+	}
+	return folders
+}
+
+func redactedGUI(gui config.GUIConfiguration, role config.Role) config.GUIConfiguration {
+	if role.Includes(config.RoleAdmin) {
+		return gui
+	}
+	return gui.Redacted()
+}
+
+func redactedOIDC(oidc config.OIDCConfiguration, role config.Role) config.OIDCConfiguration {
+	if role.Includes(config.RoleAdmin) {
+		return oidc
+	}
+	return oidc.Redacted()
+}
+
+// redactedEvents returns the events as they may be shown to the caller,
+// i.e. with the configuration carried by ConfigSaved events redacted unless
+// they're an administrator. The events are modified in place.
+func redactedEvents(evs []events.Event, role config.Role) []events.Event {
+	if role.Includes(config.RoleAdmin) {
+		return evs
+	}
+	for i := range evs {
+		if cfg, ok := evs[i].Data.(config.Configuration); ok {
+			evs[i].Data = cfg.Redacted()
+		}
+	}
+	return evs
+}
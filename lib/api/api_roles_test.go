@@ -0,0 +1,290 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	modelmocks "github.com/syncthing/syncthing/lib/model/mocks"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func TestRequiredRole(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		method, path string
+		role         config.Role
+	}{
+		{http.MethodGet, "/rest/system/status", config.RoleReadOnly},
+		{http.MethodGet, "/rest/config", config.RoleReadOnly},
+		{http.MethodPost, "/rest/system/ping", config.RoleReadOnly},
+		{http.MethodPost, "/rest/db/scan", config.RoleOperator},
+		{http.MethodPost, "/rest/system/pause", config.RoleOperator},
+		{http.MethodPost, "/rest/folder/resume", config.RoleOperator},
+		{http.MethodGet, "/rest/system/browse", config.RoleAdmin},
+		{http.MethodGet, "/rest/debug/cpuprof", config.RoleAdmin},
+		{http.MethodPost, "/rest/system/restart", config.RoleAdmin},
+		{http.MethodPut, "/rest/config", config.RoleAdmin},
+		{http.MethodPatch, "/rest/config/folders/default", config.RoleAdmin},
+		{http.MethodDelete, "/rest/cluster/pending/devices", config.RoleAdmin},
+	}
+	for _, tc := range cases {
+		if role := requiredRole(tc.method, tc.path); role != tc.role {
+			t.Errorf("%s %s: role %v != expected %v", tc.method, tc.path, role, tc.role)
+		}
+	}
+}
+
+func TestRoles(t *testing.T) {
+	t.Parallel()
+
+	user := config.GUIUser{Name: "helpdesk", Role: config.RoleOperator}
+	if err := user.HashAndSetPassword("pass"); err != nil {
+		t.Fatal(err)
+	}
+	gui := config.GUIConfiguration{
+		APIKey: testAPIKey,
+		APIKeys: []config.GUIAPIKey{
+			{Name: "monitoring", Key: "readonlykey", Role: config.RoleReadOnly},
+			{Name: "helpdesk", Key: "operatorkey", Role: config.RoleOperator},
+		},
+		Users: []config.GUIUser{user},
+	}
+	folder := config.FolderConfiguration{
+		ID:      "default",
+		Devices: []config.FolderDeviceConfiguration{{DeviceID: protocol.LocalDeviceID, EncryptionPassword: "folderpw"}},
+	}
+	cfg := newMockedConfig()
+	cfg.GUIReturns(gui)
+	cfg.RawCopyReturns(config.Configuration{GUI: gui.Copy()})
+	cfg.FolderListStub = func() []config.FolderConfiguration {
+		return []config.FolderConfiguration{folder.Copy()}
+	}
+	cfg.FolderStub = func(string) (config.FolderConfiguration, bool) {
+		return folder.Copy(), true
+	}
+	cfg.DefaultFolderStub = func() config.FolderConfiguration {
+		return folder.Copy()
+	}
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	do := func(method, path string, auth func(*http.Request)) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth(req)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	apiKey := func(key string) func(*http.Request) {
+		return func(req *http.Request) {
+			req.Header.Set("X-API-Key", key)
+		}
+	}
+
+	cases := []struct {
+		method, path string
+		auth         func(*http.Request)
+		status       int
+	}{
+		{http.MethodGet, "/rest/system/version", apiKey("readonlykey"), http.StatusOK},
+		{http.MethodPost, "/rest/db/scan?folder=default", apiKey("readonlykey"), http.StatusForbidden},
+		{http.MethodPost, "/rest/db/scan?folder=default", apiKey("operatorkey"), http.StatusOK},
+		{http.MethodPost, "/rest/system/error/clear", apiKey("operatorkey"), http.StatusForbidden},
+		{http.MethodGet, "/rest/system/browse", apiKey("operatorkey"), http.StatusForbidden},
+		{http.MethodPost, "/rest/system/error/clear", apiKey(testAPIKey), http.StatusOK},
+		{http.MethodGet, "/rest/system/version", apiKey("wrongkey"), http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		resp := do(tc.method, tc.path, tc.auth)
+		resp.Body.Close()
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s %s: status %d != expected %d", tc.method, tc.path, resp.StatusCode, tc.status)
+		}
+	}
+
+	// Users logging in get a session with their role
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli := &http.Client{Jar: jar}
+	req, _ := http.NewRequest(http.MethodGet, baseURL+"/", nil)
+	req.SetBasicAuth("helpdesk", "pass")
+	resp, err := cli.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	var csrf string
+	for _, cookie := range resp.Cookies() {
+		if strings.HasPrefix(cookie.Name, "CSRF-Token-") {
+			csrf = cookie.Value
+		}
+	}
+	for path, status := range map[string]int{"/rest/db/scan?folder=default": http.StatusOK, "/rest/system/error/clear": http.StatusForbidden} {
+		req, _ := http.NewRequest(http.MethodPost, baseURL+path, nil)
+		req.Header.Set("X-CSRF-Token-"+protocol.LocalDeviceID.String()[:5], csrf)
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("session POST %s: status %d != expected %d", path, resp.StatusCode, status)
+		}
+	}
+	req, _ = http.NewRequest(http.MethodGet, baseURL+"/rest/system/session", nil)
+	req.Header.Set("X-CSRF-Token-"+protocol.LocalDeviceID.String()[:5], csrf)
+	resp, err = cli.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var id authIdentity
+	err = json.NewDecoder(resp.Body).Decode(&id)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Username != "helpdesk" || id.Role != config.RoleOperator {
+		t.Errorf("unexpected session identity %+v", id)
+	}
+
+	// Secrets are only shown to administrators
+	for key, redacted := range map[string]bool{"readonlykey": true, testAPIKey: false} {
+		resp := do(http.MethodGet, "/rest/config", apiKey(key))
+		var got config.Configuration
+		err := json.NewDecoder(resp.Body).Decode(&got)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (got.GUI.APIKey == "") != redacted || (got.GUI.APIKeys[1].Key == "") != redacted || (got.GUI.Users[0].Password == "") != redacted {
+			t.Errorf("key %s: expected redacted %v, got %+v", key, redacted, got.GUI)
+		}
+
+		for _, path := range []string{"/rest/config/folders", "/rest/config/folders/default", "/rest/config/defaults/folder"} {
+			resp := do(http.MethodGet, path, apiKey(key))
+			var got []config.FolderConfiguration
+			var err error
+			if path == "/rest/config/folders" {
+				err = json.NewDecoder(resp.Body).Decode(&got)
+			} else {
+				got = make([]config.FolderConfiguration, 1)
+				err = json.NewDecoder(resp.Body).Decode(&got[0])
+			}
+			resp.Body.Close()
+			if err != nil {
+				t.Fatal(path, err)
+			}
+			if len(got) != 1 || len(got[0].Devices) != 1 || (got[0].Devices[0].EncryptionPassword == "") != redacted {
+				t.Errorf("key %s, %s: expected redacted %v, got %+v", key, path, redacted, got)
+			}
+		}
+	}
+}
+
+func TestEventsRedacted(t *testing.T) {
+	t.Parallel()
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	mask := events.ConfigSaved
+	sub := events.NewBufferedSubscription(evLogger.Subscribe(mask), EventSubBufferSize)
+	svc := &service{
+		evLogger:     evLogger,
+		eventSubs:    map[events.EventType]events.BufferedSubscription{mask: sub},
+		eventSubsMut: sync.NewMutex(),
+		fss:          new(modelmocks.FolderSummaryService),
+	}
+
+	const secret = "secretapikey"
+	evLogger.Log(events.ConfigSaved, config.Configuration{GUI: config.GUIConfiguration{APIKey: secret}})
+	if evs := sub.Since(0, nil, time.Minute); len(evs) == 0 {
+		t.Fatal("timed out waiting for events")
+	}
+
+	for _, role := range []config.Role{config.RoleReadOnly, config.RoleOperator, config.RoleAdmin} {
+		// Polling
+		rec := httptest.NewRecorder()
+		req := withRole(httptest.NewRequest(http.MethodGet, "/rest/events?timeout=0", nil), role)
+		svc.getEvents(rec, req, sub)
+		if got, exp := strings.Contains(rec.Body.String(), secret), role == config.RoleAdmin; got != exp {
+			t.Errorf("%v: secret in events is %v, expected %v", role, got, exp)
+		}
+
+		// Streaming
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			svc.getEventStream(w, withRole(r, role))
+		}))
+		reqCtx, reqCancel := context.WithTimeout(ctx, time.Minute)
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, srv.URL+"?events=ConfigSaved", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var data string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if strings.HasPrefix(scanner.Text(), "data: ") {
+				data = scanner.Text()
+				break
+			}
+		}
+		resp.Body.Close()
+		reqCancel()
+		if data == "" {
+			t.Fatalf("%v: no event in stream: %v", role, scanner.Err())
+		}
+		if got, exp := strings.Contains(data, secret), role == config.RoleAdmin; got != exp {
+			t.Errorf("%v: secret in event stream is %v, expected %v", role, got, exp)
+		}
+
+		// The handler notices we're gone once it wakes up from waiting
+		// for events, so keep it busy until the server has closed.
+		done := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-time.After(10 * time.Millisecond):
+					evLogger.Log(events.ConfigSaved, config.Configuration{})
+				}
+			}
+		}()
+		srv.Close()
+		close(done)
+	}
+}
@@ -147,7 +147,7 @@ func TestStopAfterBrokenConfig(t *testing.T) {
 	}
 	w := config.Wrap("/dev/null", cfg, protocol.LocalDeviceID, events.NoopLogger)
 
-	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	srv.started = make(chan string)
@@ -303,6 +303,12 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "{",
 		},
+		{
+			URL:    "/rest/db/ignores/explain?folder=default&file=something",
+			Code:   200,
+			Type:   "application/json",
+			Prefix: "{",
+		},
 		{
 			URL:    "/rest/db/need?folder=default",
 			Code:   200,
@@ -321,6 +327,16 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "null",
 		},
+		{
+			URL:    "/rest/folder/conflicts?folder=default",
+			Code:   200,
+			Type:   "application/json",
+			Prefix: "null",
+		},
+		{
+			URL:  "/rest/folder/selection?folder=nonexistent",
+			Code: 404,
+		},
 		{
 			URL:    "/rest/db/status?folder=default",
 			Code:   200,
@@ -644,7 +660,7 @@ func startHTTP(cfg config.Wrapper) (string, context.CancelFunc, error) {
 
 	// Instantiate the API service
 	urService := ur.New(cfg, m, connections, false)
-	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, events.NoopLogger, discoverer, connections, urService, mockedSummary, errorLog, systemLog, false).(*service)
+	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, events.NoopLogger, discoverer, connections, urService, nil, mockedSummary, errorLog, systemLog, false).(*service)
 	defer os.Remove(token)
 	svc.started = addrChan
 
@@ -1135,7 +1151,7 @@ func TestEventMasks(t *testing.T) {
 	cfg := newMockedConfig()
 	defSub := new(eventmocks.BufferedSubscription)
 	diskSub := new(eventmocks.BufferedSubscription)
-	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	if mask := svc.getEventMask(""); mask != DefaultEventMask {
@@ -1432,3 +1448,51 @@ func runningInContainer() bool {
 	}
 	return false
 }
+
+func TestMetricsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	baseURL, cancel, err := startHTTP(apiCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	cli := &http.Client{
+		Timeout: time.Minute,
+	}
+
+	// Without an API key the request should be rejected, as GUI
+	// authentication is not enabled in this config.
+
+	resp, err := cli.Get(baseURL + "/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatal("GET on /metrics without API key should fail, not", resp.Status)
+	}
+
+	// The API key may be given as a bearer token, which is what Prometheus
+	// supports natively.
+
+	req, _ := http.NewRequest("GET", baseURL+"/metrics", nil)
+	req.Header.Set("Authorization", "Bearer "+testAPIKey)
+	resp, err = cli.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatal("GET on /metrics with API key should succeed, not", resp.Status)
+	}
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, metric := range []string{"syncthing_connections_in_bytes_total", "syncthing_connections_out_bytes_total"} {
+		if !bytes.Contains(bs, []byte(metric)) {
+			t.Errorf("Expected metric %s in /metrics output", metric)
+		}
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/model"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+const (
+	webDAVPrefix = "/dav"
+
+	// The virtual directory in the root of each folder holding the
+	// versions of its files.
+	webDAVVersionsDir = "@versions"
+
+	// How long to reuse a version listing, as WebDAV clients tend to ask
+	// about the same directory many times in a row.
+	webDAVVersionsCacheTime = 5 * time.Second
+)
+
+// newWebDAVHandler returns a handler serving the folders read only over
+// WebDAV. Each folder is a directory in the root, and the versions of each
+// file are available under the @versions directory in the folder root,
+// as a directory per file holding its versions. A version is restored by
+// copying it to the original location of the file.
+func newWebDAVHandler(cfg config.Wrapper, m model.Model) http.Handler {
+	dfs := &webDAVFilesystem{
+		cfg:      cfg,
+		model:    m,
+		versions: make(map[string]cachedVersions),
+		mut:      sync.NewMutex(),
+	}
+	dav := &webdav.Handler{
+		Prefix:     webDAVPrefix,
+		FileSystem: dfs,
+		LockSystem: webdav.NewMemLS(),
+		Logger: func(r *http.Request, err error) {
+			if err != nil {
+				l.Debugf("WebDAV %s %s: %v", r.Method, r.URL.Path, err)
+			}
+		},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, "PROPFIND", "LOCK", "UNLOCK":
+			dav.ServeHTTP(w, r)
+		case "COPY":
+			// Restoring is the same as through the REST API and takes the
+			// same role.
+			if !requestRole(r).Includes(requiredRole(http.MethodPost, "/rest/folder/versions")) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+				return
+			}
+			dfs.serveRestore(w, r)
+		default:
+			http.Error(w, "Read only", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+type cachedVersions struct {
+	when     time.Time
+	versions map[string][]versioner.FileVersion
+}
+
+// webDAVFilesystem implements webdav.FileSystem, read only.
+type webDAVFilesystem struct {
+	cfg      config.Wrapper
+	model    model.Model
+	versions map[string]cachedVersions // folder ID -> versions
+	mut      sync.Mutex
+}
+
+// webDAVPath is a resolved path in the WebDAV tree.
+type webDAVPath struct {
+	folder   *config.FolderConfiguration // nil for the root
+	versions bool                        // in the @versions tree
+	name     string                      // slash separated, relative to the folder or @versions, "." for the root
+}
+
+func (d *webDAVFilesystem) resolve(name string) (webDAVPath, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return webDAVPath{name: "."}, nil
+	}
+
+	id, rest := name, "."
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		id, rest = name[:i], name[i+1:]
+	}
+	fcfg, ok := d.cfg.Folder(id)
+	if !ok || !webDAVFolderVisible(fcfg) {
+		return webDAVPath{}, os.ErrNotExist
+	}
+
+	if rest == webDAVVersionsDir || strings.HasPrefix(rest, webDAVVersionsDir+"/") {
+		rest = strings.TrimPrefix(strings.TrimPrefix(rest, webDAVVersionsDir), "/")
+		if rest == "" {
+			rest = "."
+		}
+		return webDAVPath{folder: &fcfg, versions: true, name: rest}, nil
+	}
+	return webDAVPath{folder: &fcfg, name: rest}, nil
+}
+
+func webDAVFolderVisible(fcfg config.FolderConfiguration) bool {
+	// Encrypted data isn't of much use to anyone browsing
+	return !fcfg.Paused && fcfg.Type != config.FolderTypeReceiveEncrypted && !strings.Contains(fcfg.ID, "/")
+}
+
+func (d *webDAVFilesystem) OpenFile(_ context.Context, name string, flag int, _ os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		return nil, os.ErrPermission
+	}
+	p, err := d.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.folder == nil:
+		return d.openRoot(), nil
+	case p.versions:
+		return d.openVersion(*p.folder, p.name)
+	default:
+		return d.openFolderFile(*p.folder, p.name)
+	}
+}
+
+func (d *webDAVFilesystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	fd, err := d.OpenFile(ctx, name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return fd.Stat()
+}
+
+func (*webDAVFilesystem) Mkdir(context.Context, string, os.FileMode) error {
+	return os.ErrPermission
+}
+
+func (*webDAVFilesystem) RemoveAll(context.Context, string) error {
+	return os.ErrPermission
+}
+
+func (*webDAVFilesystem) Rename(context.Context, string, string) error {
+	return os.ErrPermission
+}
+
+func (d *webDAVFilesystem) openRoot() webdav.File {
+	var entries []os.FileInfo
+	for _, fcfg := range d.cfg.FolderList() {
+		if webDAVFolderVisible(fcfg) {
+			entries = append(entries, webDAVDirInfo(fcfg.ID, time.Time{}))
+		}
+	}
+	return &webDAVFile{info: webDAVDirInfo("/", time.Time{}), entries: entries}
+}
+
+func (d *webDAVFilesystem) openFolderFile(fcfg config.FolderConfiguration, name string) (webdav.File, error) {
+	ffs := fcfg.Filesystem(nil)
+	native := osutil.NativeFilename(name)
+	if name != "." {
+		// Don't expose our internal files, temporary files or anything
+		// via symlinks, which might lead outside of the folder.
+		if fs.IsInternal(native) || fs.IsTemporary(native) {
+			return nil, os.ErrNotExist
+		}
+		if err := osutil.TraversesSymlink(ffs, filepath.Dir(native)); err != nil {
+			return nil, os.ErrNotExist
+		}
+	}
+	info, err := ffs.Lstat(native)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsSymlink() {
+		return nil, os.ErrNotExist
+	}
+
+	if !info.IsDir() {
+		fd, err := ffs.Open(native)
+		if err != nil {
+			return nil, err
+		}
+		return &webDAVFile{
+			rsc:  fd,
+			info: webDAVFileInfo{name: info.Name(), size: info.Size(), mode: 0444, modTime: info.ModTime()},
+		}, nil
+	}
+
+	f := &webDAVFile{info: webDAVDirInfo(path.Base(name), info.ModTime())}
+	if name == "." {
+		f.info = webDAVDirInfo(fcfg.ID, info.ModTime())
+	}
+	f.readdir = func() ([]os.FileInfo, error) {
+		names, err := ffs.DirNames(native)
+		if err != nil {
+			return nil, err
+		}
+		var entries []os.FileInfo
+		if name == "." && fcfg.Versioning.Type != "" {
+			entries = append(entries, webDAVDirInfo(webDAVVersionsDir, time.Time{}))
+		}
+		for _, child := range names {
+			childPath := filepath.Join(native, child)
+			if fs.IsInternal(childPath) || fs.IsTemporary(childPath) {
+				continue
+			}
+			info, err := ffs.Lstat(childPath)
+			if err != nil || info.IsSymlink() {
+				continue
+			}
+			if info.IsDir() {
+				entries = append(entries, webDAVDirInfo(osutil.NormalizedFilename(child), info.ModTime()))
+			} else {
+				entries = append(entries, webDAVFileInfo{name: osutil.NormalizedFilename(child), size: info.Size(), mode: 0444, modTime: info.ModTime()})
+			}
+		}
+		return entries, nil
+	}
+	return f, nil
+}
+
+func (d *webDAVFilesystem) folderVersions(folder string) (map[string][]versioner.FileVersion, error) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	if cached, ok := d.versions[folder]; ok && time.Since(cached.when) < webDAVVersionsCacheTime {
+		return cached.versions, nil
+	}
+	versions, err := d.model.GetFolderVersions(folder)
+	if err != nil {
+		return nil, err
+	}
+	d.versions[folder] = cachedVersions{when: time.Now(), versions: versions}
+	return versions, nil
+}
+
+// openVersion opens a directory or version in the @versions tree of the
+// folder. The tree has the directory structure of the folder, with a
+// directory in place of each file with versions, holding the versions as
+// tagged file names.
+func (d *webDAVFilesystem) openVersion(fcfg config.FolderConfiguration, name string) (webdav.File, error) {
+	versions, err := d.folderVersions(fcfg.ID)
+	if err != nil {
+		return nil, os.ErrNotExist
+	}
+
+	// Is it a version of a file?
+	if file, versionTime, ok := parseWebDAVVersionName(name); ok {
+		for _, version := range versions[file] {
+			if !version.VersionTime.Equal(versionTime) {
+				continue
+			}
+			rsc, err := d.model.OpenFolderVersion(fcfg.ID, file, versionTime)
+			if err != nil {
+				if errors.Is(err, versioner.ErrOpenNotSupported) {
+					return nil, os.ErrPermission
+				}
+				return nil, err
+			}
+			return &webDAVFile{
+				rsc:  rsc,
+				info: webDAVFileInfo{name: path.Base(name), size: version.Size, mode: 0444, modTime: version.ModTime},
+			}, nil
+		}
+	}
+
+	// Otherwise it's a directory if there are versions of anything below it
+	children := make(map[string]os.FileInfo)
+	var dirTime time.Time
+	for file, fileVersions := range versions {
+		var rel string
+		switch {
+		case name == ".":
+			rel = file
+		case file == name:
+			for _, version := range fileVersions {
+				tagged := versioner.TagFilename(path.Base(file), version.VersionTime.Format(versioner.TimeFormat))
+				children[tagged] = webDAVFileInfo{name: tagged, size: version.Size, mode: 0444, modTime: version.ModTime}
+			}
+		case strings.HasPrefix(file, name+"/"):
+			rel = file[len(name)+1:]
+		default:
+			continue
+		}
+		for _, version := range fileVersions {
+			if version.VersionTime.After(dirTime) {
+				dirTime = version.VersionTime
+			}
+		}
+		if rel == "" {
+			continue
+		}
+		child := rel
+		if i := strings.IndexByte(rel, '/'); i >= 0 {
+			child = rel[:i]
+		}
+		if _, ok := children[child]; !ok {
+			children[child] = webDAVDirInfo(child, time.Time{})
+		}
+	}
+	if len(children) == 0 && name != "." {
+		return nil, os.ErrNotExist
+	}
+
+	entries := make([]os.FileInfo, 0, len(children))
+	for _, info := range children {
+		entries = append(entries, info)
+	}
+	sort.Slice(entries, func(a, b int) bool { return entries[a].Name() < entries[b].Name() })
+	dirName := path.Base(name)
+	if name == "." {
+		dirName = webDAVVersionsDir
+	}
+	return &webDAVFile{info: webDAVDirInfo(dirName, dirTime), entries: entries}, nil
+}
+
+// parseWebDAVVersionName returns the file and version time for a version
+// name in the @versions tree, i.e. "dir/file.txt/file~20060102-150405.txt".
+func parseWebDAVVersionName(name string) (string, time.Time, bool) {
+	file := path.Dir(name)
+	untagged, tag := versioner.UntagFilename(path.Base(name))
+	if file == "." || untagged != path.Base(file) {
+		return "", time.Time{}, false
+	}
+	versionTime, err := time.ParseInLocation(versioner.TimeFormat, tag, time.Local)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return file, versionTime, true
+}
+
+// serveRestore handles COPY requests, which are only allowed from a version
+// to the original location of the file, restoring the version.
+func (d *webDAVFilesystem) serveRestore(w http.ResponseWriter, r *http.Request) {
+	src, err := d.resolve(strings.TrimPrefix(r.URL.Path, webDAVPrefix))
+	if err != nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	file, versionTime, ok := parseWebDAVVersionName(src.name)
+	if !src.versions || !ok {
+		http.Error(w, "Only versions can be copied", http.StatusForbidden)
+		return
+	}
+
+	dstURL, err := url.Parse(r.Header.Get("Destination"))
+	if err != nil || (dstURL.Host != "" && dstURL.Host != r.Host) || !strings.HasPrefix(dstURL.Path, webDAVPrefix+"/") {
+		http.Error(w, "Bad destination", http.StatusBadGateway)
+		return
+	}
+	dst, err := d.resolve(strings.TrimPrefix(dstURL.Path, webDAVPrefix))
+	if err != nil || dst.folder == nil || dst.folder.ID != src.folder.ID || dst.versions || dst.name != file {
+		http.Error(w, "Versions can only be restored to the original location", http.StatusForbidden)
+		return
+	}
+
+	exists := false
+	if fd, err := d.openFolderFile(*dst.folder, dst.name); err == nil {
+		fd.Close()
+		exists = true
+	}
+	if exists && r.Header.Get("Overwrite") == "F" {
+		http.Error(w, "Destination exists", http.StatusPreconditionFailed)
+		return
+	}
+
+	errs, err := d.model.RestoreFolderVersions(src.folder.ID, map[string]time.Time{file: versionTime})
+	if err == nil {
+		err = errs[file]
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Restoring %s: %v", file, err), http.StatusInternalServerError)
+		return
+	}
+
+	d.mut.Lock()
+	delete(d.versions, src.folder.ID)
+	d.mut.Unlock()
+
+	if exists {
+		w.WriteHeader(http.StatusNoContent)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+// webDAVFile implements webdav.File for a file (rsc set) or a directory
+// (entries or readdir set).
+type webDAVFile struct {
+	rsc     io.ReadSeekCloser
+	info    os.FileInfo
+	entries []os.FileInfo
+	readdir func() ([]os.FileInfo, error)
+	pos     int
+}
+
+func (f *webDAVFile) Read(p []byte) (int, error) {
+	if f.rsc == nil {
+		return 0, os.ErrInvalid
+	}
+	return f.rsc.Read(p)
+}
+
+func (f *webDAVFile) Seek(offset int64, whence int) (int64, error) {
+	if f.rsc == nil {
+		return 0, os.ErrInvalid
+	}
+	return f.rsc.Seek(offset, whence)
+}
+
+func (*webDAVFile) Write([]byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+func (f *webDAVFile) Close() error {
+	if f.rsc == nil {
+		return nil
+	}
+	return f.rsc.Close()
+}
+
+func (f *webDAVFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *webDAVFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.info.IsDir() {
+		return nil, os.ErrInvalid
+	}
+	if f.readdir != nil {
+		entries, err := f.readdir()
+		if err != nil {
+			return nil, err
+		}
+		f.entries, f.readdir = entries, nil
+	}
+
+	rest := f.entries[f.pos:]
+	if count <= 0 {
+		f.pos = len(f.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	f.pos += count
+	return rest[:count], nil
+}
+
+// webDAVFileInfo implements os.FileInfo
+type webDAVFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func webDAVDirInfo(name string, modTime time.Time) webDAVFileInfo {
+	return webDAVFileInfo{name: name, mode: os.ModeDir | 0555, modTime: modTime}
+}
+
+func (i webDAVFileInfo) Name() string       { return i.name }
+func (i webDAVFileInfo) Size() int64        { return i.size }
+func (i webDAVFileInfo) Mode() os.FileMode  { return i.mode }
+func (i webDAVFileInfo) ModTime() time.Time { return i.modTime }
+func (i webDAVFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (webDAVFileInfo) Sys() interface{}     { return nil }
@@ -0,0 +1,179 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	modelmocks "github.com/syncthing/syncthing/lib/model/mocks"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+func TestWebDAVRequiresAPIKey(t *testing.T) {
+	t.Parallel()
+
+	cfg := newMockedConfig()
+	cfg.GUIReturns(config.GUIConfiguration{APIKey: testAPIKey, WebDAVEnabled: true})
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	// GUI authentication is not enabled, so an API key is needed.
+	for key, status := range map[string]int{"": http.StatusUnauthorized, "wrongkey": http.StatusUnauthorized, testAPIKey: http.StatusMultiStatus} {
+		req, _ := http.NewRequest("PROPFIND", baseURL+webDAVPrefix+"/", nil)
+		req.Header.Set("Depth", "1")
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("key %q: status %d != expected %d", key, resp.StatusCode, status)
+		}
+	}
+}
+
+func TestWebDAV(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"file.txt":            "current",
+		"sub/other.txt":       "other",
+		".stignore":           "secret",
+		".stversions/ignored": "ignored",
+	} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versionTime := time.Date(2022, 8, 1, 12, 30, 0, 0, time.Local)
+	cfg := newMockedConfig()
+	fcfg := config.FolderConfiguration{
+		ID:             "default",
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           dir,
+		Versioning:     config.VersioningConfiguration{Type: "simple"},
+	}
+	cfg.FolderReturns(fcfg, true)
+	cfg.FolderListReturns([]config.FolderConfiguration{fcfg})
+
+	m := new(modelmocks.Model)
+	m.GetFolderVersionsReturns(map[string][]versioner.FileVersion{
+		"sub/old.txt": {{VersionTime: versionTime, ModTime: versionTime, Size: 3}},
+	}, nil)
+	m.OpenFolderVersionReturns(nopSeekCloser{strings.NewReader("old")}, nil)
+	m.RestoreFolderVersionsReturns(map[string]error{}, nil)
+
+	srv := httptest.NewServer(newWebDAVHandler(cfg, m))
+	defer srv.Close()
+
+	do := func(method, path string, headers map[string]string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, srv.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		bs, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(bs)
+	}
+
+	// Listing the folder root shows files and the versions, but not our
+	// internal files.
+	code, body := do("PROPFIND", "/dav/default/", map[string]string{"Depth": "1"})
+	if code != http.StatusMultiStatus {
+		t.Fatalf("PROPFIND: unexpected status %d", code)
+	}
+	for _, name := range []string{"/dav/default/file.txt", "/dav/default/sub/", "/dav/default/@versions/"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("PROPFIND: %s missing from listing", name)
+		}
+	}
+	for _, name := range []string{".stignore", ".stversions"} {
+		if strings.Contains(body, name) {
+			t.Errorf("PROPFIND: %s should not be listed", name)
+		}
+	}
+
+	if code, body := do(http.MethodGet, "/dav/default/sub/other.txt", nil); code != http.StatusOK || body != "other" {
+		t.Errorf("GET file: %d %q", code, body)
+	}
+	if code, _ := do(http.MethodGet, "/dav/default/.stignore", nil); code != http.StatusNotFound {
+		t.Errorf("GET internal file: unexpected status %d", code)
+	}
+	if code, _ := do(http.MethodPut, "/dav/default/new.txt", nil); code != http.StatusMethodNotAllowed {
+		t.Errorf("PUT: unexpected status %d", code)
+	}
+
+	// Versions are directories per file
+	versionPath := "/dav/default/@versions/sub/old.txt/old~20220801-123000.txt"
+	code, body = do("PROPFIND", "/dav/default/@versions/sub/old.txt/", map[string]string{"Depth": "1"})
+	if code != http.StatusMultiStatus || !strings.Contains(body, versionPath) {
+		t.Errorf("PROPFIND versions: %d, version missing from listing", code)
+	}
+	if code, body := do(http.MethodGet, versionPath, nil); code != http.StatusOK || body != "old" {
+		t.Errorf("GET version: %d %q", code, body)
+	}
+	if m.OpenFolderVersionCallCount() == 0 {
+		t.Fatal("expected version to be opened")
+	}
+	folder, file, vt := m.OpenFolderVersionArgsForCall(0)
+	if folder != "default" || file != "sub/old.txt" || !vt.Equal(versionTime) {
+		t.Errorf("opened wrong version: %s %s %v", folder, file, vt)
+	}
+
+	// Restoring is only possible to the original location
+	if code, _ := do("COPY", versionPath, map[string]string{"Destination": srv.URL + "/dav/default/elsewhere.txt"}); code != http.StatusForbidden {
+		t.Errorf("COPY elsewhere: unexpected status %d", code)
+	}
+	if code, _ := do("COPY", "/dav/default/file.txt", map[string]string{"Destination": srv.URL + "/dav/default/copy.txt"}); code != http.StatusForbidden {
+		t.Errorf("COPY regular file: unexpected status %d", code)
+	}
+	if m.RestoreFolderVersionsCallCount() != 0 {
+		t.Fatal("unexpected restore")
+	}
+	if code, _ := do("COPY", versionPath, map[string]string{"Destination": srv.URL + "/dav/default/sub/old.txt"}); code != http.StatusCreated {
+		t.Errorf("COPY restore: unexpected status %d", code)
+	}
+	if m.RestoreFolderVersionsCallCount() != 1 {
+		t.Fatal("expected a restore")
+	}
+	folder, versions := m.RestoreFolderVersionsArgsForCall(0)
+	if folder != "default" || len(versions) != 1 || !versions["sub/old.txt"].Equal(versionTime) {
+		t.Errorf("restored wrong version: %s %v", folder, versions)
+	}
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
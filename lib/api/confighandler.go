@@ -8,11 +8,15 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
+	"gopkg.in/yaml.v3"
 
+	"github.com/syncthing/syncthing/lib/auditlog"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/util"
@@ -25,8 +29,8 @@ type configMuxBuilder struct {
 }
 
 func (c *configMuxBuilder) registerConfig(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedConfig(c.cfg.RawCopy(), requestRole(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +39,8 @@ func (c *configMuxBuilder) registerConfig(path string) {
 }
 
 func (c *configMuxBuilder) registerConfigDeprecated(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedConfig(c.cfg.RawCopy(), requestRole(r)))
 	})
 
 	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
@@ -57,8 +61,8 @@ func (c *configMuxBuilder) registerConfigRequiresRestart(path string) {
 }
 
 func (c *configMuxBuilder) registerFolders(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.FolderList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedFolders(c.cfg.FolderList(), requestRole(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -76,7 +80,7 @@ func (c *configMuxBuilder) registerFolders(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetFolders(folders)
 		})
 		if err != nil {
@@ -111,7 +115,7 @@ func (c *configMuxBuilder) registerDevices(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetDevices(devices)
 		})
 		if err != nil {
@@ -127,13 +131,13 @@ func (c *configMuxBuilder) registerDevices(path string) {
 }
 
 func (c *configMuxBuilder) registerFolder(path string) {
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		folder, ok := c.cfg.Folder(p.ByName("id"))
 		if !ok {
 			http.Error(w, "No folder with given ID", http.StatusNotFound)
 			return
 		}
-		sendJSON(w, folder)
+		sendJSON(w, redactedFolder(folder, requestRole(r)))
 	})
 
 	c.Handle(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -149,8 +153,10 @@ func (c *configMuxBuilder) registerFolder(path string) {
 		c.adjustFolder(w, r, folder, false)
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
-		waiter, err := c.cfg.RemoveFolder(p.ByName("id"))
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+			cfg.RemoveFolder(p.ByName("id"))
+		})
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
@@ -190,13 +196,15 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		}
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		id, err := protocol.DeviceIDFromString(p.ByName("id"))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		waiter, err := c.cfg.RemoveDevice(id)
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+			cfg.RemoveDevice(id)
+		})
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
@@ -206,8 +214,8 @@ func (c *configMuxBuilder) registerDevice(path string) {
 }
 
 func (c *configMuxBuilder) registerDefaultFolder(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultFolder())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedFolder(c.cfg.DefaultFolder(), requestRole(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -248,7 +256,7 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.Defaults.Ignores = ignores
 		})
 		if err != nil {
@@ -291,9 +299,66 @@ func (c *configMuxBuilder) registerLDAP(path string) {
 	})
 }
 
-func (c *configMuxBuilder) registerGUI(path string) {
+func (c *configMuxBuilder) registerOIDC(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedOIDC(c.cfg.OIDC(), requestRole(r)))
+	})
+
+	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		var cfg config.OIDCConfiguration
+		util.SetDefaults(&cfg)
+		c.adjustOIDC(w, r, cfg)
+	})
+
+	c.HandlerFunc(http.MethodPatch, path, func(w http.ResponseWriter, r *http.Request) {
+		c.adjustOIDC(w, r, c.cfg.OIDC())
+	})
+}
+
+func (c *configMuxBuilder) registerApply(path string) {
+	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		c.applyConfig(w, r)
+	})
+}
+
+func (c *configMuxBuilder) registerHistory(path string) {
 	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.GUI())
+		sendJSON(w, c.cfg.History())
+	})
+
+	c.Handle(http.MethodGet, path+"/:revision", func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		cfg, ok := c.cfg.HistoryConfig(p.ByName("revision"))
+		if !ok {
+			http.Error(w, "No configuration with given revision", http.StatusNotFound)
+			return
+		}
+		sendJSON(w, redactedConfig(cfg, requestRole(r)))
+	})
+}
+
+func (c *configMuxBuilder) registerRollback(path string) {
+	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		revision := r.URL.Query().Get("revision")
+		to, ok := c.cfg.HistoryConfig(revision)
+		if !ok {
+			http.Error(w, "No configuration with given revision", http.StatusNotFound)
+			return
+		}
+		origin := fmt.Sprintf("%s (rollback to %s)", requestOrigin(r), revision)
+		waiter, err := modifyConfigWithOrigin(r, c.cfg, origin, func(cfg *config.Configuration) {
+			*cfg = to
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+	})
+}
+
+func (c *configMuxBuilder) registerGUI(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, redactedGUI(c.cfg.GUI(), requestRole(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -317,7 +382,7 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if to.GUI.Password != cfg.GUI.Password {
 			if err := to.GUI.HashAndSetPassword(to.GUI.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -337,12 +402,112 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 	c.finish(w, waiter)
 }
 
+// applyConfig applies a partial configuration in JSON or YAML, see
+// config.Configuration.ApplyPartial, responding with the resulting
+// changes. With dryrun=true nothing is changed. If a revision is given,
+// as parameter or in the If-Match header, the configuration is only
+// changed if it's still at that revision.
+func (c *configMuxBuilder) applyConfig(w http.ResponseWriter, r *http.Request) {
+	partial, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if strings.Contains(r.Header.Get("Content-Type"), "yaml") {
+		if partial, err = yamlToJSON(partial); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	qs := r.URL.Query()
+	dryRun := qs.Get("dryrun") == "true"
+	revision := qs.Get("revision")
+	if revision == "" {
+		revision = strings.Trim(r.Header.Get("If-Match"), `"`)
+	}
+
+	var resp applyResponse
+	var errMsg string
+	var status int
+	apply := func(cfg *config.Configuration) {
+		if revision != "" && revision != cfg.Revision() {
+			errMsg = "configuration was changed in the meantime"
+			status = http.StatusConflict
+			return
+		}
+		to, err := cfg.ApplyPartial(partial, c.id)
+		if err != nil {
+			errMsg = err.Error()
+			status = http.StatusBadRequest
+			return
+		}
+		if to.GUI.Password != cfg.GUI.Password {
+			if err := to.GUI.HashAndSetPassword(to.GUI.Password); err != nil {
+				l.Warnln("hashing password:", err)
+				errMsg = err.Error()
+				status = http.StatusInternalServerError
+				return
+			}
+		}
+		resp.Changes = auditlog.Diff(*cfg, to)
+		if !dryRun {
+			*cfg = to
+		}
+	}
+
+	if dryRun {
+		cfg := c.cfg.RawCopy()
+		apply(&cfg)
+		resp.Revision = cfg.Revision()
+		if errMsg != "" {
+			http.Error(w, errMsg, status)
+			return
+		}
+		sendJSON(w, resp)
+		return
+	}
+
+	waiter, err := modifyConfig(r, c.cfg, apply)
+	if errMsg != "" {
+		http.Error(w, errMsg, status)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	waiter.Wait()
+	if err := c.cfg.Save(); err != nil {
+		l.Warnln("Saving config:", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp.Revision = c.cfg.RawCopy().Revision()
+	resp.Applied = true
+	sendJSON(w, resp)
+}
+
+type applyResponse struct {
+	Revision string            `json:"revision"`
+	Changes  []auditlog.Change `json:"changes"`
+	Applied  bool              `json:"applied"`
+}
+
+// yamlToJSON converts a YAML document to JSON.
+func yamlToJSON(bs []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(bs, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
 func (c *configMuxBuilder) adjustFolder(w http.ResponseWriter, r *http.Request, folder config.FolderConfiguration, defaults bool) {
 	if err := unmarshalTo(r.Body, &folder); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Folder = folder
 		} else {
@@ -361,7 +526,7 @@ func (c *configMuxBuilder) adjustDevice(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Device = device
 		} else {
@@ -380,7 +545,7 @@ func (c *configMuxBuilder) adjustOptions(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.Options = opts
 	})
 	if err != nil {
@@ -399,7 +564,7 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if gui.Password != oldPassword {
 			if err := gui.HashAndSetPassword(gui.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -424,7 +589,7 @@ func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ld
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.LDAP = ldap
 	})
 	if err != nil {
@@ -434,6 +599,21 @@ func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ld
 	c.finish(w, waiter)
 }
 
+func (c *configMuxBuilder) adjustOIDC(w http.ResponseWriter, r *http.Request, oidc config.OIDCConfiguration) {
+	if err := unmarshalTo(r.Body, &oidc); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+		cfg.OIDC = oidc
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	c.finish(w, waiter)
+}
+
 // Unmarshals the content of the given body and stores it in to (i.e. to must be a pointer).
 func unmarshalTo(body io.ReadCloser, to interface{}) error {
 	bs, err := io.ReadAll(body)
@@ -0,0 +1,186 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/syncthing/syncthing/lib/auditlog"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestConfigApply(t *testing.T) {
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Folders = []config.FolderConfiguration{cfg.Defaults.Folder.Copy()}
+	cfg.Folders[0].ID = "default"
+	cfg.Folders[0].Path = "/old"
+	w := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Serve(ctx)
+
+	c := &configMuxBuilder{Router: httprouter.New(), id: protocol.LocalDeviceID, cfg: w}
+	c.registerApply("/rest/config/apply")
+
+	apply := func(query, contentType, body string) (*httptest.ResponseRecorder, applyResponse) {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodPost, "/rest/config/apply"+query, strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		c.ServeHTTP(rec, r)
+		var res applyResponse
+		if rec.Code == http.StatusOK {
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return rec, res
+	}
+
+	partial := "folders:\n  - id: default\n    path: /new\ngui:\n  password: hunter2\n"
+	expected := []auditlog.Change{
+		{Path: "folders[default].path", Old: "/old", New: "/new"},
+		{Path: "gui.password", Redacted: true},
+	}
+
+	rec, dry := apply("?dryrun=true", "application/yaml", partial)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("dry run: %d %s", rec.Code, rec.Body)
+	}
+	if dry.Applied || dry.Revision != w.RawCopy().Revision() {
+		t.Errorf("unexpected dry run response %+v", dry)
+	}
+	if len(dry.Changes) != len(expected) {
+		t.Fatalf("unexpected changes %+v", dry.Changes)
+	}
+	for i := range expected {
+		if dry.Changes[i] != expected[i] {
+			t.Errorf("change %d: %+v != expected %+v", i, dry.Changes[i], expected[i])
+		}
+	}
+	if fcfg, _ := w.Folder("default"); fcfg.Path != "/old" {
+		t.Fatal("dry run changed the configuration")
+	}
+
+	// Someone else changes the configuration in between
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.Options.URAccepted = -1
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	if rec, _ := apply("?revision="+dry.Revision, "application/yaml", partial); rec.Code != http.StatusConflict {
+		t.Fatalf("expected conflict, got %d %s", rec.Code, rec.Body)
+	}
+	if fcfg, _ := w.Folder("default"); fcfg.Path != "/old" {
+		t.Fatal("conflicting apply changed the configuration")
+	}
+
+	rec, res := apply("", "application/json", `{"folders": [{"id": "default", "path": "/new"}], "gui": {"password": "hunter2"}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("apply: %d %s", rec.Code, rec.Body)
+	}
+	if !res.Applied || res.Revision != w.RawCopy().Revision() || len(res.Changes) != len(expected) {
+		t.Errorf("unexpected response %+v", res)
+	}
+	if fcfg, _ := w.Folder("default"); fcfg.Path != "/new" {
+		t.Error("configuration not applied")
+	}
+	if gui := w.GUI(); gui.Password == "hunter2" || gui.CompareHashedPassword("hunter2") != nil {
+		t.Error("password not hashed")
+	}
+
+	if rec, _ := apply("", "application/json", `{"folders": "nope"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected bad request, got %d %s", rec.Code, rec.Body)
+	}
+}
+
+type recordingCommitter struct {
+	commits []config.Configuration
+}
+
+func (c *recordingCommitter) CommitConfiguration(_, to config.Configuration) bool {
+	c.commits = append(c.commits, to)
+	return true
+}
+
+func (*recordingCommitter) String() string {
+	return "recordingCommitter"
+}
+
+func TestConfigHistoryRollback(t *testing.T) {
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Options.MaxSendKbps = 100
+	w := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Serve(ctx)
+	committer := new(recordingCommitter)
+	w.Subscribe(committer)
+
+	c := &configMuxBuilder{Router: httprouter.New(), id: protocol.LocalDeviceID, cfg: w}
+	c.registerOptions("/rest/config/options")
+	c.registerHistory("/rest/config/history")
+	c.registerRollback("/rest/config/rollback")
+
+	do := func(r *http.Request) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		c.ServeHTTP(rec, r)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s %s: %d %s", r.Method, r.URL, rec.Code, rec.Body)
+		}
+		return rec
+	}
+
+	r := httptest.NewRequest(http.MethodPatch, "/rest/config/options", strings.NewReader(`{"maxSendKbps": 200}`))
+	do(withIdentity(r, authIdentity{Username: "alice", Role: config.RoleAdmin}))
+
+	var history []config.HistoryEntry
+	if err := json.Unmarshal(do(httptest.NewRequest(http.MethodGet, "/rest/config/history", nil)).Body.Bytes(), &history); err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Origin != config.OriginStartup || history[1].Origin != "api:alice" {
+		t.Fatalf("unexpected history %+v", history)
+	}
+
+	var old config.Configuration
+	if err := json.Unmarshal(do(httptest.NewRequest(http.MethodGet, "/rest/config/history/"+history[0].Revision, nil)).Body.Bytes(), &old); err != nil {
+		t.Fatal(err)
+	}
+	if old.Options.MaxSendKbps != 100 {
+		t.Error("unexpected historic configuration", old.Options.MaxSendKbps)
+	}
+
+	do(httptest.NewRequest(http.MethodPost, "/rest/config/rollback?revision="+history[0].Revision, nil))
+	if kbps := w.Options().MaxSendKbps; kbps != 100 {
+		t.Error("not rolled back, max send rate", kbps)
+	}
+	if len(committer.commits) != 2 || committer.commits[1].Options.MaxSendKbps != 100 {
+		t.Error("rollback not committed to subscribers")
+	}
+	if history = w.History(); len(history) != 3 || history[2].Revision != history[0].Revision || history[2].Origin != "api (rollback to "+history[0].Revision+")" {
+		t.Errorf("unexpected history after rollback %+v", history)
+	}
+
+	rec := httptest.NewRecorder()
+	c.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/rest/config/rollback?revision=nope", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected not found for unknown revision, got %d", rec.Code)
+	}
+}
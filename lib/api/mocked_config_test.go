@@ -7,12 +7,15 @@
 package api
 
 import (
+	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/config/mocks"
 )
 
 func newMockedConfig() *mocks.Wrapper {
 	m := &mocks.Wrapper{}
 	m.ModifyReturns(noopWaiter{}, nil)
+	m.ModifyWithOriginReturns(noopWaiter{}, nil)
+	m.ModifyCommittedReturns(noopWaiter{}, config.Configuration{}, config.Configuration{}, nil)
 	m.RemoveFolderReturns(noopWaiter{}, nil)
 	m.RemoveDeviceReturns(noopWaiter{}, nil)
 	return m
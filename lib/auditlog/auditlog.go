@@ -0,0 +1,87 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package auditlog keeps a record of administrative actions: who did what,
+// from where, and how it changed the configuration.
+package auditlog
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// An Entry describes one administrative action.
+type Entry struct {
+	Time          time.Time `json:"time"`
+	User          string    `json:"user,omitempty"`
+	Role          string    `json:"role"`
+	RemoteAddress string    `json:"remoteAddress"`
+	ForwardedFor  string    `json:"forwardedFor,omitempty"`
+	Method        string    `json:"method"`
+	Path          string    `json:"path"`
+	Query         string    `json:"query,omitempty"`
+	Status        int       `json:"status"`
+	Changes       []Change  `json:"changes,omitempty"`
+}
+
+type Log interface {
+	Record(e Entry)
+}
+
+// FileLog writes entries as lines of JSON to a file. When the file would
+// grow beyond maxSize it's rotated; there will be the base file plus up to
+// maxFiles rotated ones.
+type FileLog struct {
+	name     string
+	maxSize  int64
+	maxFiles int
+	file     *osutil.RotatedFile // opened on first use
+	mut      sync.Mutex
+}
+
+func NewFileLog(name string, maxSize int64, maxFiles int) *FileLog {
+	return &FileLog{
+		name:     name,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		mut:      sync.NewMutex(),
+	}
+}
+
+// Record writes the entry to the log. Errors are logged, as there is
+// nobody to return them to: the action has already happened.
+func (f *FileLog) Record(e Entry) {
+	bs, err := json.Marshal(e)
+	if err != nil {
+		l.Warnln("Audit log:", err)
+		return
+	}
+	bs = append(bs, '\n')
+
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if f.file == nil {
+		f.file, err = osutil.NewRotatedFile(f.name, createLogFile, f.maxSize, f.maxFiles, func(err error) {
+			l.Warnln("Rotating audit log:", err)
+		})
+		if err != nil {
+			l.Warnln("Audit log:", err)
+			return
+		}
+	}
+	if _, err := f.file.Write(bs); err != nil {
+		l.Warnln("Audit log:", err)
+	}
+}
+
+func createLogFile(name string) (io.WriteCloser, error) {
+	return os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestFileLogRotation(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "audit.log")
+	log := NewFileLog(name, 300, 2)
+
+	for i := 0; i < 10; i++ {
+		log.Record(Entry{Method: "POST", Path: "/rest/system/reset", Status: i})
+	}
+
+	// Each entry is a bit over 100 bytes, so two fit in each file, and we
+	// keep the latest six.
+	for file, statuses := range map[string][]int{
+		name:                              {8, 9},
+		filepath.Join(dir, "audit.0.log"): {6, 7},
+		filepath.Join(dir, "audit.1.log"): {4, 5},
+	} {
+		fd, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		sc := bufio.NewScanner(fd)
+		for sc.Scan() {
+			var e Entry
+			if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, e.Status)
+		}
+		fd.Close()
+		if !reflect.DeepEqual(got, statuses) {
+			t.Errorf("%s: got %v, expected %v", file, got, statuses)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "audit.2.log")); !os.IsNotExist(err) {
+		t.Error("too many files kept")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	device1, _ := protocol.DeviceIDFromString("AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR")
+	device2, _ := protocol.DeviceIDFromString("GYRZZQB-IRNPV4Z-T7TC52W-EQYJ3TT-FDQW6MW-DFLMU42-SSSU6EM-FBK2VAY")
+
+	from := config.New(device1)
+	from.Folders = []config.FolderConfiguration{
+		{ID: "a", Path: "/a", Devices: []config.FolderDeviceConfiguration{{DeviceID: device1}}},
+		{ID: "b", Path: "/b"},
+	}
+	from.Devices = []config.DeviceConfiguration{{DeviceID: device1, Name: "one"}}
+	from.Options.RawListenAddresses = []string{"default"}
+
+	to := from.Copy()
+	to.Folders = []config.FolderConfiguration{
+		{ID: "b", Path: "/b", Paused: true},
+		{ID: "a", Path: "/a", Devices: []config.FolderDeviceConfiguration{{DeviceID: device1}, {DeviceID: device2, EncryptionPassword: "foo"}}},
+	}
+	to.Devices = append(to.Devices, config.DeviceConfiguration{DeviceID: device2, Name: "two"})
+	to.Options.RawListenAddresses = []string{"tcp://:22000"}
+	to.Webhooks = []config.WebhookConfiguration{{ID: "chat", URL: "https://example.com/", Secret: "s3cret"}}
+	to.GUI.APIKey = "abc"
+
+	expected := []Change{
+		{Path: "folders[b].paused", Old: false, New: true},
+		{Path: "folders[a].devices[" + device2.String() + "]", New: config.FolderDeviceConfiguration{DeviceID: device2}},
+		{Path: "devices[" + device2.String() + "]", New: config.DeviceConfiguration{DeviceID: device2, Name: "two"}},
+		{Path: "gui.apiKey", Redacted: true},
+		{Path: "options.listenAddresses", Old: []string{"default"}, New: []string{"tcp://:22000"}},
+		{Path: "webhooks[chat]", New: config.WebhookConfiguration{ID: "chat", URL: "https://example.com/"}},
+	}
+	if changes := Diff(from, to); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("got changes\n%+v\nexpected\n%+v", changes, expected)
+	}
+
+	if changes := Diff(from, from.Copy()); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package auditlog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// A Change is a difference between two values at the given path, which is
+// made of JSON field names, map keys and the IDs of list items, like
+// "folders[abcd-1234].devices[ABCDEFG-...].introducedBy". Old is nil for
+// additions, New for removals.
+type Change struct {
+	Path     string      `json:"path"`
+	Old      interface{} `json:"old"`
+	New      interface{} `json:"new"`
+	Redacted bool        `json:"redacted,omitempty"`
+}
+
+// sensitiveFields are the JSON names of fields whose values must not end
+// up in the log; we only note that they changed.
+var sensitiveFields = map[string]struct{}{
+	"password":           {},
+	"encryptionPassword": {},
+	"apiKey":             {},
+	"key":                {},
+	"secret":             {},
+	"clientSecret":       {},
+}
+
+// listKeyFields are the fields identifying the items of lists of structs,
+// in order of preference.
+var listKeyFields = []string{"ID", "DeviceID", "Name"}
+
+// Diff returns the differences between from and to, which should be of
+// the same type.
+func Diff(from, to interface{}) []Change {
+	var changes []Change
+	diff(&changes, "", reflect.ValueOf(from), reflect.ValueOf(to))
+	return changes
+}
+
+func diff(changes *[]Change, path string, a, b reflect.Value) {
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Slice, reflect.Map:
+		if a.Len() == 0 && b.Len() == 0 {
+			// nil and empty are the same thing to us
+			return
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, ok := jsonName(field)
+			if !ok {
+				continue
+			}
+			fieldPath := joinPath(path, name)
+			if _, ok := sensitiveFields[name]; ok {
+				if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+					*changes = append(*changes, Change{Path: fieldPath, Redacted: true})
+				}
+				continue
+			}
+			diff(changes, fieldPath, a.Field(i), b.Field(i))
+		}
+		return
+
+	case reflect.Slice:
+		if keyField, ok := listKey(a.Type().Elem()); ok {
+			aItems, aKeys, aOK := keyedItems(a, keyField)
+			bItems, bKeys, bOK := keyedItems(b, keyField)
+			if aOK && bOK {
+				diffKeyed(changes, path, aItems, aKeys, bItems, bKeys)
+				return
+			}
+		}
+
+	case reflect.Map:
+		aItems := make(map[string]reflect.Value, a.Len())
+		bItems := make(map[string]reflect.Value, b.Len())
+		var aKeys, bKeys []string
+		for _, k := range a.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			aItems[key] = a.MapIndex(k)
+			aKeys = append(aKeys, key)
+		}
+		for _, k := range b.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			bItems[key] = b.MapIndex(k)
+			bKeys = append(bKeys, key)
+		}
+		sort.Strings(aKeys)
+		sort.Strings(bKeys)
+		diffKeyed(changes, path, aItems, aKeys, bItems, bKeys)
+		return
+	}
+
+	*changes = append(*changes, Change{Path: path, Old: redacted(a), New: redacted(b)})
+}
+
+// diffKeyed compares items by key, reporting removed items first, then
+// changed and added ones in the order of b.
+func diffKeyed(changes *[]Change, path string, aItems map[string]reflect.Value, aKeys []string, bItems map[string]reflect.Value, bKeys []string) {
+	for _, key := range aKeys {
+		if _, ok := bItems[key]; !ok {
+			*changes = append(*changes, Change{Path: path + "[" + key + "]", Old: redacted(aItems[key])})
+		}
+	}
+	for _, key := range bKeys {
+		itemPath := path + "[" + key + "]"
+		if a, ok := aItems[key]; ok {
+			diff(changes, itemPath, a, bItems[key])
+		} else {
+			*changes = append(*changes, Change{Path: itemPath, New: redacted(bItems[key])})
+		}
+	}
+}
+
+// keyedItems returns the items of a list by key, and the keys in order. It
+// fails if keys aren't unique.
+func keyedItems(v reflect.Value, keyField string) (map[string]reflect.Value, []string, bool) {
+	items := make(map[string]reflect.Value, v.Len())
+	keys := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		key := fmt.Sprint(v.Index(i).FieldByName(keyField).Interface())
+		if _, ok := items[key]; ok {
+			return nil, nil, false
+		}
+		items[key] = v.Index(i)
+		keys = append(keys, key)
+	}
+	return items, keys, true
+}
+
+func listKey(t reflect.Type) (string, bool) {
+	if t.Kind() != reflect.Struct {
+		return "", false
+	}
+	for _, name := range listKeyFields {
+		if _, ok := t.FieldByName(name); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		// unexported
+		return "", false
+	}
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	switch tag {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return tag, true
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// redacted returns the value with any sensitive fields within it cleared.
+func redacted(v reflect.Value) interface{} {
+	if !containsSensitive(v.Type(), make(map[reflect.Type]bool)) {
+		return v.Interface()
+	}
+	cp := reflect.New(v.Type()).Elem()
+	redactInto(cp, v)
+	return cp.Interface()
+}
+
+func redactInto(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Struct:
+		t := src.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			if name, ok := jsonName(t.Field(i)); ok {
+				if _, ok := sensitiveFields[name]; ok {
+					continue
+				}
+			}
+			redactInto(dst.Field(i), src.Field(i))
+		}
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			redactInto(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		for _, k := range src.MapKeys() {
+			item := reflect.New(src.Type().Elem()).Elem()
+			redactInto(item, src.MapIndex(k))
+			dst.SetMapIndex(k, item)
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+func containsSensitive(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, ok := jsonName(field)
+			if !ok {
+				continue
+			}
+			if _, ok := sensitiveFields[name]; ok {
+				return true
+			}
+			if containsSensitive(field.Type, seen) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Ptr:
+		return containsSensitive(t.Elem(), seen)
+	}
+	return false
+}
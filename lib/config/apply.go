@@ -0,0 +1,212 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// RemoveKey marks an item of a list in a partial configuration as to be
+// removed, as in {"id": "abcd-1234", "_remove": true}.
+const RemoveKey = "_remove"
+
+// listItemKeys are the JSON fields identifying items in lists, such as
+// folders and devices, in order of preference.
+var listItemKeys = []string{"id", "deviceID", "name"}
+
+// Revision returns a fingerprint of the configuration, which changes
+// whenever the configuration does.
+func (cfg Configuration) Revision() string {
+	// Copying makes nil and empty lists the same.
+	bs, err := json.Marshal(cfg.Copy())
+	if err != nil {
+		// Can't happen; the configuration is always serializable.
+		panic("bug: marshalling configuration: " + err.Error())
+	}
+	hash := sha256.Sum256(bs)
+	return hex.EncodeToString(hash[:16])
+}
+
+// ApplyPartial returns the configuration with the given partial
+// configuration in JSON applied to it. Objects are merged field by field,
+// lists of items with an ID (like folders and devices) are merged item by
+// item, with new items getting the usual defaults, and anything else in
+// the partial configuration replaces what's there. Items are only removed
+// when asked for with RemoveKey.
+func (cfg Configuration) ApplyPartial(partial []byte, myID protocol.DeviceID) (Configuration, error) {
+	current, err := toJSONValue(cfg)
+	if err != nil {
+		return Configuration{}, err
+	}
+	var desired interface{}
+	dec := json.NewDecoder(bytes.NewReader(partial))
+	dec.UseNumber()
+	if err := dec.Decode(&desired); err != nil {
+		return Configuration{}, err
+	}
+	if _, ok := desired.(map[string]interface{}); !ok {
+		return Configuration{}, fmt.Errorf("partial configuration must be an object")
+	}
+
+	merged, err := mergeJSON("", current, desired)
+	if err != nil {
+		return Configuration{}, err
+	}
+	bs, err := json.Marshal(merged)
+	if err != nil {
+		return Configuration{}, err
+	}
+	return ReadJSON(bytes.NewReader(bs), myID)
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+	err = dec.Decode(&res)
+	return res, err
+}
+
+func mergeJSON(path string, current, desired interface{}) (interface{}, error) {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		cur, ok := current.(map[string]interface{})
+		if !ok {
+			return desired, nil
+		}
+		res := make(map[string]interface{}, len(cur))
+		for k, v := range cur {
+			res[k] = v
+		}
+		for k, v := range desired {
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			merged, err := mergeJSON(fieldPath, cur[k], v)
+			if err != nil {
+				return nil, err
+			}
+			res[k] = merged
+		}
+		return res, nil
+
+	case []interface{}:
+		cur, ok := current.([]interface{})
+		if !ok {
+			return desired, nil
+		}
+		key, ok := itemKey(cur, desired)
+		if !ok {
+			return desired, nil
+		}
+		return mergeItems(path, key, cur, desired)
+
+	default:
+		return desired, nil
+	}
+}
+
+// itemKey returns the field identifying the items of the lists, if they
+// are lists of objects that all have one.
+func itemKey(lists ...[]interface{}) (string, bool) {
+nextKey:
+	for _, key := range listItemKeys {
+		n := 0
+		for _, list := range lists {
+			for _, item := range list {
+				obj, ok := item.(map[string]interface{})
+				if !ok {
+					return "", false
+				}
+				if _, ok := obj[key].(string); !ok {
+					continue nextKey
+				}
+				n++
+			}
+		}
+		if n > 0 {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// itemID returns the ID of the item, with device IDs in their canonical
+// form so that they match however they were written.
+func itemID(key string, item interface{}) string {
+	id := item.(map[string]interface{})[key].(string)
+	if key == "deviceID" {
+		if devID, err := protocol.DeviceIDFromString(id); err == nil {
+			return devID.String()
+		}
+	}
+	return id
+}
+
+func mergeItems(path, key string, current, desired []interface{}) (interface{}, error) {
+	res := make([]interface{}, len(current))
+	copy(res, current)
+	index := make(map[string]int, len(current))
+	for i, item := range current {
+		index[itemID(key, item)] = i
+	}
+
+	removed := make(map[int]bool)
+	for _, item := range desired {
+		obj := item.(map[string]interface{})
+		id := itemID(key, obj)
+		itemPath := fmt.Sprintf("%s[%s]", path, id)
+		i, exists := index[id]
+
+		if remove, ok := obj[RemoveKey]; ok {
+			if remove != true {
+				return nil, fmt.Errorf("%s: %s must be true if set", itemPath, RemoveKey)
+			}
+			if exists {
+				// Removing what isn't there is fine; it's the desired state.
+				removed[i] = true
+			}
+			continue
+		}
+
+		if !exists {
+			index[id] = len(res)
+			res = append(res, obj)
+			continue
+		}
+		if removed[i] {
+			return nil, fmt.Errorf("%s: both removed and changed", itemPath)
+		}
+		merged, err := mergeJSON(itemPath, res[i], obj)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = merged
+	}
+
+	if len(removed) == 0 {
+		return res, nil
+	}
+	kept := res[:0]
+	for i, item := range res {
+		if !removed[i] {
+			kept = append(kept, item)
+		}
+	}
+	return kept, nil
+}
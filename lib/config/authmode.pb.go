@@ -27,16 +27,19 @@ type AuthMode int32
 const (
 	AuthModeStatic AuthMode = 0
 	AuthModeLDAP   AuthMode = 1
+	AuthModeOIDC   AuthMode = 2
 )
 
 var AuthMode_name = map[int32]string{
 	0: "AUTH_MODE_STATIC",
 	1: "AUTH_MODE_LDAP",
+	2: "AUTH_MODE_OIDC",
 }
 
 var AuthMode_value = map[string]int32{
 	"AUTH_MODE_STATIC": 0,
 	"AUTH_MODE_LDAP":   1,
+	"AUTH_MODE_OIDC":   2,
 }
 
 func (AuthMode) EnumDescriptor() ([]byte, []int) {
@@ -50,20 +53,21 @@ func init() {
 func init() { proto.RegisterFile("lib/config/authmode.proto", fileDescriptor_8e30b562e1bcea1e) }
 
 var fileDescriptor_8e30b562e1bcea1e = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
+	// 248 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0xc9, 0x4c, 0xd2,
 	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0xcd, 0x4f, 0x49, 0xd5,
 	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0x08, 0x4b, 0x29, 0x17, 0xa5, 0x16, 0xe4, 0x17,
 	0xeb, 0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4, 0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c, 0x30, 0x0b, 0xa2,
-	0x58, 0x8a, 0x33, 0xb5, 0xa2, 0x04, 0xc2, 0xd4, 0x2a, 0xe0, 0xe2, 0x70, 0x2c, 0x2d, 0xc9, 0xf0,
-	0xcd, 0x4f, 0x49, 0x15, 0xd2, 0xe0, 0x12, 0x70, 0x0c, 0x0d, 0xf1, 0x88, 0xf7, 0xf5, 0x77, 0x71,
-	0x8d, 0x0f, 0x0e, 0x71, 0x0c, 0xf1, 0x74, 0x16, 0x60, 0x90, 0x12, 0xea, 0x9a, 0xab, 0xc0, 0x07,
-	0x53, 0x13, 0x5c, 0x92, 0x58, 0x92, 0x99, 0x2c, 0x64, 0xc2, 0xc5, 0x87, 0x50, 0xe9, 0xe3, 0xe2,
-	0x18, 0x20, 0xc0, 0x28, 0xa5, 0xd0, 0x35, 0x57, 0x81, 0x07, 0xa6, 0x0e, 0x24, 0x76, 0xa9, 0x4f,
-	0x15, 0x85, 0x2f, 0xc5, 0xb2, 0x62, 0x89, 0x1c, 0x83, 0x93, 0xf7, 0x89, 0x87, 0x72, 0x0c, 0x17,
-	0x1e, 0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x0b, 0x1e, 0xcb, 0x31, 0x5e, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x71, 0x65, 0x5e, 0x72, 0x49, 0x46,
-	0x66, 0x5e, 0x3a, 0x12, 0x0b, 0x11, 0x0a, 0x49, 0x6c, 0x60, 0x5f, 0x18, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x48, 0x80, 0x1f, 0x0c, 0x1a, 0x01, 0x00, 0x00,
+	0x58, 0x8a, 0x33, 0xb5, 0xa2, 0x04, 0xc2, 0xd4, 0x5a, 0xc6, 0xc8, 0xc5, 0xe1, 0x58, 0x5a, 0x92,
+	0xe1, 0x9b, 0x9f, 0x92, 0x2a, 0xa4, 0xc1, 0x25, 0xe0, 0x18, 0x1a, 0xe2, 0x11, 0xef, 0xeb, 0xef,
+	0xe2, 0x1a, 0x1f, 0x1c, 0xe2, 0x18, 0xe2, 0xe9, 0x2c, 0xc0, 0x20, 0x25, 0xd4, 0x35, 0x57, 0x81,
+	0x0f, 0xa6, 0x26, 0xb8, 0x24, 0xb1, 0x24, 0x33, 0x59, 0xc8, 0x84, 0x8b, 0x0f, 0xa1, 0xd2, 0xc7,
+	0xc5, 0x31, 0x40, 0x80, 0x51, 0x4a, 0xa1, 0x6b, 0xae, 0x02, 0x0f, 0x4c, 0x1d, 0x48, 0xec, 0x52,
+	0x9f, 0x2a, 0x0a, 0x1f, 0x55, 0x97, 0xbf, 0xa7, 0x8b, 0xb3, 0x00, 0x13, 0xaa, 0x2e, 0x90, 0x18,
+	0xb2, 0x2e, 0x10, 0x5f, 0x8a, 0x65, 0xc5, 0x12, 0x39, 0x06, 0x27, 0xef, 0x13, 0x0f, 0xe5, 0x18,
+	0x2e, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
+	0x18, 0x16, 0x3c, 0x96, 0x63, 0xbc, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcd,
+	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xca, 0xbc, 0xe4, 0x92,
+	0x8c, 0xcc, 0xbc, 0x74, 0x24, 0x16, 0x22, 0xf0, 0x92, 0xd8, 0xc0, 0x9e, 0x37, 0x06, 0x0c, 0x00,
+	0xbe, 0x6b, 0x92, 0x33, 0x51, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,82 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"strings"
+	"time"
+)
+
+func (p BandwidthProfile) Copy() BandwidthProfile {
+	c := p
+	c.Schedule = p.Schedule.Copy()
+	return c
+}
+
+func (opts *OptionsConfiguration) prepareBandwidthProfiles() {
+	seen := make(map[string]struct{}, len(opts.BandwidthProfiles))
+	profiles := opts.BandwidthProfiles[:0]
+	for _, p := range opts.BandwidthProfiles {
+		p.Name = strings.TrimSpace(p.Name)
+		if p.Name == "" {
+			l.Warnln("Ignoring bandwidth profile without a name")
+			continue
+		}
+		if _, ok := seen[p.Name]; ok {
+			l.Warnf("Ignoring duplicate bandwidth profile %q", p.Name)
+			continue
+		}
+		seen[p.Name] = struct{}{}
+
+		if p.MaxSendKbps < 0 {
+			p.MaxSendKbps = 0
+		}
+		if p.MaxRecvKbps < 0 {
+			p.MaxRecvKbps = 0
+		}
+		if err := p.Schedule.Validate(); err != nil {
+			l.Warnf("Bandwidth profile %q: %v; the window will never be active", p.Name, err)
+		}
+		profiles = append(profiles, p)
+	}
+	opts.BandwidthProfiles = profiles
+}
+
+// BandwidthProfile returns the profile with the given name.
+func (opts OptionsConfiguration) BandwidthProfile(name string) (BandwidthProfile, bool) {
+	for _, p := range opts.BandwidthProfiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return BandwidthProfile{}, false
+}
+
+// ScheduledBandwidthProfile returns the first profile with a schedule that
+// allows the given time. Profiles without a schedule are only used when
+// selected explicitly and never match here.
+func (opts OptionsConfiguration) ScheduledBandwidthProfile(t time.Time) (BandwidthProfile, bool) {
+	for _, p := range opts.BandwidthProfiles {
+		if p.Schedule.IsEnabled() && p.Schedule.Allows(t) {
+			return p, true
+		}
+	}
+	return BandwidthProfile{}, false
+}
+
+// NextBandwidthProfileChange returns the first point in time after t at
+// which the scheduled profile may change, or the zero time if it never
+// does.
+func (opts OptionsConfiguration) NextBandwidthProfileChange(t time.Time) time.Time {
+	var next time.Time
+	for _, p := range opts.BandwidthProfiles {
+		if change := p.Schedule.NextChange(t); !change.IsZero() && (next.IsZero() || change.Before(next)) {
+			next = change
+		}
+	}
+	return next
+}
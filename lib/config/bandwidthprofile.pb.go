@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/bandwidthprofile.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BandwidthProfile is a named set of global rate limits that replaces the
+// limits in the options while its schedule allows it.
+type BandwidthProfile struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	MaxSendKbps int      `protobuf:"varint,2,opt,name=max_send_kbps,json=maxSendKbps,proto3,casttype=int" json:"maxSendKbps" xml:"maxSendKbps"`
+	MaxRecvKbps int      `protobuf:"varint,3,opt,name=max_recv_kbps,json=maxRecvKbps,proto3,casttype=int" json:"maxRecvKbps" xml:"maxRecvKbps"`
+	Schedule    Schedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule" xml:"schedule"`
+}
+
+func (m *BandwidthProfile) Reset()         { *m = BandwidthProfile{} }
+func (m *BandwidthProfile) String() string { return proto.CompactTextString(m) }
+func (*BandwidthProfile) ProtoMessage()    {}
+func (*BandwidthProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11fd576fe2c8e1ba, []int{0}
+}
+func (m *BandwidthProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BandwidthProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BandwidthProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BandwidthProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthProfile.Merge(m, src)
+}
+func (m *BandwidthProfile) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BandwidthProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthProfile proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BandwidthProfile)(nil), "config.BandwidthProfile")
+}
+
+func init() { proto.RegisterFile("lib/config/bandwidthprofile.proto", fileDescriptor_11fd576fe2c8e1ba) }
+
+var fileDescriptor_11fd576fe2c8e1ba = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x7b, 0x80, 0x44, 0x4a, 0x54, 0xec, 0x84, 0x0c, 0x77, 0xd8, 0x38, 0xd4, 0x68, 0x4a,
+	0xa2, 0x9b, 0x71, 0xea, 0xca, 0x20, 0x29, 0x9b, 0x0b, 0xe9, 0x9f, 0x83, 0x5e, 0xa4, 0xd7, 0xa6,
+	0x3d, 0xb0, 0x7e, 0x0b, 0x3f, 0x82, 0x1f, 0xc6, 0x81, 0x4d, 0x46, 0xa7, 0x4b, 0xa0, 0x5b, 0x47,
+	0x46, 0x27, 0x63, 0xeb, 0xd5, 0xe2, 0xf6, 0x3e, 0xcf, 0xfb, 0xcb, 0x6f, 0x78, 0x5f, 0xf9, 0x7c,
+	0x4e, 0xec, 0x81, 0x13, 0xd0, 0x29, 0x99, 0x0d, 0x6c, 0x8b, 0xba, 0xcf, 0xc4, 0x65, 0x5e, 0x18,
+	0x05, 0x53, 0x32, 0xc7, 0x7a, 0x18, 0x05, 0x2c, 0x50, 0x9a, 0xc5, 0xba, 0x77, 0x56, 0x41, 0x63,
+	0xc7, 0xc3, 0xee, 0x42, 0x20, 0xbd, 0x16, 0x4e, 0x58, 0x31, 0xaa, 0xef, 0x35, 0xb9, 0x63, 0x08,
+	0xd1, 0xa8, 0x10, 0x29, 0xf7, 0x72, 0x83, 0x5a, 0x3e, 0xee, 0x82, 0x3e, 0xd0, 0x5a, 0x86, 0x96,
+	0x71, 0x94, 0xe7, 0x1d, 0x47, 0x27, 0x89, 0x3f, 0xbf, 0x53, 0x7f, 0xc2, 0xb5, 0xc5, 0x58, 0xa4,
+	0x66, 0x1f, 0x17, 0xad, 0x32, 0x99, 0x39, 0xa5, 0x3c, 0xc8, 0x47, 0xbe, 0x95, 0x4c, 0x62, 0x4c,
+	0xdd, 0xc9, 0x93, 0x1d, 0xc6, 0xdd, 0x5a, 0x1f, 0x68, 0x07, 0xc6, 0x55, 0xc6, 0x51, 0xdb, 0xb7,
+	0x92, 0x31, 0xa6, 0xee, 0xd0, 0x0e, 0xe3, 0x1d, 0x47, 0xa7, 0xb9, 0xad, 0xd2, 0xa9, 0x5f, 0x1c,
+	0xd5, 0x09, 0x65, 0x66, 0x15, 0x14, 0xc2, 0x08, 0x3b, 0xcb, 0x42, 0x58, 0xdf, 0x13, 0x9a, 0xd8,
+	0x59, 0xfe, 0x17, 0x8a, 0x6e, 0x4f, 0x28, 0x4a, 0x65, 0x24, 0x1f, 0x8a, 0x8b, 0x74, 0x1b, 0x7d,
+	0xa0, 0xb5, 0x6f, 0x3a, 0x7a, 0x71, 0x29, 0x7d, 0xfc, 0xdb, 0x1b, 0xea, 0x8a, 0x23, 0x29, 0xe3,
+	0xa8, 0x24, 0x77, 0x1c, 0x1d, 0xe7, 0x7a, 0x51, 0xa8, 0x66, 0xb9, 0x33, 0x86, 0xab, 0x0d, 0x94,
+	0xd6, 0x1b, 0x28, 0xad, 0xb6, 0x10, 0xac, 0xb7, 0x10, 0xbc, 0xa6, 0x50, 0x7a, 0x4b, 0x21, 0x58,
+	0xa7, 0x50, 0xfa, 0x4c, 0xa1, 0xf4, 0x78, 0x39, 0x23, 0xcc, 0x5b, 0xd8, 0xba, 0x13, 0xf8, 0x83,
+	0xf8, 0x85, 0x3a, 0xcc, 0x23, 0x74, 0x56, 0x99, 0xfe, 0xbe, 0x65, 0x37, 0xf3, 0xd7, 0xdc, 0x7e,
+	0x0f, 0x00, 0xd7, 0x48, 0x53, 0x37, 0xed, 0x01, 0x00, 0x00,
+}
+
+func (m *BandwidthProfile) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BandwidthProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandwidthProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBandwidthprofile(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxRecvKbps != 0 {
+		i = encodeVarintBandwidthprofile(dAtA, i, uint64(m.MaxRecvKbps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSendKbps != 0 {
+		i = encodeVarintBandwidthprofile(dAtA, i, uint64(m.MaxSendKbps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBandwidthprofile(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBandwidthprofile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBandwidthprofile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BandwidthProfile) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBandwidthprofile(uint64(l))
+	}
+	if m.MaxSendKbps != 0 {
+		n += 1 + sovBandwidthprofile(uint64(m.MaxSendKbps))
+	}
+	if m.MaxRecvKbps != 0 {
+		n += 1 + sovBandwidthprofile(uint64(m.MaxRecvKbps))
+	}
+	l = m.Schedule.ProtoSize()
+	n += 1 + l + sovBandwidthprofile(uint64(l))
+	return n
+}
+
+func sovBandwidthprofile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBandwidthprofile(x uint64) (n int) {
+	return sovBandwidthprofile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BandwidthProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBandwidthprofile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandwidthProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandwidthProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBandwidthprofile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandwidthprofile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendKbps", wireType)
+			}
+			m.MaxSendKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSendKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecvKbps", wireType)
+			}
+			m.MaxRecvKbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecvKbps |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBandwidthprofile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBandwidthprofile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBandwidthprofile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBandwidthprofile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBandwidthprofile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBandwidthprofile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBandwidthprofile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBandwidthprofile
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBandwidthprofile
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBandwidthprofile
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBandwidthprofile        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBandwidthprofile          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBandwidthprofile = fmt.Errorf("proto: unexpected end of group")
+)
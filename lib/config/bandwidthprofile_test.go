@@ -0,0 +1,47 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBandwidthProfilesPrepare(t *testing.T) {
+	opts := OptionsConfiguration{
+		BandwidthProfiles: []BandwidthProfile{
+			{Name: " night ", MaxSendKbps: -5, Schedule: Schedule{Windows: []ScheduleWindow{{Start: "22:00", End: "06:00"}}}},
+			{Name: ""},
+			{Name: "night", MaxSendKbps: 100},
+			{Name: "day", MaxSendKbps: 50, Schedule: Schedule{Windows: []ScheduleWindow{{Start: "06:00", End: "22:00"}}}},
+			{Name: "unlimited"},
+		},
+	}
+	opts.prepareBandwidthProfiles()
+
+	if len(opts.BandwidthProfiles) != 3 {
+		t.Fatalf("Expected three profiles, got %v", opts.BandwidthProfiles)
+	}
+	night, ok := opts.BandwidthProfile("night")
+	if !ok || night.MaxSendKbps != 0 {
+		t.Errorf("Unexpected night profile %v", night)
+	}
+
+	// 2026-10-19 is a Monday
+	at := func(h int) time.Time {
+		return time.Date(2026, 10, 19, h, 0, 0, 0, time.Local)
+	}
+	if p, ok := opts.ScheduledBandwidthProfile(at(23)); !ok || p.Name != "night" {
+		t.Errorf("Expected the night profile at 23:00, got %v", p)
+	}
+	if p, ok := opts.ScheduledBandwidthProfile(at(12)); !ok || p.Name != "day" {
+		t.Errorf("Expected the day profile at 12:00, got %v", p)
+	}
+	if next := opts.NextBandwidthProfileChange(at(12)); !next.Equal(at(22)) {
+		t.Errorf("Expected the next change at 22:00, got %v", next)
+	}
+}
@@ -221,14 +221,37 @@ func (cfg Configuration) Copy() Configuration {
 
 	newCfg.Options = cfg.Options.Copy()
 	newCfg.GUI = cfg.GUI.Copy()
+	newCfg.OIDC = cfg.OIDC.Copy()
 
 	// DeviceIDs are values
 	newCfg.IgnoredDevices = make([]ObservedDevice, len(cfg.IgnoredDevices))
 	copy(newCfg.IgnoredDevices, cfg.IgnoredDevices)
 
+	newCfg.Webhooks = make([]WebhookConfiguration, len(cfg.Webhooks))
+	for i := range newCfg.Webhooks {
+		newCfg.Webhooks[i] = cfg.Webhooks[i].Copy()
+	}
+
 	return newCfg
 }
 
+// Redacted returns a copy of the configuration without secrets, i.e.
+// passwords, API keys, folder encryption passwords, webhook secrets and the
+// OIDC client secret.
+func (cfg Configuration) Redacted() Configuration {
+	cfg = cfg.Copy()
+	for i := range cfg.Folders {
+		cfg.Folders[i] = cfg.Folders[i].Redacted()
+	}
+	cfg.Defaults.Folder = cfg.Defaults.Folder.Redacted()
+	cfg.GUI = cfg.GUI.Redacted()
+	cfg.OIDC = cfg.OIDC.Redacted()
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].Secret = ""
+	}
+	return cfg
+}
+
 func (cfg *Configuration) WriteXML(w io.Writer) error {
 	e := xml.NewEncoder(w)
 	e.Indent("", "    ")
@@ -258,6 +281,8 @@ func (cfg *Configuration) prepare(myID protocol.DeviceID) error {
 
 	cfg.Defaults.prepare(myID, existingDevices)
 
+	cfg.prepareWebhooks()
+
 	cfg.removeDeprecatedProtocols()
 
 	util.FillNilExceptDeprecated(cfg)
@@ -413,6 +438,13 @@ func (cfg *Configuration) DeviceMap() map[protocol.DeviceID]DeviceConfiguration
 	return m
 }
 
+// RemoveDevice removes the device with the given ID, if there is one.
+func (cfg *Configuration) RemoveDevice(id protocol.DeviceID) {
+	if _, i, ok := cfg.Device(id); ok {
+		cfg.Devices = append(cfg.Devices[:i], cfg.Devices[i+1:]...)
+	}
+}
+
 func (cfg *Configuration) SetDevice(device DeviceConfiguration) {
 	cfg.SetDevices([]DeviceConfiguration{device})
 }
@@ -463,6 +495,13 @@ func (cfg Configuration) FolderPasswords(device protocol.DeviceID) map[string]st
 	return res
 }
 
+// RemoveFolder removes the folder with the given ID, if there is one.
+func (cfg *Configuration) RemoveFolder(id string) {
+	if _, i, ok := cfg.Folder(id); ok {
+		cfg.Folders = append(cfg.Folders[:i], cfg.Folders[i+1:]...)
+	}
+}
+
 func (cfg *Configuration) SetFolder(folder FolderConfiguration) {
 	cfg.SetFolders([]FolderConfiguration{folder})
 }
@@ -24,15 +24,17 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Configuration struct {
-	Version                  int                   `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
-	Folders                  []FolderConfiguration `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
-	Devices                  []DeviceConfiguration `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
-	GUI                      GUIConfiguration      `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
-	LDAP                     LDAPConfiguration     `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
-	Options                  OptionsConfiguration  `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
-	IgnoredDevices           []ObservedDevice      `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
-	DeprecatedPendingDevices []ObservedDevice      `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
-	Defaults                 Defaults              `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Version                  int                    `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
+	Folders                  []FolderConfiguration  `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices                  []DeviceConfiguration  `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
+	GUI                      GUIConfiguration       `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
+	LDAP                     LDAPConfiguration      `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
+	Options                  OptionsConfiguration   `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
+	IgnoredDevices           []ObservedDevice       `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
+	DeprecatedPendingDevices []ObservedDevice       `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
+	Defaults                 Defaults               `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Webhooks                 []WebhookConfiguration `protobuf:"bytes,10,rep,name=webhooks,proto3" json:"webhooks" xml:"webhook"`
+	OIDC                     OIDCConfiguration      `protobuf:"bytes,11,opt,name=oidc,proto3" json:"oidc" xml:"oidc"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -153,52 +155,57 @@ func init() {
 func init() { proto.RegisterFile("lib/config/config.proto", fileDescriptor_baadf209193dc627) }
 
 var fileDescriptor_baadf209193dc627 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x8d, 0x9b, 0xed, 0x0b, 0x19, 0x44, 0x5d, 0x1e, 0xde, 0xb0, 0x0a, 0x28,
-	0xa0, 0x3e, 0xa4, 0x72, 0xa9, 0xb8, 0x11, 0x22, 0x4a, 0x55, 0x24, 0x2a, 0xa3, 0x22, 0xe0, 0x82,
-	0x92, 0x78, 0xeb, 0xae, 0x94, 0xd8, 0x96, 0xed, 0x54, 0xed, 0x91, 0x23, 0x37, 0xc4, 0x27, 0xe0,
-	0x84, 0xc4, 0x37, 0xe9, 0xad, 0x39, 0x72, 0x5a, 0xa9, 0xcd, 0xcd, 0x47, 0x1f, 0x39, 0xa1, 0x7d,
-	0x39, 0xb6, 0x6a, 0xe0, 0x94, 0xcc, 0xfc, 0xff, 0xf3, 0xdb, 0xd5, 0xec, 0x8c, 0xc1, 0xea, 0x80,
-	0xf4, 0xb6, 0xfa, 0xbe, 0x77, 0x44, 0x5c, 0xf9, 0xb3, 0x19, 0x84, 0x7e, 0xec, 0x1b, 0x35, 0x11,
-	0xdd, 0x69, 0xe6, 0x0c, 0x47, 0xfe, 0xc0, 0xc1, 0xa1, 0x08, 0x46, 0x61, 0x37, 0x26, 0xbe, 0x27,
-	0xdc, 0x05, 0x97, 0x83, 0x4f, 0x48, 0x1f, 0x97, 0xb9, 0x1e, 0xe4, 0x5c, 0xee, 0x88, 0x94, 0x59,
-	0x50, 0xce, 0x32, 0x70, 0xba, 0x41, 0x99, 0xe7, 0x61, 0xce, 0xe3, 0x07, 0x4c, 0x88, 0xca, 0x6c,
-	0x6b, 0x79, 0x5b, 0x2f, 0xc2, 0xe1, 0x09, 0x76, 0xa4, 0x54, 0xc7, 0xa7, 0xb1, 0xf8, 0x8b, 0x7e,
-	0xe8, 0x60, 0xe9, 0x45, 0xbe, 0xda, 0xb0, 0x81, 0x7e, 0x82, 0xc3, 0x88, 0xf8, 0x9e, 0xa9, 0x35,
-	0xb4, 0xd6, 0x5c, 0x7b, 0x27, 0xa1, 0x50, 0xa5, 0x52, 0x0a, 0x8d, 0xd3, 0xe1, 0xe0, 0x19, 0x92,
-	0xf1, 0x7a, 0x37, 0x8e, 0x43, 0xf4, 0x9b, 0xc2, 0x2a, 0xf1, 0xe2, 0xe4, 0xa2, 0xb9, 0x98, 0xcf,
-	0xdb, 0xaa, 0xca, 0x78, 0x07, 0x74, 0xd1, 0xbc, 0xc8, 0x9c, 0x69, 0x54, 0x5b, 0x0b, 0xdb, 0x77,
-	0x37, 0x65, 0xb7, 0x5f, 0xf2, 0x74, 0xe1, 0x06, 0x6d, 0x78, 0x4e, 0x61, 0x85, 0x1d, 0x2a, 0x6b,
-	0x52, 0x0a, 0x17, 0xf9, 0xa1, 0x22, 0x46, 0xb6, 0x12, 0x18, 0x57, 0xb4, 0x3b, 0x32, 0xab, 0x45,
-	0x6e, 0x87, 0xa7, 0xff, 0xc2, 0x95, 0x35, 0x19, 0x57, 0xc4, 0xc8, 0x56, 0x82, 0x61, 0x83, 0xaa,
-	0x3b, 0x22, 0xe6, 0x6c, 0x43, 0x6b, 0x2d, 0x6c, 0x9b, 0x8a, 0xb9, 0x7b, 0xb8, 0x57, 0x04, 0x3e,
-	0x62, 0xc0, 0x2b, 0x0a, 0xab, 0xbb, 0x87, 0x7b, 0x09, 0x85, 0xac, 0x26, 0xa5, 0xb0, 0xce, 0x99,
-	0xee, 0x88, 0xa0, 0x6f, 0xe3, 0x26, 0x93, 0x6c, 0x26, 0x18, 0x1f, 0xc0, 0x2c, 0x7b, 0x51, 0x73,
-	0x8e, 0x43, 0xd7, 0x14, 0xf4, 0x75, 0xe7, 0xf9, 0x41, 0x91, 0xfa, 0x44, 0x52, 0x67, 0x99, 0x94,
-	0x50, 0xc8, 0xcb, 0x52, 0x0a, 0x01, 0xe7, 0xb2, 0x80, 0x81, 0xb9, 0x6a, 0x73, 0xcd, 0x78, 0x0f,
-	0x74, 0x39, 0x08, 0x66, 0x8d, 0xd3, 0xef, 0x29, 0xfa, 0x1b, 0x91, 0x2e, 0x1e, 0xd0, 0x50, 0x7d,
-	0x90, 0x45, 0x29, 0x85, 0x4b, 0x9c, 0x2d, 0x63, 0x64, 0x2b, 0xc5, 0xf8, 0xa9, 0x81, 0x15, 0xe2,
-	0x7a, 0x7e, 0x88, 0x9d, 0x4f, 0xaa, 0xd3, 0x3a, 0xef, 0xf4, 0xed, 0xec, 0x08, 0x39, 0x5b, 0xa2,
-	0xe3, 0xed, 0x63, 0x09, 0xbf, 0x15, 0xe2, 0xa1, 0x1f, 0xe3, 0x3d, 0x51, 0xdc, 0xc9, 0x3a, 0xbe,
-	0xc6, 0x4f, 0x2a, 0x11, 0x51, 0x72, 0xd1, 0xbc, 0x59, 0x92, 0x4f, 0x2f, 0x9a, 0xa5, 0x2c, 0x7b,
-	0x99, 0x14, 0x62, 0xe3, 0x8b, 0x06, 0x56, 0x02, 0xec, 0x39, 0xc4, 0x73, 0xb3, 0xbb, 0xce, 0xff,
-	0xf3, 0xae, 0xaf, 0x64, 0xa7, 0xcd, 0x0e, 0x0e, 0x42, 0xdc, 0xef, 0xc6, 0xd8, 0x39, 0x10, 0x00,
-	0xc9, 0x4c, 0x28, 0xd4, 0x36, 0x52, 0x0a, 0xef, 0xf3, 0x4b, 0x07, 0x79, 0x6d, 0xdd, 0x1f, 0x92,
-	0x18, 0x0f, 0x83, 0xf8, 0x0c, 0x99, 0x9a, 0xbd, 0x5c, 0xd0, 0x22, 0xe3, 0x00, 0xcc, 0x3b, 0xf8,
-	0xa8, 0x3b, 0x1a, 0xc4, 0x91, 0x59, 0xe7, 0x4f, 0x72, 0x63, 0x3a, 0x99, 0x22, 0xdf, 0x46, 0xb2,
-	0x53, 0x99, 0x33, 0xa5, 0x70, 0x59, 0xce, 0xa3, 0x48, 0x20, 0x3b, 0xd3, 0xd0, 0xe7, 0x19, 0x30,
-	0xaf, 0x4a, 0x8d, 0xb7, 0xa0, 0x26, 0x56, 0x80, 0xaf, 0xe8, 0x7f, 0xd6, 0xc9, 0x92, 0xe7, 0xc8,
-	0x92, 0x6b, 0xdb, 0x24, 0xf3, 0x0c, 0x2a, 0xda, 0x66, 0xce, 0x14, 0xa1, 0x65, 0xbb, 0x94, 0x41,
-	0x45, 0xc9, 0xb5, 0x55, 0x92, 0x79, 0x63, 0x1f, 0xe8, 0xe2, 0x99, 0xd8, 0x86, 0x32, 0xea, 0x8a,
-	0xa2, 0x8a, 0xd7, 0x8c, 0xa6, 0xd3, 0x28, 0x7d, 0xd9, 0x34, 0xca, 0x18, 0xd9, 0x4a, 0x41, 0x3b,
-	0x40, 0x97, 0x55, 0xc6, 0x06, 0x98, 0x1b, 0x10, 0x0f, 0x47, 0xa6, 0xd6, 0xa8, 0xb6, 0xea, 0xed,
-	0xd5, 0x84, 0x42, 0x91, 0x98, 0x2e, 0x0a, 0xf1, 0x30, 0xb2, 0x45, 0xb2, 0xbd, 0x7f, 0x7e, 0x69,
-	0x55, 0xc6, 0x97, 0x56, 0xe5, 0xfc, 0xca, 0xd2, 0xc6, 0x57, 0x96, 0xf6, 0x75, 0x62, 0x55, 0xbe,
-	0x4f, 0x2c, 0x6d, 0x3c, 0xb1, 0x2a, 0xbf, 0x26, 0x56, 0xe5, 0xe3, 0x63, 0x97, 0xc4, 0xc7, 0xa3,
-	0xde, 0x66, 0xdf, 0x1f, 0x6e, 0x45, 0x67, 0x5e, 0x3f, 0x3e, 0x26, 0x9e, 0x9b, 0xfb, 0x37, 0xfd,
-	0x9a, 0xf6, 0x6a, 0xfc, 0xd3, 0xf9, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x7d, 0xcb,
-	0x2a, 0x3d, 0x06, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0x36, 0x8f, 0xe9, 0xeb, 0xca, 0xf7, 0xea, 0xd6, 0xbd, 0x17, 0x3c, 0x61, 0x14,
+	0x50, 0x40, 0x7d, 0x48, 0x65, 0x53, 0xb1, 0x23, 0x8d, 0x28, 0x51, 0x91, 0x5a, 0x19, 0x95, 0xd7,
+	0x06, 0x25, 0xf1, 0xc4, 0x19, 0x91, 0xd8, 0x91, 0xed, 0x94, 0x76, 0xc9, 0x92, 0x1d, 0xe2, 0x17,
+	0xb0, 0xe5, 0x9f, 0x74, 0xd7, 0x2c, 0x59, 0x8d, 0xd4, 0x66, 0x45, 0x96, 0x5e, 0xb2, 0x42, 0xf3,
+	0x72, 0x6c, 0xd5, 0xa5, 0xab, 0xfa, 0x7c, 0xdf, 0x77, 0xbe, 0x99, 0x9c, 0x73, 0xe6, 0x14, 0xac,
+	0xf5, 0x49, 0x7b, 0xbb, 0xe3, 0xb9, 0x5d, 0xe2, 0xc8, 0x3f, 0x5b, 0x43, 0xdf, 0x0b, 0x3d, 0xbd,
+	0x20, 0xa2, 0xff, 0xaa, 0x09, 0x41, 0xd7, 0xeb, 0xdb, 0xd8, 0x17, 0xc1, 0xc8, 0x6f, 0x85, 0xc4,
+	0x73, 0x85, 0x3a, 0xa5, 0xb2, 0xf1, 0x09, 0xe9, 0xe0, 0x2c, 0xd5, 0xbd, 0x84, 0xca, 0x19, 0x91,
+	0x2c, 0x09, 0x4a, 0x48, 0xfa, 0x76, 0x6b, 0x78, 0x9b, 0xc6, 0x23, 0x76, 0x27, 0x4b, 0x73, 0x3f,
+	0xa9, 0x19, 0x32, 0x22, 0xc8, 0x92, 0xad, 0x27, 0x65, 0xed, 0x00, 0xfb, 0x27, 0xd8, 0xce, 0x70,
+	0xf8, 0x88, 0xdb, 0x3d, 0xcf, 0xfb, 0x90, 0xe5, 0x50, 0xc6, 0xa7, 0xa1, 0xf8, 0x44, 0x3f, 0x4b,
+	0x60, 0x79, 0x2f, 0x29, 0xd1, 0x2d, 0x50, 0x3c, 0xc1, 0x7e, 0x40, 0x3c, 0xd7, 0xd0, 0x2a, 0x5a,
+	0x6d, 0xa1, 0xbe, 0x3b, 0xa5, 0x50, 0x41, 0x11, 0x85, 0xfa, 0xe9, 0xa0, 0xff, 0x04, 0xc9, 0x78,
+	0xa3, 0x15, 0x86, 0x3e, 0xfa, 0x45, 0x61, 0x9e, 0xb8, 0xe1, 0xf4, 0xa2, 0xba, 0x94, 0xc4, 0x2d,
+	0x95, 0xa5, 0xbf, 0x02, 0x45, 0xd1, 0x87, 0xc0, 0x98, 0xab, 0xe4, 0x6b, 0x8b, 0x3b, 0xff, 0x6f,
+	0xc9, 0xc6, 0x3d, 0xe3, 0x70, 0xea, 0x06, 0x75, 0x78, 0x4e, 0x61, 0x8e, 0x1d, 0x2a, 0x73, 0x22,
+	0x0a, 0x97, 0xf8, 0xa1, 0x22, 0x46, 0x96, 0x22, 0x98, 0xaf, 0xe8, 0x5c, 0x60, 0xe4, 0xd3, 0xbe,
+	0x0d, 0x0e, 0xdf, 0xe0, 0x2b, 0x73, 0x62, 0x5f, 0x11, 0x23, 0x4b, 0x11, 0xba, 0x05, 0xf2, 0xce,
+	0x88, 0x18, 0xf3, 0x15, 0xad, 0xb6, 0xb8, 0x63, 0x28, 0xcf, 0xfd, 0xe3, 0x66, 0xda, 0xf0, 0x01,
+	0x33, 0xbc, 0xa2, 0x30, 0xbf, 0x7f, 0xdc, 0x9c, 0x52, 0xc8, 0x72, 0x22, 0x0a, 0xcb, 0xdc, 0xd3,
+	0x19, 0x11, 0xf4, 0x75, 0x5c, 0x65, 0x94, 0xc5, 0x08, 0xfd, 0x2d, 0x98, 0x67, 0xc3, 0x61, 0x2c,
+	0x70, 0xd3, 0x75, 0x65, 0xfa, 0xa2, 0xf1, 0xf4, 0x28, 0xed, 0xfa, 0x48, 0xba, 0xce, 0x33, 0x6a,
+	0x4a, 0x21, 0x4f, 0x8b, 0x28, 0x04, 0xdc, 0x97, 0x05, 0xcc, 0x98, 0xb3, 0x16, 0xe7, 0xf4, 0x37,
+	0xa0, 0x28, 0xe7, 0xc5, 0x28, 0x70, 0xf7, 0x3b, 0xca, 0xfd, 0x50, 0xc0, 0xe9, 0x03, 0x2a, 0xaa,
+	0x0e, 0x32, 0x29, 0xa2, 0x70, 0x99, 0x7b, 0xcb, 0x18, 0x59, 0x8a, 0xd1, 0xbf, 0x6b, 0x60, 0x95,
+	0x38, 0xae, 0xe7, 0x63, 0xfb, 0xbd, 0xaa, 0x74, 0x91, 0x57, 0xfa, 0xdf, 0xf8, 0x08, 0x39, 0x82,
+	0xa2, 0xe2, 0xf5, 0x9e, 0x34, 0xff, 0xc7, 0xc7, 0x03, 0x2f, 0xc4, 0x4d, 0x91, 0xdc, 0x88, 0x2b,
+	0xbe, 0xce, 0x4f, 0xca, 0x20, 0xd1, 0xf4, 0xa2, 0xfa, 0x77, 0x06, 0x1e, 0x5d, 0x54, 0x33, 0xbd,
+	0xac, 0x15, 0x92, 0x8a, 0xf5, 0xcf, 0x1a, 0x58, 0x1d, 0x62, 0xd7, 0x26, 0xae, 0x13, 0xdf, 0xb5,
+	0xf4, 0xc7, 0xbb, 0x3e, 0x97, 0x95, 0x36, 0x1a, 0x78, 0xe8, 0xe3, 0x4e, 0x2b, 0xc4, 0xf6, 0x91,
+	0x30, 0x90, 0x9e, 0x53, 0x0a, 0xb5, 0xcd, 0x88, 0xc2, 0xbb, 0xfc, 0xd2, 0xc3, 0x24, 0xb7, 0xe1,
+	0x0d, 0x48, 0x88, 0x07, 0xc3, 0xf0, 0x0c, 0x19, 0x9a, 0xb5, 0x92, 0xe2, 0x02, 0xfd, 0x08, 0x94,
+	0x6c, 0xdc, 0x6d, 0x8d, 0xfa, 0x61, 0x60, 0x94, 0x79, 0x4b, 0xfe, 0x9a, 0x4d, 0xa6, 0xc0, 0xeb,
+	0x48, 0x56, 0x2a, 0x56, 0x46, 0x14, 0xae, 0xc8, 0x79, 0x14, 0x00, 0xb2, 0x62, 0x4e, 0xef, 0x82,
+	0x92, 0x7c, 0xd1, 0x81, 0x01, 0x2a, 0xf9, 0x64, 0x93, 0x5f, 0x0b, 0x3c, 0xdd, 0xe4, 0x0d, 0xe5,
+	0xae, 0xb2, 0xe2, 0x2e, 0x4b, 0x80, 0xd5, 0xbb, 0x28, 0xbf, 0xad, 0x58, 0xc5, 0xc6, 0x94, 0xed,
+	0x27, 0x63, 0x31, 0x3d, 0xa6, 0x87, 0xcd, 0xc6, 0xde, 0x0d, 0x63, 0xca, 0x28, 0x36, 0xa6, 0x2c,
+	0x2d, 0x1e, 0x53, 0x16, 0xf0, 0x31, 0x65, 0xac, 0xc5, 0x39, 0xf4, 0x69, 0x0e, 0x94, 0xd4, 0xaf,
+	0xd7, 0x5f, 0x82, 0x82, 0x78, 0xc5, 0x7c, 0xcb, 0xdc, 0xb2, 0x11, 0x4c, 0xf9, 0x63, 0x64, 0xca,
+	0xb5, 0x85, 0x20, 0x71, 0x66, 0x2a, 0x3a, 0x6f, 0xcc, 0xa5, 0x4d, 0xb3, 0xd6, 0x41, 0x6c, 0x2a,
+	0x52, 0xae, 0x6d, 0x03, 0x89, 0xeb, 0x07, 0xa0, 0x28, 0x26, 0x8d, 0x2d, 0x19, 0xe6, 0xba, 0xaa,
+	0x5c, 0xc5, 0x40, 0x06, 0xb3, 0x07, 0x25, 0x75, 0x71, 0xa9, 0x65, 0x8c, 0x2c, 0xc5, 0xa0, 0x5d,
+	0x50, 0x94, 0x59, 0xfa, 0x26, 0x58, 0xe8, 0x13, 0x17, 0x07, 0x86, 0x56, 0xc9, 0xd7, 0xca, 0xf5,
+	0xb5, 0x29, 0x85, 0x02, 0x98, 0xbd, 0x75, 0xe2, 0x62, 0x64, 0x09, 0xb0, 0x7e, 0x70, 0x7e, 0x69,
+	0xe6, 0xc6, 0x97, 0x66, 0xee, 0xfc, 0xca, 0xd4, 0xc6, 0x57, 0xa6, 0xf6, 0x65, 0x62, 0xe6, 0xbe,
+	0x4d, 0x4c, 0x6d, 0x3c, 0x31, 0x73, 0x3f, 0x26, 0x66, 0xee, 0xdd, 0x43, 0x87, 0x84, 0xbd, 0x51,
+	0x7b, 0xab, 0xe3, 0x0d, 0xb6, 0x83, 0x33, 0xb7, 0x13, 0xf6, 0x88, 0xeb, 0x24, 0xbe, 0x66, 0xff,
+	0x1c, 0xda, 0x05, 0xbe, 0xfd, 0x1f, 0xff, 0x1e, 0x00, 0x54, 0xed, 0x37, 0x5e, 0x4b, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -221,6 +228,30 @@ func (m *Configuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.OIDC.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Webhooks) > 0 {
+		for iNdEx := len(m.Webhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Webhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -462,6 +493,14 @@ func (m *Configuration) ProtoSize() (n int) {
 	}
 	l = m.Defaults.ProtoSize()
 	n += 1 + l + sovConfig(uint64(l))
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.ProtoSize()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	l = m.OIDC.ProtoSize()
+	n += 1 + l + sovConfig(uint64(l))
 	return n
 }
 
@@ -817,6 +856,73 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, WebhookConfiguration{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OIDC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
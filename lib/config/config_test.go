@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 
@@ -85,6 +86,8 @@ func TestDefaultValues(t *testing.T) {
 			RawStunServers:          []string{"default"},
 			AnnounceLANAddresses:    true,
 			FeatureFlags:            []string{},
+			BandwidthProfiles:       []BandwidthProfile{},
+			ConfigHistoryEntries:    10,
 		},
 		Defaults: Defaults{
 			Folder: FolderConfiguration{
@@ -106,18 +109,24 @@ func TestDefaultValues(t *testing.T) {
 				WeakHashThresholdPct: 25,
 				MarkerName:           ".stfolder",
 				MaxConcurrentWrites:  2,
+				Schedule:             Schedule{Windows: []ScheduleWindow{}},
+				MergePatterns:        []string{},
+				MergeMaxSize:         Size{1, "MB"},
+				SelectedPaths:        []string{},
 			},
 			Device: DeviceConfiguration{
 				Addresses:       []string{"dynamic"},
 				AllowedNetworks: []string{},
 				Compression:     protocol.CompressionMetadata,
 				IgnoredFolders:  []ObservedFolder{},
+				Schedule:        Schedule{Windows: []ScheduleWindow{}},
 			},
 			Ignores: Ignores{
 				Lines: []string{},
 			},
 		},
 		IgnoredDevices: []ObservedDevice{},
+		Webhooks:       []WebhookConfiguration{},
 	}
 	expected.Devices = []DeviceConfiguration{expected.Defaults.Device.Copy()}
 	expected.Devices[0].DeviceID = device1
@@ -126,6 +135,7 @@ func TestDefaultValues(t *testing.T) {
 	cfg := New(device1)
 	cfg.GUI = GUIConfiguration{}
 	cfg.LDAP = LDAPConfiguration{}
+	cfg.OIDC = OIDCConfiguration{}
 
 	if diff, equal := messagediff.PrettyDiff(expected, cfg); !equal {
 		t.Errorf("Default config differs. Diff:\n%s", diff)
@@ -177,6 +187,10 @@ func TestDeviceConfig(t *testing.T) {
 				MarkerName:           DefaultMarkerName,
 				JunctionsAsDirs:      true,
 				MaxConcurrentWrites:  maxConcurrentWritesDefault,
+				Schedule:             Schedule{Windows: []ScheduleWindow{}},
+				MergePatterns:        []string{},
+				MergeMaxSize:         Size{1, "MB"},
+				SelectedPaths:        []string{},
 			},
 		}
 
@@ -188,6 +202,7 @@ func TestDeviceConfig(t *testing.T) {
 				Compression:     protocol.CompressionMetadata,
 				AllowedNetworks: []string{},
 				IgnoredFolders:  []ObservedFolder{},
+				Schedule:        Schedule{Windows: []ScheduleWindow{}},
 			},
 			{
 				DeviceID:        device4,
@@ -196,6 +211,7 @@ func TestDeviceConfig(t *testing.T) {
 				Compression:     protocol.CompressionMetadata,
 				AllowedNetworks: []string{},
 				IgnoredFolders:  []ObservedFolder{},
+				Schedule:        Schedule{Windows: []ScheduleWindow{}},
 			},
 		}
 		expectedDeviceIDs := []protocol.DeviceID{device1, device4}
@@ -270,6 +286,8 @@ func TestOverriddenValues(t *testing.T) {
 		StunKeepaliveMinS:       900,
 		RawStunServers:          []string{"foo"},
 		FeatureFlags:            []string{"feature"},
+		BandwidthProfiles:       []BandwidthProfile{},
+		ConfigHistoryEntries:    5,
 	}
 	expectedPath := "/media/syncthing"
 
@@ -297,18 +315,21 @@ func TestDeviceAddressesDynamic(t *testing.T) {
 			Addresses:       []string{"dynamic"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device2: {
 			DeviceID:        device2,
 			Addresses:       []string{"dynamic"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device3: {
 			DeviceID:        device3,
 			Addresses:       []string{"dynamic"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device4: {
 			DeviceID:        device4,
@@ -317,6 +338,7 @@ func TestDeviceAddressesDynamic(t *testing.T) {
 			Compression:     protocol.CompressionMetadata,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 	}
 
@@ -341,6 +363,7 @@ func TestDeviceCompression(t *testing.T) {
 			Compression:     protocol.CompressionMetadata,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device2: {
 			DeviceID:        device2,
@@ -348,6 +371,7 @@ func TestDeviceCompression(t *testing.T) {
 			Compression:     protocol.CompressionMetadata,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device3: {
 			DeviceID:        device3,
@@ -355,6 +379,7 @@ func TestDeviceCompression(t *testing.T) {
 			Compression:     protocol.CompressionNever,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device4: {
 			DeviceID:        device4,
@@ -363,6 +388,7 @@ func TestDeviceCompression(t *testing.T) {
 			Compression:     protocol.CompressionMetadata,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 	}
 
@@ -386,18 +412,21 @@ func TestDeviceAddressesStatic(t *testing.T) {
 			Addresses:       []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device2: {
 			DeviceID:        device2,
 			Addresses:       []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device3: {
 			DeviceID:        device3,
 			Addresses:       []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 		device4: {
 			DeviceID:        device4,
@@ -406,6 +435,7 @@ func TestDeviceAddressesStatic(t *testing.T) {
 			Compression:     protocol.CompressionMetadata,
 			AllowedNetworks: []string{},
 			IgnoredFolders:  []ObservedFolder{},
+			Schedule:        Schedule{Windows: []ScheduleWindow{}},
 		},
 	}
 
@@ -445,6 +475,124 @@ func TestVersioningConfig(t *testing.T) {
 	}
 }
 
+func TestWebhooks(t *testing.T) {
+	cfg, cfgCancel, err := copyAndLoad("testdata/webhooks.xml", device4)
+	defer cfgCancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The duplicate and the one with an invalid URL are dropped
+	webhooks := cfg.RawCopy().Webhooks
+	if len(webhooks) != 2 {
+		t.Fatalf("expected two webhooks, got %d", len(webhooks))
+	}
+
+	hook := webhooks[0]
+	if hook.ID != "chat" || hook.URL != "https://example.com/hook" || !hook.Enabled || hook.Secret != "s3cret" {
+		t.Errorf("unexpected webhook %+v", hook)
+	}
+	if mask := hook.EventMask(); mask != events.FolderErrors|events.DeviceDisconnected {
+		t.Errorf("unexpected event mask %v", mask)
+	}
+	if hook.TimeoutS != 10 || hook.MaxRetries != 5 || hook.MaxRetryIntervalS != 600 {
+		t.Errorf("defaults not applied: %+v", hook)
+	}
+	for retry, expected := range map[int]time.Duration{1: 30 * time.Second, 2: time.Minute, 3: 2 * time.Minute, 6: 10 * time.Minute, 10: 10 * time.Minute} {
+		if interval := hook.RetryInterval(retry); interval != expected {
+			t.Errorf("retry %d: interval %v != %v", retry, interval, expected)
+		}
+	}
+
+	if webhooks[1].ID != "disabled" || webhooks[1].Enabled {
+		t.Errorf("unexpected webhook %+v", webhooks[1])
+	}
+	if mask := webhooks[1].EventMask(); mask != DefaultWebhookEventMask {
+		t.Errorf("unexpected event mask %v", mask)
+	}
+}
+
+func TestGUIRoles(t *testing.T) {
+	cfg := Configuration{
+		GUI: GUIConfiguration{
+			User:   "admin",
+			APIKey: "adminkey",
+			Users: []GUIUser{
+				{Name: "helpdesk", Password: "plaintext", Role: RoleOperator},
+				{Name: "nopassword"},
+			},
+			APIKeys: []GUIAPIKey{
+				{Name: "monitoring", Key: "monitoringkey"},
+				{Name: "generated", Role: RoleOperator},
+			},
+		},
+	}
+	if err := cfg.GUI.HashAndSetPassword("adminpass"); err != nil {
+		t.Fatal(err)
+	}
+	cfg.prepare(device1)
+	gui := cfg.GUI
+
+	if len(gui.Users) != 1 || gui.Users[0].Password == "plaintext" {
+		t.Fatalf("expected one user with a hashed password, got %+v", gui.Users)
+	}
+	if gui.APIKeys[1].Key == "" {
+		t.Error("expected a generated API key")
+	}
+
+	for _, tc := range []struct {
+		user, password string
+		role           Role
+		ok             bool
+	}{
+		{"admin", "adminpass", RoleAdmin, true},
+		{"helpdesk", "plaintext", RoleOperator, true},
+		{"helpdesk", "adminpass", RoleReadOnly, false},
+		{"nopassword", "", RoleReadOnly, false},
+	} {
+		if role, ok := gui.UserRole(tc.user, tc.password); role != tc.role || ok != tc.ok {
+			t.Errorf("user %s: got %v, %v", tc.user, role, ok)
+		}
+	}
+
+	for key, expected := range map[string]Role{"adminkey": RoleAdmin, "monitoringkey": RoleReadOnly, gui.APIKeys[1].Key: RoleOperator} {
+		if role, ok := gui.APIKeyRole(key); !ok || role != expected {
+			t.Errorf("key %s: got %v, %v", key, role, ok)
+		}
+	}
+	if _, ok := gui.APIKeyRole("wrong"); ok {
+		t.Error("unexpected valid key")
+	}
+}
+
+func TestLDAPGroupsRole(t *testing.T) {
+	ldap := LDAPConfiguration{}
+	if role, ok := ldap.GroupsRole(nil); !ok || role != RoleAdmin {
+		t.Errorf("without group roles everyone should be admin, got %v, %v", role, ok)
+	}
+
+	ldap.GroupRoles = []LDAPGroupRole{
+		{Group: "cn=helpdesk,dc=example,dc=com", Role: RoleOperator},
+		{Group: "cn=admins,dc=example,dc=com", Role: RoleAdmin},
+		{Group: "cn=staff,dc=example,dc=com", Role: RoleReadOnly},
+	}
+	for _, tc := range []struct {
+		groups []string
+		role   Role
+		ok     bool
+	}{
+		{nil, RoleReadOnly, false},
+		{[]string{"cn=others,dc=example,dc=com"}, RoleReadOnly, false},
+		{[]string{"CN=Staff,DC=example,DC=com"}, RoleReadOnly, true},
+		{[]string{"cn=staff,dc=example,dc=com", "cn=helpdesk,dc=example,dc=com"}, RoleOperator, true},
+		{[]string{"cn=admins,dc=example,dc=com", "cn=helpdesk,dc=example,dc=com"}, RoleAdmin, true},
+	} {
+		if role, ok := ldap.GroupsRole(tc.groups); role != tc.role || ok != tc.ok {
+			t.Errorf("groups %v: got %v, %v", tc.groups, role, ok)
+		}
+	}
+}
+
 func TestIssue1262(t *testing.T) {
 	if !build.IsWindows {
 		t.Skipf("path gets converted to absolute as part of the filesystem initialization on linux")
@@ -560,6 +708,7 @@ func TestNewSaveLoad(t *testing.T) {
 	path := "testdata/temp.xml"
 	os.Remove(path)
 	defer os.Remove(path)
+	defer os.Remove(historyPath(path))
 
 	exists := func(path string) bool {
 		_, err := os.Stat(path)
@@ -1318,6 +1467,7 @@ func copyAndLoad(path string, myID protocol.DeviceID) (*testWrapper, func(), err
 	return wrapper, func() {
 		wrapper.stop()
 		os.Remove(temp)
+		os.Remove(historyPath(temp))
 	}, nil
 }
 
@@ -1420,3 +1570,313 @@ func TestReceiveEncryptedFolderFixed(t *testing.T) {
 		t.Error("IgnorePerms should be true")
 	}
 }
+
+func TestOIDCAllowed(t *testing.T) {
+	cfg := OIDCConfiguration{AllowedUsers: []string{"Alice"}, AllowedGroups: []string{"admins"}}
+	cases := []struct {
+		user   string
+		groups []string
+		ok     bool
+	}{
+		{"alice", nil, true},
+		{"bob", []string{"users", "admins"}, true},
+		{"bob", []string{"Admins"}, false},
+		{"", nil, false},
+	}
+	for _, tc := range cases {
+		if ok := cfg.IsAllowed(tc.user, tc.groups); ok != tc.ok {
+			t.Errorf("%q %v: got %v, expected %v", tc.user, tc.groups, ok, tc.ok)
+		}
+	}
+	if (OIDCConfiguration{}).IsAllowed("alice", []string{"admins"}) {
+		t.Error("nobody should be allowed without allowed users or groups")
+	}
+}
+
+func TestOIDCGroupsRole(t *testing.T) {
+	cfg := OIDCConfiguration{}
+	if role := cfg.GroupsRole([]string{"admins"}); role != RoleReadOnly {
+		t.Errorf("without group roles: got %v", role)
+	}
+	cfg.GroupRoles = []OIDCGroupRole{
+		{Group: "admins", Role: RoleAdmin},
+		{Group: "helpdesk", Role: RoleOperator},
+	}
+	cases := []struct {
+		groups []string
+		role   Role
+	}{
+		{nil, RoleReadOnly},
+		{[]string{"users"}, RoleReadOnly},
+		{[]string{"helpdesk"}, RoleOperator},
+		{[]string{"admins", "helpdesk"}, RoleAdmin},
+		{[]string{"Admins"}, RoleReadOnly},
+	}
+	for _, tc := range cases {
+		if role := cfg.GroupsRole(tc.groups); role != tc.role {
+			t.Errorf("%v: got %v, expected %v", tc.groups, role, tc.role)
+		}
+	}
+}
+
+func TestOIDCRequestScopes(t *testing.T) {
+	if scopes := (OIDCConfiguration{}).RequestScopes(); !reflect.DeepEqual(scopes, []string{"openid", "profile", "email"}) {
+		t.Errorf("default scopes: %v", scopes)
+	}
+	if scopes := (OIDCConfiguration{Scopes: []string{"email", "openid", "groups"}}).RequestScopes(); !reflect.DeepEqual(scopes, []string{"openid", "email", "groups"}) {
+		t.Errorf("configured scopes: %v", scopes)
+	}
+}
+
+func TestApplyPartial(t *testing.T) {
+	cfg := New(device1)
+	cfg.Defaults.Folder.RescanIntervalS = 1234
+	cfg.SetDevice(DeviceConfiguration{DeviceID: device2, Name: "second", Addresses: []string{"tcp://192.0.2.1:22000"}})
+	cfg.SetDevice(DeviceConfiguration{DeviceID: device3, Name: "third"})
+	cfg.SetFolder(FolderConfiguration{ID: "default", Path: "/tmp/default", Label: "Default"})
+	cfg.GUI.RawAddress = "127.0.0.1:8384"
+
+	partial := `{
+		"gui": {"address": "0.0.0.0:8384"},
+		"options": {"listenAddresses": ["tcp://0.0.0.0:22000"]},
+		"devices": [
+			{"deviceID": "` + strings.ToLower(strings.ReplaceAll(device2.String(), "-", "")) + `", "name": "renamed"},
+			{"deviceID": "` + device3.String() + `", "_remove": true},
+			{"deviceID": "` + device4.String() + `", "_remove": true}
+		],
+		"folders": [
+			{"id": "new", "path": "/tmp/new"}
+		]
+	}`
+	applied, err := cfg.ApplyPartial([]byte(partial), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if applied.GUI.RawAddress != "0.0.0.0:8384" {
+		t.Error("GUI address not applied:", applied.GUI.RawAddress)
+	}
+	if applied.GUI.RawUseTLS != cfg.GUI.RawUseTLS || applied.GUI.APIKey != cfg.GUI.APIKey {
+		t.Error("unmentioned GUI settings changed")
+	}
+	if !reflect.DeepEqual(applied.Options.RawListenAddresses, []string{"tcp://0.0.0.0:22000"}) {
+		t.Error("listen addresses not replaced:", applied.Options.RawListenAddresses)
+	}
+
+	if dev, _, ok := applied.Device(device2); !ok || dev.Name != "renamed" {
+		t.Error("device not renamed:", dev.Name)
+	} else if !reflect.DeepEqual(dev.Addresses, []string{"tcp://192.0.2.1:22000"}) {
+		t.Error("unmentioned device settings changed:", dev.Addresses)
+	}
+	if _, _, ok := applied.Device(device3); ok {
+		t.Error("device not removed")
+	}
+
+	if fcfg, _, ok := applied.Folder("default"); !ok || fcfg.Label != "Default" {
+		t.Error("existing folder changed")
+	}
+	if fcfg, _, ok := applied.Folder("new"); !ok {
+		t.Error("folder not added")
+	} else if fcfg.RescanIntervalS != 1234 {
+		t.Error("new folder didn't get defaults, rescan interval", fcfg.RescanIntervalS)
+	}
+
+	if applied.Revision() == cfg.Revision() {
+		t.Error("revision unchanged")
+	}
+	if cfg.Copy().Revision() != cfg.Revision() {
+		t.Error("revision changed by copying")
+	}
+
+	// Applying the same again is a no-op.
+	again, err := applied.ApplyPartial([]byte(partial), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Revision() != applied.Revision() {
+		t.Error("applying again changed the configuration")
+	}
+}
+
+func TestApplyPartialInvalid(t *testing.T) {
+	cfg := New(device1)
+	cfg.SetFolder(FolderConfiguration{ID: "default", Path: "/tmp/default"})
+
+	for _, partial := range []string{
+		`[]`,
+		`{"folders": [{"id": "default", "_remove": "yes"}]}`,
+		`{"folders": [{"id": "default", "_remove": true}, {"id": "default", "label": "x"}]}`,
+		`{"options": {"listenAddresses": "tcp://0.0.0.0:22000"}}`,
+	} {
+		if _, err := cfg.ApplyPartial([]byte(partial), device1); err == nil {
+			t.Errorf("%s: expected an error", partial)
+		}
+	}
+}
+
+func TestModifyCommitted(t *testing.T) {
+	wrapper, wrapperCancel, err := copyAndLoad("testdata/example.xml", device1)
+	defer wrapperCancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := wrapper.RawCopy()
+
+	waiter, from, to, err := wrapper.ModifyCommitted("api:alice", func(cfg *Configuration) {
+		cfg.Options.MaxSendKbps = 1234
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	if !reflect.DeepEqual(from, before) {
+		t.Error("unexpected configuration before the change")
+	}
+	if !reflect.DeepEqual(to, wrapper.RawCopy()) || to.Options.MaxSendKbps != 1234 {
+		t.Error("unexpected configuration after the change")
+	}
+
+	// Without a change, both are the same.
+	_, from, to, err = wrapper.ModifyCommitted("api:alice", func(*Configuration) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(from, to) {
+		t.Error("configuration changed without a change")
+	}
+}
+
+func TestConfigHistory(t *testing.T) {
+	wrapper, wrapperCancel, err := copyAndLoad("testdata/example.xml", device1)
+	defer wrapperCancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	startup := wrapper.RawCopy()
+
+	waiter, err := wrapper.ModifyWithOrigin("api:alice", func(cfg *Configuration) {
+		cfg.Options.MaxSendKbps = 1234
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	history := wrapper.History()
+	if len(history) != 2 {
+		t.Fatalf("expected two entries, got %+v", history)
+	}
+	if history[0].Origin != OriginStartup || history[0].Revision != startup.Revision() {
+		t.Errorf("unexpected startup entry %+v", history[0])
+	}
+	if history[1].Origin != "api:alice" || history[1].Revision != wrapper.RawCopy().Revision() || history[1].Time.IsZero() {
+		t.Errorf("unexpected entry %+v", history[1])
+	}
+	if cfg, ok := wrapper.HistoryConfig(history[0].Revision); !ok || cfg.Options.MaxSendKbps == 1234 {
+		t.Error("startup configuration not in history")
+	}
+	if _, ok := wrapper.HistoryConfig("nope"); ok {
+		t.Error("unexpected configuration for unknown revision")
+	}
+
+	// The history is kept beyond restarts, up to the configured number of
+	// entries.
+	if err := wrapper.Save(); err != nil {
+		t.Fatal(err)
+	}
+	wrapper.stop()
+	wrapper, err = load(wrapper.ConfigPath(), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloaded := wrapper.History()
+	if len(reloaded) != len(history) {
+		t.Fatalf("history not kept: %+v != %+v", reloaded, history)
+	}
+	for i := range history {
+		if reloaded[i].Revision != history[i].Revision || reloaded[i].Origin != history[i].Origin || !reloaded[i].Time.Equal(history[i].Time) {
+			t.Errorf("history not kept: %+v != %+v", reloaded[i], history[i])
+		}
+	}
+	waiter, err = wrapper.Modify(func(cfg *Configuration) {
+		cfg.Options.ConfigHistoryEntries = 2
+		cfg.Options.MaxRecvKbps = 1234
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	if history = wrapper.History(); len(history) != 2 || history[1].Origin != "" {
+		t.Errorf("unexpected history %+v", history)
+	}
+}
+
+func TestFolderShouldMerge(t *testing.T) {
+	fcfg := FolderConfiguration{
+		MergeConflicts: true,
+		MergePatterns:  []string{"*.md", "/etc/*.conf"},
+		MergeMaxSize:   Size{1, "kB"},
+	}
+	cases := []struct {
+		name   string
+		size   int64
+		result bool
+	}{
+		{"notes.md", 100, true},
+		{"sub/dir/notes.md", 100, true},
+		{"notes.md", 1001, false},
+		{"notes.txt", 100, false},
+		{"etc/app.conf", 100, true},
+		{"sub/etc/app.conf", 100, false},
+		{"app.conf", 100, false},
+	}
+	for _, tc := range cases {
+		if res := fcfg.ShouldMerge(filepath.FromSlash(tc.name), tc.size); res != tc.result {
+			t.Errorf("%s (%d bytes): got %v, expected %v", tc.name, tc.size, res, tc.result)
+		}
+	}
+
+	fcfg.MergePatterns = nil
+	if !fcfg.ShouldMerge("anything", 1000) {
+		t.Error("expected all files to be merged without patterns")
+	}
+	fcfg.MergeMaxSize = Size{}
+	if !fcfg.ShouldMerge("anything", 1e6) || fcfg.ShouldMerge("anything", 1e6+1) {
+		t.Error("expected a zero size limit to be the default")
+	}
+	fcfg.MergeConflicts = false
+	if fcfg.ShouldMerge("notes.md", 100) {
+		t.Error("expected no merging when disabled")
+	}
+}
+
+func TestFolderSelectedPaths(t *testing.T) {
+	fcfg := FolderConfiguration{
+		ID:            "test",
+		SelectiveSync: true,
+		SelectedPaths: []string{"/docs/", "docs", "src/app/../lib", "..", "", ".", "../outside", filepath.FromSlash("a/b")},
+	}
+	fcfg.prepare(device1, nil)
+	expected := []string{"a/b", "docs", "outside", "src/lib"}
+	if !reflect.DeepEqual(fcfg.SelectedPaths, expected) {
+		t.Errorf("got %q, expected %q", fcfg.SelectedPaths, expected)
+	}
+
+	fcfg.Type = FolderTypeReceiveEncrypted
+	fcfg.prepare(device1, nil)
+	if fcfg.SelectiveSync {
+		t.Error("expected selective sync to be disabled for receive encrypted folders")
+	}
+}
+
+func TestFolderSelectDeselect(t *testing.T) {
+	var fcfg FolderConfiguration
+	fcfg.Select("docs", "/src/app/", "src/lib", "docs")
+	if expected := []string{"docs", "src/app", "src/lib"}; !reflect.DeepEqual(fcfg.SelectedPaths, expected) {
+		t.Errorf("got %q, expected %q", fcfg.SelectedPaths, expected)
+	}
+	fcfg.Deselect("src/", "nonexistent")
+	if expected := []string{"docs"}; !reflect.DeepEqual(fcfg.SelectedPaths, expected) {
+		t.Errorf("got %q, expected %q", fcfg.SelectedPaths, expected)
+	}
+}
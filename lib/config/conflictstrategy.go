@@ -0,0 +1,42 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (s ConflictStrategy) String() string {
+	switch s {
+	case ConflictStrategyCopy:
+		return "copy"
+	case ConflictStrategyNewestWins:
+		return "newestWins"
+	case ConflictStrategyDeviceWins:
+		return "deviceWins"
+	case ConflictStrategyDirectory:
+		return "directory"
+	default:
+		return "unknown"
+	}
+}
+
+func (s ConflictStrategy) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *ConflictStrategy) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "copy":
+		*s = ConflictStrategyCopy
+	case "newestWins":
+		*s = ConflictStrategyNewestWins
+	case "deviceWins":
+		*s = ConflictStrategyDeviceWins
+	case "directory":
+		*s = ConflictStrategyDirectory
+	default:
+		*s = ConflictStrategyCopy
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/conflictstrategy.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ConflictStrategy int32
+
+const (
+	ConflictStrategyCopy       ConflictStrategy = 0
+	ConflictStrategyNewestWins ConflictStrategy = 1
+	ConflictStrategyDeviceWins ConflictStrategy = 2
+	ConflictStrategyDirectory  ConflictStrategy = 3
+)
+
+var ConflictStrategy_name = map[int32]string{
+	0: "CONFLICT_STRATEGY_COPY",
+	1: "CONFLICT_STRATEGY_NEWEST_WINS",
+	2: "CONFLICT_STRATEGY_DEVICE_WINS",
+	3: "CONFLICT_STRATEGY_DIRECTORY",
+}
+
+var ConflictStrategy_value = map[string]int32{
+	"CONFLICT_STRATEGY_COPY":        0,
+	"CONFLICT_STRATEGY_NEWEST_WINS": 1,
+	"CONFLICT_STRATEGY_DEVICE_WINS": 2,
+	"CONFLICT_STRATEGY_DIRECTORY":   3,
+}
+
+func (ConflictStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a601d7a676175dbc, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.ConflictStrategy", ConflictStrategy_name, ConflictStrategy_value)
+}
+
+func init() { proto.RegisterFile("lib/config/conflictstrategy.proto", fileDescriptor_a601d7a676175dbc) }
+
+var fileDescriptor_a601d7a676175dbc = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd1, 0x3f, 0x4b, 0xf3, 0x40,
+	0x1c, 0x07, 0xf0, 0x4b, 0x9f, 0x87, 0x0e, 0x99, 0x42, 0x11, 0xd1, 0x93, 0x1e, 0x15, 0x27, 0x1d,
+	0x9a, 0x41, 0x67, 0xa1, 0x5e, 0x4f, 0x09, 0x4a, 0x2a, 0x4d, 0xb0, 0xd4, 0xa5, 0x98, 0xe3, 0x7a,
+	0x3d, 0xa8, 0xb9, 0x90, 0x5c, 0x95, 0xbc, 0x85, 0x4c, 0x0e, 0xae, 0x01, 0x07, 0x07, 0x5f, 0x4a,
+	0xc7, 0x8c, 0xae, 0x6d, 0xde, 0x88, 0x70, 0x29, 0x28, 0x6d, 0xa6, 0xfb, 0xde, 0x9f, 0xef, 0x67,
+	0xb8, 0x9f, 0x79, 0x3c, 0x17, 0x81, 0x4d, 0x65, 0x38, 0x15, 0x5c, 0x2f, 0x73, 0x41, 0x55, 0xa2,
+	0xe2, 0x27, 0xc5, 0x78, 0xda, 0x8d, 0x62, 0xa9, 0x64, 0xab, 0x59, 0x5d, 0xc3, 0x93, 0x98, 0x45,
+	0x32, 0xb1, 0xf5, 0x61, 0xb0, 0x98, 0xda, 0x5c, 0x72, 0xa9, 0x37, 0x3a, 0x55, 0x8f, 0xcf, 0xde,
+	0x1b, 0xa6, 0x85, 0x37, 0x8e, 0xb7, 0x71, 0x5a, 0x17, 0xe6, 0x3e, 0x1e, 0xb8, 0xd7, 0x77, 0x0e,
+	0xf6, 0x27, 0x9e, 0x3f, 0xec, 0xf9, 0xe4, 0x66, 0x3c, 0xc1, 0x83, 0xfb, 0xb1, 0x05, 0xe0, 0x41,
+	0x96, 0x77, 0xf6, 0xb6, 0x1b, 0x58, 0x46, 0x69, 0xab, 0x67, 0xb6, 0x77, 0x5b, 0x2e, 0x19, 0x11,
+	0xcf, 0x9f, 0x8c, 0x1c, 0xd7, 0xb3, 0x0c, 0x88, 0xb2, 0xbc, 0x03, 0xb7, 0xcb, 0x2e, 0x7b, 0x65,
+	0x89, 0x1a, 0x89, 0x30, 0xa9, 0x27, 0xfa, 0xe4, 0xc1, 0xc1, 0xa4, 0x22, 0x1a, 0xf5, 0x44, 0x9f,
+	0xbd, 0x08, 0xca, 0x34, 0x71, 0x69, 0x1e, 0xd5, 0x10, 0xce, 0x90, 0x60, 0x7f, 0x30, 0x1c, 0x5b,
+	0xff, 0x60, 0x3b, 0xcb, 0x3b, 0x87, 0x3b, 0x80, 0x88, 0x19, 0x55, 0x32, 0x4e, 0xe1, 0xff, 0xaf,
+	0x4f, 0x04, 0xae, 0x6e, 0x97, 0x2b, 0x04, 0x8a, 0x15, 0x02, 0xcb, 0x35, 0x32, 0x8a, 0x35, 0x32,
+	0xde, 0x4a, 0x04, 0x3e, 0x4a, 0x64, 0x14, 0x25, 0x02, 0xdf, 0x25, 0x02, 0x8f, 0xa7, 0x5c, 0xa8,
+	0xd9, 0x22, 0xe8, 0x52, 0xf9, 0x6c, 0x27, 0x69, 0x48, 0xd5, 0x4c, 0x84, 0xfc, 0x4f, 0xfa, 0x9d,
+	0x53, 0xd0, 0xd4, 0x5f, 0x7d, 0xfe, 0x33, 0x00, 0xc5, 0x5c, 0xbf, 0x28, 0xbc, 0x01, 0x00, 0x00,
+}
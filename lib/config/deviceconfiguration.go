@@ -19,6 +19,7 @@ func (cfg DeviceConfiguration) Copy() DeviceConfiguration {
 	copy(c.AllowedNetworks, cfg.AllowedNetworks)
 	c.IgnoredFolders = make([]ObservedFolder, len(cfg.IgnoredFolders))
 	copy(c.IgnoredFolders, cfg.IgnoredFolders)
+	c.Schedule = cfg.Schedule.Copy()
 	return c
 }
 
@@ -34,6 +35,10 @@ func (cfg *DeviceConfiguration) prepare(sharedFolders []string) {
 	}
 
 	cfg.IgnoredFolders = sortedObservedFolderSlice(ignoredFolders)
+
+	if err := cfg.Schedule.Validate(); err != nil {
+		l.Warnf("Device %s: %v; the window will never be active", cfg.Description(), err)
+	}
 }
 
 func (cfg *DeviceConfiguration) IgnoredFolder(folder string) bool {
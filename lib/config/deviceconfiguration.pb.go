@@ -44,6 +44,10 @@ type DeviceConfiguration struct {
 	MaxRequestKiB            int                                                  `protobuf:"varint,16,opt,name=max_request_kib,json=maxRequestKib,proto3,casttype=int" json:"maxRequestKiB" xml:"maxRequestKiB"`
 	Untrusted                bool                                                 `protobuf:"varint,17,opt,name=untrusted,proto3" json:"untrusted" xml:"untrusted"`
 	RemoteGUIPort            int                                                  `protobuf:"varint,18,opt,name=remote_gui_port,json=remoteGuiPort,proto3,casttype=int" json:"remoteGUIPort" xml:"remoteGUIPort"`
+	Schedule                 Schedule                                             `protobuf:"bytes,19,opt,name=schedule,proto3" json:"schedule" xml:"schedule"`
+	// The algorithm used for compressed messages when the remote device
+	// supports it, otherwise LZ4.
+	CompressionAlgorithm protocol.CompressionAlgorithm `protobuf:"varint,20,opt,name=compression_algorithm,json=compressionAlgorithm,proto3,enum=protocol.CompressionAlgorithm" json:"compressionAlgorithm" xml:"compressionAlgorithm,attr"`
 }
 
 func (m *DeviceConfiguration) Reset()         { *m = DeviceConfiguration{} }
@@ -88,72 +92,77 @@ func init() {
 }
 
 var fileDescriptor_744b782bd13071dd = []byte{
-	// 1026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
-	0x18, 0x15, 0xeb, 0xc4, 0xb6, 0xce, 0x3f, 0x64, 0xd3, 0x88, 0xc3, 0x18, 0x88, 0x4e, 0x50, 0x35,
-	0x28, 0x68, 0x22, 0x17, 0x6e, 0x27, 0xa3, 0x2d, 0x50, 0xc6, 0x68, 0x63, 0x18, 0x4d, 0x5c, 0x16,
-	0x5d, 0xbc, 0xb0, 0x24, 0xef, 0xac, 0x1c, 0x2c, 0xf2, 0x58, 0xf2, 0xa8, 0x58, 0x40, 0xff, 0x80,
-	0x76, 0x2b, 0x02, 0x74, 0xea, 0x92, 0xf6, 0xdf, 0xe8, 0xd0, 0xd5, 0x9b, 0x35, 0x16, 0x1d, 0x0e,
-	0x88, 0xbd, 0x71, 0x29, 0xc0, 0x31, 0x53, 0x71, 0x77, 0x14, 0x45, 0xca, 0x51, 0x50, 0xa0, 0x1b,
-	0xef, 0xbd, 0x77, 0xef, 0xdd, 0xf7, 0xe9, 0xbb, 0x13, 0xe8, 0x0c, 0x88, 0xbb, 0xeb, 0xd1, 0xe0,
-	0x94, 0xf4, 0x77, 0x11, 0x1e, 0x12, 0x0f, 0xab, 0x45, 0x12, 0x39, 0x8c, 0xd0, 0xa0, 0x17, 0x46,
-	0x94, 0x51, 0x7d, 0x51, 0x81, 0x3b, 0xdb, 0x42, 0x2d, 0x21, 0x8f, 0x0e, 0x76, 0x5d, 0x1c, 0x2a,
-	0x7e, 0xe7, 0x5e, 0xc9, 0x85, 0xba, 0x31, 0x8e, 0x86, 0x18, 0xe5, 0x54, 0x1d, 0x9f, 0x33, 0xf5,
-	0xd9, 0xfe, 0x67, 0x03, 0x6c, 0x1d, 0xc8, 0x8c, 0xc7, 0xe5, 0x0c, 0xfd, 0x4f, 0x0d, 0xd4, 0x55,
-	0xb6, 0x4d, 0x90, 0xa1, 0xb5, 0xb4, 0xee, 0xaa, 0xf9, 0x9b, 0x76, 0xc1, 0x61, 0xed, 0x6f, 0x0e,
-	0x3f, 0xee, 0x13, 0xf6, 0x3c, 0x71, 0x7b, 0x1e, 0xf5, 0x77, 0xe3, 0x51, 0xe0, 0xb1, 0xe7, 0x24,
-	0xe8, 0x97, 0xbe, 0xca, 0x27, 0xea, 0x29, 0xf7, 0xc3, 0x83, 0x2b, 0x0e, 0x97, 0x27, 0xdf, 0x29,
-	0x87, 0xcb, 0x28, 0xff, 0xce, 0x38, 0x6c, 0x9e, 0xfb, 0x83, 0xfd, 0x36, 0x41, 0x0f, 0x1d, 0xc6,
-	0xa2, 0x76, 0x2b, 0xa0, 0x08, 0x9f, 0x3a, 0xc9, 0x80, 0xed, 0xb7, 0x59, 0x94, 0xe0, 0x76, 0x7a,
-	0xd9, 0x59, 0xca, 0xc9, 0xec, 0xb2, 0x53, 0x6c, 0xfc, 0x71, 0xdc, 0xd1, 0x5e, 0x8e, 0x3b, 0x85,
-	0xe9, 0xab, 0x71, 0x47, 0xb3, 0x26, 0x2c, 0xd2, 0x8f, 0xc1, 0xad, 0xc0, 0xf1, 0xb1, 0xf1, 0x5e,
-	0x4b, 0xeb, 0xd6, 0xcd, 0x4f, 0x52, 0x0e, 0xe5, 0x3a, 0xe3, 0xf0, 0x9e, 0x8c, 0x13, 0x0b, 0xe9,
-	0xf9, 0x90, 0xfa, 0x84, 0x61, 0x3f, 0x64, 0x23, 0x91, 0xb4, 0xf5, 0x16, 0xdc, 0x92, 0x3b, 0xf5,
-	0x73, 0x50, 0x77, 0x10, 0x8a, 0x70, 0x1c, 0xe3, 0xd8, 0x58, 0x68, 0x2d, 0x74, 0xeb, 0xe6, 0x49,
-	0xca, 0xe1, 0x14, 0xcc, 0x38, 0x7c, 0x20, 0xbd, 0x73, 0xa4, 0xe4, 0xdc, 0x2a, 0x4a, 0x42, 0xa3,
-	0xc0, 0xf1, 0x89, 0x27, 0xb2, 0x36, 0x6f, 0xe8, 0xde, 0x5c, 0x76, 0x96, 0x72, 0x81, 0x35, 0xf5,
-	0xd5, 0x87, 0x60, 0xc5, 0xa3, 0x7e, 0x28, 0x56, 0x84, 0x06, 0xc6, 0xad, 0x96, 0xd6, 0x5d, 0xdf,
-	0xbb, 0xd3, 0x2b, 0x7a, 0xfc, 0x78, 0x4a, 0x9a, 0x9f, 0xa6, 0x1c, 0x96, 0xd5, 0x19, 0x87, 0xdb,
-	0xf2, 0x50, 0x25, 0x4c, 0x35, 0x3a, 0xbd, 0xec, 0x6c, 0xcc, 0x82, 0x56, 0x79, 0xab, 0x8e, 0x41,
-	0xdd, 0xc3, 0x11, 0xb3, 0x65, 0x23, 0x6f, 0xcb, 0x46, 0x3e, 0x11, 0xbf, 0x9d, 0x00, 0x9f, 0xaa,
-	0x66, 0xde, 0x57, 0xde, 0x39, 0xf0, 0x96, 0x86, 0xde, 0x9d, 0xc3, 0x59, 0x85, 0x8b, 0x7e, 0x02,
-	0x00, 0x09, 0x58, 0x44, 0x51, 0xe2, 0xe1, 0xc8, 0x58, 0x6c, 0x69, 0xdd, 0x65, 0x73, 0x3f, 0xe5,
-	0xb0, 0x84, 0x66, 0x1c, 0xde, 0x51, 0x53, 0x52, 0x40, 0x45, 0x11, 0x8d, 0x19, 0xcc, 0x2a, 0xed,
-	0xd3, 0x7f, 0xd7, 0xc0, 0x4e, 0x7c, 0x46, 0x42, 0x7b, 0x82, 0x89, 0xf1, 0xb6, 0x23, 0xec, 0xd3,
-	0xa1, 0x33, 0x88, 0x8d, 0x25, 0x19, 0x86, 0x52, 0x0e, 0x0d, 0xa1, 0x3a, 0x2c, 0x89, 0xac, 0x5c,
-	0x93, 0x71, 0xf8, 0xbe, 0x8c, 0x9e, 0x27, 0x28, 0x0e, 0x72, 0xff, 0x9d, 0x0a, 0x6b, 0x6e, 0x82,
-	0xfe, 0x87, 0x06, 0xd6, 0x8a, 0x33, 0x23, 0xdb, 0x1d, 0x19, 0xcb, 0xf2, 0xc6, 0xfd, 0xf2, 0xbf,
-	0x6e, 0x5c, 0xca, 0xe1, 0xea, 0xd4, 0xd5, 0x1c, 0x65, 0x1c, 0x76, 0xab, 0x3d, 0x44, 0xe6, 0x68,
-	0xfe, 0x9d, 0xdb, 0xbc, 0x21, 0x13, 0x37, 0x4e, 0xde, 0xb2, 0x8a, 0xad, 0xbe, 0x07, 0x16, 0x43,
-	0x27, 0x89, 0x31, 0x32, 0xea, 0xb2, 0x9b, 0x3b, 0x29, 0x87, 0x39, 0x92, 0x71, 0xb8, 0x2a, 0x23,
-	0xd5, 0xb2, 0x6d, 0xe5, 0xb8, 0xfe, 0x03, 0xd8, 0x70, 0x06, 0x03, 0xfa, 0x02, 0x23, 0x3b, 0xc0,
-	0xec, 0x05, 0x8d, 0xce, 0x62, 0x03, 0xc8, 0x2b, 0xf5, 0x75, 0xca, 0x61, 0x23, 0xe7, 0x9e, 0xe6,
-	0x54, 0xf1, 0x46, 0x54, 0xf1, 0xea, 0xa0, 0x19, 0xf3, 0x48, 0x6b, 0xd6, 0x4e, 0xff, 0x0e, 0x6c,
-	0x39, 0x09, 0xa3, 0xb6, 0xe3, 0x79, 0x38, 0x64, 0xf6, 0x29, 0x1d, 0x20, 0x1c, 0xc5, 0xc6, 0x8a,
-	0x3c, 0xfe, 0x87, 0x29, 0x87, 0x9b, 0x82, 0xfe, 0x5c, 0xb2, 0x5f, 0x28, 0x32, 0xe3, 0xf0, 0xae,
-	0x3a, 0xc2, 0x2c, 0xd3, 0xb6, 0x6e, 0xaa, 0xf5, 0x67, 0x60, 0xcd, 0x77, 0xce, 0xed, 0x18, 0x07,
-	0xc8, 0x3e, 0x73, 0xc3, 0xd8, 0x58, 0x6d, 0x69, 0xdd, 0xdb, 0xe6, 0x07, 0xe2, 0x72, 0xfa, 0xce,
-	0xf9, 0x37, 0x38, 0x40, 0x47, 0x6e, 0x28, 0x5c, 0x37, 0xa5, 0x6b, 0x09, 0x6b, 0xbf, 0xe1, 0x70,
-	0x81, 0x04, 0xcc, 0x2a, 0x0b, 0x27, 0x86, 0x11, 0xf6, 0x86, 0xca, 0x70, 0xad, 0x62, 0x68, 0x61,
-	0x6f, 0x38, 0x6b, 0x38, 0xc1, 0x2a, 0x86, 0x13, 0x50, 0x0f, 0x40, 0x83, 0xf4, 0x03, 0x1a, 0x61,
-	0x54, 0xd4, 0xbf, 0xde, 0x5a, 0xe8, 0xae, 0xec, 0x6d, 0xf7, 0xd4, 0xbf, 0x46, 0xef, 0x59, 0xfe,
-	0xaf, 0xa1, 0x6a, 0x32, 0x1f, 0x89, 0x59, 0x4c, 0x39, 0x5c, 0xcf, 0xb7, 0x4d, 0x1b, 0xb3, 0xa5,
-	0xa6, 0xaa, 0x0c, 0xb7, 0xad, 0x19, 0x99, 0xfe, 0x93, 0x06, 0x1a, 0x21, 0x0e, 0x10, 0x09, 0xfa,
-	0x45, 0x60, 0xe3, 0x9d, 0x81, 0x4f, 0x44, 0xe0, 0x15, 0x87, 0xc6, 0x01, 0x0e, 0x23, 0xec, 0x39,
-	0x0c, 0xa3, 0x63, 0x65, 0x90, 0x7b, 0xa6, 0x1c, 0x6a, 0x8f, 0x8a, 0x37, 0x28, 0x2c, 0x73, 0xa5,
-	0xd1, 0x30, 0x34, 0x6b, 0xbd, 0xc2, 0xc5, 0xfa, 0xaf, 0x1a, 0x68, 0xa8, 0x6e, 0x7e, 0x9f, 0xe0,
-	0x98, 0xd9, 0x67, 0xc4, 0x35, 0x36, 0x64, 0x3f, 0xe3, 0x2b, 0x0e, 0xd7, 0xbe, 0x12, 0x6d, 0x92,
-	0xcc, 0x11, 0x31, 0x53, 0x0e, 0xd7, 0xfc, 0x32, 0x50, 0x14, 0x5c, 0x41, 0x27, 0x4d, 0x4e, 0x2f,
-	0x3b, 0x33, 0xf2, 0x59, 0xe0, 0xe5, 0xb8, 0x53, 0x4d, 0xb0, 0x2a, 0xbc, 0xab, 0x7f, 0x06, 0xea,
-	0x49, 0xc0, 0xa2, 0x24, 0x66, 0x18, 0x19, 0x9b, 0x72, 0x26, 0x5b, 0xe2, 0x7f, 0xa6, 0x00, 0x33,
-	0x0e, 0x1b, 0xf2, 0x04, 0x05, 0xd2, 0xb6, 0xa6, 0xac, 0xac, 0x4e, 0x3c, 0x70, 0x0c, 0xdb, 0xfd,
-	0x84, 0xd8, 0x21, 0x8d, 0x98, 0xa1, 0x4f, 0xab, 0xb3, 0x24, 0xf5, 0xe5, 0xb7, 0x87, 0xc7, 0x34,
-	0x62, 0xa2, 0xba, 0xa8, 0x0c, 0x14, 0xd5, 0x55, 0xd0, 0x72, 0x75, 0x55, 0xf9, 0x2c, 0x20, 0xaa,
-	0xab, 0x24, 0x58, 0x13, 0x3e, 0x21, 0x62, 0x69, 0x1e, 0x5d, 0xbc, 0x6e, 0xd6, 0xc6, 0xaf, 0x9b,
-	0xb5, 0x8b, 0xab, 0xa6, 0x36, 0xbe, 0x6a, 0x6a, 0x3f, 0x5f, 0x37, 0x6b, 0xaf, 0xae, 0x9b, 0xda,
-	0xf8, 0xba, 0x59, 0xfb, 0xeb, 0xba, 0x59, 0x3b, 0x79, 0xf0, 0x1f, 0x1e, 0x3b, 0x35, 0x31, 0xee,
-	0xa2, 0x7c, 0xf4, 0x3e, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x4a, 0x4f, 0x60, 0x33, 0x09,
-	0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xbd, 0x6f, 0xdb, 0x46,
+	0x1c, 0x15, 0xeb, 0xc4, 0xb1, 0xce, 0x1f, 0xb2, 0xe9, 0xd8, 0xa1, 0x0d, 0x44, 0x47, 0xb0, 0x1a,
+	0x14, 0x34, 0x91, 0x0b, 0xb7, 0x93, 0xd1, 0x16, 0x08, 0x63, 0xb4, 0x31, 0x8c, 0x26, 0x2e, 0x83,
+	0x02, 0x85, 0x17, 0x96, 0xe2, 0x9d, 0x65, 0xc2, 0xe2, 0x47, 0xc9, 0xa3, 0x62, 0x01, 0xfd, 0x03,
+	0xda, 0xad, 0x08, 0xd0, 0xa9, 0x4b, 0xda, 0xbd, 0x7f, 0x41, 0x87, 0xae, 0xde, 0xac, 0xb1, 0xe8,
+	0x70, 0x40, 0xec, 0x8d, 0x5b, 0x39, 0x66, 0x2a, 0x78, 0x47, 0x9e, 0x48, 0x59, 0x0a, 0x0a, 0x74,
+	0xe3, 0xbd, 0xf7, 0xee, 0xfd, 0x3e, 0xf4, 0xbb, 0x3b, 0x81, 0x56, 0xdf, 0xe9, 0xee, 0xd8, 0xbe,
+	0x77, 0xe2, 0xf4, 0x76, 0x10, 0x1e, 0x38, 0x36, 0xe6, 0x8b, 0x38, 0xb4, 0x88, 0xe3, 0x7b, 0x9d,
+	0x20, 0xf4, 0x89, 0x2f, 0xcf, 0x73, 0x70, 0x7b, 0x33, 0x53, 0x33, 0xc8, 0xf6, 0xfb, 0x3b, 0x5d,
+	0x1c, 0x70, 0x7e, 0x7b, 0xab, 0xe4, 0xe2, 0x77, 0x23, 0x1c, 0x0e, 0x30, 0x9a, 0x42, 0x45, 0xf6,
+	0x29, 0x46, 0x71, 0x1f, 0xe7, 0x54, 0x1d, 0x9f, 0x13, 0xfe, 0xa9, 0xfd, 0x23, 0x83, 0xf5, 0x7d,
+	0x16, 0xfe, 0x49, 0x39, 0xbc, 0xfc, 0xa7, 0x04, 0xea, 0x3c, 0x2d, 0xd3, 0x41, 0x8a, 0xa4, 0x4a,
+	0xed, 0x25, 0xfd, 0x57, 0xe9, 0x82, 0xc2, 0xda, 0xdf, 0x14, 0x7e, 0xdc, 0x73, 0xc8, 0x69, 0xdc,
+	0xed, 0xd8, 0xbe, 0xbb, 0x13, 0x0d, 0x3d, 0x9b, 0x9c, 0x3a, 0x5e, 0xaf, 0xf4, 0x55, 0x4e, 0xb6,
+	0xc3, 0xdd, 0x0f, 0xf6, 0xaf, 0x28, 0x5c, 0x28, 0xbe, 0x13, 0x0a, 0x17, 0x50, 0xfe, 0x9d, 0x52,
+	0xd8, 0x3c, 0x77, 0xfb, 0x7b, 0x9a, 0x83, 0x1e, 0x5a, 0x84, 0x84, 0x9a, 0xea, 0xf9, 0x08, 0x9f,
+	0x58, 0x71, 0x9f, 0xec, 0x69, 0x24, 0x8c, 0xb1, 0x96, 0x5c, 0xb6, 0xee, 0xe4, 0x64, 0x7a, 0xd9,
+	0x12, 0x1b, 0x7f, 0x18, 0xb5, 0xa4, 0x57, 0xa3, 0x96, 0x30, 0x7d, 0x3d, 0x6a, 0x49, 0x46, 0xc1,
+	0x22, 0xf9, 0x08, 0xdc, 0xf2, 0x2c, 0x17, 0x2b, 0xef, 0xa9, 0x52, 0xbb, 0xae, 0x7f, 0x92, 0x50,
+	0xc8, 0xd6, 0x29, 0x85, 0x5b, 0x2c, 0x5c, 0xb6, 0x60, 0x9e, 0x0f, 0x7d, 0xd7, 0x21, 0xd8, 0x0d,
+	0xc8, 0x30, 0x8b, 0xb4, 0x3e, 0x05, 0x37, 0xd8, 0x4e, 0xf9, 0x1c, 0xd4, 0x2d, 0x84, 0x42, 0x1c,
+	0x45, 0x38, 0x52, 0xe6, 0xd4, 0xb9, 0x76, 0x5d, 0x3f, 0x4e, 0x28, 0x1c, 0x83, 0x29, 0x85, 0x0f,
+	0x98, 0x77, 0x8e, 0x94, 0x9c, 0x55, 0x51, 0x12, 0x1a, 0x7a, 0x96, 0xeb, 0xd8, 0x59, 0xac, 0xb5,
+	0x1b, 0xba, 0xb7, 0x97, 0xad, 0x3b, 0xb9, 0xc0, 0x18, 0xfb, 0xca, 0x03, 0xb0, 0x68, 0xfb, 0x6e,
+	0x90, 0xad, 0x1c, 0xdf, 0x53, 0x6e, 0xa9, 0x52, 0x7b, 0x65, 0x77, 0xa3, 0x23, 0x7a, 0xfc, 0x64,
+	0x4c, 0xea, 0x9f, 0x26, 0x14, 0x96, 0xd5, 0x29, 0x85, 0x9b, 0x2c, 0xa9, 0x12, 0xc6, 0x1b, 0x9d,
+	0x5c, 0xb6, 0x56, 0x27, 0x41, 0xa3, 0xbc, 0x55, 0xc6, 0xa0, 0x6e, 0xe3, 0x90, 0x98, 0xac, 0x91,
+	0xb7, 0x59, 0x23, 0x9f, 0x66, 0xbf, 0x5d, 0x06, 0x3e, 0xe3, 0xcd, 0xbc, 0xcf, 0xbd, 0x73, 0x60,
+	0x4a, 0x43, 0xef, 0xcd, 0xe0, 0x0c, 0xe1, 0x22, 0x1f, 0x03, 0xe0, 0x78, 0x24, 0xf4, 0x51, 0x6c,
+	0xe3, 0x50, 0x99, 0x57, 0xa5, 0xf6, 0x82, 0xbe, 0x97, 0x50, 0x58, 0x42, 0x53, 0x0a, 0x37, 0xf8,
+	0x94, 0x08, 0x48, 0x14, 0xd1, 0x98, 0xc0, 0x8c, 0xd2, 0x3e, 0xf9, 0x37, 0x09, 0x6c, 0x47, 0x67,
+	0x4e, 0x60, 0x16, 0x58, 0x36, 0xde, 0x66, 0x88, 0x5d, 0x7f, 0x60, 0xf5, 0x23, 0xe5, 0x0e, 0x0b,
+	0x86, 0x12, 0x0a, 0x95, 0x4c, 0x75, 0x50, 0x12, 0x19, 0xb9, 0x26, 0xa5, 0xf0, 0x7d, 0x16, 0x7a,
+	0x96, 0x40, 0x24, 0x72, 0xff, 0x9d, 0x0a, 0x63, 0x66, 0x04, 0xf9, 0x0f, 0x09, 0x2c, 0x8b, 0x9c,
+	0x91, 0xd9, 0x1d, 0x2a, 0x0b, 0xec, 0xc4, 0xfd, 0xfc, 0xbf, 0x4e, 0x5c, 0x42, 0xe1, 0xd2, 0xd8,
+	0x55, 0x1f, 0xa6, 0x14, 0xb6, 0xab, 0x3d, 0x44, 0xfa, 0x70, 0xf6, 0x99, 0x5b, 0xbb, 0x21, 0xcb,
+	0x4e, 0x1c, 0x3b, 0x65, 0x15, 0x5b, 0x79, 0x17, 0xcc, 0x07, 0x56, 0x1c, 0x61, 0xa4, 0xd4, 0x59,
+	0x37, 0xb7, 0x13, 0x0a, 0x73, 0x24, 0xa5, 0x70, 0x89, 0x85, 0xe4, 0x4b, 0xcd, 0xc8, 0x71, 0xf9,
+	0x7b, 0xb0, 0x6a, 0xf5, 0xfb, 0xfe, 0x4b, 0x8c, 0x4c, 0x0f, 0x93, 0x97, 0x7e, 0x78, 0x16, 0x29,
+	0x80, 0x1d, 0xa9, 0xaf, 0x12, 0x0a, 0x1b, 0x39, 0xf7, 0x2c, 0xa7, 0xc4, 0x1d, 0x51, 0xc5, 0xab,
+	0x83, 0xa6, 0xcc, 0x22, 0x8d, 0x49, 0x3b, 0xf9, 0x5b, 0xb0, 0x6e, 0xc5, 0xc4, 0x37, 0x2d, 0xdb,
+	0xc6, 0x01, 0x31, 0x4f, 0xfc, 0x3e, 0xc2, 0x61, 0xa4, 0x2c, 0xb2, 0xf4, 0x3f, 0x4c, 0x28, 0x5c,
+	0xcb, 0xe8, 0xc7, 0x8c, 0xfd, 0x9c, 0x93, 0x29, 0x85, 0xf7, 0x78, 0x0a, 0x93, 0x8c, 0x66, 0xdc,
+	0x54, 0xcb, 0xcf, 0xc1, 0xb2, 0x6b, 0x9d, 0x9b, 0x11, 0xf6, 0x90, 0x79, 0xd6, 0x0d, 0x22, 0x65,
+	0x49, 0x95, 0xda, 0xb7, 0xf5, 0x0f, 0xb2, 0xc3, 0xe9, 0x5a, 0xe7, 0x2f, 0xb0, 0x87, 0x0e, 0xbb,
+	0x41, 0xe6, 0xba, 0xc6, 0x5c, 0x4b, 0x98, 0xf6, 0x96, 0xc2, 0x39, 0xc7, 0x23, 0x46, 0x59, 0x58,
+	0x18, 0x86, 0xd8, 0x1e, 0x70, 0xc3, 0xe5, 0x8a, 0xa1, 0x81, 0xed, 0xc1, 0xa4, 0x61, 0x81, 0x55,
+	0x0c, 0x0b, 0x50, 0xf6, 0x40, 0xc3, 0xe9, 0x79, 0x7e, 0x88, 0x91, 0xa8, 0x7f, 0x45, 0x9d, 0x6b,
+	0x2f, 0xee, 0x6e, 0x76, 0xf8, 0xab, 0xd1, 0x79, 0x9e, 0x3f, 0x28, 0xbc, 0x26, 0xfd, 0x51, 0x36,
+	0x8b, 0x09, 0x85, 0x2b, 0xf9, 0xb6, 0x71, 0x63, 0xd6, 0xf9, 0x54, 0x95, 0x61, 0xcd, 0x98, 0x90,
+	0xc9, 0x3f, 0x4a, 0xa0, 0x11, 0x60, 0x0f, 0x39, 0x5e, 0x4f, 0x04, 0x6c, 0xbc, 0x33, 0xe0, 0xd3,
+	0x2c, 0xe0, 0x15, 0x85, 0xca, 0x3e, 0x0e, 0x42, 0x6c, 0x5b, 0x04, 0xa3, 0x23, 0x6e, 0x90, 0x7b,
+	0x26, 0x14, 0x4a, 0x8f, 0xc4, 0x1d, 0x14, 0x94, 0xb9, 0xd2, 0x68, 0x28, 0x92, 0xb1, 0x52, 0xe1,
+	0x22, 0xf9, 0x17, 0x09, 0x34, 0x78, 0x37, 0xbf, 0x8b, 0x71, 0x44, 0xcc, 0x33, 0xa7, 0xab, 0xac,
+	0xb2, 0x7e, 0x46, 0x57, 0x14, 0x2e, 0x7f, 0x99, 0xb5, 0x89, 0x31, 0x87, 0x8e, 0x9e, 0x50, 0xb8,
+	0xec, 0x96, 0x01, 0x51, 0x70, 0x05, 0x2d, 0x9a, 0x9c, 0x5c, 0xb6, 0x26, 0xe4, 0x93, 0xc0, 0xab,
+	0x51, 0xab, 0x1a, 0xc1, 0xa8, 0xf0, 0x5d, 0xf9, 0x33, 0x50, 0x8f, 0x3d, 0x12, 0xc6, 0x11, 0xc1,
+	0x48, 0x59, 0x63, 0x33, 0xa9, 0x66, 0xef, 0x8c, 0x00, 0x53, 0x0a, 0x1b, 0x2c, 0x03, 0x81, 0x68,
+	0xc6, 0x98, 0x65, 0xd5, 0x65, 0x17, 0x1c, 0xc1, 0x66, 0x2f, 0x76, 0xcc, 0xc0, 0x0f, 0x89, 0x22,
+	0x8f, 0xab, 0x33, 0x18, 0xf5, 0xc5, 0xd7, 0x07, 0x47, 0x7e, 0x48, 0xb2, 0xea, 0xc2, 0x32, 0x20,
+	0xaa, 0xab, 0xa0, 0xe5, 0xea, 0xaa, 0xf2, 0x49, 0x20, 0xab, 0xae, 0x12, 0xc1, 0x28, 0xf8, 0xd8,
+	0xc9, 0x96, 0xf2, 0x11, 0x58, 0x28, 0xfe, 0x8e, 0x28, 0xeb, 0xaa, 0xd4, 0x5e, 0xdc, 0x5d, 0x2d,
+	0x7e, 0xff, 0x17, 0x39, 0xae, 0x6b, 0xf9, 0xa8, 0x09, 0x65, 0x4a, 0xe1, 0x0a, 0xbf, 0x83, 0x73,
+	0x40, 0x33, 0x04, 0x27, 0xff, 0x2e, 0x81, 0x8d, 0xd2, 0xab, 0x65, 0x5a, 0xfd, 0x9e, 0x1f, 0x3a,
+	0xe4, 0xd4, 0x55, 0xee, 0xb2, 0x87, 0xb2, 0x39, 0xf5, 0xa1, 0x7c, 0x5c, 0xa8, 0xf4, 0x6f, 0x12,
+	0x0a, 0xef, 0xda, 0x53, 0x98, 0x94, 0x42, 0x38, 0xf9, 0x74, 0x0a, 0x52, 0xdc, 0xfa, 0x5b, 0x33,
+	0x59, 0x63, 0xaa, 0xab, 0x7e, 0x78, 0xf1, 0xa6, 0x59, 0x1b, 0xbd, 0x69, 0xd6, 0x2e, 0xae, 0x9a,
+	0xd2, 0xe8, 0xaa, 0x29, 0xfd, 0x74, 0xdd, 0xac, 0xbd, 0xbe, 0x6e, 0x4a, 0xa3, 0xeb, 0x66, 0xed,
+	0xaf, 0xeb, 0x66, 0xed, 0xf8, 0xc1, 0x7f, 0xb8, 0xee, 0x79, 0xcf, 0xba, 0xf3, 0xac, 0xb6, 0x8f,
+	0xfe, 0x1d, 0x00, 0x13, 0x9f, 0xd5, 0xa4, 0x50, 0x0a, 0x00, 0x00,
 }
 
 func (m *DeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -176,6 +185,25 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CompressionAlgorithm != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.CompressionAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	if m.RemoteGUIPort != 0 {
 		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.RemoteGUIPort))
 		i--
@@ -423,6 +451,11 @@ func (m *DeviceConfiguration) ProtoSize() (n int) {
 	if m.RemoteGUIPort != 0 {
 		n += 2 + sovDeviceconfiguration(uint64(m.RemoteGUIPort))
 	}
+	l = m.Schedule.ProtoSize()
+	n += 2 + l + sovDeviceconfiguration(uint64(l))
+	if m.CompressionAlgorithm != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.CompressionAlgorithm))
+	}
 	return n
 }
 
@@ -918,6 +951,58 @@ func (m *DeviceConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgorithm", wireType)
+			}
+			m.CompressionAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgorithm |= protocol.CompressionAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
@@ -9,6 +9,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
@@ -35,14 +37,32 @@ const (
 	maxConcurrentWritesLimit   = 64
 )
 
+// Merging reads and retains whole files, so there is always a limit.
+var defaultMergeMaxSize = Size{1, "MB"}
+
 func (f FolderConfiguration) Copy() FolderConfiguration {
 	c := f
 	c.Devices = make([]FolderDeviceConfiguration, len(f.Devices))
 	copy(c.Devices, f.Devices)
 	c.Versioning = f.Versioning.Copy()
+	c.Schedule = f.Schedule.Copy()
+	c.MergePatterns = make([]string, len(f.MergePatterns))
+	copy(c.MergePatterns, f.MergePatterns)
+	c.SelectedPaths = make([]string, len(f.SelectedPaths))
+	copy(c.SelectedPaths, f.SelectedPaths)
 	return c
 }
 
+// Redacted returns a copy of the folder without the encryption passwords
+// of its devices.
+func (f FolderConfiguration) Redacted() FolderConfiguration {
+	f = f.Copy()
+	for i := range f.Devices {
+		f.Devices[i].EncryptionPassword = ""
+	}
+	return f
+}
+
 // Filesystem creates a filesystem for the path and options of this folder.
 // The fset parameter may be nil, in which case no mtime handling on top of
 // the fileystem is provided.
@@ -209,6 +229,24 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.WeakHashThresholdPct = 25
 	}
 
+	if err := f.Schedule.Validate(); err != nil {
+		l.Warnf("Folder %s: %v; the window will never be active", f.Description(), err)
+	}
+
+	if f.ConflictStrategy == ConflictStrategyDeviceWins && f.ConflictWinner == protocol.EmptyDeviceID {
+		l.Warnf("Folder %s: conflict strategy %v without a winning device; keeping conflict copies instead", f.Description(), f.ConflictStrategy)
+	}
+
+	if f.MergeMaxSize.BaseValue() <= 0 {
+		f.MergeMaxSize = defaultMergeMaxSize
+	}
+
+	for _, pattern := range f.MergePatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			l.Warnf("Folder %s: invalid merge pattern %q: %v", f.Description(), pattern, err)
+		}
+	}
+
 	if f.MarkerName == "" {
 		f.MarkerName = DefaultMarkerName
 	}
@@ -219,10 +257,57 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.MaxConcurrentWrites = maxConcurrentWritesLimit
 	}
 
+	f.SelectedPaths = cleanSelectedPaths(f.SelectedPaths)
+
 	if f.Type == FolderTypeReceiveEncrypted {
 		f.DisableTempIndexes = true
 		f.IgnorePerms = true
+		f.SelectiveSync = false
+		f.PlaceholderFiles = false
+	}
+}
+
+// Select adds the given paths to the selection for selective sync.
+func (f *FolderConfiguration) Select(paths ...string) {
+	f.SelectedPaths = cleanSelectedPaths(append(f.SelectedPaths, paths...))
+}
+
+// Deselect removes the given paths, and any selected paths below them, from
+// the selection for selective sync.
+func (f *FolderConfiguration) Deselect(paths ...string) {
+	paths = cleanSelectedPaths(paths)
+	selected := make([]string, 0, len(f.SelectedPaths))
+nextSelected:
+	for _, sel := range f.SelectedPaths {
+		for _, p := range paths {
+			if sel == p || strings.HasPrefix(sel, p+"/") {
+				continue nextSelected
+			}
+		}
+		selected = append(selected, sel)
 	}
+	f.SelectedPaths = selected
+}
+
+// cleanSelectedPaths returns the selected paths as sorted, unique, slash
+// separated paths relative to the folder root, which they can't leave. The
+// root itself isn't a meaningful selection and is dropped.
+func cleanSelectedPaths(paths []string) []string {
+	cleaned := make([]string, 0, len(paths))
+	seen := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		p = path.Clean("/" + filepath.ToSlash(p))[1:]
+		if p == "" {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		cleaned = append(cleaned, p)
+	}
+	sort.Strings(cleaned)
+	return cleaned
 }
 
 // RequiresRestartOnly returns a copy with only the attributes that require
@@ -257,6 +342,36 @@ func (f *FolderConfiguration) SharedWith(device protocol.DeviceID) bool {
 	return ok
 }
 
+// ShouldMerge returns whether conflicting changes to the given file should
+// be merged. Merge patterns without a slash match the file name in any
+// directory, others the whole path within the folder.
+func (f *FolderConfiguration) ShouldMerge(name string, size int64) bool {
+	if !f.MergeConflicts {
+		return false
+	}
+	max := f.MergeMaxSize.BaseValue()
+	if max <= 0 {
+		max = defaultMergeMaxSize.BaseValue()
+	}
+	if float64(size) > max {
+		return false
+	}
+	if len(f.MergePatterns) == 0 {
+		return true
+	}
+	name = filepath.ToSlash(name)
+	for _, pattern := range f.MergePatterns {
+		target := name
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
+		}
+		if ok, _ := path.Match(strings.TrimPrefix(pattern, "/"), target); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *FolderConfiguration) CheckAvailableSpace(req uint64) error {
 	val := f.MinDiskFree.BaseValue()
 	if val <= 0 {
@@ -66,42 +66,53 @@ func (m *FolderDeviceConfiguration) XXX_DiscardUnknown() {
 var xxx_messageInfo_FolderDeviceConfiguration proto.InternalMessageInfo
 
 type FolderConfiguration struct {
-	ID                      string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
-	Label                   string                      `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
-	FilesystemType          fs.FilesystemType           `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
-	Path                    string                      `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
-	Type                    FolderType                  `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
-	Devices                 []FolderDeviceConfiguration `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
-	RescanIntervalS         int                         `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
-	FSWatcherEnabled        bool                        `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
-	FSWatcherDelayS         int                         `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
-	IgnorePerms             bool                        `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
-	AutoNormalize           bool                        `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
-	MinDiskFree             Size                        `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
-	Versioning              VersioningConfiguration     `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
-	Copiers                 int                         `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
-	PullerMaxPendingKiB     int                         `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
-	Hashers                 int                         `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
-	Order                   PullOrder                   `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
-	IgnoreDelete            bool                        `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	ScanProgressIntervalS   int                         `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
-	PullerPauseS            int                         `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
-	MaxConflicts            int                         `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
-	DisableSparseFiles      bool                        `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
-	DisableTempIndexes      bool                        `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused                  bool                        `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	WeakHashThresholdPct    int                         `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
-	MarkerName              string                      `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
-	CopyOwnershipFromParent bool                        `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
-	RawModTimeWindowS       int                         `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
-	MaxConcurrentWrites     int                         `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
-	DisableFsync            bool                        `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
-	BlockPullOrder          BlockPullOrder              `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
-	CopyRangeMethod         fs.CopyRangeMethod          `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
-	CaseSensitiveFS         bool                        `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
-	JunctionsAsDirs         bool                        `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
-	SyncOwnership           bool                        `protobuf:"varint,35,opt,name=sync_ownership,json=syncOwnership,proto3" json:"syncOwnership" xml:"syncOwnership"`
-	ScanOwnership           bool                        `protobuf:"varint,36,opt,name=scan_ownership,json=scanOwnership,proto3" json:"scanOwnership" xml:"scanOwnership"`
+	ID                      string                                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
+	Label                   string                                               `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
+	FilesystemType          fs.FilesystemType                                    `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
+	Path                    string                                               `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
+	Type                    FolderType                                           `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
+	Devices                 []FolderDeviceConfiguration                          `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
+	RescanIntervalS         int                                                  `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
+	FSWatcherEnabled        bool                                                 `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
+	FSWatcherDelayS         int                                                  `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
+	IgnorePerms             bool                                                 `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
+	AutoNormalize           bool                                                 `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
+	MinDiskFree             Size                                                 `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
+	Versioning              VersioningConfiguration                              `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
+	Copiers                 int                                                  `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
+	PullerMaxPendingKiB     int                                                  `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
+	Hashers                 int                                                  `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
+	Order                   PullOrder                                            `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
+	IgnoreDelete            bool                                                 `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	ScanProgressIntervalS   int                                                  `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
+	PullerPauseS            int                                                  `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
+	MaxConflicts            int                                                  `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
+	DisableSparseFiles      bool                                                 `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
+	DisableTempIndexes      bool                                                 `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused                  bool                                                 `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	WeakHashThresholdPct    int                                                  `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
+	MarkerName              string                                               `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
+	CopyOwnershipFromParent bool                                                 `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
+	RawModTimeWindowS       int                                                  `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
+	MaxConcurrentWrites     int                                                  `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
+	DisableFsync            bool                                                 `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
+	BlockPullOrder          BlockPullOrder                                       `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
+	CopyRangeMethod         fs.CopyRangeMethod                                   `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
+	CaseSensitiveFS         bool                                                 `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
+	JunctionsAsDirs         bool                                                 `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
+	SyncOwnership           bool                                                 `protobuf:"varint,35,opt,name=sync_ownership,json=syncOwnership,proto3" json:"syncOwnership" xml:"syncOwnership"`
+	ScanOwnership           bool                                                 `protobuf:"varint,36,opt,name=scan_ownership,json=scanOwnership,proto3" json:"scanOwnership" xml:"scanOwnership"`
+	Schedule                Schedule                                             `protobuf:"bytes,37,opt,name=schedule,proto3" json:"schedule" xml:"schedule"`
+	NestedIgnoreFiles       bool                                                 `protobuf:"varint,38,opt,name=nested_ignore_files,json=nestedIgnoreFiles,proto3" json:"nestedIgnoreFiles" xml:"nestedIgnoreFiles"`
+	GitignoreFiles          bool                                                 `protobuf:"varint,39,opt,name=gitignore_files,json=gitignoreFiles,proto3" json:"gitignoreFiles" xml:"gitignoreFiles"`
+	ConflictStrategy        ConflictStrategy                                     `protobuf:"varint,40,opt,name=conflict_strategy,json=conflictStrategy,proto3,enum=config.ConflictStrategy" json:"conflictStrategy" xml:"conflictStrategy"`
+	ConflictWinner          github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,41,opt,name=conflict_winner,json=conflictWinner,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"conflictWinner" xml:"conflictWinner" nodefault:"true"`
+	MergeConflicts          bool                                                 `protobuf:"varint,42,opt,name=merge_conflicts,json=mergeConflicts,proto3" json:"mergeConflicts" xml:"mergeConflicts"`
+	MergePatterns           []string                                             `protobuf:"bytes,43,rep,name=merge_patterns,json=mergePatterns,proto3" json:"mergePatterns" xml:"mergePattern"`
+	MergeMaxSize            Size                                                 `protobuf:"bytes,44,opt,name=merge_max_size,json=mergeMaxSize,proto3" json:"mergeMaxSize" xml:"mergeMaxSize" default:"1 MB"`
+	SelectiveSync           bool                                                 `protobuf:"varint,45,opt,name=selective_sync,json=selectiveSync,proto3" json:"selectiveSync" xml:"selectiveSync"`
+	SelectedPaths           []string                                             `protobuf:"bytes,46,rep,name=selected_paths,json=selectedPaths,proto3" json:"selectedPaths" xml:"selectedPath"`
+	PlaceholderFiles        bool                                                 `protobuf:"varint,47,opt,name=placeholder_files,json=placeholderFiles,proto3" json:"placeholderFiles" xml:"placeholderFiles"`
 	// Legacy deprecated
 	DeprecatedReadOnly       bool    `protobuf:"varint,9000,opt,name=read_only,json=readOnly,proto3" json:"-" xml:"ro,attr,omitempty"`                       // Deprecated: Do not use.
 	DeprecatedMinDiskFreePct float64 `protobuf:"fixed64,9001,opt,name=min_disk_free_pct,json=minDiskFreePct,proto3" json:"-" xml:"minDiskFreePct,omitempty"` // Deprecated: Do not use.
@@ -151,138 +162,161 @@ func init() {
 }
 
 var fileDescriptor_44a9785876ed3afa = []byte{
-	// 2093 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0xdc, 0xc6,
-	0xf5, 0x17, 0xe5, 0x5f, 0xd2, 0xe8, 0xf7, 0xc8, 0xb2, 0xc7, 0x72, 0xb2, 0xb3, 0x66, 0xd6, 0xf9,
-	0x2a, 0x41, 0x22, 0xdb, 0xca, 0x17, 0x05, 0x6a, 0xd4, 0x6d, 0xb3, 0x52, 0x84, 0xba, 0xae, 0xe2,
-	0x05, 0xe5, 0xd6, 0x68, 0x5a, 0x80, 0xe5, 0x92, 0xb3, 0xbb, 0x8c, 0xf8, 0xab, 0x33, 0x5c, 0x4b,
-	0xeb, 0x43, 0xe0, 0x5e, 0x8a, 0x16, 0xcd, 0xa1, 0x50, 0x0f, 0xbd, 0x06, 0x68, 0x51, 0xb4, 0xf9,
-	0x07, 0x0a, 0xf4, 0xd4, 0xa3, 0x2f, 0x85, 0xf6, 0x54, 0x14, 0x3d, 0x0c, 0x10, 0xf9, 0xb6, 0x47,
-	0x1e, 0x7d, 0x2a, 0x66, 0x86, 0xe4, 0x92, 0xdc, 0x0d, 0x50, 0xa0, 0x37, 0xce, 0xe7, 0xf3, 0xe6,
-	0xbd, 0x0f, 0xdf, 0xcc, 0xbc, 0x79, 0x24, 0x68, 0x78, 0x6e, 0xfb, 0x8e, 0x1d, 0x06, 0x1d, 0xb7,
-	0x7b, 0xa7, 0x13, 0x7a, 0x0e, 0xa1, 0x6a, 0xd0, 0xa7, 0x56, 0xec, 0x86, 0xc1, 0x76, 0x44, 0xc3,
-	0x38, 0x84, 0x97, 0x15, 0xb8, 0x79, 0x73, 0xc2, 0x3a, 0x1e, 0x44, 0x44, 0x19, 0x6d, 0x6e, 0x14,
-	0x48, 0xe6, 0x3e, 0xcf, 0xe0, 0xcd, 0x02, 0x1c, 0xf5, 0x3d, 0x2f, 0xa4, 0x0e, 0xa1, 0x29, 0xb7,
-	0x55, 0xe0, 0x9e, 0x11, 0xca, 0xdc, 0x30, 0x70, 0x83, 0xee, 0x14, 0x05, 0x9b, 0xb8, 0x60, 0xd9,
-	0xf6, 0x42, 0xfb, 0xa8, 0xea, 0x0a, 0x0a, 0x83, 0x0e, 0xbb, 0x23, 0x04, 0xb1, 0x14, 0x7b, 0x23,
-	0xc5, 0xec, 0x30, 0x1a, 0x50, 0x2b, 0xe8, 0x12, 0x9f, 0xc4, 0xbd, 0xd0, 0x49, 0xd9, 0x79, 0x72,
-	0x12, 0xab, 0x47, 0xfd, 0x9f, 0x17, 0xc0, 0x8d, 0x7d, 0xf9, 0x3e, 0x7b, 0xe4, 0x99, 0x6b, 0x93,
-	0xdd, 0xa2, 0x02, 0xf8, 0xa5, 0x06, 0xe6, 0x1d, 0x89, 0x9b, 0xae, 0x83, 0xb4, 0xba, 0xb6, 0xb5,
-	0xd8, 0xfc, 0x5c, 0x7b, 0xc9, 0xf1, 0xcc, 0xbf, 0x39, 0xfe, 0xff, 0xae, 0x1b, 0xf7, 0xfa, 0xed,
-	0x6d, 0x3b, 0xf4, 0xef, 0xb0, 0x41, 0x60, 0xc7, 0x3d, 0x37, 0xe8, 0x16, 0x9e, 0x84, 0x04, 0x19,
-	0xc4, 0x0e, 0xbd, 0x6d, 0xe5, 0xfd, 0xe1, 0xde, 0x39, 0xc7, 0x73, 0xd9, 0xf3, 0x88, 0xe3, 0x39,
-	0x27, 0x7d, 0x4e, 0x38, 0x5e, 0x3a, 0xf1, 0xbd, 0xfb, 0xba, 0xeb, 0xbc, 0x67, 0xc5, 0x31, 0xd5,
-	0x47, 0x67, 0x8d, 0x2b, 0xe9, 0x73, 0x72, 0xd6, 0xc8, 0xed, 0x7e, 0x35, 0x6c, 0x68, 0xa7, 0xc3,
-	0x46, 0xee, 0xc3, 0xc8, 0x18, 0x07, 0xfe, 0x49, 0x03, 0x4b, 0x6e, 0x10, 0xd3, 0xd0, 0xe9, 0xdb,
-	0xc4, 0x31, 0xdb, 0x03, 0x34, 0x2b, 0x05, 0xbf, 0xf8, 0x9f, 0x04, 0x8f, 0x38, 0x5e, 0x1c, 0x7b,
-	0x6d, 0x0e, 0x12, 0x8e, 0xaf, 0x2b, 0xa1, 0x05, 0x30, 0x97, 0xbc, 0x36, 0x81, 0x0a, 0xc1, 0x46,
-	0xc9, 0x03, 0xb4, 0xc1, 0x3a, 0x09, 0x6c, 0x3a, 0x88, 0x44, 0x8e, 0xcd, 0xc8, 0x62, 0xec, 0x38,
-	0xa4, 0x0e, 0xba, 0x50, 0xd7, 0xb6, 0xe6, 0x9b, 0x3b, 0x23, 0x8e, 0xe1, 0x98, 0x6e, 0xa5, 0x6c,
-	0xc2, 0x31, 0x92, 0x61, 0x27, 0x29, 0xdd, 0x98, 0x62, 0xaf, 0xff, 0xfd, 0x16, 0x58, 0x57, 0x0b,
-	0x5b, 0x5e, 0xd2, 0x43, 0x30, 0x9b, 0x2e, 0xe5, 0x7c, 0x73, 0xf7, 0x9c, 0xe3, 0x59, 0xf9, 0x8a,
-	0xb3, 0xae, 0x88, 0x50, 0x2b, 0xad, 0x40, 0x3d, 0x08, 0x1d, 0xd2, 0xb1, 0xfa, 0x5e, 0x7c, 0x5f,
-	0x8f, 0x69, 0x9f, 0x14, 0x97, 0xe4, 0x74, 0xd8, 0x98, 0x7d, 0xb8, 0xf7, 0x85, 0x78, 0xb7, 0x59,
-	0xd7, 0x81, 0x3f, 0x04, 0x97, 0x3c, 0xab, 0x4d, 0x3c, 0x99, 0xf1, 0xf9, 0xe6, 0x77, 0x46, 0x1c,
-	0x2b, 0x20, 0xe1, 0xb8, 0x2e, 0x9d, 0xca, 0x51, 0xea, 0x97, 0x12, 0x16, 0x5b, 0x34, 0xbe, 0xaf,
-	0x77, 0x2c, 0x8f, 0x49, 0xb7, 0x60, 0x4c, 0xbf, 0x18, 0x36, 0x66, 0x0c, 0x35, 0x19, 0x76, 0xc1,
-	0x4a, 0xc7, 0xf5, 0x08, 0x1b, 0xb0, 0x98, 0xf8, 0xa6, 0xd8, 0xdf, 0x32, 0x49, 0xcb, 0x3b, 0x70,
-	0xbb, 0xc3, 0xb6, 0xf7, 0x73, 0xea, 0xc9, 0x20, 0x22, 0xcd, 0x77, 0x47, 0x1c, 0x2f, 0x77, 0x4a,
-	0x58, 0xc2, 0xf1, 0x55, 0x19, 0xbd, 0x0c, 0xeb, 0x46, 0xc5, 0x0e, 0x1e, 0x80, 0x8b, 0x91, 0x15,
-	0xf7, 0xd0, 0x45, 0x29, 0xff, 0x9b, 0x23, 0x8e, 0xe5, 0x38, 0xe1, 0xf8, 0xa6, 0x9c, 0x2f, 0x06,
-	0xa9, 0xf8, 0x3c, 0x25, 0x9f, 0x09, 0xe1, 0xf3, 0x39, 0xf3, 0xfa, 0xac, 0xa1, 0x7d, 0x66, 0xc8,
-	0x69, 0xb0, 0x05, 0x2e, 0x4a, 0xb1, 0x97, 0x52, 0xb1, 0xea, 0xf4, 0x6e, 0xab, 0xe5, 0x90, 0x62,
-	0xb7, 0x44, 0x88, 0x58, 0x49, 0x5c, 0x91, 0x21, 0xc4, 0x20, 0xdf, 0x46, 0xf3, 0xf9, 0xc8, 0x90,
-	0x56, 0xf0, 0xa7, 0xe0, 0x8a, 0xda, 0xe7, 0x0c, 0x5d, 0xae, 0x5f, 0xd8, 0x5a, 0xd8, 0xb9, 0x55,
-	0x76, 0x3a, 0xe5, 0xf0, 0x36, 0xb1, 0xd8, 0xf6, 0x23, 0x8e, 0xb3, 0x99, 0x09, 0xc7, 0x8b, 0x32,
-	0x94, 0x1a, 0xeb, 0x46, 0x46, 0xc0, 0xdf, 0x69, 0x60, 0x8d, 0x12, 0x66, 0x5b, 0x81, 0xe9, 0x06,
-	0x31, 0xa1, 0xcf, 0x2c, 0xcf, 0x64, 0xe8, 0x4a, 0x5d, 0xdb, 0xba, 0xd4, 0xec, 0x8e, 0x38, 0x5e,
-	0x51, 0xe4, 0xc3, 0x94, 0x3b, 0x4c, 0x38, 0x7e, 0x47, 0x7a, 0xaa, 0xe0, 0xd5, 0x14, 0x7d, 0xf0,
-	0x8d, 0xbb, 0x77, 0xf5, 0xd7, 0x1c, 0x5f, 0x70, 0x83, 0x78, 0x74, 0xd6, 0xb8, 0x3a, 0xcd, 0xfc,
-	0xf5, 0x59, 0xe3, 0xa2, 0xb0, 0x33, 0xaa, 0x41, 0xe0, 0xdf, 0x34, 0x00, 0x3b, 0xcc, 0x3c, 0xb6,
-	0x62, 0xbb, 0x47, 0xa8, 0x49, 0x02, 0xab, 0xed, 0x11, 0x07, 0xcd, 0xd5, 0xb5, 0xad, 0xb9, 0xe6,
-	0x6f, 0xb4, 0x73, 0x8e, 0x57, 0xf7, 0x0f, 0x9f, 0x2a, 0xf6, 0x23, 0x45, 0x8e, 0x38, 0x5e, 0xed,
-	0xb0, 0x32, 0x96, 0x70, 0xfc, 0xae, 0xda, 0x04, 0x15, 0xa2, 0xaa, 0x36, 0xdb, 0xe3, 0x1b, 0x53,
-	0x0d, 0x85, 0x4e, 0x61, 0x71, 0x3a, 0x6c, 0x4c, 0x84, 0x35, 0x26, 0x82, 0xc2, 0xbf, 0x96, 0xc5,
-	0x3b, 0xc4, 0xb3, 0x06, 0x26, 0x43, 0xf3, 0x32, 0xa7, 0xbf, 0x16, 0xe2, 0x57, 0x72, 0x2f, 0x7b,
-	0x82, 0x3c, 0x14, 0x79, 0xce, 0xdd, 0x28, 0x28, 0xe1, 0xf8, 0xff, 0xca, 0xd2, 0x15, 0x5e, 0x55,
-	0x7e, 0xaf, 0x94, 0xe5, 0x69, 0xc6, 0xaf, 0xcf, 0x1a, 0xb3, 0xf7, 0xee, 0x9e, 0x0e, 0x1b, 0xd5,
-	0xa8, 0x46, 0x35, 0x26, 0xfc, 0x19, 0x58, 0x74, 0xbb, 0x41, 0x48, 0x89, 0x19, 0x11, 0xea, 0x33,
-	0x04, 0x64, 0xbe, 0x1f, 0x8c, 0x38, 0x5e, 0x50, 0x78, 0x4b, 0xc0, 0x09, 0xc7, 0xd7, 0x54, 0xb5,
-	0x18, 0x63, 0xf9, 0xf6, 0x5d, 0xad, 0x82, 0x46, 0x71, 0x2a, 0xfc, 0x85, 0x06, 0x96, 0xad, 0x7e,
-	0x1c, 0x9a, 0x41, 0x48, 0x7d, 0xcb, 0x73, 0x9f, 0x13, 0xb4, 0x20, 0x83, 0x7c, 0x32, 0xe2, 0x78,
-	0x49, 0x30, 0x1f, 0x67, 0x44, 0x9e, 0x81, 0x12, 0xfa, 0x75, 0x2b, 0x07, 0x27, 0xad, 0xb2, 0x65,
-	0x33, 0xca, 0x7e, 0x61, 0x08, 0x96, 0x7c, 0x37, 0x30, 0x1d, 0x97, 0x1d, 0x99, 0x1d, 0x4a, 0x08,
-	0x5a, 0xac, 0x6b, 0x5b, 0x0b, 0x3b, 0x8b, 0xd9, 0xb1, 0x3a, 0x74, 0x9f, 0x93, 0xe6, 0x83, 0xf4,
-	0x04, 0x2d, 0xf8, 0x6e, 0xb0, 0xe7, 0xb2, 0xa3, 0x7d, 0x4a, 0x84, 0x22, 0x2c, 0x15, 0x15, 0xb0,
-	0xe2, 0x52, 0xd4, 0x6f, 0xeb, 0xaf, 0xcf, 0x1a, 0x17, 0xee, 0xd5, 0x6f, 0x1b, 0xc5, 0x69, 0xb0,
-	0x0b, 0xc0, 0xf8, 0x9e, 0x47, 0x4b, 0x32, 0x1a, 0xce, 0xa2, 0xfd, 0x28, 0x67, 0xca, 0x47, 0xf8,
-	0xed, 0x54, 0x40, 0x61, 0x6a, 0xc2, 0xf1, 0xaa, 0x8c, 0x3f, 0x86, 0x74, 0xa3, 0xc0, 0xc3, 0x07,
-	0xe0, 0x8a, 0x1d, 0x46, 0x2e, 0xa1, 0x0c, 0x2d, 0xcb, 0xdd, 0xf6, 0x96, 0xa8, 0x01, 0x29, 0x94,
-	0x5f, 0xb3, 0xe9, 0x38, 0xdb, 0x37, 0x46, 0x66, 0x00, 0xff, 0xa1, 0x81, 0x6b, 0xa2, 0xc3, 0x20,
-	0xd4, 0xf4, 0xad, 0x13, 0x33, 0x22, 0x81, 0xe3, 0x06, 0x5d, 0xf3, 0xc8, 0x6d, 0xa3, 0x15, 0xe9,
-	0xee, 0xf7, 0x62, 0xf3, 0xae, 0xb7, 0xa4, 0xc9, 0x81, 0x75, 0xd2, 0x52, 0x06, 0x8f, 0xdc, 0xe6,
-	0x88, 0xe3, 0xf5, 0x68, 0x12, 0x4e, 0x38, 0xbe, 0xa1, 0x8a, 0xe8, 0x24, 0x57, 0xd8, 0xb6, 0x53,
-	0xa7, 0x4e, 0x87, 0x4f, 0x87, 0x8d, 0x69, 0xf1, 0x8d, 0x29, 0xb6, 0x6d, 0x91, 0x8e, 0x9e, 0xc5,
-	0x7a, 0x22, 0x1d, 0xab, 0xe3, 0x74, 0xa4, 0x50, 0x9e, 0x8e, 0x74, 0x3c, 0x4e, 0x47, 0x0a, 0xc0,
-	0x0f, 0xc1, 0x25, 0xd9, 0x6b, 0xa1, 0x35, 0x59, 0xcb, 0xd7, 0xb2, 0x15, 0x13, 0xf1, 0x1f, 0x0b,
-	0xa2, 0x89, 0xc4, 0x65, 0x27, 0x6d, 0x12, 0x8e, 0x17, 0xa4, 0x37, 0x39, 0xd2, 0x0d, 0x85, 0xc2,
-	0x47, 0x60, 0x29, 0x3d, 0x50, 0x0e, 0xf1, 0x48, 0x4c, 0x10, 0x94, 0x9b, 0xfd, 0x6d, 0xd9, 0x59,
-	0x48, 0x62, 0x4f, 0xe2, 0x09, 0xc7, 0xb0, 0x70, 0xa4, 0x14, 0xa8, 0x1b, 0x25, 0x1b, 0x78, 0x02,
-	0x90, 0xac, 0xd3, 0x11, 0x0d, 0xbb, 0x94, 0x30, 0x56, 0x2c, 0xd8, 0xeb, 0xf2, 0xfd, 0xc4, 0xe5,
-	0xbb, 0x21, 0x6c, 0x5a, 0xa9, 0x49, 0xb1, 0x6c, 0xab, 0xeb, 0x6c, 0x2a, 0x9b, 0xbf, 0xfb, 0xf4,
-	0xc9, 0xf0, 0x10, 0x2c, 0xa7, 0xfb, 0x22, 0xb2, 0xfa, 0x8c, 0x98, 0x0c, 0x5d, 0x95, 0xf1, 0xde,
-	0x17, 0xef, 0xa1, 0x98, 0x96, 0x20, 0x0e, 0xf3, 0xf7, 0x28, 0x82, 0xb9, 0xf7, 0x92, 0x29, 0x24,
-	0x60, 0x49, 0xec, 0x32, 0x91, 0x54, 0xcf, 0xb5, 0x63, 0x86, 0x36, 0xa4, 0xcf, 0xef, 0x0a, 0x9f,
-	0xbe, 0x75, 0xb2, 0x9b, 0xe1, 0xe3, 0x53, 0x57, 0x00, 0xa7, 0x56, 0x40, 0x55, 0xe9, 0x8c, 0xd2,
-	0x6c, 0xe8, 0x80, 0xab, 0x8e, 0xcb, 0x44, 0x65, 0x36, 0x59, 0x64, 0x51, 0x46, 0x4c, 0xd9, 0x00,
-	0xa0, 0x6b, 0x72, 0x25, 0x64, 0xcb, 0x95, 0xf2, 0x87, 0x92, 0x96, 0xad, 0x45, 0xde, 0x72, 0x4d,
-	0x52, 0xba, 0x31, 0xc5, 0xbe, 0x18, 0x25, 0x26, 0x7e, 0x64, 0xba, 0x81, 0x43, 0x4e, 0x08, 0x43,
-	0xd7, 0x27, 0xa2, 0x3c, 0x21, 0x7e, 0xf4, 0x50, 0xb1, 0xd5, 0x28, 0x05, 0x6a, 0x1c, 0xa5, 0x00,
-	0xc2, 0x1d, 0x70, 0x59, 0x2e, 0x80, 0x83, 0x90, 0xf4, 0xbb, 0x39, 0xe2, 0x38, 0x45, 0xf2, 0x1b,
-	0x5e, 0x0d, 0x75, 0x23, 0xc5, 0x61, 0x0c, 0xae, 0x1f, 0x13, 0xeb, 0xc8, 0x14, 0xbb, 0xda, 0x8c,
-	0x7b, 0x94, 0xb0, 0x5e, 0xe8, 0x39, 0x66, 0x64, 0xc7, 0xe8, 0x86, 0x4c, 0xb8, 0x28, 0xef, 0x57,
-	0x85, 0xc9, 0xf7, 0x2c, 0xd6, 0x7b, 0x92, 0x19, 0xb4, 0xec, 0x38, 0xe1, 0x78, 0x53, 0xba, 0x9c,
-	0x46, 0xe6, 0x8b, 0x3a, 0x75, 0x2a, 0xdc, 0x05, 0x0b, 0xbe, 0x45, 0x8f, 0x08, 0x35, 0x03, 0xcb,
-	0x27, 0x68, 0x53, 0x36, 0x57, 0xba, 0x28, 0x67, 0x0a, 0xfe, 0xd8, 0xf2, 0x49, 0x5e, 0xce, 0xc6,
-	0x90, 0x6e, 0x14, 0x78, 0x38, 0x00, 0x9b, 0xe2, 0x23, 0xc6, 0x0c, 0x8f, 0x03, 0x42, 0x59, 0xcf,
-	0x8d, 0xcc, 0x0e, 0x0d, 0x7d, 0x33, 0xb2, 0x28, 0x09, 0x62, 0x74, 0x53, 0xa6, 0xe0, 0x5b, 0x23,
-	0x8e, 0xaf, 0x0b, 0xab, 0xc7, 0x99, 0xd1, 0x3e, 0x0d, 0xfd, 0x96, 0x34, 0x49, 0x38, 0x7e, 0x33,
-	0xab, 0x78, 0xd3, 0x78, 0xdd, 0xf8, 0xba, 0x99, 0xf0, 0x97, 0x1a, 0x58, 0xf3, 0x43, 0xc7, 0x8c,
-	0x5d, 0x9f, 0x98, 0xc7, 0x6e, 0xe0, 0x84, 0xc7, 0x26, 0x43, 0x6f, 0xc8, 0x84, 0xfd, 0xe4, 0x9c,
-	0xe3, 0x35, 0xc3, 0x3a, 0x3e, 0x08, 0x9d, 0x27, 0xae, 0x4f, 0x9e, 0x4a, 0x56, 0xdc, 0xe1, 0xcb,
-	0x7e, 0x09, 0xc9, 0x5b, 0xd0, 0x32, 0x9c, 0x65, 0xee, 0x74, 0xd8, 0x98, 0xf4, 0x62, 0x54, 0x7c,
-	0xc0, 0x17, 0x1a, 0xd8, 0x48, 0x8f, 0x89, 0xdd, 0xa7, 0x42, 0x9b, 0x79, 0x4c, 0xdd, 0x98, 0x30,
-	0xf4, 0xa6, 0x14, 0xf3, 0x03, 0x51, 0x7a, 0xd5, 0x86, 0x4f, 0xf9, 0xa7, 0x92, 0x4e, 0x38, 0xbe,
-	0x5d, 0x38, 0x35, 0x25, 0xae, 0x70, 0x78, 0x76, 0x0a, 0x67, 0x47, 0xdb, 0x31, 0xa6, 0x79, 0x12,
-	0x45, 0x2c, 0xdb, 0xdb, 0x1d, 0xf1, 0xc5, 0x84, 0x6a, 0xe3, 0x22, 0x96, 0x12, 0xfb, 0x02, 0xcf,
-	0x0f, 0x7f, 0x11, 0xd4, 0x8d, 0x92, 0x0d, 0xf4, 0xc0, 0xaa, 0xfc, 0x92, 0x35, 0x45, 0x2d, 0x30,
-	0x55, 0x7d, 0xc5, 0xb2, 0xbe, 0x5e, 0xcb, 0xea, 0x6b, 0x53, 0xf0, 0xe3, 0x22, 0x2b, 0x9b, 0xfb,
-	0x76, 0x09, 0xcb, 0x33, 0x5b, 0x86, 0x75, 0xa3, 0x62, 0x07, 0x3f, 0xd7, 0xc0, 0x9a, 0xdc, 0x42,
-	0xf2, 0x43, 0xd8, 0x54, 0x5f, 0xc2, 0xa8, 0x2e, 0xe3, 0xad, 0x8b, 0x0f, 0x89, 0xdd, 0x30, 0x1a,
-	0x18, 0x82, 0x3b, 0x90, 0x54, 0xf3, 0x91, 0x68, 0xc5, 0xec, 0x32, 0x98, 0x70, 0xbc, 0x95, 0x6f,
-	0xa3, 0x02, 0x5e, 0x48, 0x23, 0x8b, 0xad, 0xc0, 0xb1, 0xa8, 0x23, 0xee, 0xff, 0xb9, 0x6c, 0x60,
-	0x54, 0x1d, 0xc1, 0x3f, 0x0a, 0x39, 0x96, 0x28, 0xa0, 0x24, 0x60, 0x6e, 0xec, 0x3e, 0x13, 0x19,
-	0x45, 0xb7, 0x64, 0x3a, 0x4f, 0x44, 0x5f, 0xb8, 0x6b, 0x31, 0x72, 0x98, 0x71, 0xfb, 0xb2, 0x2f,
-	0xb4, 0xcb, 0x50, 0xc2, 0xf1, 0x86, 0x12, 0x53, 0xc6, 0x45, 0x0f, 0x34, 0x61, 0x3b, 0x09, 0x89,
-	0x36, 0xb0, 0x12, 0xc4, 0xa8, 0xd8, 0x30, 0xf8, 0x07, 0x0d, 0xac, 0x76, 0x42, 0xcf, 0x0b, 0x8f,
-	0xcd, 0x4f, 0xfb, 0x81, 0x2d, 0xda, 0x11, 0x86, 0xf4, 0xb1, 0xca, 0xef, 0x67, 0xe0, 0x87, 0x6c,
-	0xcf, 0xa5, 0x4c, 0xa8, 0xfc, 0xb4, 0x0c, 0xe5, 0x2a, 0x2b, 0xb8, 0x54, 0x59, 0xb5, 0x9d, 0x84,
-	0x84, 0xca, 0x4a, 0x10, 0x63, 0x45, 0x29, 0xca, 0x61, 0xf8, 0x18, 0x2c, 0x8b, 0x1d, 0x35, 0xae,
-	0x0e, 0xe8, 0x2d, 0x29, 0x51, 0x7c, 0x5f, 0x2d, 0x09, 0x26, 0x3f, 0xd7, 0x09, 0xc7, 0xeb, 0xea,
-	0xf2, 0x2b, 0xa2, 0xba, 0x51, 0xb6, 0x92, 0x0e, 0xc5, 0xfd, 0x3a, 0x76, 0xd8, 0x28, 0x38, 0xb4,
-	0xad, 0x60, 0x8a, 0xc3, 0x22, 0x2a, 0x1c, 0x16, 0xc7, 0xf0, 0x08, 0xcc, 0x53, 0x62, 0x39, 0x66,
-	0x18, 0x78, 0x03, 0xf4, 0xe7, 0x7d, 0xe9, 0xec, 0xe0, 0x9c, 0x63, 0xb8, 0x47, 0x22, 0x4a, 0x6c,
-	0x2b, 0x26, 0x8e, 0x41, 0x2c, 0xe7, 0x71, 0xe0, 0x0d, 0x46, 0x1c, 0x6b, 0xef, 0xe7, 0xff, 0x17,
-	0x68, 0x28, 0x1b, 0xd8, 0xf7, 0x42, 0xdf, 0x15, 0xb7, 0x49, 0x3c, 0x90, 0xff, 0x17, 0x26, 0x50,
-	0xa4, 0x19, 0x73, 0x34, 0x75, 0x00, 0x7f, 0x0e, 0xd6, 0x4a, 0x5d, 0xad, 0xac, 0xf0, 0x7f, 0x11,
-	0x41, 0xb5, 0xe6, 0x47, 0xe7, 0x1c, 0xa3, 0x71, 0xd0, 0x83, 0x71, 0x6f, 0xda, 0xb2, 0xe3, 0x2c,
-	0x74, 0xad, 0xda, 0xda, 0xb6, 0xec, 0xb8, 0xa0, 0x00, 0x69, 0xc6, 0x72, 0x99, 0x84, 0x3f, 0x06,
-	0x57, 0xd4, 0x8d, 0xce, 0xd0, 0x97, 0xfb, 0xb2, 0x1a, 0x7d, 0x5b, 0x94, 0xc6, 0x71, 0x20, 0xd5,
-	0xa9, 0xb1, 0xf2, 0xcb, 0xa5, 0x53, 0x0a, 0xae, 0xd3, 0x12, 0x84, 0x34, 0x23, 0xf3, 0xd7, 0x7c,
-	0xf4, 0xf2, 0xab, 0xda, 0xcc, 0xf0, 0xab, 0xda, 0xcc, 0xcb, 0xf3, 0x9a, 0x36, 0x3c, 0xaf, 0x69,
-	0xbf, 0x7d, 0x55, 0x9b, 0xf9, 0xe2, 0x55, 0x4d, 0x1b, 0xbe, 0xaa, 0xcd, 0xfc, 0xeb, 0x55, 0x6d,
-	0xe6, 0x93, 0x77, 0xfe, 0x8b, 0x3f, 0x3a, 0xaa, 0xa0, 0xb4, 0x2f, 0xcb, 0x3f, 0x3b, 0x1f, 0xfc,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xc6, 0xcc, 0xd3, 0xf7, 0x13, 0x00, 0x00,
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0x25, 0xff, 0x91, 0x46, 0xff, 0x47, 0x96, 0x3d, 0x56, 0x12, 0xcd, 0x9a, 0x59, 0x27,
+	0x8a, 0x9b, 0xc8, 0xb6, 0x52, 0x14, 0xa8, 0x51, 0xb7, 0xf5, 0x4a, 0x11, 0xea, 0xba, 0xb2, 0x17,
+	0x5c, 0xb7, 0x46, 0x93, 0x02, 0x0c, 0x45, 0xce, 0xee, 0x32, 0xe2, 0x92, 0x2c, 0x87, 0x6b, 0x69,
+	0x7d, 0x48, 0xdd, 0x43, 0x8b, 0x16, 0xcd, 0xa1, 0x50, 0x0f, 0xbd, 0x06, 0x68, 0x51, 0xa4, 0xf9,
+	0x02, 0x05, 0xfa, 0x09, 0x7c, 0x29, 0xa4, 0x53, 0x51, 0xf4, 0x30, 0x40, 0xe4, 0xdb, 0x1e, 0xf7,
+	0xe8, 0x53, 0x31, 0x6f, 0x48, 0x2e, 0xc9, 0xdd, 0x00, 0x05, 0x72, 0xda, 0x9d, 0xdf, 0xef, 0xcd,
+	0x7b, 0x8f, 0x8f, 0xef, 0xbd, 0x79, 0x43, 0x54, 0xf5, 0xdc, 0xfd, 0x9b, 0x76, 0xe0, 0x37, 0xdd,
+	0xd6, 0xcd, 0x66, 0xe0, 0x39, 0x2c, 0x52, 0x8b, 0x6e, 0x64, 0xc5, 0x6e, 0xe0, 0x6f, 0x86, 0x51,
+	0x10, 0x07, 0xf8, 0x82, 0x02, 0xd7, 0x5e, 0x1b, 0x91, 0x8e, 0x7b, 0x21, 0x53, 0x42, 0x6b, 0xab,
+	0x39, 0x92, 0xbb, 0xcf, 0x52, 0x78, 0x2d, 0x07, 0x87, 0x5d, 0xcf, 0x0b, 0x22, 0x87, 0x45, 0x09,
+	0xb7, 0x91, 0xe3, 0x9e, 0xb2, 0x88, 0xbb, 0x81, 0xef, 0xfa, 0xad, 0x31, 0x1e, 0xac, 0xd1, 0x9c,
+	0xe4, 0xbe, 0x17, 0xd8, 0x07, 0x65, 0x55, 0x57, 0xf3, 0xd6, 0xed, 0x36, 0x73, 0xba, 0x5e, 0xea,
+	0xc1, 0xb5, 0x1c, 0x25, 0x7f, 0x3c, 0xd7, 0x8e, 0x79, 0x1c, 0x59, 0x31, 0x6b, 0xf5, 0x12, 0x11,
+	0x2c, 0x45, 0x9a, 0xfc, 0xa6, 0x7c, 0x1c, 0x9e, 0x60, 0xaf, 0x27, 0x98, 0x1d, 0x84, 0xbd, 0xc8,
+	0xf2, 0x5b, 0xac, 0xc3, 0xe2, 0x76, 0xe0, 0x24, 0xec, 0x0c, 0x3b, 0x8a, 0xd5, 0x5f, 0xfd, 0xdf,
+	0x53, 0xe8, 0xea, 0x2e, 0x44, 0x63, 0x87, 0x3d, 0x75, 0x6d, 0xb6, 0x9d, 0xf7, 0x1f, 0x7f, 0xa9,
+	0xa1, 0x19, 0x07, 0x70, 0xd3, 0x75, 0x88, 0x56, 0xd1, 0x36, 0xe6, 0x6a, 0x9f, 0x69, 0x2f, 0x04,
+	0x9d, 0xf8, 0xaf, 0xa0, 0xdf, 0x6e, 0xb9, 0x71, 0xbb, 0xbb, 0xbf, 0x69, 0x07, 0x9d, 0x9b, 0xbc,
+	0xe7, 0xdb, 0x71, 0xdb, 0xf5, 0x5b, 0xb9, 0x7f, 0xd2, 0x05, 0x30, 0x62, 0x07, 0xde, 0xa6, 0xd2,
+	0x7e, 0x7f, 0xe7, 0x4c, 0xd0, 0xe9, 0xf4, 0x7f, 0x5f, 0xd0, 0x69, 0x27, 0xf9, 0x3f, 0x10, 0x74,
+	0xfe, 0xa8, 0xe3, 0xdd, 0xd1, 0x5d, 0xe7, 0x5d, 0x2b, 0x8e, 0x23, 0xbd, 0x7f, 0x52, 0xbd, 0x98,
+	0xfc, 0x1f, 0x9c, 0x54, 0x33, 0xb9, 0xdf, 0x9d, 0x56, 0xb5, 0xe3, 0xd3, 0x6a, 0xa6, 0xc3, 0x48,
+	0x19, 0x07, 0xff, 0x4d, 0x43, 0xf3, 0xae, 0x1f, 0x47, 0x81, 0xd3, 0xb5, 0x99, 0x63, 0xee, 0xf7,
+	0xc8, 0x24, 0x38, 0xfc, 0xfc, 0x1b, 0x39, 0xdc, 0x17, 0x74, 0x6e, 0xa8, 0xb5, 0xd6, 0x1b, 0x08,
+	0x7a, 0x45, 0x39, 0x9a, 0x03, 0x33, 0x97, 0x97, 0x47, 0x50, 0xe9, 0xb0, 0x51, 0xd0, 0x80, 0x6d,
+	0xb4, 0xc2, 0x7c, 0x3b, 0xea, 0x85, 0x32, 0xc6, 0x66, 0x68, 0x71, 0x7e, 0x18, 0x44, 0x0e, 0x99,
+	0xaa, 0x68, 0x1b, 0x33, 0xb5, 0xad, 0xbe, 0xa0, 0x78, 0x48, 0xd7, 0x13, 0x76, 0x20, 0x28, 0x01,
+	0xb3, 0xa3, 0x94, 0x6e, 0x8c, 0x91, 0xd7, 0x7f, 0x73, 0x03, 0xad, 0xa8, 0x17, 0x5b, 0x7c, 0xa5,
+	0x0d, 0x34, 0x99, 0xbc, 0xca, 0x99, 0xda, 0xf6, 0x99, 0xa0, 0x93, 0xf0, 0x88, 0x93, 0xae, 0xb4,
+	0xb0, 0x5e, 0x78, 0x03, 0x15, 0x3f, 0x70, 0x58, 0xd3, 0xea, 0x7a, 0xf1, 0x1d, 0x3d, 0x8e, 0xba,
+	0x2c, 0xff, 0x4a, 0x8e, 0x4f, 0xab, 0x93, 0xf7, 0x77, 0x3e, 0x97, 0xcf, 0x36, 0xe9, 0x3a, 0xf8,
+	0xa7, 0xe8, 0xbc, 0x67, 0xed, 0x33, 0x0f, 0x22, 0x3e, 0x53, 0xfb, 0x41, 0x5f, 0x50, 0x05, 0x0c,
+	0x04, 0xad, 0x80, 0x52, 0x58, 0x25, 0x7a, 0x23, 0xc6, 0x63, 0x2b, 0x8a, 0xef, 0xe8, 0x4d, 0xcb,
+	0xe3, 0xa0, 0x16, 0x0d, 0xe9, 0xe7, 0xa7, 0xd5, 0x09, 0x43, 0x6d, 0xc6, 0x2d, 0xb4, 0xd8, 0x74,
+	0x3d, 0xc6, 0x7b, 0x3c, 0x66, 0x1d, 0x53, 0xe6, 0x37, 0x04, 0x69, 0x61, 0x0b, 0x6f, 0x36, 0xf9,
+	0xe6, 0x6e, 0x46, 0x3d, 0xee, 0x85, 0xac, 0x76, 0xa3, 0x2f, 0xe8, 0x42, 0xb3, 0x80, 0x0d, 0x04,
+	0xbd, 0x04, 0xd6, 0x8b, 0xb0, 0x6e, 0x94, 0xe4, 0xf0, 0x1e, 0x3a, 0x17, 0x5a, 0x71, 0x9b, 0x9c,
+	0x03, 0xf7, 0xbf, 0xdb, 0x17, 0x14, 0xd6, 0x03, 0x41, 0x5f, 0x83, 0xfd, 0x72, 0x91, 0x38, 0x9f,
+	0x85, 0xe4, 0x53, 0xe9, 0xf8, 0x4c, 0xc6, 0xbc, 0x3a, 0xa9, 0x6a, 0x9f, 0x1a, 0xb0, 0x0d, 0xd7,
+	0xd1, 0x39, 0x70, 0xf6, 0x7c, 0xe2, 0xac, 0xaa, 0xdf, 0x4d, 0xf5, 0x3a, 0xc0, 0xd9, 0x0d, 0x69,
+	0x22, 0x56, 0x2e, 0x2e, 0x82, 0x09, 0xb9, 0xc8, 0xd2, 0x68, 0x26, 0x5b, 0x19, 0x20, 0x85, 0x7f,
+	0x81, 0x2e, 0xaa, 0x3c, 0xe7, 0xe4, 0x42, 0x65, 0x6a, 0x63, 0x76, 0xeb, 0x5a, 0x51, 0xe9, 0x98,
+	0xe2, 0xad, 0x51, 0x99, 0xf6, 0x7d, 0x41, 0xd3, 0x9d, 0x03, 0x41, 0xe7, 0xc0, 0x94, 0x5a, 0xeb,
+	0x46, 0x4a, 0xe0, 0x3f, 0x69, 0x68, 0x39, 0x62, 0xdc, 0xb6, 0x7c, 0xd3, 0xf5, 0x63, 0x16, 0x3d,
+	0xb5, 0x3c, 0x93, 0x93, 0x8b, 0x15, 0x6d, 0xe3, 0x7c, 0xad, 0xd5, 0x17, 0x74, 0x51, 0x91, 0xf7,
+	0x13, 0xae, 0x31, 0x10, 0xf4, 0x1d, 0xd0, 0x54, 0xc2, 0xcb, 0x21, 0x7a, 0xff, 0x3b, 0xb7, 0x6e,
+	0xe9, 0xaf, 0x04, 0x9d, 0x72, 0xfd, 0xb8, 0x7f, 0x52, 0xbd, 0x34, 0x4e, 0xfc, 0xd5, 0x49, 0xf5,
+	0x9c, 0x94, 0x33, 0xca, 0x46, 0xf0, 0x3f, 0x35, 0x84, 0x9b, 0xdc, 0x3c, 0xb4, 0x62, 0xbb, 0xcd,
+	0x22, 0x93, 0xf9, 0xd6, 0xbe, 0xc7, 0x1c, 0x32, 0x5d, 0xd1, 0x36, 0xa6, 0x6b, 0x7f, 0xd0, 0xce,
+	0x04, 0x5d, 0xda, 0x6d, 0x3c, 0x51, 0xec, 0x07, 0x8a, 0xec, 0x0b, 0xba, 0xd4, 0xe4, 0x45, 0x6c,
+	0x20, 0xe8, 0x0d, 0x95, 0x04, 0x25, 0xa2, 0xec, 0x6d, 0x9a, 0xe3, 0xab, 0x63, 0x05, 0xa5, 0x9f,
+	0x52, 0xe2, 0xf8, 0xb4, 0x3a, 0x62, 0xd6, 0x18, 0x31, 0x8a, 0xff, 0x51, 0x74, 0xde, 0x61, 0x9e,
+	0xd5, 0x33, 0x39, 0x99, 0x81, 0x98, 0xfe, 0x5e, 0x3a, 0xbf, 0x98, 0x69, 0xd9, 0x91, 0x64, 0x43,
+	0xc6, 0xb9, 0xc9, 0x0b, 0xd0, 0x40, 0xd0, 0xb7, 0x8b, 0xae, 0x2b, 0xbc, 0xec, 0xf9, 0xed, 0x42,
+	0x94, 0xc7, 0x09, 0xbf, 0x3a, 0xa9, 0x4e, 0xde, 0xbe, 0x75, 0x7c, 0x5a, 0x2d, 0x5b, 0x35, 0xca,
+	0x36, 0xf1, 0xc7, 0x68, 0xce, 0x6d, 0xf9, 0x41, 0xc4, 0xcc, 0x90, 0x45, 0x1d, 0x4e, 0x10, 0xc4,
+	0xfb, 0x6e, 0x5f, 0xd0, 0x59, 0x85, 0xd7, 0x25, 0x3c, 0x10, 0xf4, 0xb2, 0xea, 0x16, 0x43, 0x2c,
+	0x4b, 0xdf, 0xa5, 0x32, 0x68, 0xe4, 0xb7, 0xe2, 0x5f, 0x6b, 0x68, 0xc1, 0xea, 0xc6, 0x81, 0xe9,
+	0x07, 0x51, 0xc7, 0xf2, 0xdc, 0x67, 0x8c, 0xcc, 0x82, 0x91, 0x0f, 0xfb, 0x82, 0xce, 0x4b, 0xe6,
+	0x61, 0x4a, 0x64, 0x11, 0x28, 0xa0, 0x5f, 0xf7, 0xe6, 0xf0, 0xa8, 0x54, 0xfa, 0xda, 0x8c, 0xa2,
+	0x5e, 0x1c, 0xa0, 0xf9, 0x8e, 0xeb, 0x9b, 0x8e, 0xcb, 0x0f, 0xcc, 0x66, 0xc4, 0x18, 0x99, 0xab,
+	0x68, 0x1b, 0xb3, 0x5b, 0x73, 0x69, 0x59, 0x35, 0xdc, 0x67, 0xac, 0x76, 0x37, 0xa9, 0xa0, 0xd9,
+	0x8e, 0xeb, 0xef, 0xb8, 0xfc, 0x60, 0x37, 0x62, 0xd2, 0x23, 0x0a, 0x1e, 0xe5, 0xb0, 0xfc, 0xab,
+	0xa8, 0x5c, 0xd7, 0x5f, 0x9d, 0x54, 0xa7, 0x6e, 0x57, 0xae, 0x1b, 0xf9, 0x6d, 0xb8, 0x85, 0xd0,
+	0x70, 0x4a, 0x20, 0xf3, 0x60, 0x8d, 0xa6, 0xd6, 0x7e, 0x96, 0x31, 0xc5, 0x12, 0x7e, 0x2b, 0x71,
+	0x20, 0xb7, 0x75, 0x20, 0xe8, 0x12, 0xd8, 0x1f, 0x42, 0xba, 0x91, 0xe3, 0xf1, 0x5d, 0x74, 0xd1,
+	0x0e, 0x42, 0x97, 0x45, 0x9c, 0x2c, 0x40, 0xb6, 0xbd, 0x29, 0x7b, 0x40, 0x02, 0x65, 0xc7, 0x6c,
+	0xb2, 0x4e, 0xf3, 0xc6, 0x48, 0x05, 0xf0, 0xbf, 0x34, 0x74, 0x59, 0xce, 0x27, 0x2c, 0x32, 0x3b,
+	0xd6, 0x91, 0x19, 0x32, 0xdf, 0x71, 0xfd, 0x96, 0x79, 0xe0, 0xee, 0x93, 0x45, 0x50, 0xf7, 0x67,
+	0x99, 0xbc, 0x2b, 0x75, 0x10, 0xd9, 0xb3, 0x8e, 0xea, 0x4a, 0xe0, 0x81, 0x5b, 0xeb, 0x0b, 0xba,
+	0x12, 0x8e, 0xc2, 0x03, 0x41, 0xaf, 0xaa, 0x26, 0x3a, 0xca, 0xe5, 0xd2, 0x76, 0xec, 0xd6, 0xf1,
+	0xf0, 0xf1, 0x69, 0x75, 0x9c, 0x7d, 0x63, 0x8c, 0xec, 0xbe, 0x0c, 0x47, 0xdb, 0xe2, 0x6d, 0x19,
+	0x8e, 0xa5, 0x61, 0x38, 0x12, 0x28, 0x0b, 0x47, 0xb2, 0x1e, 0x86, 0x23, 0x01, 0xf0, 0x3d, 0x74,
+	0x1e, 0x26, 0x35, 0xb2, 0x0c, 0xbd, 0x7c, 0x39, 0x7d, 0x63, 0xd2, 0xfe, 0x23, 0x49, 0xd4, 0x88,
+	0x3c, 0xec, 0x40, 0x66, 0x20, 0xe8, 0x2c, 0x68, 0x83, 0x95, 0x6e, 0x28, 0x14, 0x3f, 0x40, 0xf3,
+	0x49, 0x41, 0x39, 0xcc, 0x63, 0x31, 0x23, 0x18, 0x92, 0xfd, 0x2d, 0x98, 0x2c, 0x80, 0xd8, 0x01,
+	0x7c, 0x20, 0x28, 0xce, 0x95, 0x94, 0x02, 0x75, 0xa3, 0x20, 0x83, 0x8f, 0x10, 0x81, 0x3e, 0x1d,
+	0x46, 0x41, 0x2b, 0x62, 0x9c, 0xe7, 0x1b, 0xf6, 0x0a, 0x3c, 0x9f, 0x3c, 0x7c, 0x57, 0xa5, 0x4c,
+	0x3d, 0x11, 0xc9, 0xb7, 0x6d, 0x75, 0x9c, 0x8d, 0x65, 0xb3, 0x67, 0x1f, 0xbf, 0x19, 0x37, 0xd0,
+	0x42, 0x92, 0x17, 0xa1, 0xd5, 0xe5, 0xcc, 0xe4, 0xe4, 0x12, 0xd8, 0x7b, 0x4f, 0x3e, 0x87, 0x62,
+	0xea, 0x92, 0x68, 0x64, 0xcf, 0x91, 0x07, 0x33, 0xed, 0x05, 0x51, 0xcc, 0xd0, 0xbc, 0xcc, 0xb2,
+	0x6c, 0xb2, 0x25, 0xab, 0xa0, 0xf3, 0x87, 0x52, 0x67, 0xc7, 0x3a, 0xda, 0x4e, 0xf1, 0x61, 0xd5,
+	0xe5, 0xc0, 0xb1, 0x1d, 0x50, 0x75, 0x3a, 0xa3, 0xb0, 0x1b, 0x3b, 0xe8, 0x92, 0xe3, 0x72, 0xd9,
+	0x99, 0x4d, 0x1e, 0x5a, 0x11, 0x67, 0x26, 0x0c, 0x00, 0xe4, 0x32, 0xbc, 0x09, 0x18, 0xb9, 0x12,
+	0xbe, 0x01, 0x34, 0x8c, 0x16, 0xd9, 0xc8, 0x35, 0x4a, 0xe9, 0xc6, 0x18, 0xf9, 0xbc, 0x95, 0x98,
+	0x75, 0x42, 0xd3, 0xf5, 0x1d, 0x76, 0xc4, 0x38, 0xb9, 0x32, 0x62, 0xe5, 0x31, 0xeb, 0x84, 0xf7,
+	0x15, 0x5b, 0xb6, 0x92, 0xa3, 0x86, 0x56, 0x72, 0x20, 0xde, 0x42, 0x17, 0xe0, 0x05, 0x38, 0x84,
+	0x80, 0xde, 0xb5, 0xbe, 0xa0, 0x09, 0x92, 0x9d, 0xf0, 0x6a, 0xa9, 0x1b, 0x09, 0x8e, 0x63, 0x74,
+	0xe5, 0x90, 0x59, 0x07, 0xa6, 0xcc, 0x6a, 0x33, 0x6e, 0x47, 0x8c, 0xb7, 0x03, 0xcf, 0x31, 0x43,
+	0x3b, 0x26, 0x57, 0x21, 0xe0, 0xb2, 0xbd, 0x5f, 0x92, 0x22, 0x3f, 0xb2, 0x78, 0xfb, 0x71, 0x2a,
+	0x50, 0xb7, 0xe3, 0x81, 0xa0, 0x6b, 0xa0, 0x72, 0x1c, 0x99, 0xbd, 0xd4, 0xb1, 0x5b, 0xf1, 0x36,
+	0x9a, 0xed, 0x58, 0xd1, 0x01, 0x8b, 0x4c, 0xdf, 0xea, 0x30, 0xb2, 0x06, 0xc3, 0x95, 0x2e, 0xdb,
+	0x99, 0x82, 0x1f, 0x5a, 0x1d, 0x96, 0xb5, 0xb3, 0x21, 0xa4, 0x1b, 0x39, 0x1e, 0xf7, 0xd0, 0x9a,
+	0xbc, 0xc4, 0x98, 0xc1, 0xa1, 0xcf, 0x22, 0xde, 0x76, 0x43, 0xb3, 0x19, 0x05, 0x1d, 0x33, 0xb4,
+	0x22, 0xe6, 0xc7, 0xe4, 0x35, 0x08, 0xc1, 0xf7, 0xfa, 0x82, 0x5e, 0x91, 0x52, 0x8f, 0x52, 0xa1,
+	0xdd, 0x28, 0xe8, 0xd4, 0x41, 0x64, 0x20, 0xe8, 0x1b, 0x69, 0xc7, 0x1b, 0xc7, 0xeb, 0xc6, 0xd7,
+	0xed, 0xc4, 0xbf, 0xd5, 0xd0, 0x72, 0x27, 0x70, 0xcc, 0xd8, 0xed, 0x30, 0xf3, 0xd0, 0xf5, 0x9d,
+	0xe0, 0xd0, 0xe4, 0xe4, 0x75, 0x08, 0xd8, 0x47, 0x67, 0x82, 0x2e, 0x1b, 0xd6, 0xe1, 0x5e, 0xe0,
+	0x3c, 0x76, 0x3b, 0xec, 0x09, 0xb0, 0xf2, 0x0c, 0x5f, 0xe8, 0x14, 0x90, 0x6c, 0x04, 0x2d, 0xc2,
+	0x69, 0xe4, 0x8e, 0x4f, 0xab, 0xa3, 0x5a, 0x8c, 0x92, 0x0e, 0xfc, 0x5c, 0x43, 0xab, 0x49, 0x99,
+	0xd8, 0xdd, 0x48, 0xfa, 0x66, 0x1e, 0x46, 0x6e, 0xcc, 0x38, 0x79, 0x03, 0x9c, 0xf9, 0x89, 0x6c,
+	0xbd, 0x2a, 0xe1, 0x13, 0xfe, 0x09, 0xd0, 0x03, 0x41, 0xaf, 0xe7, 0xaa, 0xa6, 0xc0, 0xe5, 0x8a,
+	0x67, 0x2b, 0x57, 0x3b, 0xda, 0x96, 0x31, 0x4e, 0x93, 0x6c, 0x62, 0x69, 0x6e, 0x37, 0xe5, 0x8d,
+	0x89, 0xac, 0x0f, 0x9b, 0x58, 0x42, 0xec, 0x4a, 0x3c, 0x2b, 0xfe, 0x3c, 0xa8, 0x1b, 0x05, 0x19,
+	0xec, 0xa1, 0x25, 0xb8, 0x07, 0x9b, 0xb2, 0x17, 0x98, 0xaa, 0xbf, 0x52, 0xe8, 0xaf, 0x97, 0xd3,
+	0xfe, 0x5a, 0x93, 0xfc, 0xb0, 0xc9, 0xc2, 0x70, 0xbf, 0x5f, 0xc0, 0xb2, 0xc8, 0x16, 0x61, 0xdd,
+	0x28, 0xc9, 0xe1, 0xcf, 0x34, 0xb4, 0x0c, 0x29, 0x04, 0x17, 0x61, 0x53, 0xdd, 0x84, 0x49, 0x05,
+	0xec, 0xad, 0xc8, 0x8b, 0xc4, 0x76, 0x10, 0xf6, 0x0c, 0xc9, 0xed, 0x01, 0x55, 0x7b, 0x20, 0x47,
+	0x31, 0xbb, 0x08, 0x0e, 0x04, 0xdd, 0xc8, 0xd2, 0x28, 0x87, 0xe7, 0xc2, 0xc8, 0x63, 0xcb, 0x77,
+	0xac, 0xc8, 0x91, 0xe7, 0xff, 0x74, 0xba, 0x30, 0xca, 0x8a, 0xf0, 0x5f, 0xa5, 0x3b, 0x96, 0x6c,
+	0xa0, 0xcc, 0xe7, 0x6e, 0xec, 0x3e, 0x95, 0x11, 0x25, 0xd7, 0x20, 0x9c, 0x47, 0x72, 0x2e, 0xdc,
+	0xb6, 0x38, 0x6b, 0xa4, 0xdc, 0x2e, 0xcc, 0x85, 0x76, 0x11, 0x1a, 0x08, 0xba, 0xaa, 0x9c, 0x29,
+	0xe2, 0x72, 0x06, 0x1a, 0x91, 0x1d, 0x85, 0xe4, 0x18, 0x58, 0x32, 0x62, 0x94, 0x64, 0x38, 0xfe,
+	0x8b, 0x86, 0x96, 0x9a, 0x81, 0xe7, 0x05, 0x87, 0xe6, 0x27, 0x5d, 0xdf, 0x96, 0xe3, 0x08, 0x27,
+	0xfa, 0xd0, 0xcb, 0x1f, 0xa7, 0xe0, 0x3d, 0xbe, 0xe3, 0x46, 0x5c, 0x7a, 0xf9, 0x49, 0x11, 0xca,
+	0xbc, 0x2c, 0xe1, 0xe0, 0x65, 0x59, 0x76, 0x14, 0x92, 0x5e, 0x96, 0x8c, 0x18, 0x8b, 0xca, 0xa3,
+	0x0c, 0xc6, 0x8f, 0xd0, 0x82, 0xcc, 0xa8, 0x61, 0x77, 0x20, 0x6f, 0x82, 0x8b, 0xf2, 0x7e, 0x35,
+	0x2f, 0x99, 0xac, 0xae, 0x07, 0x82, 0xae, 0xa8, 0xc3, 0x2f, 0x8f, 0xea, 0x46, 0x51, 0x0a, 0x14,
+	0xca, 0xf3, 0x75, 0xa8, 0xb0, 0x9a, 0x53, 0x68, 0x5b, 0xfe, 0x18, 0x85, 0x79, 0x54, 0x2a, 0xcc,
+	0xaf, 0x71, 0x1d, 0x4d, 0xa7, 0x9f, 0x74, 0xc8, 0x75, 0x98, 0xfa, 0x96, 0xb2, 0x19, 0x33, 0xc1,
+	0x6b, 0x7a, 0x32, 0xe6, 0x65, 0x92, 0x03, 0x41, 0x17, 0x12, 0xdd, 0x0a, 0xd0, 0x8d, 0x8c, 0xc3,
+	0x1f, 0xa3, 0x15, 0x9f, 0xf1, 0x98, 0x39, 0x66, 0x32, 0x56, 0xa8, 0xb3, 0xec, 0x2d, 0xf0, 0xf3,
+	0x56, 0x5f, 0xd0, 0x65, 0x45, 0xdf, 0x07, 0x36, 0x3d, 0xca, 0xd4, 0x47, 0x8b, 0x11, 0x46, 0x37,
+	0x46, 0xa5, 0x71, 0x03, 0x2d, 0xb6, 0xdc, 0xb8, 0xa0, 0xfd, 0x6d, 0xd0, 0x0e, 0x65, 0x98, 0x51,
+	0xa9, 0x6a, 0x55, 0x86, 0x45, 0x58, 0x37, 0x4a, 0x72, 0xb8, 0x2b, 0xab, 0x50, 0x1d, 0xc8, 0x66,
+	0xfa, 0x05, 0x8b, 0x6c, 0x40, 0x15, 0x92, 0x34, 0x22, 0xe9, 0x89, 0xdd, 0x48, 0xf8, 0xda, 0xa6,
+	0xbc, 0xd1, 0xd9, 0x25, 0x34, 0xbb, 0x7b, 0x94, 0x09, 0xdd, 0x18, 0x91, 0xc5, 0x5f, 0x68, 0x68,
+	0x31, 0xb3, 0x7b, 0xe8, 0xfa, 0x3e, 0x8b, 0xc8, 0x3b, 0xf0, 0x5d, 0xe8, 0x57, 0xdf, 0xf0, 0xb3,
+	0xd0, 0x42, 0xaa, 0xf6, 0x09, 0x68, 0xcd, 0x9a, 0x6d, 0x11, 0x1e, 0xfd, 0x8c, 0x22, 0xbf, 0x08,
+	0xc1, 0x97, 0x93, 0x92, 0x02, 0x19, 0xf6, 0x0e, 0x8b, 0x5a, 0x2c, 0x37, 0x0e, 0xdd, 0x18, 0x86,
+	0x1d, 0xa8, 0xfc, 0x40, 0x94, 0x9c, 0x2b, 0x05, 0x58, 0x37, 0x4a, 0x72, 0xf8, 0x21, 0x52, 0x88,
+	0x19, 0x5a, 0x71, 0xcc, 0x22, 0x9f, 0x93, 0x6f, 0x55, 0xa6, 0x36, 0x66, 0x6a, 0x6f, 0xcb, 0x84,
+	0x06, 0xa6, 0x9e, 0x10, 0x59, 0xeb, 0xce, 0xa3, 0xba, 0x51, 0x14, 0xc2, 0xdd, 0x54, 0x9f, 0x3c,
+	0x90, 0xe4, 0xa7, 0x52, 0xf2, 0xee, 0x98, 0x9b, 0xd3, 0xbd, 0x24, 0xa3, 0xe7, 0x40, 0x76, 0xcf,
+	0x3a, 0x6a, 0xa8, 0xcb, 0xdc, 0xb5, 0xa1, 0x81, 0x04, 0x2c, 0xdc, 0x9d, 0xf6, 0x6a, 0xb2, 0x79,
+	0x9e, 0x93, 0x7f, 0x8c, 0xc2, 0x56, 0xa8, 0x4b, 0xe6, 0x31, 0x1b, 0xfa, 0x25, 0x1c, 0x40, 0xef,
+	0xe5, 0xea, 0x32, 0x65, 0x1a, 0xea, 0x04, 0x4a, 0xea, 0x32, 0x8f, 0xca, 0xba, 0xcc, 0xaf, 0x65,
+	0x5c, 0x14, 0xc0, 0x1c, 0x19, 0x9a, 0x36, 0x27, 0x9b, 0xc3, 0xb8, 0xa4, 0x4c, 0x5d, 0x12, 0x59,
+	0x5c, 0xf2, 0x68, 0xa6, 0x2f, 0x11, 0xc2, 0x1f, 0xa1, 0xe5, 0xd0, 0xb3, 0x6c, 0xd6, 0x86, 0xef,
+	0x31, 0x49, 0xd5, 0xdc, 0x04, 0x1f, 0x21, 0x89, 0x73, 0x64, 0x5a, 0x37, 0x2a, 0x89, 0xcb, 0x84,
+	0x6e, 0x8c, 0xc8, 0xe2, 0x03, 0x34, 0x13, 0x31, 0xcb, 0x31, 0x03, 0xdf, 0xeb, 0x91, 0x2f, 0x76,
+	0x41, 0xeb, 0xde, 0x99, 0xa0, 0x78, 0x87, 0x85, 0x11, 0xb3, 0xad, 0x98, 0x39, 0x06, 0xb3, 0x9c,
+	0x47, 0xbe, 0xd7, 0xeb, 0x0b, 0xaa, 0xbd, 0x97, 0xd5, 0x7b, 0x14, 0xc0, 0x2d, 0xf8, 0xdd, 0xa0,
+	0xe3, 0xca, 0x91, 0x34, 0xee, 0xc1, 0x47, 0xca, 0x11, 0x94, 0x68, 0xc6, 0x74, 0x94, 0x28, 0xc0,
+	0xbf, 0x44, 0xcb, 0x85, 0xab, 0x31, 0x8c, 0x89, 0x7f, 0x97, 0x46, 0xb5, 0xda, 0x07, 0x67, 0x82,
+	0x92, 0xa1, 0xd1, 0xbd, 0xe1, 0x05, 0xb7, 0x6e, 0xc7, 0xa9, 0xe9, 0xf5, 0xf2, 0xfd, 0xb8, 0x6e,
+	0xc7, 0x39, 0x0f, 0x88, 0x66, 0x2c, 0x14, 0x49, 0xfc, 0x73, 0x74, 0x51, 0x5d, 0x0b, 0x38, 0xf9,
+	0x72, 0x17, 0x46, 0x9a, 0xef, 0xcb, 0xf9, 0x6a, 0x68, 0x48, 0x5d, 0xf7, 0x78, 0xf1, 0xe1, 0x92,
+	0x2d, 0x39, 0xd5, 0xc9, 0x1c, 0x43, 0x34, 0x23, 0xd5, 0x57, 0x7b, 0xf0, 0xe2, 0xab, 0xf5, 0x89,
+	0xd3, 0xaf, 0xd6, 0x27, 0x5e, 0x9c, 0xad, 0x6b, 0xa7, 0x67, 0xeb, 0xda, 0x1f, 0x5f, 0xae, 0x4f,
+	0x7c, 0xfe, 0x72, 0x5d, 0x3b, 0x7d, 0xb9, 0x3e, 0xf1, 0x9f, 0x97, 0xeb, 0x13, 0x1f, 0xbe, 0xf3,
+	0x7f, 0xd4, 0xbf, 0xca, 0xed, 0xfd, 0x0b, 0xd0, 0x07, 0xde, 0xff, 0xdf, 0x00, 0x39, 0xeb, 0x54,
+	0x1f, 0x7a, 0x18, 0x00, 0x00,
 }
 
 func (m *FolderDeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -388,6 +422,131 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.PlaceholderFiles {
+		i--
+		if m.PlaceholderFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.SelectedPaths) > 0 {
+		for iNdEx := len(m.SelectedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SelectedPaths[iNdEx])
+			copy(dAtA[i:], m.SelectedPaths[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.SelectedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.SelectiveSync {
+		i--
+		if m.SelectiveSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size, err := m.MergeMaxSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xe2
+	if len(m.MergePatterns) > 0 {
+		for iNdEx := len(m.MergePatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MergePatterns[iNdEx])
+			copy(dAtA[i:], m.MergePatterns[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.MergePatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.MergeConflicts {
+		i--
+		if m.MergeConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.ConflictWinner.ProtoSize()
+		i -= size
+		if _, err := m.ConflictWinner.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if m.ConflictStrategy != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ConflictStrategy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.GitignoreFiles {
+		i--
+		if m.GitignoreFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.NestedIgnoreFiles {
+		i--
+		if m.NestedIgnoreFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xaa
 	if m.ScanOwnership {
 		i--
 		if m.ScanOwnership {
@@ -852,6 +1011,42 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	if m.ScanOwnership {
 		n += 3
 	}
+	l = m.Schedule.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.NestedIgnoreFiles {
+		n += 3
+	}
+	if m.GitignoreFiles {
+		n += 3
+	}
+	if m.ConflictStrategy != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ConflictStrategy))
+	}
+	l = m.ConflictWinner.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.MergeConflicts {
+		n += 3
+	}
+	if len(m.MergePatterns) > 0 {
+		for _, s := range m.MergePatterns {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	l = m.MergeMaxSize.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.SelectiveSync {
+		n += 3
+	}
+	if len(m.SelectedPaths) > 0 {
+		for _, s := range m.SelectedPaths {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.PlaceholderFiles {
+		n += 3
+	}
 	if m.DeprecatedReadOnly {
 		n += 4
 	}
@@ -1839,6 +2034,288 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ScanOwnership = bool(v != 0)
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedIgnoreFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NestedIgnoreFiles = bool(v != 0)
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitignoreFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GitignoreFiles = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictStrategy", wireType)
+			}
+			m.ConflictStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictStrategy |= ConflictStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictWinner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConflictWinner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MergeConflicts = bool(v != 0)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergePatterns = append(m.MergePatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMaxSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MergeMaxSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectiveSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelectiveSync = bool(v != 0)
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectedPaths = append(m.SelectedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceholderFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PlaceholderFiles = bool(v != 0)
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
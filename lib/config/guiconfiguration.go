@@ -7,6 +7,7 @@
 package config
 
 import (
+	"crypto/subtle"
 	"net/url"
 	"os"
 	"strconv"
@@ -18,7 +19,7 @@ import (
 )
 
 func (c GUIConfiguration) IsAuthEnabled() bool {
-	return c.AuthMode == AuthModeLDAP || (len(c.User) > 0 && len(c.Password) > 0)
+	return c.AuthMode == AuthModeLDAP || c.AuthMode == AuthModeOIDC || (len(c.User) > 0 && len(c.Password) > 0) || len(c.Users) > 0
 }
 
 func (GUIConfiguration) IsOverridden() bool {
@@ -135,24 +136,115 @@ func (c GUIConfiguration) CompareHashedPassword(password string) error {
 // IsValidAPIKey returns true when the given API key is valid, including both
 // the value in config and any overrides
 func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
+	_, ok := c.APIKeyRole(apiKey)
+	return ok
+}
+
+// APIKeyRole returns the role of the given API key, if it is valid. The
+// main API key and any override are administrators.
+func (c GUIConfiguration) APIKeyRole(apiKey string) (Role, bool) {
 	switch apiKey {
 	case "":
-		return false
+		return RoleReadOnly, false
 
 	case c.APIKey, os.Getenv("STGUIAPIKEY"):
-		return true
+		return RoleAdmin, true
+	}
 
-	default:
-		return false
+	for _, key := range c.APIKeys {
+		if key.Key != "" && subtle.ConstantTimeCompare([]byte(key.Key), []byte(apiKey)) == 1 {
+			return key.Role, true
+		}
+	}
+	return RoleReadOnly, false
+}
+
+// UserRole returns the role of the given user, if the password matches.
+// The main user is an administrator.
+func (c GUIConfiguration) UserRole(username, password string) (Role, bool) {
+	if username == c.User && c.CompareHashedPassword(password) == nil {
+		return RoleAdmin, true
+	}
+	for _, user := range c.Users {
+		if username == user.Name && user.CompareHashedPassword(password) == nil {
+			return user.Role, true
+		}
 	}
+	return RoleReadOnly, false
 }
 
 func (c *GUIConfiguration) prepare() {
 	if c.APIKey == "" {
 		c.APIKey = rand.String(32)
 	}
+
+	users := c.Users[:0]
+	for _, user := range c.Users {
+		if user.Name == "" || user.Password == "" {
+			l.Warnln("Ignoring GUI user without a name or password")
+			continue
+		}
+		if !isBcryptHash(user.Password) {
+			// Set in plain text by hand or through the API
+			if err := user.HashAndSetPassword(user.Password); err != nil {
+				l.Warnf("Hashing password of GUI user %q: %v", user.Name, err)
+				continue
+			}
+		}
+		users = append(users, user)
+	}
+	c.Users = users
+
+	keys := c.APIKeys[:0]
+	for _, key := range c.APIKeys {
+		if key.Key == "" {
+			key.Key = rand.String(32)
+		}
+		keys = append(keys, key)
+	}
+	c.APIKeys = keys
 }
 
 func (c GUIConfiguration) Copy() GUIConfiguration {
+	cp := c
+	cp.Users = make([]GUIUser, len(c.Users))
+	copy(cp.Users, c.Users)
+	cp.APIKeys = make([]GUIAPIKey, len(c.APIKeys))
+	copy(cp.APIKeys, c.APIKeys)
+	return cp
+}
+
+// Redacted returns a copy of the configuration without passwords and API
+// keys.
+func (c GUIConfiguration) Redacted() GUIConfiguration {
+	c = c.Copy()
+	c.Password = ""
+	c.APIKey = ""
+	for i := range c.Users {
+		c.Users[i].Password = ""
+	}
+	for i := range c.APIKeys {
+		c.APIKeys[i].Key = ""
+	}
 	return c
 }
+
+// HashAndSetPassword hashes the given plaintext password and stores the new hash.
+func (u *GUIUser) HashAndSetPassword(password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), 0)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hash)
+	return nil
+}
+
+// CompareHashedPassword returns nil when the given plaintext password matches the stored hash.
+func (u GUIUser) CompareHashedPassword(password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
+}
+
+func isBcryptHash(s string) bool {
+	_, err := bcrypt.Cost([]byte(s))
+	return err == nil
+}
@@ -37,6 +37,11 @@ type GUIConfiguration struct {
 	Debugging                 bool     `protobuf:"varint,11,opt,name=debugging,proto3" json:"debugging" xml:"debugging,attr"`
 	InsecureSkipHostCheck     bool     `protobuf:"varint,12,opt,name=insecure_skip_host_check,json=insecureSkipHostCheck,proto3" json:"insecureSkipHostcheck" xml:"insecureSkipHostcheck,omitempty"`
 	InsecureAllowFrameLoading bool     `protobuf:"varint,13,opt,name=insecure_allow_frame_loading,json=insecureAllowFrameLoading,proto3" json:"insecureAllowFrameLoading" xml:"insecureAllowFrameLoading,omitempty"`
+	WebDAVEnabled             bool     `protobuf:"varint,14,opt,name=webdav_enabled,json=webdavEnabled,proto3" json:"webdavEnabled" xml:"webdavEnabled,omitempty"`
+	// Additional users and API keys with restricted roles; the user and
+	// API key above are always administrators.
+	Users   []GUIUser   `protobuf:"bytes,15,rep,name=users,proto3" json:"users" xml:"namedUser"`
+	APIKeys []GUIAPIKey `protobuf:"bytes,16,rep,name=api_keys,json=apiKeys,proto3" json:"apiKeys" xml:"namedAPIKey"`
 }
 
 func (m *GUIConfiguration) Reset()         { *m = GUIConfiguration{} }
@@ -79,60 +84,70 @@ func init() {
 func init() { proto.RegisterFile("lib/config/guiconfiguration.proto", fileDescriptor_2a9586d611855d64) }
 
 var fileDescriptor_2a9586d611855d64 = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x5b, 0x47, 0xb2, 0xb6, 0xae, 0x60, 0xb0, 0x4d, 0xcb, 0x04, 0x0d, 0xd7, 0x51, 0xd8,
-	0xc2, 0x01, 0x02, 0x39, 0x71, 0x5a, 0x24, 0xf0, 0xa1, 0x80, 0x1c, 0x20, 0x4d, 0x60, 0x17, 0x08,
-	0xe8, 0xfa, 0x92, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0xfc, 0x2b, 0x77, 0x09, 0x4b, 0x87, 0xf6,
-	0x19, 0x0a, 0xf5, 0x5c, 0xa0, 0xcf, 0xd0, 0x4b, 0x5f, 0x21, 0x37, 0xe9, 0x54, 0xe4, 0xb4, 0x40,
-	0xa4, 0x1b, 0x8f, 0x3c, 0xe6, 0x54, 0xec, 0xf2, 0x47, 0xa2, 0xac, 0xd4, 0xbd, 0xed, 0x7c, 0xf3,
-	0xcd, 0x7c, 0x33, 0xc3, 0x19, 0x10, 0xdc, 0x75, 0x49, 0xef, 0xc0, 0x0e, 0xfc, 0x0b, 0xd2, 0x3f,
-	0xe8, 0xc7, 0x24, 0x7b, 0xc5, 0x11, 0x62, 0x24, 0xf0, 0x3b, 0x61, 0x14, 0xb0, 0x40, 0xad, 0x67,
-	0xe0, 0xed, 0x5b, 0x2b, 0x54, 0x14, 0xb3, 0x81, 0x17, 0x38, 0x38, 0xa3, 0xdc, 0x6e, 0xe2, 0x11,
-	0xcb, 0x9e, 0xed, 0xb7, 0x3b, 0x60, 0xf7, 0x87, 0xf3, 0x97, 0xcf, 0x56, 0x13, 0xa9, 0x3d, 0xd0,
-	0xc0, 0x3e, 0xea, 0xb9, 0xd8, 0xd1, 0x94, 0x3d, 0x65, 0x7f, 0xfb, 0xf8, 0x45, 0xc2, 0x61, 0x01,
-	0xa5, 0x1c, 0xde, 0x1d, 0x79, 0xee, 0x51, 0x3b, 0xb7, 0x1f, 0x20, 0xc6, 0xa2, 0xf6, 0x9e, 0x83,
-	0x2f, 0x50, 0xec, 0xb2, 0xa3, 0x36, 0x8b, 0x62, 0xdc, 0x4e, 0xa6, 0xc6, 0xce, 0xaa, 0xff, 0xfd,
-	0xd4, 0xd8, 0x12, 0x0e, 0xb3, 0xc8, 0xa2, 0xfe, 0x02, 0x1a, 0xc8, 0x71, 0x22, 0x4c, 0xa9, 0xf6,
-	0xd1, 0x9e, 0xb2, 0xdf, 0x3c, 0xb6, 0xe7, 0x1c, 0x02, 0x13, 0x5d, 0x76, 0x33, 0x54, 0x28, 0xe6,
-	0x84, 0x94, 0xc3, 0x6f, 0xa4, 0x62, 0x6e, 0xaf, 0x88, 0x3d, 0x3a, 0x7c, 0xd2, 0x79, 0xd8, 0x79,
-	0xd8, 0x79, 0x74, 0xf4, 0xf4, 0xf1, 0xd3, 0x6f, 0xdb, 0xef, 0xa7, 0x46, 0xab, 0x0a, 0x4d, 0x66,
-	0xc6, 0x4a, 0x52, 0xb3, 0x48, 0xa9, 0xfe, 0xa3, 0x80, 0x2f, 0x63, 0x9f, 0x8c, 0x2c, 0x1a, 0xd8,
-	0x43, 0xcc, 0xac, 0x10, 0x47, 0x1e, 0xa1, 0x94, 0x04, 0x3e, 0xd5, 0x3e, 0x96, 0xf5, 0xfc, 0xa1,
-	0xcc, 0x39, 0xd4, 0x4c, 0x74, 0x79, 0xee, 0x93, 0xd1, 0x99, 0x64, 0xbd, 0x5a, 0x92, 0x12, 0x0e,
-	0x6f, 0xc6, 0x9b, 0x1c, 0x29, 0x87, 0x5f, 0xcb, 0x62, 0x37, 0x7a, 0x1f, 0x04, 0x1e, 0x61, 0xd8,
-	0x0b, 0xd9, 0x58, 0x8c, 0x08, 0x5e, 0xc3, 0x99, 0xcc, 0x8c, 0x0f, 0x16, 0x60, 0x6e, 0x96, 0x57,
-	0x9f, 0x83, 0xad, 0x98, 0xe2, 0x48, 0xdb, 0x92, 0x4d, 0x1c, 0x26, 0x1c, 0x4a, 0x3b, 0xe5, 0xf0,
-	0xf3, 0xac, 0x2c, 0x8a, 0xa3, 0x6a, 0x15, 0xad, 0x2a, 0x64, 0x4a, 0xbe, 0xfa, 0x1a, 0x6c, 0x87,
-	0x88, 0xd2, 0xcb, 0x20, 0x72, 0xb4, 0x1b, 0x32, 0xd7, 0xf7, 0x09, 0x87, 0x25, 0x96, 0x72, 0xa8,
-	0xc9, 0x7c, 0x05, 0x50, 0xcd, 0xa9, 0x5e, 0x85, 0xcd, 0x32, 0x56, 0xf5, 0x40, 0x53, 0x6c, 0xa4,
-	0x25, 0x56, 0x52, 0xab, 0xef, 0x29, 0xfb, 0xad, 0xc3, 0xdd, 0x4e, 0xb6, 0xaa, 0x9d, 0x6e, 0xcc,
-	0x06, 0x3f, 0x06, 0x0e, 0xce, 0xe4, 0x50, 0x6e, 0x95, 0x72, 0x05, 0xb0, 0x26, 0x77, 0x15, 0x36,
-	0xcb, 0x58, 0x15, 0x83, 0x46, 0x4c, 0xb1, 0xc5, 0x5c, 0xaa, 0x35, 0xe4, 0x3a, 0x9f, 0xce, 0x39,
-	0x6c, 0x8a, 0xc1, 0x52, 0xfc, 0xd3, 0xe9, 0x59, 0xc2, 0x61, 0x3d, 0x96, 0xaf, 0x94, 0xc3, 0x96,
-	0x54, 0x61, 0x2e, 0xcd, 0xd6, 0x3a, 0x99, 0x1a, 0xdb, 0x85, 0x91, 0x4e, 0x8d, 0x9c, 0x37, 0x99,
-	0x19, 0xcb, 0x70, 0x53, 0x82, 0x2e, 0x15, 0x32, 0x28, 0x24, 0xd6, 0x10, 0x8f, 0xb5, 0x6d, 0x39,
-	0x30, 0x21, 0x53, 0xef, 0xbe, 0x7a, 0x79, 0x82, 0xc7, 0x42, 0x03, 0x85, 0xe4, 0x04, 0x8f, 0x53,
-	0x0e, 0xbf, 0xc8, 0x3a, 0x09, 0xc9, 0x10, 0x8f, 0xab, 0x7d, 0xec, 0xae, 0x83, 0x93, 0x99, 0x91,
-	0x67, 0x30, 0xf3, 0x78, 0xf5, 0x77, 0x05, 0xdc, 0x24, 0x3e, 0xc5, 0x76, 0x1c, 0x61, 0x0b, 0x39,
-	0x1e, 0xf1, 0x2d, 0x64, 0xdb, 0xe2, 0x8e, 0x9a, 0xb2, 0x39, 0x2b, 0xe1, 0xf0, 0xb3, 0x82, 0xd0,
-	0x15, 0xfe, 0xae, 0x74, 0xa7, 0x1c, 0xde, 0x93, 0xc2, 0x1b, 0x7c, 0xd5, 0x2a, 0xee, 0xfc, 0x27,
-	0xc3, 0xdc, 0x94, 0x5c, 0x3d, 0x01, 0x37, 0xd8, 0x00, 0x7b, 0x58, 0x03, 0xb2, 0xf5, 0xef, 0x12,
-	0x0e, 0x33, 0x20, 0xe5, 0xf0, 0x4e, 0x36, 0x53, 0x61, 0xad, 0x9c, 0x6e, 0xfe, 0x10, 0x37, 0xdb,
-	0xc8, 0xdf, 0x66, 0x16, 0xa2, 0x9e, 0x83, 0xa6, 0x83, 0x7b, 0x71, 0xbf, 0x4f, 0xfc, 0xbe, 0xf6,
-	0x89, 0xec, 0xea, 0x49, 0xc2, 0xe1, 0x12, 0x2c, 0xb7, 0xb9, 0x44, 0xca, 0xcf, 0xd5, 0xaa, 0x42,
-	0xe6, 0x32, 0x48, 0xfd, 0x5b, 0x01, 0x5a, 0x39, 0x39, 0x3a, 0x24, 0xa1, 0x35, 0x08, 0x28, 0xb3,
-	0xec, 0x01, 0xb6, 0x87, 0xda, 0x8e, 0x94, 0xf9, 0x55, 0xdc, 0x75, 0xc1, 0x39, 0x1b, 0x92, 0xf0,
-	0x45, 0x40, 0x99, 0x24, 0x94, 0x77, 0xbd, 0xd1, 0xbb, 0x76, 0xd7, 0xd7, 0x70, 0xd2, 0xa9, 0xb1,
-	0x59, 0xc4, 0xbc, 0x02, 0x3f, 0x13, 0xb0, 0xfa, 0x97, 0x02, 0xbe, 0x5a, 0x7e, 0x73, 0xd7, 0x0d,
-	0x2e, 0xad, 0x8b, 0x08, 0x79, 0xd8, 0x72, 0x03, 0xe4, 0x88, 0x21, 0x7d, 0x2a, 0xab, 0xff, 0x39,
-	0xe1, 0xf0, 0x56, 0xf9, 0x75, 0x04, 0xed, 0xb9, 0x60, 0x9d, 0x66, 0xa4, 0x94, 0xc3, 0xfb, 0xd5,
-	0x05, 0x58, 0x67, 0x54, 0xbb, 0xb8, 0xf7, 0x3f, 0x78, 0xe6, 0x87, 0xe5, 0x8e, 0x4f, 0xde, 0xbc,
-	0xd3, 0x6b, 0xb3, 0x77, 0x7a, 0xed, 0xcd, 0x5c, 0x57, 0x66, 0x73, 0x5d, 0xf9, 0x6d, 0xa1, 0xd7,
-	0xfe, 0x5c, 0xe8, 0xca, 0x6c, 0xa1, 0xd7, 0xde, 0x2e, 0xf4, 0xda, 0xeb, 0xfb, 0x7d, 0xc2, 0x06,
-	0x71, 0xaf, 0x63, 0x07, 0xde, 0x01, 0x1d, 0xfb, 0x36, 0x1b, 0x10, 0xbf, 0xbf, 0xf2, 0x5a, 0xfe,
-	0xc1, 0x7a, 0x75, 0xf9, 0xbb, 0x7a, 0xfc, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x8c, 0xef,
-	0xc0, 0x01, 0x07, 0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x1b, 0xdb, 0xb2, 0xe8, 0x58, 0x76, 0xd8, 0xa6, 0x61, 0x82, 0x46, 0xa7, 0x28, 0x6c,
+	0xe1, 0x00, 0xa9, 0x9c, 0x38, 0x2d, 0x12, 0x78, 0x28, 0x20, 0xa5, 0xf9, 0x61, 0xd8, 0x05, 0x02,
+	0xda, 0x6a, 0x81, 0x2c, 0x04, 0x45, 0x9e, 0xa5, 0x83, 0xf8, 0x43, 0xe5, 0x1d, 0x2b, 0x6b, 0x68,
+	0x51, 0xf4, 0x2f, 0x28, 0xd4, 0xb9, 0x40, 0xc7, 0xce, 0x5d, 0xfa, 0x2f, 0x78, 0x93, 0xa6, 0xa2,
+	0xd3, 0x01, 0x91, 0x37, 0x8e, 0x1c, 0x33, 0x15, 0x77, 0x47, 0x52, 0xa2, 0x2d, 0x37, 0xd9, 0xee,
+	0x7d, 0xef, 0xbb, 0xf7, 0xbd, 0x77, 0xf7, 0xde, 0x91, 0xf2, 0x1d, 0x07, 0xb5, 0xb7, 0x2d, 0xdf,
+	0x3b, 0x46, 0x9d, 0xed, 0x4e, 0x88, 0xc4, 0x2a, 0x0c, 0x4c, 0x82, 0x7c, 0xaf, 0xde, 0x0f, 0x7c,
+	0xe2, 0x2b, 0x2b, 0x02, 0xbc, 0x75, 0x73, 0x8e, 0x6a, 0x86, 0xa4, 0xeb, 0xfa, 0x36, 0x14, 0x94,
+	0x5b, 0x6a, 0x3e, 0x4a, 0x88, 0x61, 0x90, 0x78, 0x4a, 0xf0, 0x84, 0x88, 0x65, 0x8d, 0x6e, 0xc8,
+	0x9b, 0x2f, 0x5a, 0x7b, 0x4f, 0xe7, 0x25, 0x94, 0xb6, 0x5c, 0x84, 0x9e, 0xd9, 0x76, 0xa0, 0xad,
+	0x4a, 0x55, 0x69, 0x6b, 0xb5, 0xf9, 0x32, 0xa2, 0x20, 0x85, 0x62, 0x0a, 0xee, 0x9c, 0xb8, 0xce,
+	0x6e, 0x2d, 0xb1, 0xef, 0x9b, 0x84, 0x04, 0xb5, 0xaa, 0x0d, 0x8f, 0xcd, 0xd0, 0x21, 0xbb, 0x35,
+	0x12, 0x84, 0xb0, 0x16, 0x8d, 0xb5, 0xab, 0xf3, 0xfe, 0xb7, 0x63, 0x6d, 0x89, 0x39, 0xf4, 0x34,
+	0x8a, 0xf2, 0xa3, 0x5c, 0x34, 0x6d, 0x3b, 0x80, 0x18, 0xab, 0x1f, 0x54, 0xa5, 0xad, 0x52, 0xd3,
+	0x9a, 0x52, 0x20, 0xeb, 0xe6, 0xa0, 0x21, 0x50, 0xa6, 0x98, 0x10, 0x62, 0x0a, 0x3e, 0xe3, 0x8a,
+	0x89, 0x3d, 0x27, 0xf6, 0x70, 0xe7, 0x71, 0xfd, 0x41, 0xfd, 0x41, 0xfd, 0xe1, 0xee, 0x93, 0x47,
+	0x4f, 0xbe, 0xa8, 0xbd, 0x1d, 0x6b, 0xe5, 0x3c, 0x34, 0x9a, 0x68, 0x73, 0x41, 0xf5, 0x34, 0xa4,
+	0xf2, 0x8f, 0x24, 0xdf, 0x08, 0x3d, 0x74, 0x62, 0x60, 0xdf, 0xea, 0x41, 0x62, 0xf4, 0x61, 0xe0,
+	0x22, 0x8c, 0x91, 0xef, 0x61, 0xf5, 0x0a, 0xcf, 0xe7, 0x77, 0x69, 0x4a, 0x81, 0xaa, 0x9b, 0x83,
+	0x96, 0x87, 0x4e, 0x0e, 0x39, 0xeb, 0xd5, 0x8c, 0x14, 0x51, 0x70, 0x3d, 0x5c, 0xe4, 0x88, 0x29,
+	0xf8, 0x94, 0x27, 0xbb, 0xd0, 0x7b, 0xdf, 0x77, 0x11, 0x81, 0x6e, 0x9f, 0x0c, 0xd9, 0x11, 0x81,
+	0x77, 0x70, 0x46, 0x13, 0xed, 0xd2, 0x04, 0xf4, 0xc5, 0xf2, 0xca, 0x73, 0x79, 0x89, 0xdd, 0xb4,
+	0xba, 0xc4, 0x8b, 0xd8, 0x89, 0x28, 0xe0, 0x76, 0x4c, 0xc1, 0x47, 0x22, 0x2d, 0x0c, 0x83, 0x7c,
+	0x16, 0xe5, 0x3c, 0xa4, 0x73, 0xbe, 0xf2, 0x5a, 0x5e, 0xed, 0x9b, 0x18, 0x0f, 0xfc, 0xc0, 0x56,
+	0x97, 0x79, 0xac, 0xaf, 0x22, 0x0a, 0x32, 0x2c, 0xa6, 0x40, 0xe5, 0xf1, 0x52, 0x20, 0x1f, 0x53,
+	0xb9, 0x08, 0xeb, 0xd9, 0x5e, 0xc5, 0x95, 0x4b, 0xac, 0x57, 0x0d, 0xd6, 0xac, 0xea, 0x4a, 0x55,
+	0xda, 0x2a, 0xef, 0x6c, 0xd6, 0x45, 0xa7, 0xd6, 0x1b, 0x21, 0xe9, 0x7e, 0xe3, 0xdb, 0x50, 0xc8,
+	0x99, 0x89, 0x95, 0xc9, 0xa5, 0xc0, 0x39, 0xb9, 0x8b, 0xb0, 0x9e, 0xed, 0x55, 0xa0, 0x5c, 0x0c,
+	0x31, 0x34, 0x88, 0x83, 0xd5, 0x22, 0x6f, 0xe7, 0x83, 0x29, 0x05, 0x25, 0x76, 0xb0, 0x18, 0x1e,
+	0x1d, 0x1c, 0x46, 0x14, 0xac, 0x84, 0x7c, 0x15, 0x53, 0x50, 0xe6, 0x2a, 0xc4, 0xc1, 0xa2, 0xad,
+	0xa3, 0xb1, 0xb6, 0x9a, 0x1a, 0xf1, 0x58, 0x4b, 0x78, 0xa3, 0x89, 0x36, 0xdb, 0xae, 0x73, 0xd0,
+	0xc1, 0x4c, 0xc6, 0xec, 0x23, 0xa3, 0x07, 0x87, 0xea, 0x2a, 0x3f, 0x30, 0x26, 0xb3, 0xd2, 0x78,
+	0xb5, 0xb7, 0x0f, 0x87, 0x4c, 0xc3, 0xec, 0xa3, 0x7d, 0x38, 0x8c, 0x29, 0xf8, 0x58, 0x54, 0xd2,
+	0x47, 0x3d, 0x38, 0xcc, 0xd7, 0xb1, 0x79, 0x1e, 0x1c, 0x4d, 0xb4, 0x24, 0x82, 0x9e, 0xec, 0x57,
+	0x7e, 0x93, 0xe4, 0xeb, 0xc8, 0xc3, 0xd0, 0x0a, 0x03, 0x68, 0x98, 0xb6, 0x8b, 0x3c, 0xc3, 0xb4,
+	0x2c, 0x36, 0x47, 0x25, 0x5e, 0x9c, 0x11, 0x51, 0xf0, 0x61, 0x4a, 0x68, 0x30, 0x7f, 0x83, 0xbb,
+	0x63, 0x0a, 0xee, 0x72, 0xe1, 0x05, 0xbe, 0x7c, 0x16, 0xb7, 0xff, 0x97, 0xa1, 0x2f, 0x0a, 0xae,
+	0xec, 0xcb, 0xcb, 0xa4, 0x0b, 0x5d, 0xa8, 0xca, 0xbc, 0xf4, 0x2f, 0x23, 0x0a, 0x04, 0x10, 0x53,
+	0x70, 0x5b, 0x9c, 0x29, 0xb3, 0xe6, 0x46, 0x37, 0x59, 0xb0, 0x99, 0x2d, 0x26, 0x6b, 0x5d, 0x6c,
+	0x51, 0x5a, 0x72, 0xc9, 0x86, 0xed, 0xb0, 0xd3, 0x41, 0x5e, 0x47, 0x5d, 0xe3, 0x55, 0x3d, 0x8e,
+	0x28, 0x98, 0x81, 0x59, 0x37, 0x67, 0x48, 0x76, 0x5d, 0xe5, 0x3c, 0xa4, 0xcf, 0x36, 0x29, 0x7f,
+	0x4b, 0xb2, 0x9a, 0x9d, 0x1c, 0xee, 0xa1, 0xbe, 0xd1, 0xf5, 0x31, 0x31, 0xac, 0x2e, 0xb4, 0x7a,
+	0xea, 0x55, 0x2e, 0xf3, 0x13, 0x9b, 0xeb, 0x94, 0x73, 0xd8, 0x43, 0xfd, 0x97, 0x3e, 0x26, 0x9c,
+	0x90, 0xcd, 0xf5, 0x42, 0xef, 0xb9, 0xb9, 0x7e, 0x07, 0x27, 0x1e, 0x6b, 0x8b, 0x45, 0xf4, 0x0b,
+	0xf0, 0x53, 0x06, 0x2b, 0x7f, 0x49, 0xf2, 0x27, 0xb3, 0x3b, 0x77, 0x1c, 0x7f, 0x60, 0x1c, 0x07,
+	0xa6, 0x0b, 0x0d, 0xc7, 0x37, 0x6d, 0x76, 0x48, 0xeb, 0x3c, 0xfb, 0xef, 0x23, 0x0a, 0x6e, 0x66,
+	0xb7, 0xc3, 0x68, 0xcf, 0x19, 0xeb, 0x40, 0x90, 0x62, 0x0a, 0xee, 0xe5, 0x1b, 0xe0, 0x3c, 0x23,
+	0x5f, 0xc5, 0xdd, 0xf7, 0xe0, 0xe9, 0x97, 0xcb, 0x29, 0x7f, 0x4a, 0x72, 0x79, 0x00, 0xdb, 0xb6,
+	0xf9, 0x83, 0x91, 0x7e, 0x4d, 0xca, 0x3c, 0xcd, 0x9f, 0xd9, 0xcb, 0xba, 0xfe, 0x1d, 0x6c, 0x7f,
+	0xdd, 0xf8, 0xf6, 0x99, 0xf0, 0x44, 0x14, 0xac, 0x0b, 0xee, 0xb3, 0xec, 0x2b, 0x23, 0xda, 0x26,
+	0x87, 0xe6, 0x13, 0xbc, 0x71, 0x89, 0x2f, 0x1e, 0x6b, 0xf9, 0x60, 0xa3, 0x89, 0x96, 0x97, 0xd3,
+	0xf3, 0x7e, 0xe5, 0x48, 0x5e, 0x66, 0x8f, 0x1e, 0x56, 0x37, 0xaa, 0x57, 0xb6, 0xd6, 0x76, 0x36,
+	0xd2, 0xc7, 0xe8, 0x45, 0x6b, 0xaf, 0x85, 0x61, 0xd0, 0xfc, 0xfc, 0x94, 0x82, 0x02, 0x6b, 0x69,
+	0xce, 0x8a, 0x29, 0xd8, 0xe0, 0xb9, 0x79, 0xa6, 0x0b, 0x6d, 0x46, 0x60, 0xd9, 0x94, 0x32, 0x4b,
+	0x17, 0x34, 0xe5, 0x17, 0x49, 0x5e, 0x4d, 0x5e, 0x04, 0xac, 0x6e, 0xf2, 0xc8, 0xd7, 0xe6, 0x22,
+	0x8b, 0xb9, 0x6e, 0x1e, 0xb1, 0xd8, 0x53, 0x0a, 0x8a, 0xc2, 0x16, 0x1f, 0x3e, 0x3e, 0xea, 0x4c,
+	0xe8, 0xda, 0x4c, 0x48, 0xf8, 0x99, 0xd4, 0xda, 0x9c, 0x1d, 0x8f, 0xb5, 0x94, 0x3e, 0x9a, 0x68,
+	0x69, 0x10, 0x3d, 0xc5, 0x9a, 0xfb, 0xa7, 0x6f, 0x2a, 0x85, 0xc9, 0x9b, 0x4a, 0xe1, 0x74, 0x5a,
+	0x91, 0x26, 0xd3, 0x8a, 0xf4, 0xeb, 0x59, 0xa5, 0xf0, 0xc7, 0x59, 0x45, 0x9a, 0x9c, 0x55, 0x0a,
+	0xff, 0x9e, 0x55, 0x0a, 0xaf, 0xef, 0x75, 0x10, 0xe9, 0x86, 0xed, 0xba, 0xe5, 0xbb, 0xdb, 0x78,
+	0xe8, 0x59, 0xa4, 0x8b, 0xbc, 0xce, 0xdc, 0x6a, 0xf6, 0x1b, 0xd1, 0x5e, 0xe1, 0x3f, 0x0d, 0x8f,
+	0xfe, 0x1b, 0x00, 0xcb, 0x56, 0x3a, 0x0e, 0xa1, 0x08, 0x00, 0x00,
 }
 
 func (m *GUIConfiguration) Marshal() (dAtA []byte, err error) {
@@ -155,6 +170,46 @@ func (m *GUIConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.APIKeys) > 0 {
+		for iNdEx := len(m.APIKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.APIKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.WebDAVEnabled {
+		i--
+		if m.WebDAVEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.InsecureAllowFrameLoading {
 		i--
 		if m.InsecureAllowFrameLoading {
@@ -327,6 +382,21 @@ func (m *GUIConfiguration) ProtoSize() (n int) {
 	if m.InsecureAllowFrameLoading {
 		n += 2
 	}
+	if m.WebDAVEnabled {
+		n += 2
+	}
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.ProtoSize()
+			n += 1 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	if len(m.APIKeys) > 0 {
+		for _, e := range m.APIKeys {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -696,6 +766,94 @@ func (m *GUIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.InsecureAllowFrameLoading = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebDAVEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WebDAVEnabled = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, GUIUser{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeys = append(m.APIKeys, GUIAPIKey{})
+			if err := m.APIKeys[len(m.APIKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuiconfiguration(dAtA[iNdEx:])
@@ -0,0 +1,629 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guiuser.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GUIUser is a GUI user in addition to the one in the GUI configuration,
+// with a given role.
+type GUIUser struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password" xml:"password"`
+	Role     Role   `protobuf:"varint,3,opt,name=role,proto3,enum=config.Role" json:"role" xml:"role,attr"`
+}
+
+func (m *GUIUser) Reset()         { *m = GUIUser{} }
+func (m *GUIUser) String() string { return proto.CompactTextString(m) }
+func (*GUIUser) ProtoMessage()    {}
+func (*GUIUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32d337d5bb21b69, []int{0}
+}
+func (m *GUIUser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIUser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIUser.Merge(m, src)
+}
+func (m *GUIUser) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIUser proto.InternalMessageInfo
+
+// GUIAPIKey is an API key in addition to the one in the GUI configuration,
+// with a given role.
+type GUIAPIKey struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key" xml:"key"`
+	Role Role   `protobuf:"varint,3,opt,name=role,proto3,enum=config.Role" json:"role" xml:"role,attr"`
+}
+
+func (m *GUIAPIKey) Reset()         { *m = GUIAPIKey{} }
+func (m *GUIAPIKey) String() string { return proto.CompactTextString(m) }
+func (*GUIAPIKey) ProtoMessage()    {}
+func (*GUIAPIKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32d337d5bb21b69, []int{1}
+}
+func (m *GUIAPIKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIAPIKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIAPIKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIAPIKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIAPIKey.Merge(m, src)
+}
+func (m *GUIAPIKey) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIAPIKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIAPIKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIAPIKey proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GUIUser)(nil), "config.GUIUser")
+	proto.RegisterType((*GUIAPIKey)(nil), "config.GUIAPIKey")
+}
+
+func init() { proto.RegisterFile("lib/config/guiuser.proto", fileDescriptor_c32d337d5bb21b69) }
+
+var fileDescriptor_c32d337d5bb21b69 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0xb6, 0x54, 0x73, 0x48, 0x85, 0xa0, 0x10, 0x3a, 0xdc, 0x95, 0x43, 0xa4, 0x82,
+	0xa4, 0xa0, 0x5b, 0x71, 0xb1, 0x4b, 0xa9, 0x5d, 0x24, 0xd0, 0xc5, 0xad, 0xad, 0x67, 0x1a, 0x9a,
+	0xe6, 0xca, 0xe5, 0x8a, 0xcd, 0xb7, 0xf0, 0x23, 0xf8, 0x45, 0xdc, 0xbb, 0x35, 0xa3, 0xd3, 0x41,
+	0x9b, 0x2d, 0x63, 0x3e, 0x81, 0xdc, 0x45, 0xd3, 0xee, 0xba, 0xdd, 0xef, 0xff, 0xde, 0x3d, 0x7e,
+	0x8f, 0x07, 0xed, 0xc0, 0x1f, 0xb7, 0x27, 0x2c, 0x7c, 0xf5, 0xbd, 0xb6, 0xb7, 0xf4, 0x97, 0x11,
+	0xe5, 0xce, 0x82, 0x33, 0xc1, 0xac, 0x5a, 0x91, 0x36, 0x2e, 0x0e, 0x3a, 0x38, 0x0b, 0x68, 0x51,
+	0x6e, 0x98, 0x74, 0x25, 0x8a, 0x27, 0xd9, 0x00, 0x78, 0xdc, 0x1b, 0xf6, 0x87, 0x11, 0xe5, 0xd6,
+	0x3d, 0xac, 0x86, 0xa3, 0x39, 0xb5, 0x41, 0x13, 0xb4, 0xcc, 0x6e, 0x2b, 0x93, 0x58, 0x73, 0x2e,
+	0xf1, 0xd9, 0x6a, 0x1e, 0x74, 0x88, 0x82, 0x9b, 0x91, 0x10, 0x9c, 0x64, 0x9b, 0x4b, 0xb3, 0x24,
+	0x57, 0x77, 0x59, 0x1d, 0x78, 0xb2, 0x18, 0x45, 0xd1, 0x1b, 0xe3, 0x2f, 0xf6, 0x91, 0x9e, 0x80,
+	0x32, 0x89, 0xcb, 0x2c, 0x97, 0xb8, 0xae, 0xa7, 0xfc, 0x06, 0xc4, 0x2d, 0x6b, 0xd6, 0x23, 0xac,
+	0x2a, 0x3d, 0xbb, 0xd2, 0x04, 0xad, 0xfa, 0xed, 0xa9, 0x53, 0x28, 0x3b, 0x2e, 0x0b, 0x68, 0xe1,
+	0xa1, 0xaa, 0xa5, 0x87, 0x82, 0xbd, 0x47, 0x49, 0xae, 0xee, 0x22, 0x9f, 0x00, 0x9a, 0xbd, 0x61,
+	0xff, 0xe1, 0xa9, 0x3f, 0xa0, 0xf1, 0x1f, 0x77, 0xba, 0x82, 0x95, 0x19, 0x8d, 0x7f, 0xd6, 0x39,
+	0xcf, 0x24, 0x56, 0x98, 0x4b, 0x6c, 0xea, 0xbf, 0x33, 0x1a, 0x13, 0x57, 0x25, 0xff, 0xe9, 0xdf,
+	0x1d, 0xac, 0xb7, 0xc8, 0x48, 0xb6, 0xc8, 0x58, 0xef, 0x10, 0x48, 0x76, 0x08, 0xbc, 0xa7, 0xc8,
+	0xf8, 0x48, 0x11, 0x48, 0x52, 0x64, 0x7c, 0xa5, 0xc8, 0x78, 0xbe, 0xf6, 0x7c, 0x31, 0x5d, 0x8e,
+	0x9d, 0x09, 0x9b, 0xb7, 0xa3, 0x38, 0x9c, 0x88, 0xa9, 0x1f, 0x7a, 0x07, 0xaf, 0xfd, 0xd1, 0xc7,
+	0x35, 0x7d, 0xe5, 0xbb, 0xef, 0x01, 0x00, 0xe3, 0xe8, 0xe3, 0xcd, 0x2b, 0x02, 0x00, 0x00,
+}
+
+func (m *GUIUser) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintGuiuser(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GUIAPIKey) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIAPIKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIAPIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintGuiuser(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGuiuser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuiuser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuiuser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GUIUser) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovGuiuser(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *GUIAPIKey) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGuiuser(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovGuiuser(uint64(m.Role))
+	}
+	return n
+}
+
+func sovGuiuser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuiuser(x uint64) (n int) {
+	return sovGuiuser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GUIUser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiuser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIUser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIUser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiuser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GUIAPIKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiuser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIAPIKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIAPIKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiuser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiuser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuiuser(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuiuser
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiuser
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuiuser
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuiuser
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuiuser
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuiuser        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuiuser          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuiuser = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,123 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+// OriginStartup is the origin of the configuration in effect at startup.
+const OriginStartup = "startup"
+
+// A HistoryEntry describes a committed configuration. Origin says where the
+// change came from, like "api:alice"; it's empty for changes Syncthing made
+// by itself.
+type HistoryEntry struct {
+	Revision string    `json:"revision"`
+	Time     time.Time `json:"time"`
+	Origin   string    `json:"origin"`
+}
+
+type historyEntry struct {
+	HistoryEntry
+	Config Configuration `json:"config"`
+}
+
+// historyPath returns the path of the history file belonging to the
+// configuration file, i.e. "config-history.json" for "config.xml". The
+// history is only kept on disk for configurations loaded from a file.
+func historyPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "-history.json"
+}
+
+// loadHistory reads the history from the file, if there is any. Entries
+// from other configuration versions are dropped as we can't roll back to
+// them.
+func loadHistory(path string) []historyEntry {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			l.Warnln("Loading config history:", err)
+		}
+		return nil
+	}
+	var entries []historyEntry
+	if err := json.Unmarshal(bs, &entries); err != nil {
+		l.Warnln("Loading config history:", err)
+		return nil
+	}
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.Config.Version == CurrentVersion {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+func saveHistory(path string, entries []historyEntry) error {
+	fd, err := osutil.CreateAtomic(path)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(fd).Encode(entries); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// recordLocked adds the configuration to the history, dropping the oldest
+// entries beyond the configured number.
+func (w *wrapper) recordLocked(origin string, cfg Configuration) {
+	w.history = append(w.history, historyEntry{
+		HistoryEntry: HistoryEntry{
+			Revision: cfg.Revision(),
+			Time:     time.Now().Truncate(time.Second),
+			Origin:   origin,
+		},
+		Config: cfg.Copy(),
+	})
+	if max := cfg.Options.ConfigHistoryEntries; len(w.history) > max {
+		if max < 0 {
+			max = 0
+		}
+		w.history = append(w.history[:0], w.history[len(w.history)-max:]...)
+	}
+	w.historyDirty = true
+}
+
+// History returns the committed configurations, oldest first. The last
+// one is the one in effect.
+func (w *wrapper) History() []HistoryEntry {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	entries := make([]HistoryEntry, len(w.history))
+	for i, e := range w.history {
+		entries[i] = e.HistoryEntry
+	}
+	return entries
+}
+
+// HistoryConfig returns the most recent committed configuration with the
+// given revision.
+func (w *wrapper) HistoryConfig(revision string) (Configuration, bool) {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	for i := len(w.history) - 1; i >= 0; i-- {
+		if w.history[i].Revision == revision {
+			return w.history[i].Config.Copy(), true
+		}
+	}
+	return Configuration{}, false
+}
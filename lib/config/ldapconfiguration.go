@@ -6,6 +6,43 @@
 
 package config
 
+import (
+	"strings"
+	"time"
+)
+
 func (c LDAPConfiguration) Copy() LDAPConfiguration {
-	return c
+	cp := c
+	cp.GroupRoles = make([]LDAPGroupRole, len(c.GroupRoles))
+	copy(cp.GroupRoles, c.GroupRoles)
+	return cp
+}
+
+// GroupsRole returns the highest role granted by any of the given groups,
+// which are compared case insensitively as is the custom for DNs. If group
+// roles aren't used at all, everyone is an administrator.
+func (c LDAPConfiguration) GroupsRole(groups []string) (Role, bool) {
+	if len(c.GroupRoles) == 0 {
+		return RoleAdmin, true
+	}
+	var role Role
+	found := false
+	for _, gr := range c.GroupRoles {
+		for _, group := range groups {
+			if strings.EqualFold(gr.Group, group) && (!found || gr.Role.Includes(role)) {
+				role = gr.Role
+				found = true
+			}
+		}
+	}
+	return role, found
+}
+
+// BindCacheTTL is how long a successful login may be reused while the LDAP
+// server is unreachable.
+func (c LDAPConfiguration) BindCacheTTL() time.Duration {
+	if c.BindCacheTTLS <= 0 {
+		return 0
+	}
+	return time.Duration(c.BindCacheTTLS) * time.Second
 }
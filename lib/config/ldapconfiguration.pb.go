@@ -30,6 +30,18 @@ type LDAPConfiguration struct {
 	InsecureSkipVerify bool          `protobuf:"varint,4,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecureSkipVerify" xml:"insecureSkipVerify,omitempty" default:"false"`
 	SearchBaseDN       string        `protobuf:"bytes,5,opt,name=search_base_dn,json=searchBaseDn,proto3" json:"searchBaseDN" xml:"searchBaseDN,omitempty"`
 	SearchFilter       string        `protobuf:"bytes,6,opt,name=search_filter,json=searchFilter,proto3" json:"searchFilter" xml:"searchFilter,omitempty"`
+	// Group membership is given by the member attribute of the user found
+	// by the search, and of the groups in turn for nested groups. When
+	// group roles are set, users get the highest role of the groups they
+	// are a member of, and are denied access when there is none.
+	MemberAttribute string          `protobuf:"bytes,7,opt,name=member_attribute,json=memberAttribute,proto3" json:"memberAttribute" xml:"memberAttribute,omitempty" default:"memberOf"`
+	GroupRoles      []LDAPGroupRole `protobuf:"bytes,8,rep,name=group_roles,json=groupRoles,proto3" json:"groupRoles" xml:"groupRole"`
+	// When set, only members of this group may log in.
+	RequiredGroup        string `protobuf:"bytes,9,opt,name=required_group,json=requiredGroup,proto3" json:"requiredGroup" xml:"requiredGroup,omitempty"`
+	DisplayNameAttribute string `protobuf:"bytes,10,opt,name=display_name_attribute,json=displayNameAttribute,proto3" json:"displayNameAttribute" xml:"displayNameAttribute,omitempty" default:"displayName"`
+	// How long a successful login is remembered, to be accepted while the
+	// LDAP server is unreachable. Zero disables this.
+	BindCacheTTLS int `protobuf:"varint,11,opt,name=bind_cache_ttl_s,json=bindCacheTtlS,proto3,casttype=int" json:"bindCacheTTLS" xml:"bindCacheTTLS,omitempty"`
 }
 
 func (m *LDAPConfiguration) Reset()         { *m = LDAPConfiguration{} }
@@ -74,39 +86,56 @@ func init() {
 }
 
 var fileDescriptor_9681ad7e41c73956 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x81, 0xba, 0xc4, 0x2a, 0x15, 0x35, 0x50, 0x4c, 0x55, 0xf9, 0x22, 0xcb, 0x43, 0x90,
-	0x50, 0x22, 0x95, 0xad, 0x4c, 0x35, 0x15, 0x03, 0x20, 0x84, 0x5c, 0xe8, 0xc0, 0x12, 0xf9, 0xc7,
-	0x39, 0x39, 0xd5, 0x39, 0x5b, 0x77, 0xe7, 0xaa, 0xe1, 0xaf, 0x80, 0xfe, 0x05, 0xdd, 0xf8, 0x57,
-	0xba, 0xc5, 0x23, 0xd3, 0x49, 0x4d, 0x36, 0x8f, 0x1e, 0x99, 0x50, 0xce, 0x4e, 0x63, 0xa7, 0x51,
-	0xb7, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0x7d, 0x77, 0x4f, 0x4f, 0x35, 0x23, 0xe4, 0xf5, 0xfc, 0x18,
-	0x87, 0x68, 0xd0, 0x8b, 0x02, 0x37, 0x29, 0xc3, 0x94, 0xb8, 0x0c, 0xc5, 0xb8, 0x9b, 0x90, 0x98,
-	0xc5, 0x9a, 0x52, 0x82, 0x7b, 0xc6, 0x4a, 0x2d, 0x23, 0x2e, 0xa6, 0x49, 0x4c, 0x58, 0x59, 0xb7,
-	0xd7, 0x82, 0x17, 0x55, 0x68, 0xfe, 0x56, 0xd4, 0x9d, 0xcf, 0xc7, 0x47, 0x5f, 0xdf, 0xd7, 0xe5,
-	0xb4, 0xef, 0xea, 0xa6, 0x1b, 0x04, 0x04, 0x52, 0xaa, 0xcb, 0x6d, 0xb9, 0xd3, 0xb2, 0xdf, 0xe5,
-	0x1c, 0x2c, 0xa0, 0x82, 0x83, 0x97, 0x17, 0xa3, 0xe8, 0xd0, 0xac, 0xf2, 0x37, 0xf1, 0x08, 0x31,
-	0x38, 0x4a, 0xd8, 0xd8, 0xcc, 0x27, 0xd6, 0xce, 0x1d, 0xd4, 0x59, 0x34, 0x6a, 0xb1, 0xba, 0xe9,
-	0x21, 0x1c, 0xf4, 0x03, 0xac, 0x3f, 0x10, 0xb2, 0xa7, 0x53, 0x0e, 0x14, 0x1b, 0xe1, 0xe0, 0xf8,
-	0x4b, 0xce, 0x81, 0xe2, 0x89, 0xa8, 0xe0, 0x60, 0x57, 0xe8, 0x97, 0x69, 0x53, 0xfe, 0xe9, 0x2a,
-	0x58, 0x4c, 0xac, 0xaa, 0xef, 0x32, 0xb3, 0x2a, 0x2d, 0xa7, 0x44, 0xb0, 0x76, 0xae, 0xb6, 0x6e,
-	0xdf, 0xae, 0x3f, 0x6c, 0xcb, 0x9d, 0xed, 0x83, 0x17, 0xdd, 0xf2, 0x63, 0xba, 0xf3, 0x57, 0x7f,
-	0x5b, 0x90, 0xf6, 0x51, 0xce, 0xc1, 0xb2, 0xb6, 0xe0, 0xe0, 0x95, 0xb0, 0x70, 0x8b, 0x34, 0x5d,
-	0x3c, 0x5b, 0x83, 0x3b, 0xcb, 0x76, 0xed, 0x8f, 0xac, 0x3e, 0x47, 0x98, 0x42, 0x3f, 0x25, 0xb0,
-	0x4f, 0xcf, 0x50, 0xd2, 0x3f, 0x87, 0x04, 0x85, 0x63, 0xfd, 0x51, 0x5b, 0xee, 0x3c, 0xb6, 0xd3,
-	0x9c, 0x03, 0x6d, 0xc1, 0x9f, 0x9c, 0xa1, 0xe4, 0x54, 0xb0, 0x05, 0x07, 0x07, 0x62, 0xea, 0x5d,
-	0xaa, 0x36, 0xbe, 0x1d, 0xc0, 0xd0, 0x4d, 0x23, 0x76, 0x68, 0x86, 0x6e, 0x44, 0xe1, 0xdc, 0xce,
-	0xfe, 0x7d, 0x0d, 0xff, 0x26, 0xd6, 0x86, 0xa8, 0x74, 0xd6, 0x8c, 0xd4, 0xae, 0x64, 0x75, 0x9b,
-	0x42, 0x97, 0xf8, 0xc3, 0xbe, 0xe7, 0x52, 0x38, 0x5f, 0xcd, 0x86, 0x58, 0xcd, 0xcf, 0x29, 0x07,
-	0x5b, 0x27, 0x82, 0xb1, 0x5d, 0x0a, 0xc5, 0x82, 0xb6, 0x68, 0x2d, 0x2f, 0x38, 0xd8, 0x17, 0x6e,
-	0xeb, 0x60, 0xf3, 0x9b, 0x76, 0xd7, 0x53, 0xc5, 0xc4, 0x6a, 0x28, 0x5d, 0x66, 0x56, 0x63, 0x92,
-	0x53, 0x67, 0xb1, 0x16, 0xab, 0x4f, 0x2a, 0x87, 0x21, 0x8a, 0x18, 0x24, 0xba, 0x22, 0x0c, 0x7e,
-	0x5c, 0x1a, 0xfa, 0x20, 0xf0, 0x15, 0x43, 0x25, 0xb8, 0xd6, 0xd0, 0x2a, 0xe5, 0x34, 0x74, 0xec,
-	0x4f, 0xd7, 0x37, 0x86, 0x94, 0xdd, 0x18, 0xd2, 0xf5, 0xd4, 0x90, 0xb3, 0xa9, 0x21, 0xff, 0x9a,
-	0x19, 0xd2, 0xd5, 0xcc, 0x90, 0xb3, 0x99, 0x21, 0xfd, 0x9d, 0x19, 0xd2, 0x8f, 0xd7, 0x03, 0xc4,
-	0x86, 0xa9, 0xd7, 0xf5, 0xe3, 0x51, 0x8f, 0x8e, 0xb1, 0xcf, 0x86, 0x08, 0x0f, 0x6a, 0xd1, 0xf2,
-	0xfe, 0x3c, 0x45, 0xdc, 0xd9, 0xdb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x64, 0x4b, 0x05,
-	0xc0, 0x03, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xcf, 0x4f, 0xe3, 0x46,
+	0x18, 0x8d, 0x4b, 0x09, 0xc4, 0xe1, 0xa7, 0x4b, 0xc1, 0x54, 0xd4, 0x13, 0x45, 0x3e, 0xa4, 0x52,
+	0x15, 0x24, 0x7a, 0xa3, 0x27, 0x0c, 0x6a, 0xa5, 0x96, 0xd2, 0xca, 0xa1, 0x1c, 0x7a, 0xb1, 0xec,
+	0x78, 0x92, 0x4c, 0xf1, 0xaf, 0x1d, 0x8f, 0x11, 0xd9, 0x7f, 0x80, 0x3d, 0xae, 0xb8, 0xaf, 0xc4,
+	0x6d, 0xcf, 0xfb, 0x0f, 0xec, 0x99, 0x5b, 0x72, 0xdc, 0xd3, 0x48, 0x24, 0x37, 0x1f, 0x7d, 0xcc,
+	0x69, 0xe5, 0x71, 0x9c, 0xd8, 0xc1, 0x70, 0xf3, 0xbc, 0xf7, 0xbe, 0xef, 0xbd, 0x7c, 0x5f, 0xc6,
+	0xe6, 0xeb, 0x16, 0x32, 0x0e, 0xdb, 0xae, 0xd3, 0x41, 0xdd, 0x43, 0xcb, 0xd4, 0xbd, 0xe4, 0x31,
+	0xc0, 0x3a, 0x41, 0xae, 0xd3, 0xf4, 0xb0, 0x4b, 0x5c, 0xa1, 0x9c, 0x80, 0x3f, 0x48, 0x0b, 0x5a,
+	0x82, 0x75, 0xc7, 0xf7, 0x5c, 0x4c, 0x12, 0xdd, 0x33, 0xbe, 0x8b, 0xdd, 0xc0, 0xc3, 0xae, 0x05,
+	0xa7, 0x7c, 0x05, 0xde, 0x4e, 0xa5, 0xf5, 0x77, 0x6b, 0xfc, 0xf6, 0xf9, 0xd9, 0xc9, 0x3f, 0xa7,
+	0x59, 0x3b, 0xe1, 0x5f, 0x7e, 0x45, 0x37, 0x4d, 0x0c, 0x7d, 0x5f, 0xe4, 0x6a, 0x5c, 0xa3, 0xa2,
+	0xfc, 0x1a, 0x52, 0x90, 0x42, 0x11, 0x05, 0x7b, 0xb7, 0xb6, 0x75, 0x5c, 0x9f, 0x9e, 0x7f, 0x76,
+	0x6d, 0x44, 0xa0, 0xed, 0x91, 0x7e, 0x3d, 0x1c, 0xc8, 0xdb, 0xcf, 0x50, 0x35, 0x2d, 0x14, 0x5c,
+	0x7e, 0xc5, 0x40, 0x8e, 0xa9, 0x99, 0x8e, 0xf8, 0x0d, 0x6b, 0x7b, 0x35, 0xa2, 0xa0, 0xac, 0x20,
+	0xc7, 0x3c, 0xbb, 0x08, 0x29, 0x28, 0x1b, 0xec, 0x29, 0xa2, 0x60, 0x97, 0xf5, 0x4f, 0x8e, 0xf9,
+	0xf6, 0x5b, 0x8b, 0x60, 0x34, 0x90, 0xa7, 0x75, 0xf7, 0x43, 0x79, 0xda, 0x4b, 0x4d, 0x10, 0x47,
+	0xb8, 0xe1, 0x2b, 0xb3, 0xd9, 0x88, 0x4b, 0x35, 0xae, 0xb1, 0x71, 0xf4, 0x7d, 0x33, 0x19, 0x4c,
+	0x33, 0xfe, 0xd5, 0x97, 0x29, 0xa9, 0x9c, 0x84, 0x14, 0xcc, 0xb5, 0x11, 0x05, 0xfb, 0x2c, 0xc2,
+	0x0c, 0xc9, 0xa7, 0xf8, 0xae, 0x00, 0x57, 0xe7, 0xe5, 0xc2, 0x47, 0x8e, 0xdf, 0x41, 0x8e, 0x0f,
+	0xdb, 0x01, 0x86, 0x9a, 0x7f, 0x8d, 0x3c, 0xed, 0x06, 0x62, 0xd4, 0xe9, 0x8b, 0xdf, 0xd6, 0xb8,
+	0xc6, 0xaa, 0x12, 0x84, 0x14, 0x08, 0x29, 0xdf, 0xba, 0x46, 0xde, 0x15, 0x63, 0x23, 0x0a, 0x8e,
+	0x98, 0xeb, 0x73, 0x2a, 0x63, 0x5f, 0x33, 0x61, 0x47, 0x0f, 0x2c, 0x72, 0x5c, 0xef, 0xe8, 0x96,
+	0x0f, 0xe3, 0x38, 0x07, 0xaf, 0x15, 0x4c, 0x06, 0xf2, 0x32, 0x53, 0xaa, 0x05, 0x96, 0xc2, 0x03,
+	0xc7, 0x6f, 0xf8, 0x50, 0xc7, 0xed, 0x9e, 0x66, 0xe8, 0x3e, 0x8c, 0x57, 0xb3, 0xcc, 0x56, 0xf3,
+	0x76, 0x44, 0xc1, 0x5a, 0x8b, 0x31, 0x8a, 0xee, 0x43, 0xb6, 0xa0, 0x35, 0x3f, 0x73, 0x8e, 0x28,
+	0x38, 0x60, 0x69, 0xb3, 0x60, 0x7e, 0x4c, 0xbb, 0xc5, 0x54, 0x34, 0x90, 0x73, 0x9d, 0xee, 0x87,
+	0x72, 0xce, 0x49, 0xcd, 0xb2, 0x8e, 0xe0, 0xf2, 0xeb, 0xd3, 0x84, 0x1d, 0x64, 0x11, 0x88, 0xc5,
+	0x32, 0x0b, 0xf8, 0xc7, 0x3c, 0xd0, 0x6f, 0x0c, 0x5f, 0x08, 0x94, 0x80, 0x85, 0x81, 0x16, 0x29,
+	0x35, 0xd7, 0x47, 0xf8, 0xc0, 0xf1, 0x5b, 0x36, 0xb4, 0x0d, 0x88, 0x35, 0x9d, 0x10, 0x8c, 0x8c,
+	0x80, 0x40, 0x71, 0x85, 0x99, 0xe2, 0x90, 0x82, 0xcd, 0x84, 0x3b, 0x49, 0xa9, 0xd9, 0xda, 0x16,
+	0xf0, 0xc2, 0x9d, 0x25, 0x9a, 0xbf, 0x3b, 0x71, 0x9a, 0xfd, 0x17, 0x0b, 0x26, 0x03, 0x79, 0x35,
+	0x55, 0xaa, 0x8b, 0x7e, 0xc2, 0xff, 0x7c, 0x95, 0xdd, 0x68, 0x2d, 0xbe, 0xd2, 0xbe, 0xb8, 0x5a,
+	0x5b, 0x6a, 0x54, 0xf3, 0xff, 0xeb, 0xdf, 0x63, 0x5a, 0x75, 0x2d, 0xa8, 0x1c, 0x3d, 0x52, 0x50,
+	0x0a, 0x29, 0xe0, 0xbb, 0x29, 0x14, 0xdf, 0xdf, 0x4d, 0x96, 0x77, 0x06, 0xc5, 0x61, 0x2a, 0xb3,
+	0x93, 0x9a, 0xd1, 0x0a, 0x84, 0xdf, 0xc0, 0xf0, 0x4d, 0x80, 0x30, 0x34, 0x35, 0x06, 0x8b, 0x15,
+	0x36, 0x88, 0xbf, 0x42, 0x0a, 0xd6, 0x53, 0x86, 0xd9, 0x45, 0x14, 0xfc, 0xc8, 0xda, 0xe6, 0xd0,
+	0xfc, 0xfc, 0xf7, 0x5e, 0xe0, 0xd4, 0x7c, 0x2b, 0xe1, 0x33, 0xc7, 0xef, 0x9a, 0xc8, 0xf7, 0x2c,
+	0xbd, 0xaf, 0x39, 0xba, 0x0d, 0x33, 0x7b, 0xe0, 0x99, 0xfd, 0x1d, 0x17, 0x52, 0xb0, 0x33, 0x95,
+	0x5c, 0xe8, 0x36, 0xcc, 0x6e, 0xe3, 0x98, 0xc5, 0x28, 0x22, 0x0b, 0x57, 0x92, 0x11, 0xc6, 0x19,
+	0xa5, 0xd7, 0x0b, 0x27, 0x03, 0xb9, 0x9a, 0x51, 0xa8, 0x85, 0x21, 0x84, 0x4f, 0x1c, 0xcf, 0xde,
+	0x54, 0x5a, 0x5b, 0x6f, 0xf7, 0xa0, 0x46, 0x88, 0xa5, 0xf9, 0x62, 0xb5, 0xc6, 0x35, 0x96, 0x95,
+	0x3b, 0x6e, 0x44, 0xc1, 0x7a, 0xfc, 0xa2, 0x3a, 0x8d, 0xb9, 0xcb, 0xcb, 0xf3, 0x56, 0x3c, 0x4b,
+	0x23, 0x0b, 0xcc, 0x66, 0x99, 0x43, 0x33, 0xe9, 0x27, 0x14, 0x2c, 0x21, 0x87, 0xc4, 0x23, 0x7d,
+	0x41, 0x12, 0x0d, 0xe4, 0x7c, 0xcf, 0xfb, 0xa1, 0x9c, 0x77, 0x55, 0x33, 0x3c, 0xb1, 0x5a, 0xca,
+	0x9f, 0x8f, 0x4f, 0x52, 0x69, 0xf8, 0x24, 0x95, 0x1e, 0x47, 0x12, 0x37, 0x1c, 0x49, 0xdc, 0xfb,
+	0xb1, 0x54, 0x7a, 0x18, 0x4b, 0xdc, 0x70, 0x2c, 0x95, 0xbe, 0x8c, 0xa5, 0xd2, 0x7f, 0x3f, 0x75,
+	0x11, 0xe9, 0x05, 0x46, 0xb3, 0xed, 0xda, 0x87, 0x7e, 0xdf, 0x69, 0x93, 0x1e, 0x72, 0xba, 0x99,
+	0xa7, 0xf9, 0x67, 0xc7, 0x28, 0xb3, 0xcf, 0xcb, 0x2f, 0x5f, 0x07, 0x00, 0x13, 0x7c, 0x66, 0x4d,
+	0xd7, 0x06, 0x00, 0x00,
 }
 
 func (m *LDAPConfiguration) Marshal() (dAtA []byte, err error) {
@@ -129,6 +158,46 @@ func (m *LDAPConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BindCacheTTLS != 0 {
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(m.BindCacheTTLS))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DisplayNameAttribute) > 0 {
+		i -= len(m.DisplayNameAttribute)
+		copy(dAtA[i:], m.DisplayNameAttribute)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.DisplayNameAttribute)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RequiredGroup) > 0 {
+		i -= len(m.RequiredGroup)
+		copy(dAtA[i:], m.RequiredGroup)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.RequiredGroup)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.GroupRoles) > 0 {
+		for iNdEx := len(m.GroupRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLdapconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MemberAttribute) > 0 {
+		i -= len(m.MemberAttribute)
+		copy(dAtA[i:], m.MemberAttribute)
+		i = encodeVarintLdapconfiguration(dAtA, i, uint64(len(m.MemberAttribute)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.SearchFilter) > 0 {
 		i -= len(m.SearchFilter)
 		copy(dAtA[i:], m.SearchFilter)
@@ -214,6 +283,27 @@ func (m *LDAPConfiguration) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLdapconfiguration(uint64(l))
 	}
+	l = len(m.MemberAttribute)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	if len(m.GroupRoles) > 0 {
+		for _, e := range m.GroupRoles {
+			l = e.ProtoSize()
+			n += 1 + l + sovLdapconfiguration(uint64(l))
+		}
+	}
+	l = len(m.RequiredGroup)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	l = len(m.DisplayNameAttribute)
+	if l > 0 {
+		n += 1 + l + sovLdapconfiguration(uint64(l))
+	}
+	if m.BindCacheTTLS != 0 {
+		n += 1 + sovLdapconfiguration(uint64(m.BindCacheTTLS))
+	}
 	return n
 }
 
@@ -419,6 +509,155 @@ func (m *LDAPConfiguration) Unmarshal(dAtA []byte) error {
 			}
 			m.SearchFilter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupRoles = append(m.GroupRoles, LDAPGroupRole{})
+			if err := m.GroupRoles[len(m.GroupRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayNameAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLdapconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayNameAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindCacheTTLS", wireType)
+			}
+			m.BindCacheTTLS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLdapconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BindCacheTTLS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLdapconfiguration(dAtA[iNdEx:])
@@ -137,6 +137,29 @@ type Wrapper struct {
 	gUIReturnsOnCall map[int]struct {
 		result1 config.GUIConfiguration
 	}
+	HistoryStub        func() []config.HistoryEntry
+	historyMutex       sync.RWMutex
+	historyArgsForCall []struct {
+	}
+	historyReturns struct {
+		result1 []config.HistoryEntry
+	}
+	historyReturnsOnCall map[int]struct {
+		result1 []config.HistoryEntry
+	}
+	HistoryConfigStub        func(string) (config.Configuration, bool)
+	historyConfigMutex       sync.RWMutex
+	historyConfigArgsForCall []struct {
+		arg1 string
+	}
+	historyConfigReturns struct {
+		result1 config.Configuration
+		result2 bool
+	}
+	historyConfigReturnsOnCall map[int]struct {
+		result1 config.Configuration
+		result2 bool
+	}
 	IgnoredDeviceStub        func(protocol.DeviceID) bool
 	ignoredDeviceMutex       sync.RWMutex
 	ignoredDeviceArgsForCall []struct {
@@ -193,6 +216,38 @@ type Wrapper struct {
 		result1 config.Waiter
 		result2 error
 	}
+	ModifyCommittedStub        func(string, config.ModifyFunction) (config.Waiter, config.Configuration, config.Configuration, error)
+	modifyCommittedMutex       sync.RWMutex
+	modifyCommittedArgsForCall []struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}
+	modifyCommittedReturns struct {
+		result1 config.Waiter
+		result2 config.Configuration
+		result3 config.Configuration
+		result4 error
+	}
+	modifyCommittedReturnsOnCall map[int]struct {
+		result1 config.Waiter
+		result2 config.Configuration
+		result3 config.Configuration
+		result4 error
+	}
+	ModifyWithOriginStub        func(string, config.ModifyFunction) (config.Waiter, error)
+	modifyWithOriginMutex       sync.RWMutex
+	modifyWithOriginArgsForCall []struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}
+	modifyWithOriginReturns struct {
+		result1 config.Waiter
+		result2 error
+	}
+	modifyWithOriginReturnsOnCall map[int]struct {
+		result1 config.Waiter
+		result2 error
+	}
 	MyIDStub        func() protocol.DeviceID
 	myIDMutex       sync.RWMutex
 	myIDArgsForCall []struct {
@@ -203,6 +258,16 @@ type Wrapper struct {
 	myIDReturnsOnCall map[int]struct {
 		result1 protocol.DeviceID
 	}
+	OIDCStub        func() config.OIDCConfiguration
+	oIDCMutex       sync.RWMutex
+	oIDCArgsForCall []struct {
+	}
+	oIDCReturns struct {
+		result1 config.OIDCConfiguration
+	}
+	oIDCReturnsOnCall map[int]struct {
+		result1 config.OIDCConfiguration
+	}
 	OptionsStub        func() config.OptionsConfiguration
 	optionsMutex       sync.RWMutex
 	optionsArgsForCall []struct {
@@ -966,6 +1031,123 @@ func (fake *Wrapper) GUIReturnsOnCall(i int, result1 config.GUIConfiguration) {
 	}{result1}
 }
 
+func (fake *Wrapper) History() []config.HistoryEntry {
+	fake.historyMutex.Lock()
+	ret, specificReturn := fake.historyReturnsOnCall[len(fake.historyArgsForCall)]
+	fake.historyArgsForCall = append(fake.historyArgsForCall, struct {
+	}{})
+	stub := fake.HistoryStub
+	fakeReturns := fake.historyReturns
+	fake.recordInvocation("History", []interface{}{})
+	fake.historyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) HistoryCallCount() int {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	return len(fake.historyArgsForCall)
+}
+
+func (fake *Wrapper) HistoryCalls(stub func() []config.HistoryEntry) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = stub
+}
+
+func (fake *Wrapper) HistoryReturns(result1 []config.HistoryEntry) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	fake.historyReturns = struct {
+		result1 []config.HistoryEntry
+	}{result1}
+}
+
+func (fake *Wrapper) HistoryReturnsOnCall(i int, result1 []config.HistoryEntry) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	if fake.historyReturnsOnCall == nil {
+		fake.historyReturnsOnCall = make(map[int]struct {
+			result1 []config.HistoryEntry
+		})
+	}
+	fake.historyReturnsOnCall[i] = struct {
+		result1 []config.HistoryEntry
+	}{result1}
+}
+
+func (fake *Wrapper) HistoryConfig(arg1 string) (config.Configuration, bool) {
+	fake.historyConfigMutex.Lock()
+	ret, specificReturn := fake.historyConfigReturnsOnCall[len(fake.historyConfigArgsForCall)]
+	fake.historyConfigArgsForCall = append(fake.historyConfigArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.HistoryConfigStub
+	fakeReturns := fake.historyConfigReturns
+	fake.recordInvocation("HistoryConfig", []interface{}{arg1})
+	fake.historyConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Wrapper) HistoryConfigCallCount() int {
+	fake.historyConfigMutex.RLock()
+	defer fake.historyConfigMutex.RUnlock()
+	return len(fake.historyConfigArgsForCall)
+}
+
+func (fake *Wrapper) HistoryConfigCalls(stub func(string) (config.Configuration, bool)) {
+	fake.historyConfigMutex.Lock()
+	defer fake.historyConfigMutex.Unlock()
+	fake.HistoryConfigStub = stub
+}
+
+func (fake *Wrapper) HistoryConfigArgsForCall(i int) string {
+	fake.historyConfigMutex.RLock()
+	defer fake.historyConfigMutex.RUnlock()
+	argsForCall := fake.historyConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Wrapper) HistoryConfigReturns(result1 config.Configuration, result2 bool) {
+	fake.historyConfigMutex.Lock()
+	defer fake.historyConfigMutex.Unlock()
+	fake.HistoryConfigStub = nil
+	fake.historyConfigReturns = struct {
+		result1 config.Configuration
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *Wrapper) HistoryConfigReturnsOnCall(i int, result1 config.Configuration, result2 bool) {
+	fake.historyConfigMutex.Lock()
+	defer fake.historyConfigMutex.Unlock()
+	fake.HistoryConfigStub = nil
+	if fake.historyConfigReturnsOnCall == nil {
+		fake.historyConfigReturnsOnCall = make(map[int]struct {
+			result1 config.Configuration
+			result2 bool
+		})
+	}
+	fake.historyConfigReturnsOnCall[i] = struct {
+		result1 config.Configuration
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *Wrapper) IgnoredDevice(arg1 protocol.DeviceID) bool {
 	fake.ignoredDeviceMutex.Lock()
 	ret, specificReturn := fake.ignoredDeviceReturnsOnCall[len(fake.ignoredDeviceArgsForCall)]
@@ -1259,6 +1441,142 @@ func (fake *Wrapper) ModifyReturnsOnCall(i int, result1 config.Waiter, result2 e
 	}{result1, result2}
 }
 
+func (fake *Wrapper) ModifyCommitted(arg1 string, arg2 config.ModifyFunction) (config.Waiter, config.Configuration, config.Configuration, error) {
+	fake.modifyCommittedMutex.Lock()
+	ret, specificReturn := fake.modifyCommittedReturnsOnCall[len(fake.modifyCommittedArgsForCall)]
+	fake.modifyCommittedArgsForCall = append(fake.modifyCommittedArgsForCall, struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}{arg1, arg2})
+	stub := fake.ModifyCommittedStub
+	fakeReturns := fake.modifyCommittedReturns
+	fake.recordInvocation("ModifyCommitted", []interface{}{arg1, arg2})
+	fake.modifyCommittedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *Wrapper) ModifyCommittedCallCount() int {
+	fake.modifyCommittedMutex.RLock()
+	defer fake.modifyCommittedMutex.RUnlock()
+	return len(fake.modifyCommittedArgsForCall)
+}
+
+func (fake *Wrapper) ModifyCommittedCalls(stub func(string, config.ModifyFunction) (config.Waiter, config.Configuration, config.Configuration, error)) {
+	fake.modifyCommittedMutex.Lock()
+	defer fake.modifyCommittedMutex.Unlock()
+	fake.ModifyCommittedStub = stub
+}
+
+func (fake *Wrapper) ModifyCommittedArgsForCall(i int) (string, config.ModifyFunction) {
+	fake.modifyCommittedMutex.RLock()
+	defer fake.modifyCommittedMutex.RUnlock()
+	argsForCall := fake.modifyCommittedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Wrapper) ModifyCommittedReturns(result1 config.Waiter, result2 config.Configuration, result3 config.Configuration, result4 error) {
+	fake.modifyCommittedMutex.Lock()
+	defer fake.modifyCommittedMutex.Unlock()
+	fake.ModifyCommittedStub = nil
+	fake.modifyCommittedReturns = struct {
+		result1 config.Waiter
+		result2 config.Configuration
+		result3 config.Configuration
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *Wrapper) ModifyCommittedReturnsOnCall(i int, result1 config.Waiter, result2 config.Configuration, result3 config.Configuration, result4 error) {
+	fake.modifyCommittedMutex.Lock()
+	defer fake.modifyCommittedMutex.Unlock()
+	fake.ModifyCommittedStub = nil
+	if fake.modifyCommittedReturnsOnCall == nil {
+		fake.modifyCommittedReturnsOnCall = make(map[int]struct {
+			result1 config.Waiter
+			result2 config.Configuration
+			result3 config.Configuration
+			result4 error
+		})
+	}
+	fake.modifyCommittedReturnsOnCall[i] = struct {
+		result1 config.Waiter
+		result2 config.Configuration
+		result3 config.Configuration
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *Wrapper) ModifyWithOrigin(arg1 string, arg2 config.ModifyFunction) (config.Waiter, error) {
+	fake.modifyWithOriginMutex.Lock()
+	ret, specificReturn := fake.modifyWithOriginReturnsOnCall[len(fake.modifyWithOriginArgsForCall)]
+	fake.modifyWithOriginArgsForCall = append(fake.modifyWithOriginArgsForCall, struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}{arg1, arg2})
+	stub := fake.ModifyWithOriginStub
+	fakeReturns := fake.modifyWithOriginReturns
+	fake.recordInvocation("ModifyWithOrigin", []interface{}{arg1, arg2})
+	fake.modifyWithOriginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Wrapper) ModifyWithOriginCallCount() int {
+	fake.modifyWithOriginMutex.RLock()
+	defer fake.modifyWithOriginMutex.RUnlock()
+	return len(fake.modifyWithOriginArgsForCall)
+}
+
+func (fake *Wrapper) ModifyWithOriginCalls(stub func(string, config.ModifyFunction) (config.Waiter, error)) {
+	fake.modifyWithOriginMutex.Lock()
+	defer fake.modifyWithOriginMutex.Unlock()
+	fake.ModifyWithOriginStub = stub
+}
+
+func (fake *Wrapper) ModifyWithOriginArgsForCall(i int) (string, config.ModifyFunction) {
+	fake.modifyWithOriginMutex.RLock()
+	defer fake.modifyWithOriginMutex.RUnlock()
+	argsForCall := fake.modifyWithOriginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Wrapper) ModifyWithOriginReturns(result1 config.Waiter, result2 error) {
+	fake.modifyWithOriginMutex.Lock()
+	defer fake.modifyWithOriginMutex.Unlock()
+	fake.ModifyWithOriginStub = nil
+	fake.modifyWithOriginReturns = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Wrapper) ModifyWithOriginReturnsOnCall(i int, result1 config.Waiter, result2 error) {
+	fake.modifyWithOriginMutex.Lock()
+	defer fake.modifyWithOriginMutex.Unlock()
+	fake.ModifyWithOriginStub = nil
+	if fake.modifyWithOriginReturnsOnCall == nil {
+		fake.modifyWithOriginReturnsOnCall = make(map[int]struct {
+			result1 config.Waiter
+			result2 error
+		})
+	}
+	fake.modifyWithOriginReturnsOnCall[i] = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Wrapper) MyID() protocol.DeviceID {
 	fake.myIDMutex.Lock()
 	ret, specificReturn := fake.myIDReturnsOnCall[len(fake.myIDArgsForCall)]
@@ -1312,6 +1630,59 @@ func (fake *Wrapper) MyIDReturnsOnCall(i int, result1 protocol.DeviceID) {
 	}{result1}
 }
 
+func (fake *Wrapper) OIDC() config.OIDCConfiguration {
+	fake.oIDCMutex.Lock()
+	ret, specificReturn := fake.oIDCReturnsOnCall[len(fake.oIDCArgsForCall)]
+	fake.oIDCArgsForCall = append(fake.oIDCArgsForCall, struct {
+	}{})
+	stub := fake.OIDCStub
+	fakeReturns := fake.oIDCReturns
+	fake.recordInvocation("OIDC", []interface{}{})
+	fake.oIDCMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) OIDCCallCount() int {
+	fake.oIDCMutex.RLock()
+	defer fake.oIDCMutex.RUnlock()
+	return len(fake.oIDCArgsForCall)
+}
+
+func (fake *Wrapper) OIDCCalls(stub func() config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = stub
+}
+
+func (fake *Wrapper) OIDCReturns(result1 config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = nil
+	fake.oIDCReturns = struct {
+		result1 config.OIDCConfiguration
+	}{result1}
+}
+
+func (fake *Wrapper) OIDCReturnsOnCall(i int, result1 config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = nil
+	if fake.oIDCReturnsOnCall == nil {
+		fake.oIDCReturnsOnCall = make(map[int]struct {
+			result1 config.OIDCConfiguration
+		})
+	}
+	fake.oIDCReturnsOnCall[i] = struct {
+		result1 config.OIDCConfiguration
+	}{result1}
+}
+
 func (fake *Wrapper) Options() config.OptionsConfiguration {
 	fake.optionsMutex.Lock()
 	ret, specificReturn := fake.optionsReturnsOnCall[len(fake.optionsArgsForCall)]
@@ -1833,6 +2204,10 @@ func (fake *Wrapper) Invocations() map[string][][]interface{} {
 	defer fake.foldersMutex.RUnlock()
 	fake.gUIMutex.RLock()
 	defer fake.gUIMutex.RUnlock()
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	fake.historyConfigMutex.RLock()
+	defer fake.historyConfigMutex.RUnlock()
 	fake.ignoredDeviceMutex.RLock()
 	defer fake.ignoredDeviceMutex.RUnlock()
 	fake.ignoredDevicesMutex.RLock()
@@ -1843,8 +2218,14 @@ func (fake *Wrapper) Invocations() map[string][][]interface{} {
 	defer fake.lDAPMutex.RUnlock()
 	fake.modifyMutex.RLock()
 	defer fake.modifyMutex.RUnlock()
+	fake.modifyCommittedMutex.RLock()
+	defer fake.modifyCommittedMutex.RUnlock()
+	fake.modifyWithOriginMutex.RLock()
+	defer fake.modifyWithOriginMutex.RUnlock()
 	fake.myIDMutex.RLock()
 	defer fake.myIDMutex.RUnlock()
+	fake.oIDCMutex.RLock()
+	defer fake.oIDCMutex.RUnlock()
 	fake.optionsMutex.RLock()
 	defer fake.optionsMutex.RUnlock()
 	fake.rawCopyMutex.RLock()
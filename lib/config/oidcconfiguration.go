@@ -0,0 +1,79 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "strings"
+
+var defaultOIDCScopes = []string{"openid", "profile", "email"}
+
+func (c OIDCConfiguration) Copy() OIDCConfiguration {
+	cp := c
+	cp.Scopes = make([]string, len(c.Scopes))
+	copy(cp.Scopes, c.Scopes)
+	cp.AllowedUsers = make([]string, len(c.AllowedUsers))
+	copy(cp.AllowedUsers, c.AllowedUsers)
+	cp.AllowedGroups = make([]string, len(c.AllowedGroups))
+	copy(cp.AllowedGroups, c.AllowedGroups)
+	cp.GroupRoles = make([]OIDCGroupRole, len(c.GroupRoles))
+	copy(cp.GroupRoles, c.GroupRoles)
+	return cp
+}
+
+// Redacted returns a copy of the configuration without the client secret.
+func (c OIDCConfiguration) Redacted() OIDCConfiguration {
+	c = c.Copy()
+	c.ClientSecret = ""
+	return c
+}
+
+// RequestScopes returns the scopes to request, which always include
+// "openid".
+func (c OIDCConfiguration) RequestScopes() []string {
+	if len(c.Scopes) == 0 {
+		return append([]string(nil), defaultOIDCScopes...)
+	}
+	scopes := []string{"openid"}
+	for _, scope := range c.Scopes {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// IsAllowed returns whether the given user, a member of the given groups,
+// may log in. Nobody may log in unless allowed users or groups are set, as
+// the provider may well have users that have no business here.
+func (c OIDCConfiguration) IsAllowed(username string, groups []string) bool {
+	for _, user := range c.AllowedUsers {
+		if username != "" && strings.EqualFold(user, username) {
+			return true
+		}
+	}
+	for _, allowed := range c.AllowedGroups {
+		for _, group := range groups {
+			if allowed == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GroupsRole returns the highest role granted by any of the given groups.
+// Users not in any group with a role get the least privileged role.
+func (c OIDCConfiguration) GroupsRole(groups []string) Role {
+	role := RoleReadOnly
+	for _, gr := range c.GroupRoles {
+		for _, group := range groups {
+			if gr.Group == group && gr.Role.Includes(role) {
+				role = gr.Role
+			}
+		}
+	}
+	return role
+}
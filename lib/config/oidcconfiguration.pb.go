@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/oidcconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type OIDCConfiguration struct {
+	// The issuer URL; the provider configuration is discovered from it.
+	Issuer       string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer" xml:"issuer,omitempty"`
+	ClientID     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientID" xml:"clientID,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"clientSecret" xml:"clientSecret,omitempty"`
+	// The URL the provider redirects back to after login. When empty it is
+	// derived from the address used to reach the GUI.
+	RedirectURL   string   `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirectURL" xml:"redirectURL,omitempty"`
+	Scopes        []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes" xml:"scope"`
+	UsernameClaim string   `protobuf:"bytes,6,opt,name=username_claim,json=usernameClaim,proto3" json:"usernameClaim" xml:"usernameClaim,omitempty" default:"preferred_username"`
+	GroupsClaim   string   `protobuf:"bytes,7,opt,name=groups_claim,json=groupsClaim,proto3" json:"groupsClaim" xml:"groupsClaim,omitempty" default:"groups"`
+	// Only the users listed here, or members of the groups listed here, may
+	// log in.
+	AllowedUsers  []string `protobuf:"bytes,8,rep,name=allowed_users,json=allowedUsers,proto3" json:"allowedUsers" xml:"allowedUser"`
+	AllowedGroups []string `protobuf:"bytes,9,rep,name=allowed_groups,json=allowedGroups,proto3" json:"allowedGroups" xml:"allowedGroup"`
+	// The role of users is the highest granted by their groups; users in
+	// none of these groups are read-only.
+	GroupRoles []OIDCGroupRole `protobuf:"bytes,10,rep,name=group_roles,json=groupRoles,proto3" json:"groupRoles" xml:"groupRole"`
+}
+
+func (m *OIDCConfiguration) Reset()         { *m = OIDCConfiguration{} }
+func (m *OIDCConfiguration) String() string { return proto.CompactTextString(m) }
+func (*OIDCConfiguration) ProtoMessage()    {}
+func (*OIDCConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee763e3bef38c648, []int{0}
+}
+func (m *OIDCConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCConfiguration.Merge(m, src)
+}
+func (m *OIDCConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OIDCConfiguration)(nil), "config.OIDCConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/oidcconfiguration.proto", fileDescriptor_ee763e3bef38c648)
+}
+
+var fileDescriptor_ee763e3bef38c648 = []byte{
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x63, 0x4a, 0x43, 0x7d, 0x49, 0x0b, 0x3d, 0xa9, 0xc5, 0x2a, 0xc8, 0x17, 0x45, 0x1e,
+	0x8a, 0x54, 0xa5, 0x52, 0x41, 0x02, 0x65, 0x4c, 0x2a, 0xa1, 0x02, 0x12, 0xc8, 0xa8, 0x03, 0x0c,
+	0x44, 0x89, 0x73, 0x4d, 0x8d, 0x1c, 0x5f, 0x74, 0x3e, 0x8b, 0x76, 0x82, 0x05, 0xb1, 0xa2, 0x2e,
+	0xac, 0x8c, 0xfc, 0x29, 0xdd, 0x92, 0x91, 0xe9, 0xa4, 0x26, 0x9b, 0x47, 0x8f, 0x9d, 0xd0, 0xdd,
+	0xd9, 0xc9, 0xb9, 0x0d, 0xdb, 0xdd, 0xe7, 0xdd, 0xf7, 0xbd, 0xef, 0xfd, 0xd0, 0x81, 0x7a, 0xe0,
+	0xf7, 0xf6, 0x3d, 0x12, 0x9e, 0xf8, 0x83, 0x7d, 0xe2, 0xf7, 0x3d, 0x35, 0x8c, 0x69, 0x97, 0xf9,
+	0x24, 0x6c, 0x8c, 0x28, 0x61, 0x04, 0x96, 0x15, 0xdc, 0xb1, 0x6f, 0xac, 0x1d, 0x50, 0x12, 0x8f,
+	0x28, 0x09, 0xb0, 0x5a, 0xb7, 0x63, 0xe2, 0x33, 0xa6, 0x86, 0xf5, 0x1f, 0x26, 0xd8, 0x7c, 0x7b,
+	0x74, 0xd8, 0x6e, 0xeb, 0x76, 0xf0, 0x1d, 0x28, 0xfb, 0x51, 0x14, 0x63, 0x6a, 0x19, 0x35, 0x63,
+	0xd7, 0x6c, 0xbd, 0x48, 0x38, 0xca, 0x48, 0xca, 0xd1, 0xf6, 0xd9, 0x30, 0x68, 0xd6, 0xd5, 0x74,
+	0x8f, 0x0c, 0x7d, 0x86, 0x87, 0x23, 0x76, 0x5e, 0x4f, 0xc6, 0xce, 0x83, 0x9b, 0xd0, 0xcd, 0xaa,
+	0xe0, 0x57, 0x60, 0x7a, 0x81, 0x8f, 0x43, 0xd6, 0xf1, 0xfb, 0xd6, 0x1d, 0x69, 0xda, 0x9b, 0x72,
+	0xb4, 0xd6, 0x96, 0xf0, 0xe8, 0x30, 0xe1, 0x68, 0xcd, 0xcb, 0xc6, 0x29, 0x47, 0x96, 0x8c, 0xc8,
+	0x41, 0x31, 0x04, 0xde, 0xc6, 0xe9, 0xd8, 0x99, 0x57, 0x5f, 0x4c, 0x9c, 0xb9, 0xab, 0x9b, 0xd3,
+	0x3e, 0x24, 0x60, 0x3d, 0x6b, 0x20, 0xc2, 0x1e, 0xc5, 0xcc, 0x5a, 0x91, 0x4d, 0xbc, 0x4a, 0x38,
+	0xaa, 0x2a, 0xe1, 0xbd, 0xe4, 0x29, 0x47, 0x8f, 0xb5, 0x70, 0x05, 0x8b, 0x0d, 0x6c, 0x2f, 0x97,
+	0xdc, 0x82, 0x0f, 0xfc, 0x65, 0x80, 0x2a, 0xc5, 0x7d, 0x9f, 0x62, 0x8f, 0x75, 0x62, 0x1a, 0x58,
+	0x77, 0x65, 0x20, 0x9b, 0x72, 0x54, 0x71, 0x33, 0x7e, 0xec, 0xbe, 0x49, 0x38, 0xaa, 0xd0, 0xc5,
+	0x34, 0xe5, 0xe8, 0x91, 0x8c, 0xd7, 0x58, 0x31, 0x7d, 0x6b, 0xa9, 0x92, 0x8e, 0x1d, 0xdd, 0xe6,
+	0x62, 0xe2, 0xe8, 0x21, 0xee, 0x42, 0xa3, 0x01, 0x6c, 0x82, 0x72, 0xe4, 0x91, 0x11, 0x8e, 0xac,
+	0xd5, 0xda, 0xca, 0xae, 0xd9, 0xaa, 0x8b, 0xdb, 0x55, 0x24, 0xe5, 0xa8, 0x22, 0xe3, 0xe5, 0x54,
+	0xc4, 0xad, 0xca, 0x91, 0x9b, 0xe9, 0xf0, 0x8f, 0x01, 0x36, 0xe2, 0x08, 0xd3, 0xb0, 0x3b, 0xc4,
+	0x1d, 0x2f, 0xe8, 0xfa, 0x43, 0xab, 0x2c, 0xf7, 0xf5, 0xcd, 0x48, 0x38, 0x5a, 0xcf, 0xa5, 0xb6,
+	0x50, 0x52, 0x8e, 0x9a, 0xd2, 0xac, 0x40, 0xb5, 0xdd, 0xd4, 0xfa, 0xf8, 0xa4, 0x1b, 0x07, 0xac,
+	0x59, 0x1f, 0x51, 0x7c, 0x82, 0x29, 0xc5, 0xfd, 0x4e, 0xbe, 0x56, 0x64, 0x3f, 0xfc, 0x4f, 0xe1,
+	0xf5, 0xd8, 0x81, 0xb7, 0x2b, 0xdc, 0x62, 0x3a, 0xfc, 0x6e, 0x80, 0xaa, 0x7c, 0xf9, 0x51, 0xd6,
+	0xe8, 0x3d, 0xf5, 0xec, 0xc4, 0x89, 0x2b, 0x9e, 0x77, 0xb9, 0x27, 0xbb, 0xd4, 0xd8, 0xd2, 0x1e,
+	0x95, 0x2e, 0xaf, 0x60, 0xe9, 0xd2, 0xeb, 0xb1, 0x53, 0x56, 0x82, 0xab, 0xfb, 0xc3, 0x0f, 0x60,
+	0xbd, 0x1b, 0x04, 0xe4, 0x4b, 0xd6, 0x6a, 0x64, 0xad, 0xc9, 0x53, 0x7f, 0x26, 0x5e, 0x5e, 0x26,
+	0x1c, 0x0b, 0x9e, 0x72, 0xb4, 0x29, 0x1b, 0xd1, 0xa0, 0x48, 0xab, 0x68, 0x73, 0xb7, 0x50, 0x01,
+	0x3f, 0x81, 0x8d, 0xdc, 0x5a, 0x25, 0x5a, 0xa6, 0xf4, 0x7e, 0x2e, 0xee, 0x22, 0x53, 0x5e, 0x4a,
+	0x21, 0xe5, 0x08, 0xea, 0xe6, 0x92, 0x0a, 0xf7, 0xaa, 0x0e, 0xdc, 0x62, 0x11, 0xfc, 0x0c, 0xd4,
+	0x4e, 0x3a, 0x94, 0x04, 0x38, 0xb2, 0x40, 0x6d, 0x65, 0xb7, 0x72, 0xb0, 0xd5, 0x50, 0x5f, 0x4b,
+	0x43, 0xfc, 0x1b, 0xaa, 0x8c, 0x04, 0xb8, 0x75, 0x70, 0xc9, 0x51, 0x29, 0xe1, 0x08, 0x0c, 0x72,
+	0x24, 0x42, 0xef, 0x2f, 0x8e, 0x56, 0x20, 0x91, 0x68, 0xce, 0x67, 0xae, 0xb6, 0xb6, 0xf5, 0xfa,
+	0xf2, 0xca, 0x2e, 0x4d, 0xae, 0xec, 0xd2, 0xe5, 0xd4, 0x36, 0x26, 0x53, 0xdb, 0xf8, 0x39, 0xb3,
+	0x4b, 0xbf, 0x67, 0xb6, 0x31, 0x99, 0xd9, 0xa5, 0xbf, 0x33, 0xbb, 0xf4, 0xf1, 0xc9, 0xc0, 0x67,
+	0xa7, 0x71, 0xaf, 0xe1, 0x91, 0xe1, 0x7e, 0x74, 0x1e, 0x7a, 0xec, 0xd4, 0x0f, 0x07, 0xda, 0x68,
+	0xf1, 0xeb, 0xf5, 0xca, 0xf2, 0x77, 0x7b, 0xfa, 0x6f, 0x00, 0x64, 0x7e, 0x32, 0x36, 0x36, 0x05,
+	0x00, 0x00,
+}
+
+func (m *OIDCConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupRoles) > 0 {
+		for iNdEx := len(m.GroupRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOidcconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedGroups) > 0 {
+		for iNdEx := len(m.AllowedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedGroups[iNdEx])
+			copy(dAtA[i:], m.AllowedGroups[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.AllowedGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllowedUsers) > 0 {
+		for iNdEx := len(m.AllowedUsers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedUsers[iNdEx])
+			copy(dAtA[i:], m.AllowedUsers[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.AllowedUsers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GroupsClaim) > 0 {
+		i -= len(m.GroupsClaim)
+		copy(dAtA[i:], m.GroupsClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.GroupsClaim)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UsernameClaim) > 0 {
+		i -= len(m.UsernameClaim)
+		copy(dAtA[i:], m.UsernameClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.UsernameClaim)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RedirectURL) > 0 {
+		i -= len(m.RedirectURL)
+		copy(dAtA[i:], m.RedirectURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.RedirectURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOidcconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOidcconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OIDCConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.RedirectURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	l = len(m.UsernameClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.GroupsClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.AllowedUsers) > 0 {
+		for _, s := range m.AllowedUsers {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	if len(m.AllowedGroups) > 0 {
+		for _, s := range m.AllowedGroups {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	if len(m.GroupRoles) > 0 {
+		for _, e := range m.GroupRoles {
+			l = e.ProtoSize()
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOidcconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOidcconfiguration(x uint64) (n int) {
+	return sovOidcconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OIDCConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsernameClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupsClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedUsers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedUsers = append(m.AllowedUsers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedGroups = append(m.AllowedGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupRoles = append(m.GroupRoles, OIDCGroupRole{})
+			if err := m.GroupRoles[len(m.GroupRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOidcconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOidcconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOidcconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOidcconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOidcconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOidcconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOidcconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
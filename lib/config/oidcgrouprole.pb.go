@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/oidcgrouprole.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OIDCGroupRole grants a role to the users whose groups claim includes the
+// group.
+type OIDCGroupRole struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group" xml:"group,attr"`
+	Role  Role   `protobuf:"varint,2,opt,name=role,proto3,enum=config.Role" json:"role" xml:"role,attr"`
+}
+
+func (m *OIDCGroupRole) Reset()         { *m = OIDCGroupRole{} }
+func (m *OIDCGroupRole) String() string { return proto.CompactTextString(m) }
+func (*OIDCGroupRole) ProtoMessage()    {}
+func (*OIDCGroupRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b60c9c57acd5fb1f, []int{0}
+}
+func (m *OIDCGroupRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCGroupRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCGroupRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCGroupRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCGroupRole.Merge(m, src)
+}
+func (m *OIDCGroupRole) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCGroupRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCGroupRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCGroupRole proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OIDCGroupRole)(nil), "config.OIDCGroupRole")
+}
+
+func init() { proto.RegisterFile("lib/config/oidcgrouprole.proto", fileDescriptor_b60c9c57acd5fb1f) }
+
+var fileDescriptor_b60c9c57acd5fb1f = []byte{
+	// 242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0xcf, 0xcf, 0x4c, 0x49, 0x4e, 0x2f, 0xca, 0x2f, 0x2d,
+	0x28, 0xca, 0xcf, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xc8, 0x49, 0x89,
+	0x22, 0xa9, 0x43, 0x48, 0x4b, 0x71, 0xa6, 0x56, 0x94, 0x40, 0x98, 0x4a, 0xf3, 0x18, 0xb9, 0x78,
+	0xfd, 0x3d, 0x5d, 0x9c, 0xdd, 0x41, 0x26, 0x04, 0xe5, 0xe7, 0xa4, 0x0a, 0x39, 0x72, 0xb1, 0x82,
+	0x8d, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0xd2, 0x7e, 0x75, 0x4f, 0x1e, 0x22, 0xf0, 0xe9,
+	0x9e, 0xbc, 0x40, 0x45, 0x6e, 0x8e, 0x95, 0x12, 0x98, 0xa7, 0x93, 0x58, 0x52, 0x52, 0xa4, 0xf4,
+	0xea, 0xbc, 0x0a, 0x17, 0x82, 0x1b, 0x04, 0x51, 0x28, 0xe4, 0xc5, 0xc5, 0x02, 0xb2, 0x4d, 0x82,
+	0x49, 0x81, 0x51, 0x83, 0xcf, 0x88, 0x47, 0x0f, 0xe2, 0x02, 0x3d, 0x90, 0xf1, 0x4e, 0x1a, 0xaf,
+	0xee, 0xc9, 0x83, 0x65, 0x3f, 0xdd, 0x93, 0xe7, 0x07, 0x1b, 0x07, 0xe2, 0xc0, 0x4d, 0xe3, 0x84,
+	0xf3, 0x82, 0xc0, 0xaa, 0x9c, 0xbc, 0x4f, 0x3c, 0x94, 0x63, 0xb8, 0xf0, 0x50, 0x8e, 0xe1, 0xc4,
+	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0x58, 0xf0, 0x58, 0x8e, 0xf1,
+	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
+	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b, 0x2b, 0xf3, 0x92, 0x4b, 0x32, 0x32, 0xf3, 0xd2, 0x91, 0x58,
+	0x88, 0x30, 0x48, 0x62, 0x03, 0x7b, 0xda, 0x18, 0x30, 0x00, 0x86, 0x50, 0x7a, 0x7f, 0x40, 0x01,
+	0x00, 0x00,
+}
+
+func (m *OIDCGroupRole) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCGroupRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCGroupRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintOidcgrouprole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintOidcgrouprole(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOidcgrouprole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOidcgrouprole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OIDCGroupRole) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovOidcgrouprole(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovOidcgrouprole(uint64(m.Role))
+	}
+	return n
+}
+
+func sovOidcgrouprole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOidcgrouprole(x uint64) (n int) {
+	return sovOidcgrouprole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OIDCGroupRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcgrouprole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCGroupRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCGroupRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcgrouprole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcgrouprole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcgrouprole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcgrouprole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcgrouprole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcgrouprole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOidcgrouprole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOidcgrouprole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcgrouprole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcgrouprole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOidcgrouprole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOidcgrouprole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOidcgrouprole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOidcgrouprole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOidcgrouprole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOidcgrouprole = fmt.Errorf("proto: unexpected end of group")
+)
@@ -25,6 +25,10 @@ func (opts OptionsConfiguration) Copy() OptionsConfiguration {
 	copy(optsCopy.AlwaysLocalNets, opts.AlwaysLocalNets)
 	optsCopy.UnackedNotificationIDs = make([]string, len(opts.UnackedNotificationIDs))
 	copy(optsCopy.UnackedNotificationIDs, opts.UnackedNotificationIDs)
+	optsCopy.BandwidthProfiles = make([]BandwidthProfile, len(opts.BandwidthProfiles))
+	for i, p := range opts.BandwidthProfiles {
+		optsCopy.BandwidthProfiles[i] = p.Copy()
+	}
 	return optsCopy
 }
 
@@ -55,6 +59,8 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 	if opts.ConnectionLimitMax < 0 {
 		opts.ConnectionLimitMax = 0
 	}
+
+	opts.prepareBandwidthProfiles()
 }
 
 // RequiresRestartOnly returns a copy with only the attributes that require
@@ -83,6 +83,16 @@ type OptionsConfiguration struct {
 	// When set, this allows TLS 1.2 on sync connections, where we otherwise
 	// default to TLS 1.3+ only.
 	InsecureAllowOldTLSVersions bool `protobuf:"varint,53,opt,name=insecure_allow_old_tls_versions,json=insecureAllowOldTlsVersions,proto3" json:"insecureAllowOldTLSVersions" xml:"insecureAllowOldTLSVersions"`
+	// Scheduled alternatives to max_send_kbps and max_recv_kbps. The first
+	// profile whose schedule allows the current time is in effect.
+	BandwidthProfiles []BandwidthProfile `protobuf:"bytes,54,rep,name=bandwidth_profiles,json=bandwidthProfiles,proto3" json:"bandwidthProfiles" xml:"bandwidthProfile"`
+	// The database implementation used for the index. Each backend keeps
+	// its database in a separate location; use `syncthing migrate-database`
+	// to carry the index over when switching.
+	DatabaseBackend DatabaseBackend `protobuf:"varint,55,opt,name=database_backend,json=databaseBackend,proto3,enum=config.DatabaseBackend" json:"databaseBackend" xml:"databaseBackend" restart:"true"`
+	// The number of committed configurations kept in the configuration
+	// history, for rolling back. Zero disables the history.
+	ConfigHistoryEntries int `protobuf:"varint,56,opt,name=config_history_entries,json=configHistoryEntries,proto3,casttype=int" json:"configHistoryEntries" xml:"configHistoryEntries" default:"10"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -135,211 +145,220 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0xd9, 0xce, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x36, 0x49, 0xbd, 0xee, 0xc9,
-	0x49, 0xeb, 0xfe, 0x25, 0xb6, 0x93, 0xe6, 0x4b, 0x23, 0x7d, 0xea, 0xe7, 0x9f, 0xfa, 0xab, 0x1b,
-	0x3b, 0xb1, 0xc6, 0xf6, 0xf7, 0xa1, 0x22, 0xb4, 0x1a, 0xef, 0xce, 0xb1, 0x17, 0xef, 0x99, 0x3d,
-	0xdd, 0x99, 0xf5, 0x4f, 0x8b, 0xa0, 0x2a, 0x82, 0x72, 0x07, 0x58, 0xfc, 0x48, 0x20, 0xa1, 0x22,
-	0x40, 0xa2, 0x94, 0x22, 0x24, 0x24, 0x24, 0xb8, 0xa1, 0x42, 0x42, 0xaa, 0xe0, 0xc2, 0xbe, 0x44,
-	0xa2, 0x2c, 0xaa, 0xd3, 0xab, 0x73, 0x81, 0xc4, 0xb9, 0x34, 0x37, 0x68, 0x66, 0xff, 0x66, 0x77,
-	0xe7, 0x24, 0xb9, 0x3b, 0xfb, 0x3e, 0xef, 0xfb, 0xce, 0xf3, 0xce, 0xcf, 0x3b, 0xef, 0xcc, 0x1c,
-	0xfd, 0xb2, 0xe7, 0xae, 0x5e, 0xb5, 0x7d, 0xd2, 0x70, 0xd7, 0xae, 0xfa, 0x2d, 0xe6, 0xfa, 0x84,
-	0xc6, 0x5f, 0x61, 0x80, 0xf8, 0xd7, 0x95, 0x56, 0xe0, 0x33, 0x1f, 0x9c, 0x88, 0x85, 0xe7, 0x87,
-	0x24, 0x75, 0x16, 0x12, 0x97, 0xac, 0xc5, 0x0a, 0xe7, 0xcf, 0x49, 0x00, 0x75, 0xdf, 0xc4, 0x89,
-	0xf8, 0x24, 0xde, 0x66, 0xf1, 0xcf, 0xda, 0xbf, 0xe6, 0xf4, 0x81, 0xbb, 0x71, 0x0b, 0xd3, 0x72,
-	0x0b, 0xe0, 0x47, 0x9a, 0x7e, 0xd6, 0x73, 0x29, 0xc3, 0xc4, 0x42, 0x8e, 0x13, 0x60, 0x4a, 0x31,
-	0x35, 0xb4, 0x91, 0x63, 0xa3, 0x27, 0xa7, 0xe8, 0x41, 0x64, 0x02, 0x88, 0xb6, 0xe6, 0x05, 0x3c,
-	0x99, 0xa2, 0xed, 0xc8, 0x3c, 0xe3, 0x15, 0x45, 0x9d, 0xc8, 0xbc, 0xbc, 0xdd, 0xf4, 0x6e, 0xd5,
-	0x0a, 0xf2, 0xda, 0x88, 0x83, 0x1b, 0x28, 0xf4, 0xd8, 0xad, 0x5a, 0xf2, 0xa3, 0x76, 0xb8, 0x57,
-	0x7f, 0x34, 0xf9, 0xbd, 0xbb, 0x5f, 0x57, 0x38, 0x87, 0x65, 0xd7, 0xe0, 0x9f, 0x9a, 0x6e, 0xac,
-	0x79, 0xfe, 0x2a, 0xf2, 0x2c, 0xc7, 0xa5, 0xb6, 0xbf, 0x89, 0x83, 0x1d, 0x8b, 0xe2, 0x60, 0x13,
-	0x07, 0xd4, 0x38, 0x2a, 0x88, 0xfe, 0x46, 0x3b, 0x88, 0xcc, 0x7e, 0x88, 0xb6, 0xfe, 0x57, 0xe8,
-	0x4d, 0x12, 0xb2, 0x14, 0xe3, 0xed, 0xc8, 0x3c, 0xb7, 0x96, 0xca, 0xfc, 0x90, 0xd8, 0x38, 0x01,
-	0x3a, 0x91, 0xf9, 0xbc, 0x20, 0xac, 0x42, 0x15, 0xbc, 0xdb, 0x7b, 0xf5, 0x01, 0x95, 0x6a, 0x67,
-	0xaf, 0xae, 0x6e, 0xa0, 0x18, 0xa8, 0x8a, 0x1b, 0x1c, 0x8c, 0x0d, 0x67, 0xd2, 0xa0, 0x12, 0x39,
-	0xf8, 0x4c, 0x15, 0x30, 0x26, 0x68, 0xd5, 0xc3, 0x8e, 0x71, 0x6c, 0x44, 0x1b, 0x7d, 0x6c, 0xea,
-	0x7d, 0x1e, 0xf0, 0xd9, 0xcc, 0xe3, 0x2b, 0x31, 0x58, 0x8d, 0x36, 0x01, 0x3a, 0x91, 0xf9, 0xac,
-	0x22, 0xda, 0x04, 0x95, 0xc2, 0x65, 0x41, 0x88, 0x79, 0xac, 0x5d, 0xdc, 0x74, 0x03, 0x0e, 0xf7,
-	0xea, 0x8f, 0x70, 0xd3, 0xdd, 0xfd, 0x7a, 0x85, 0x54, 0x25, 0xcc, 0x44, 0x0e, 0x3e, 0xd1, 0xf4,
-	0x21, 0xcf, 0xb7, 0x95, 0x51, 0x3e, 0x22, 0xa2, 0xfc, 0x09, 0x8f, 0xf2, 0xcc, 0x3c, 0xd7, 0x29,
-	0x04, 0x39, 0xe0, 0x25, 0xa2, 0x52, 0x8c, 0xcf, 0xc4, 0x53, 0x50, 0x01, 0x2a, 0x42, 0x54, 0x3b,
-	0xe9, 0x22, 0x97, 0x02, 0x2c, 0xf3, 0x81, 0xe7, 0x84, 0x41, 0x25, 0xbc, 0xbf, 0x68, 0x7a, 0x7f,
-	0x1c, 0x1e, 0x4a, 0x7c, 0x59, 0x2d, 0x3f, 0x60, 0xc6, 0xf1, 0x11, 0x6d, 0xf4, 0xf8, 0xd4, 0x0f,
-	0x78, 0x68, 0x3d, 0xa9, 0xab, 0x45, 0x3f, 0x60, 0xed, 0xc8, 0xec, 0x2b, 0x34, 0xcd, 0x85, 0x9d,
-	0xc8, 0x7c, 0xba, 0x1a, 0x14, 0x47, 0xa4, 0x88, 0x26, 0xc6, 0xc7, 0x26, 0xfe, 0xab, 0x76, 0x18,
-	0x99, 0xc7, 0x5c, 0xc2, 0xda, 0x7b, 0x75, 0x85, 0x1b, 0x95, 0xf0, 0x70, 0xaf, 0x7e, 0x5c, 0x98,
-	0xee, 0xee, 0xd7, 0x0b, 0x4c, 0x60, 0x55, 0x17, 0x7c, 0xf5, 0xa8, 0x3e, 0x52, 0x8a, 0xa6, 0x19,
-	0x7a, 0xcc, 0xb5, 0x11, 0x65, 0x69, 0xde, 0x30, 0x4e, 0x8c, 0x68, 0xa3, 0x27, 0xa7, 0x7e, 0xc7,
-	0x43, 0xeb, 0x4d, 0x1d, 0x2e, 0x4c, 0xf3, 0x95, 0xdc, 0x8e, 0xcc, 0xfe, 0x82, 0xd3, 0x58, 0xdc,
-	0x89, 0xcc, 0x1b, 0xd5, 0xf0, 0x62, 0x4c, 0x0a, 0xf0, 0xf3, 0x8d, 0xc6, 0xf8, 0xc4, 0xad, 0x5b,
-	0x37, 0xaf, 0xdd, 0xbc, 0xfe, 0x85, 0x5b, 0x71, 0xb4, 0xed, 0xbd, 0xba, 0xd2, 0xa1, 0x5a, 0x7c,
-	0xb8, 0x57, 0x07, 0x55, 0x27, 0xbb, 0xfb, 0xf5, 0x12, 0x4d, 0xf8, 0x44, 0xd1, 0x38, 0x8d, 0x30,
-	0x49, 0x46, 0xe0, 0xae, 0x7e, 0xba, 0x89, 0xb6, 0x2d, 0x8a, 0x89, 0x63, 0x6d, 0xac, 0xb6, 0xa8,
-	0xf1, 0xa8, 0x18, 0xcc, 0xe7, 0xda, 0x91, 0x79, 0xaa, 0x89, 0xb6, 0x97, 0x30, 0x71, 0x6e, 0xaf,
-	0xb6, 0x78, 0x72, 0xe9, 0x13, 0x61, 0x49, 0xb2, 0x74, 0x7c, 0xa0, 0xac, 0x98, 0x3a, 0x0c, 0xb0,
-	0xbd, 0x19, 0x3b, 0x7c, 0xac, 0xe0, 0x10, 0x62, 0x7b, 0xb3, 0xec, 0x30, 0x95, 0x15, 0x1c, 0xa6,
-	0x42, 0xf0, 0x5b, 0x4d, 0x1f, 0x0a, 0xb0, 0xed, 0x13, 0x82, 0x6d, 0x9e, 0xde, 0x2d, 0x97, 0x30,
-	0x1c, 0x6c, 0x22, 0xcf, 0xa2, 0xc6, 0x49, 0xe1, 0xfb, 0xcb, 0x22, 0xa9, 0xa7, 0x2a, 0x73, 0x09,
-	0xbc, 0xc4, 0x73, 0x87, 0x6c, 0x98, 0x01, 0x9d, 0xc8, 0x1c, 0x15, 0x6d, 0x2b, 0x51, 0x69, 0x94,
-	0x6e, 0x8c, 0xa5, 0x94, 0x0e, 0xf7, 0xea, 0x47, 0x6f, 0x8c, 0x89, 0xfc, 0x5e, 0x69, 0x07, 0xaa,
-	0x5b, 0x01, 0x0d, 0xbd, 0x37, 0xc0, 0x1e, 0xda, 0xa1, 0x59, 0x0e, 0xd0, 0x45, 0x0e, 0x78, 0xb9,
-	0x1d, 0x99, 0xa7, 0x63, 0x24, 0x5f, 0xe8, 0xb5, 0x84, 0x90, 0x24, 0x2d, 0xaf, 0xf0, 0x74, 0xc5,
-	0xc2, 0xa2, 0x31, 0x78, 0xe7, 0xa8, 0x7e, 0x21, 0x69, 0x28, 0x23, 0x92, 0x77, 0x52, 0xd3, 0x38,
-	0x25, 0x3a, 0xe9, 0x8f, 0x7c, 0x0e, 0x0f, 0x41, 0xae, 0x57, 0x09, 0x61, 0xa1, 0x1d, 0x99, 0x43,
-	0x81, 0x1a, 0xca, 0x12, 0x6d, 0x17, 0x5c, 0x62, 0x39, 0x3e, 0x26, 0x2d, 0xd9, 0xae, 0xfe, 0xba,
-	0x43, 0xbc, 0x93, 0xc7, 0x79, 0x27, 0x77, 0xa3, 0x09, 0x8d, 0x38, 0xce, 0x2a, 0x02, 0x56, 0xf5,
-	0xd3, 0x94, 0xa1, 0x80, 0x59, 0xab, 0x81, 0xbf, 0x45, 0x71, 0x60, 0xf4, 0x88, 0xbe, 0xfe, 0xef,
-	0x76, 0x64, 0xf6, 0x08, 0x60, 0x2a, 0x96, 0x77, 0x22, 0xf3, 0x49, 0x11, 0x8e, 0x2c, 0xec, 0xda,
-	0xd3, 0x05, 0x53, 0xf0, 0x33, 0x4d, 0x3f, 0x47, 0x10, 0xb3, 0x58, 0x80, 0xf8, 0xae, 0x86, 0xbc,
-	0x6c, 0x60, 0x7b, 0x45, 0x63, 0x6f, 0x1c, 0x44, 0xa6, 0x7e, 0x67, 0x72, 0x39, 0x4f, 0xeb, 0x3a,
-	0x41, 0x2c, 0x1f, 0x63, 0x53, 0x34, 0x9c, 0x8b, 0x14, 0x29, 0x5c, 0x36, 0x28, 0x7c, 0x49, 0xe9,
-	0x5a, 0x6a, 0x02, 0xf6, 0x13, 0xc4, 0x96, 0x53, 0x3a, 0xe9, 0x84, 0xf8, 0x7d, 0x85, 0xa7, 0x87,
-	0x11, 0xc5, 0x56, 0xd3, 0x38, 0x23, 0xa6, 0xc2, 0xd7, 0xf9, 0x54, 0x38, 0x79, 0x67, 0x72, 0x79,
-	0x9e, 0x8b, 0xf9, 0xe0, 0x9f, 0x21, 0x88, 0xc5, 0x1f, 0x2e, 0x09, 0x99, 0x28, 0x7e, 0x6a, 0x29,
-	0x59, 0x59, 0xae, 0x5c, 0x1b, 0xed, 0xbd, 0x7a, 0xc5, 0xbe, 0x2a, 0xca, 0x56, 0x50, 0xde, 0x30,
-	0x04, 0x32, 0xfb, 0x58, 0x06, 0xfe, 0xac, 0xe9, 0x43, 0x45, 0xf2, 0x01, 0x26, 0x78, 0x4b, 0xcc,
-	0xe4, 0xb3, 0x82, 0xfe, 0x2e, 0xa7, 0x7f, 0xea, 0xce, 0xe4, 0x32, 0x8c, 0x01, 0x1e, 0x40, 0x1f,
-	0x41, 0x2c, 0xfd, 0xcc, 0x42, 0xa8, 0xa7, 0x21, 0x14, 0x11, 0x29, 0x88, 0x6b, 0x72, 0x10, 0x0a,
-	0x1f, 0x2a, 0x21, 0x0f, 0xe4, 0x1a, 0x0f, 0x44, 0xa6, 0x00, 0x07, 0xe4, 0x50, 0x52, 0xa9, 0x22,
-	0x18, 0xe6, 0x36, 0xb1, 0x1f, 0x32, 0x8b, 0x1a, 0x7d, 0xc5, 0x60, 0x96, 0x63, 0x60, 0x29, 0x09,
-	0x26, 0xfd, 0xe4, 0x33, 0xdd, 0x29, 0x04, 0x53, 0x44, 0xba, 0x2d, 0x3f, 0x85, 0x0f, 0x95, 0x30,
-	0x5b, 0x72, 0x32, 0x85, 0x62, 0x30, 0xa9, 0x14, 0xfc, 0x50, 0xd3, 0x8d, 0x90, 0xa2, 0x35, 0x6c,
-	0x05, 0x98, 0xef, 0xfb, 0x2e, 0x59, 0xb3, 0x90, 0x6d, 0xe3, 0x16, 0xc3, 0x8e, 0x01, 0x44, 0x34,
-	0x88, 0xaf, 0x80, 0x15, 0x38, 0x99, 0x48, 0xf9, 0x0a, 0x08, 0x83, 0xf4, 0xab, 0x13, 0x99, 0x67,
-	0x45, 0x10, 0xb9, 0x48, 0x22, 0x2c, 0x2b, 0x16, 0xbe, 0xf8, 0x8c, 0xcf, 0x5d, 0xc2, 0x41, 0x41,
-	0x01, 0xa6, 0x0c, 0x52, 0x39, 0x78, 0x4b, 0x1f, 0x28, 0x93, 0xa3, 0x18, 0x13, 0xa3, 0x5f, 0x10,
-	0x9b, 0x3b, 0x88, 0xcc, 0x13, 0x2b, 0x70, 0x09, 0x63, 0xd2, 0x8e, 0xcc, 0x13, 0x61, 0xc0, 0x7f,
-	0x75, 0x22, 0xb3, 0x27, 0x21, 0xc4, 0x3f, 0x25, 0x32, 0xa9, 0x42, 0xf6, 0x6b, 0x77, 0xbf, 0x9e,
-	0x98, 0x43, 0x50, 0x24, 0xc0, 0x65, 0xe0, 0xbb, 0x9a, 0xfe, 0x78, 0xb9, 0xf5, 0x90, 0xb8, 0x6f,
-	0x84, 0xd8, 0x72, 0x1d, 0x63, 0x40, 0x14, 0x11, 0xaf, 0xc7, 0x7d, 0xb3, 0x22, 0xc4, 0x73, 0x33,
-	0x71, 0xdf, 0x24, 0x5f, 0x72, 0xdf, 0xa4, 0x0a, 0xb5, 0xb8, 0x53, 0xd2, 0xcf, 0x8e, 0xfc, 0x95,
-	0x74, 0x4a, 0x8a, 0x95, 0x3b, 0x25, 0xd5, 0x02, 0x1f, 0x69, 0x7a, 0x7f, 0x85, 0x57, 0xe0, 0x19,
-	0xe7, 0x04, 0xa3, 0x6f, 0xf2, 0xb9, 0x77, 0x7c, 0x05, 0xae, 0xc0, 0xf9, 0x76, 0x64, 0x1e, 0x0f,
-	0x83, 0x15, 0x38, 0xdf, 0x89, 0xcc, 0x9b, 0x29, 0x11, 0x38, 0x2f, 0xcd, 0xae, 0x75, 0xc6, 0x5a,
-	0xf4, 0xd6, 0xd5, 0xab, 0x0e, 0x62, 0xe8, 0x0a, 0xdd, 0x21, 0x36, 0x5b, 0xe7, 0x87, 0x35, 0x82,
-	0xd9, 0x55, 0x82, 0xb7, 0xb8, 0x94, 0x13, 0x4e, 0x9c, 0xa4, 0x3f, 0x0e, 0xf7, 0xea, 0x0f, 0x61,
-	0xb8, 0xbb, 0x5f, 0x8f, 0x59, 0xc0, 0xbe, 0x52, 0x1c, 0x81, 0x07, 0xfe, 0xa1, 0xe9, 0x66, 0x39,
-	0x84, 0x96, 0x4f, 0xf9, 0x0e, 0x47, 0xb1, 0x1d, 0x06, 0xd8, 0xdb, 0x31, 0x06, 0x45, 0xfa, 0xfd,
-	0xbe, 0x38, 0x41, 0xac, 0xc0, 0x45, 0x9f, 0xb2, 0xb9, 0x0c, 0x6c, 0x47, 0xe6, 0xd9, 0x30, 0x28,
-	0xca, 0x3a, 0x91, 0xf9, 0x54, 0x12, 0x64, 0x11, 0x90, 0xe2, 0x6d, 0x20, 0x8f, 0x8a, 0x94, 0x5c,
-	0xb5, 0x56, 0xc8, 0x78, 0xe5, 0x29, 0x2c, 0xf8, 0x79, 0xa1, 0x4c, 0x01, 0x5e, 0x2c, 0x86, 0x55,
-	0x44, 0xc1, 0xdf, 0x15, 0x11, 0xba, 0xc4, 0x65, 0x2e, 0x3f, 0x47, 0xf0, 0xfd, 0xce, 0xa2, 0xc6,
-	0x90, 0x98, 0xc5, 0xdf, 0x13, 0xa7, 0x87, 0x15, 0x38, 0x17, 0xa3, 0x33, 0x1c, 0xe4, 0x09, 0xe3,
-	0x4c, 0x18, 0x14, 0x44, 0x59, 0xba, 0x28, 0xc9, 0xe5, 0x64, 0x71, 0x73, 0xac, 0x90, 0xc0, 0xcb,
-	0x1e, 0xaa, 0x22, 0xbe, 0x03, 0x71, 0x2b, 0x7e, 0x60, 0x28, 0x51, 0x80, 0x17, 0x8a, 0x01, 0x16,
-	0x40, 0xf0, 0xae, 0xa6, 0x0f, 0xa1, 0x90, 0xf9, 0x56, 0xd8, 0x5a, 0x0b, 0x90, 0x83, 0xf3, 0xda,
-	0x64, 0xdd, 0x78, 0x5c, 0xc4, 0xb5, 0xc8, 0x4f, 0x40, 0x5c, 0x65, 0x25, 0xd6, 0x48, 0xb7, 0xf5,
-	0x57, 0xb3, 0xc3, 0x82, 0x0a, 0x94, 0xa3, 0x99, 0x90, 0x0b, 0xb5, 0xf1, 0x09, 0xa8, 0xf4, 0x06,
-	0x9a, 0xfa, 0x50, 0xca, 0x81, 0xf9, 0x56, 0x2b, 0xe0, 0x3d, 0x2e, 0xb6, 0x46, 0x6a, 0x9c, 0x17,
-	0x53, 0xe8, 0x06, 0x27, 0x92, 0xa8, 0x2c, 0xfb, 0x8b, 0x01, 0x86, 0x09, 0xde, 0x89, 0xcc, 0xf3,
-	0x71, 0x8f, 0x2a, 0xc0, 0x1a, 0x54, 0xda, 0x80, 0x4d, 0x1d, 0x6c, 0x60, 0xdc, 0xb2, 0x18, 0x6e,
-	0xb6, 0xfc, 0x00, 0x05, 0x2e, 0xa6, 0xd6, 0xba, 0x71, 0x41, 0x84, 0xfc, 0x2a, 0x9f, 0x97, 0x1c,
-	0x5d, 0xce, 0x41, 0x1e, 0xee, 0x25, 0xd1, 0x4a, 0x19, 0x90, 0x8f, 0x46, 0xd7, 0xe5, 0x50, 0x27,
-	0xae, 0xc3, 0x8a, 0x17, 0xb0, 0xa3, 0xf7, 0xdb, 0xc8, 0x5e, 0xc7, 0x96, 0xbb, 0x46, 0xfc, 0x00,
-	0x3b, 0x56, 0xc3, 0xf5, 0x30, 0x35, 0x2e, 0x8a, 0x10, 0xe7, 0xf8, 0x06, 0x23, 0xe0, 0xb9, 0x18,
-	0x9d, 0xe5, 0x60, 0xd6, 0xd1, 0x15, 0xa4, 0xb2, 0x24, 0xb2, 0xa9, 0x0e, 0xab, 0x6e, 0xc0, 0xb7,
-	0x35, 0xfd, 0x7c, 0x2b, 0xf0, 0xd7, 0xf8, 0xd9, 0xc2, 0x0a, 0x5b, 0x0e, 0x62, 0x58, 0xae, 0xd7,
-	0x9f, 0x10, 0xb1, 0x2f, 0xf3, 0x72, 0x33, 0xd5, 0x5a, 0x11, 0x4a, 0x72, 0x6d, 0x1e, 0x9f, 0x79,
-	0xbb, 0xe0, 0x12, 0x9d, 0x17, 0xa5, 0x8e, 0xd0, 0x5e, 0x84, 0xdd, 0x3c, 0x82, 0x77, 0x34, 0x7d,
-	0xd0, 0x73, 0x9b, 0x2e, 0xb3, 0x56, 0x11, 0x71, 0xb6, 0x5c, 0x87, 0xad, 0x5b, 0x2e, 0xb1, 0x3c,
-	0x44, 0x8c, 0x61, 0xd1, 0x25, 0x0b, 0xe2, 0x2c, 0xc7, 0x35, 0xa6, 0x52, 0x85, 0x39, 0x32, 0x8f,
-	0x48, 0x7e, 0xfe, 0xae, 0x62, 0xf7, 0xe9, 0x16, 0x95, 0x2b, 0xf0, 0xb6, 0xa6, 0x83, 0xa6, 0x4b,
-	0xac, 0x75, 0xbf, 0x89, 0x2d, 0xc7, 0xa5, 0x1b, 0x56, 0x23, 0xc0, 0xd8, 0x30, 0x47, 0xb4, 0xd1,
-	0x53, 0x13, 0x3d, 0x57, 0xe2, 0x8b, 0xae, 0x2b, 0x4b, 0xee, 0x9b, 0x78, 0xea, 0x95, 0x8f, 0x23,
-	0xf3, 0x08, 0x5f, 0xd5, 0x4d, 0x97, 0xbc, 0xea, 0x37, 0xf1, 0x8c, 0x4b, 0x37, 0x66, 0x03, 0x8c,
-	0xb3, 0xd9, 0x51, 0x92, 0xcb, 0xeb, 0x60, 0xe4, 0x32, 0x27, 0x72, 0x6c, 0x7c, 0xe4, 0x32, 0x2c,
-	0x9b, 0x83, 0x7b, 0x9a, 0xde, 0x93, 0xce, 0x77, 0xb1, 0x0b, 0x8c, 0x88, 0x5d, 0xe0, 0x0f, 0xa2,
-	0x02, 0x49, 0x27, 0x6d, 0xbc, 0x17, 0x9c, 0x0a, 0xf2, 0xcf, 0x4e, 0x64, 0xce, 0xa4, 0x07, 0x80,
-	0x54, 0xa6, 0xd8, 0x17, 0x92, 0x15, 0x40, 0x4b, 0x29, 0xbe, 0x89, 0x19, 0xba, 0xf2, 0x45, 0xea,
-	0x13, 0x9e, 0x4a, 0x0b, 0x6e, 0x8b, 0x9f, 0x87, 0x7b, 0xf5, 0xd1, 0x87, 0x75, 0xc5, 0xcb, 0x15,
-	0x89, 0x2f, 0xcc, 0xfd, 0x04, 0x1e, 0xf8, 0x7f, 0xbd, 0x0f, 0x79, 0x5b, 0xfc, 0x30, 0x14, 0x1f,
-	0xee, 0x09, 0x66, 0xd4, 0x78, 0x52, 0xdc, 0xa9, 0xf1, 0x33, 0xe8, 0x99, 0x18, 0x14, 0x87, 0xe4,
-	0x3b, 0x98, 0xf1, 0x89, 0x3f, 0x10, 0x67, 0x98, 0x82, 0xbc, 0x06, 0xcb, 0x8a, 0xe0, 0xdf, 0x9a,
-	0x3e, 0xea, 0x6f, 0xe2, 0x60, 0x2b, 0x70, 0x19, 0x4f, 0x1c, 0x4d, 0x9f, 0x61, 0xcb, 0xc1, 0x9b,
-	0xae, 0x8d, 0x2d, 0x82, 0x9a, 0x98, 0x5a, 0x3e, 0xb1, 0x92, 0x73, 0x89, 0x51, 0xcb, 0x6f, 0x7b,
-	0x86, 0xee, 0xa6, 0x46, 0x50, 0xd8, 0xcc, 0xe0, 0xcd, 0x3b, 0x5c, 0xbd, 0x1d, 0x99, 0x97, 0xfc,
-	0x0a, 0xe4, 0xda, 0x58, 0xa0, 0x77, 0xc9, 0x74, 0xec, 0xaa, 0x13, 0x99, 0x2f, 0x09, 0x82, 0x0f,
-	0xa1, 0xdb, 0x7d, 0x52, 0xf2, 0x43, 0x55, 0x17, 0x1e, 0xf0, 0x61, 0x58, 0x80, 0xaf, 0xe8, 0xe7,
-	0x78, 0x1a, 0xb3, 0x5c, 0xe2, 0xe0, 0x6d, 0x8b, 0xcf, 0xe4, 0x55, 0xcf, 0xb7, 0x37, 0xa8, 0x71,
-	0x49, 0x2c, 0x69, 0x3e, 0x69, 0x00, 0x57, 0x98, 0xe3, 0xf8, 0x82, 0x4b, 0xa6, 0x04, 0x9a, 0x5d,
-	0xa2, 0x56, 0x21, 0x65, 0xe1, 0x1a, 0x97, 0xa3, 0x50, 0xe1, 0x09, 0xfc, 0x8d, 0x57, 0x9f, 0x04,
-	0xd9, 0x1b, 0xd8, 0xb1, 0x88, 0xcf, 0xdc, 0x86, 0x6b, 0xa3, 0xf8, 0x3a, 0xc0, 0xa1, 0x46, 0x5d,
-	0x8c, 0xef, 0x7b, 0xbc, 0xbb, 0x07, 0x57, 0x62, 0xa5, 0x3b, 0x92, 0xce, 0xdc, 0x0c, 0xef, 0xed,
-	0xc1, 0x50, 0x89, 0x74, 0x22, 0xf3, 0x42, 0x9c, 0xda, 0x55, 0xb0, 0xb8, 0x3a, 0x54, 0x22, 0x9d,
-	0xbd, 0x7a, 0x17, 0x8f, 0xbb, 0xfb, 0xf5, 0x2e, 0x2c, 0xa0, 0xd2, 0xc2, 0xa1, 0x00, 0xea, 0xa7,
-	0x59, 0x80, 0x1a, 0x0d, 0xd7, 0xb6, 0x6c, 0x0f, 0x51, 0x6a, 0x5c, 0x16, 0xdd, 0xfa, 0x02, 0x3f,
-	0xbe, 0x26, 0xc0, 0x34, 0x97, 0x77, 0x22, 0x13, 0xc4, 0x1d, 0x2a, 0x09, 0xb3, 0x7b, 0x93, 0x82,
-	0x2a, 0x78, 0x4b, 0xef, 0x4f, 0xba, 0xd8, 0x6a, 0xf8, 0x9e, 0x83, 0x03, 0xab, 0x85, 0xd8, 0xba,
-	0xf1, 0x94, 0x58, 0xf5, 0xb7, 0x0f, 0x22, 0xf3, 0xc2, 0x0c, 0x6e, 0x05, 0xd8, 0x46, 0x0c, 0x3b,
-	0x33, 0xb1, 0xe2, 0xac, 0xd0, 0x5b, 0x44, 0x6c, 0xbd, 0x1d, 0x99, 0xda, 0x0b, 0xd9, 0x61, 0xd9,
-	0x29, 0xc3, 0xcf, 0xfb, 0x4d, 0x97, 0x0f, 0x12, 0xdb, 0xa9, 0x19, 0x1a, 0xec, 0xab, 0xe0, 0x60,
-	0x43, 0x3f, 0x4b, 0x31, 0xb3, 0x3c, 0x7f, 0xcb, 0x6a, 0x05, 0xae, 0x1f, 0xb8, 0x6c, 0xc7, 0x78,
-	0x5a, 0x2c, 0x8a, 0xc9, 0x76, 0x64, 0xf6, 0x52, 0xcc, 0xe6, 0xfd, 0xad, 0xc5, 0x04, 0xc9, 0x32,
-	0x5b, 0x51, 0xdc, 0xf5, 0x58, 0x5e, 0x32, 0x07, 0xef, 0x6b, 0xfa, 0x60, 0x13, 0x6d, 0xa7, 0x61,
-	0xda, 0x3e, 0xb1, 0xc3, 0x20, 0xc0, 0xc4, 0xde, 0x31, 0x46, 0x45, 0x3f, 0x52, 0x71, 0xf7, 0x81,
-	0xb6, 0x16, 0xd0, 0x76, 0xcc, 0x71, 0x3a, 0x57, 0xe1, 0x5b, 0x7e, 0x53, 0x21, 0xcf, 0xb6, 0x7c,
-	0x15, 0x98, 0x76, 0xb9, 0xb8, 0xac, 0x50, 0xfb, 0x85, 0x4a, 0xaf, 0xe0, 0x13, 0x4d, 0xef, 0xb7,
-	0x03, 0x44, 0xd7, 0x4b, 0x25, 0xf9, 0x33, 0x62, 0x58, 0x3e, 0x10, 0x25, 0xf9, 0x74, 0x5a, 0x92,
-	0xdb, 0x49, 0x49, 0x3e, 0x1b, 0xef, 0xcd, 0xdc, 0x2c, 0x2f, 0x8e, 0x95, 0x69, 0x58, 0xe8, 0x54,
-	0xcb, 0x6c, 0x21, 0xe6, 0x73, 0xb9, 0xaf, 0xe2, 0x84, 0x17, 0xeb, 0x76, 0x52, 0xac, 0xd7, 0x1f,
-	0xc6, 0x0d, 0x2f, 0xd7, 0xa7, 0xe3, 0x72, 0xbd, 0xe4, 0x2c, 0xf0, 0xc0, 0x8f, 0x35, 0x7d, 0xa8,
-	0x1c, 0x5e, 0x7a, 0x4b, 0xf2, 0xac, 0x18, 0x7f, 0xf7, 0x20, 0x32, 0x4f, 0x4e, 0x43, 0xe9, 0x82,
-	0xbf, 0xe8, 0xa5, 0x7c, 0xc1, 0xaf, 0x44, 0xbb, 0x4d, 0x8d, 0xdd, 0xfd, 0x7a, 0xee, 0x1b, 0xaa,
-	0x3d, 0x83, 0xaf, 0x69, 0xfa, 0x20, 0x65, 0x21, 0xb1, 0x78, 0xe5, 0x84, 0x3c, 0x77, 0x13, 0x5b,
-	0xf1, 0xdd, 0x11, 0x35, 0x9e, 0xcb, 0xea, 0xd1, 0x7e, 0xae, 0x71, 0x3b, 0x55, 0x58, 0xe2, 0xf8,
-	0x52, 0x56, 0x25, 0x29, 0xb0, 0x62, 0x6d, 0x2d, 0x25, 0xb4, 0x63, 0xe3, 0x37, 0xc7, 0xa0, 0xca,
-	0x1b, 0x3f, 0xb2, 0x96, 0x68, 0xf0, 0xbc, 0x4a, 0x8d, 0xe7, 0x05, 0x89, 0xd7, 0x78, 0xa1, 0x56,
-	0x30, 0x5b, 0x70, 0x49, 0x5e, 0xda, 0x57, 0x10, 0xb9, 0x46, 0x2c, 0x24, 0xd4, 0x89, 0x31, 0x58,
-	0xf5, 0xc3, 0xab, 0xf2, 0x1e, 0xd1, 0x7a, 0xfa, 0xee, 0xf4, 0x82, 0xc8, 0xa1, 0xce, 0x41, 0x64,
-	0xf6, 0x42, 0xb4, 0xb5, 0xc4, 0x42, 0xe9, 0xc5, 0xe9, 0x14, 0xcd, 0x3f, 0xb3, 0xbb, 0xa1, 0x5c,
-	0xf6, 0xc0, 0x57, 0xb1, 0x92, 0x47, 0x28, 0xfb, 0x03, 0x9b, 0xfa, 0x19, 0x7e, 0x0a, 0x5c, 0x45,
-	0x14, 0x5b, 0xf1, 0x13, 0xa0, 0x71, 0x65, 0x44, 0x1b, 0xed, 0x9d, 0xe8, 0x4d, 0xcb, 0xa2, 0x65,
-	0x21, 0x15, 0x97, 0x79, 0xbd, 0xa9, 0x6a, 0x2c, 0xcb, 0x32, 0x47, 0x51, 0x5c, 0x1b, 0x09, 0xb0,
-	0x18, 0xd2, 0x64, 0x7a, 0xbc, 0xbd, 0x5f, 0xd7, 0x60, 0xc9, 0x14, 0x7c, 0xe7, 0xa8, 0x7e, 0x89,
-	0x67, 0x8d, 0x2c, 0x5d, 0xf0, 0x33, 0xa5, 0xed, 0x37, 0xf9, 0x94, 0x0d, 0xf0, 0x1b, 0x21, 0xa6,
-	0xcc, 0xda, 0x70, 0x57, 0x8d, 0xab, 0x62, 0x38, 0xfe, 0xa4, 0x25, 0x4f, 0x87, 0x0b, 0x68, 0x7b,
-	0x7a, 0x0e, 0xc6, 0xf8, 0x6d, 0x77, 0xaa, 0x1d, 0x99, 0x66, 0x13, 0x6d, 0x67, 0x4b, 0x9c, 0xcd,
-	0x25, 0x3e, 0x72, 0x95, 0x6c, 0x17, 0x7c, 0x80, 0x9e, 0x74, 0x1e, 0x7b, 0xa0, 0xcb, 0x07, 0xab,
-	0x24, 0x8f, 0x91, 0x25, 0xba, 0xf0, 0x01, 0x66, 0xab, 0xe0, 0x33, 0x4d, 0x1f, 0xcc, 0x5e, 0x44,
-	0x3c, 0x24, 0xbf, 0xa1, 0x8e, 0x89, 0x05, 0xfc, 0x21, 0xef, 0x89, 0x81, 0xf4, 0x45, 0x61, 0x7e,
-	0xf2, 0x8e, 0xfc, 0x8c, 0x3a, 0x80, 0x14, 0xf2, 0xac, 0x90, 0x56, 0x81, 0xaa, 0x87, 0x2c, 0xa5,
-	0x93, 0x2e, 0x72, 0x69, 0xe9, 0x2b, 0x49, 0xc1, 0xdc, 0x0a, 0x49, 0x6f, 0xb0, 0x9b, 0xfa, 0x79,
-	0xf1, 0xe8, 0xd1, 0x08, 0x3d, 0x2f, 0xa9, 0x6a, 0x7c, 0x92, 0x1e, 0x51, 0x8d, 0x71, 0x11, 0xe9,
-	0x2d, 0x5e, 0x35, 0x70, 0xad, 0xd9, 0xd0, 0xf3, 0x44, 0x3d, 0x72, 0x97, 0x24, 0x87, 0xca, 0x4e,
-	0x64, 0x5e, 0x4c, 0xb6, 0x2c, 0x15, 0x5c, 0x83, 0x5d, 0xec, 0xc0, 0x6b, 0xfa, 0xe9, 0x06, 0x46,
-	0x2c, 0x0c, 0xb0, 0xd5, 0xf0, 0xd0, 0x1a, 0x35, 0x26, 0xc4, 0xba, 0xbb, 0xcc, 0x77, 0xfa, 0x04,
-	0x98, 0xe5, 0xf2, 0xec, 0x81, 0x44, 0x12, 0xd6, 0x60, 0x41, 0x05, 0x6c, 0xe9, 0x43, 0xd2, 0xbb,
-	0x48, 0x7c, 0xc6, 0xc1, 0xc4, 0x0f, 0xd7, 0xd6, 0x8d, 0x6b, 0x62, 0xd2, 0xbe, 0x2c, 0xd2, 0x6b,
-	0xa6, 0x32, 0xcf, 0x35, 0x5e, 0x11, 0x0a, 0x59, 0xd5, 0xa3, 0x44, 0xb3, 0x8a, 0x42, 0x6d, 0x0c,
-	0x36, 0xf4, 0x81, 0x4a, 0xc3, 0x4d, 0xb4, 0x6d, 0x5c, 0x17, 0xad, 0xbe, 0xc4, 0x8b, 0xc1, 0x92,
-	0xe1, 0x02, 0xda, 0xee, 0x44, 0xa6, 0xa1, 0x6a, 0x72, 0x01, 0x6d, 0x67, 0xed, 0x29, 0xcc, 0xc0,
-	0xbb, 0x47, 0x75, 0x33, 0xbd, 0xec, 0xb1, 0x90, 0xc7, 0x4b, 0x0a, 0xdf, 0x73, 0x2c, 0xe6, 0x51,
-	0x8b, 0xe7, 0x0f, 0xd7, 0x27, 0xd4, 0x78, 0x51, 0x8c, 0xd7, 0x47, 0x7c, 0x66, 0x5e, 0x48, 0xaf,
-	0x56, 0x26, 0xb9, 0xea, 0x5d, 0xcf, 0x59, 0x9e, 0x5f, 0xfa, 0xbf, 0x44, 0xaf, 0x1d, 0x99, 0x17,
-	0xdc, 0xee, 0x70, 0x56, 0xef, 0xdc, 0x47, 0x87, 0xcf, 0xcf, 0xfb, 0xfa, 0xb8, 0x3f, 0xbc, 0xbb,
-	0x5f, 0xbf, 0x1f, 0x41, 0x58, 0xb5, 0xf5, 0x68, 0x0a, 0x82, 0x2f, 0xe9, 0x3d, 0x61, 0x8b, 0xb4,
-	0xb2, 0x0d, 0xf5, 0xe7, 0xb3, 0x22, 0xec, 0xcf, 0x1d, 0x44, 0xe6, 0xb9, 0xbc, 0x96, 0x5b, 0x59,
-	0x24, 0x8b, 0xf9, 0xee, 0x2a, 0xaa, 0xb8, 0xa4, 0xc0, 0x6d, 0x91, 0x56, 0x02, 0x48, 0xf5, 0xdb,
-	0xee, 0x7e, 0x5d, 0x6d, 0x6c, 0x68, 0xf0, 0x94, 0x64, 0x02, 0x7e, 0xaa, 0x25, 0xcd, 0xa7, 0xaf,
-	0x09, 0xef, 0xcf, 0x8a, 0xe1, 0x7e, 0x5b, 0xe4, 0x83, 0xa2, 0x8b, 0xec, 0x65, 0x41, 0x34, 0x3f,
-	0x92, 0x35, 0x2f, 0xbf, 0x08, 0x48, 0x1c, 0xf2, 0xc4, 0x77, 0xbe, 0xbb, 0x16, 0x5f, 0xe0, 0xaa,
-	0x56, 0x0c, 0x0d, 0xea, 0xb9, 0x15, 0xf8, 0xb5, 0xa6, 0xf7, 0x0a, 0x9a, 0xf9, 0xbb, 0xc1, 0x2f,
-	0x62, 0xa2, 0xdf, 0x10, 0xe7, 0x83, 0xa2, 0x0b, 0xe9, 0x0d, 0x41, 0x50, 0xad, 0x65, 0x54, 0x8b,
-	0xb7, 0xfe, 0x4a, 0xb2, 0x17, 0xef, 0xa7, 0xc7, 0x4f, 0x01, 0xea, 0xb6, 0x0c, 0x0d, 0xf6, 0xc8,
-	0x96, 0x39, 0xe5, 0xfc, 0x75, 0xe0, 0x83, 0xee, 0x94, 0xa5, 0x97, 0x82, 0x12, 0xe5, 0xe2, 0xdd,
-	0x7e, 0x77, 0xca, 0xdd, 0xf4, 0xaa, 0x94, 0x53, 0xcd, 0x94, 0x72, 0xf6, 0x18, 0xd0, 0xd0, 0xe3,
-	0x57, 0xc8, 0xac, 0x7c, 0xf8, 0xe5, 0xac, 0xc8, 0x63, 0xff, 0x53, 0xe4, 0x2b, 0x1e, 0xf2, 0xf2,
-	0x3a, 0x42, 0x9a, 0x8c, 0x41, 0x8e, 0x14, 0x0f, 0x13, 0x3d, 0x12, 0x42, 0xc5, 0xe5, 0x4d, 0xf5,
-	0xde, 0xc4, 0x6a, 0xd9, 0xcc, 0xf8, 0x90, 0x77, 0x91, 0x36, 0xb5, 0x70, 0x10, 0x99, 0x17, 0xf3,
-	0x16, 0x17, 0x8a, 0xb7, 0x1e, 0x8b, 0x36, 0x2b, 0xf6, 0x53, 0xb3, 0x82, 0x17, 0x9b, 0x07, 0x55,
-	0x05, 0x5e, 0x2b, 0x0d, 0x94, 0x2a, 0x05, 0x6a, 0x23, 0x42, 0x8d, 0x5f, 0xc5, 0xa3, 0xb4, 0x5c,
-	0xa2, 0x20, 0xef, 0xb0, 0x4b, 0x5c, 0xb1, 0x44, 0xa1, 0x82, 0x57, 0x87, 0x4a, 0x30, 0xa9, 0xe8,
-	0x4d, 0xdd, 0xfe, 0xf8, 0xd3, 0xe1, 0x23, 0xfb, 0x9f, 0x0e, 0x1f, 0xf9, 0xf8, 0x60, 0x58, 0xdb,
-	0x3f, 0x18, 0xd6, 0xbe, 0x75, 0x6f, 0xf8, 0xc8, 0x7b, 0xf7, 0x86, 0xb5, 0xfd, 0x7b, 0xc3, 0x47,
-	0xfe, 0x7a, 0x6f, 0xf8, 0xc8, 0xeb, 0xcf, 0xac, 0xb9, 0x6c, 0x3d, 0x5c, 0xbd, 0x62, 0xfb, 0xcd,
-	0xab, 0x59, 0xfd, 0x2e, 0xfd, 0xca, 0xff, 0x56, 0xb5, 0x7a, 0x42, 0xfc, 0x8f, 0xea, 0xda, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x13, 0xeb, 0x90, 0xb3, 0x25, 0x00, 0x00,
+	// 3401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1c, 0xc7,
+	0x91, 0xd6, 0x48, 0x96, 0x6c, 0x0d, 0x29, 0x52, 0x1c, 0x52, 0xe4, 0x58, 0x92, 0x39, 0xf4, 0x6a,
+	0x65, 0xd3, 0x3f, 0x92, 0x48, 0x4a, 0x96, 0x65, 0x01, 0x07, 0x1f, 0x7f, 0xc4, 0x13, 0x2d, 0x52,
+	0x22, 0x9a, 0xe4, 0xdd, 0xc1, 0x87, 0xc3, 0xa0, 0x77, 0xb6, 0x97, 0x9c, 0xe3, 0xec, 0xcc, 0x7a,
+	0xba, 0x87, 0x3f, 0xb6, 0x71, 0x67, 0xd8, 0x38, 0xfb, 0xde, 0xee, 0x8e, 0xb8, 0x1f, 0xe0, 0x0e,
+	0x08, 0x1c, 0x24, 0x01, 0xe2, 0x38, 0x0e, 0x02, 0x04, 0x08, 0x90, 0xbc, 0xc4, 0x08, 0x10, 0xc0,
+	0x48, 0x1e, 0xc8, 0xc7, 0x00, 0x49, 0x26, 0x30, 0x95, 0xa7, 0x7d, 0xc8, 0xc3, 0x3e, 0x32, 0x40,
+	0x10, 0x54, 0xcf, 0x5f, 0xcf, 0x4c, 0xaf, 0xa4, 0xb7, 0xed, 0xfa, 0xaa, 0xaa, 0xab, 0x7a, 0xba,
+	0xab, 0xab, 0xaa, 0x57, 0xbd, 0xec, 0xd8, 0xb5, 0x6b, 0x96, 0xe7, 0x36, 0xec, 0xf5, 0x6b, 0x5e,
+	0x8b, 0xd9, 0x9e, 0x4b, 0xa3, 0x51, 0xe0, 0x63, 0x18, 0x5d, 0x6d, 0xf9, 0x1e, 0xf3, 0xb4, 0x53,
+	0x11, 0xf1, 0xfc, 0x88, 0xc0, 0xce, 0x02, 0xd7, 0x76, 0xd7, 0x23, 0x86, 0xf3, 0xe7, 0x04, 0x80,
+	0xda, 0xef, 0x92, 0x98, 0xfc, 0xbc, 0x40, 0xae, 0x61, 0xb7, 0xbe, 0x6d, 0xd7, 0xd9, 0x46, 0xcb,
+	0xf7, 0x1a, 0xb6, 0x93, 0xb0, 0x8c, 0x09, 0x2c, 0x75, 0xcc, 0x70, 0x0d, 0x53, 0x52, 0xc3, 0xd6,
+	0x26, 0x71, 0xeb, 0x31, 0xc7, 0x69, 0xb2, 0xc3, 0xa2, 0x9f, 0x95, 0x3f, 0x2f, 0xaa, 0x43, 0x0f,
+	0x22, 0x33, 0x67, 0x45, 0x33, 0xb5, 0x6f, 0x28, 0xea, 0x59, 0xc7, 0xa6, 0x8c, 0xb8, 0x26, 0xae,
+	0xd7, 0x7d, 0x42, 0x29, 0xa1, 0xba, 0x32, 0x76, 0x62, 0xfc, 0xf4, 0x0c, 0x3d, 0x0c, 0x0d, 0x0d,
+	0xe1, 0xed, 0x45, 0x0e, 0x4f, 0x27, 0x68, 0x3b, 0x34, 0xfa, 0x9d, 0x3c, 0xa9, 0x13, 0x1a, 0x97,
+	0x77, 0x9a, 0xce, 0xed, 0x4a, 0x8e, 0x5e, 0x19, 0xab, 0x93, 0x06, 0x0e, 0x1c, 0x76, 0xbb, 0x12,
+	0xff, 0xa8, 0x1c, 0xed, 0x57, 0x9f, 0x8e, 0x7f, 0xef, 0x1d, 0x54, 0x25, 0xca, 0x51, 0x51, 0xb5,
+	0xf6, 0x47, 0x45, 0xd5, 0xd7, 0x1d, 0xaf, 0x86, 0x1d, 0xb3, 0x6e, 0x53, 0xcb, 0xdb, 0x22, 0xfe,
+	0xae, 0x49, 0x89, 0xbf, 0x45, 0x7c, 0xaa, 0x1f, 0xe7, 0x86, 0xfe, 0x48, 0x39, 0x0c, 0x8d, 0x41,
+	0x84, 0xb7, 0xff, 0x86, 0xf3, 0x4d, 0xbb, 0xee, 0x4a, 0x84, 0xb7, 0x43, 0xe3, 0xdc, 0x7a, 0x42,
+	0xf3, 0x02, 0xd7, 0x22, 0x31, 0xd0, 0x09, 0x8d, 0x57, 0xb9, 0xc1, 0x32, 0x54, 0x62, 0x77, 0x7b,
+	0xbf, 0x3a, 0x24, 0x63, 0xed, 0xec, 0x57, 0xe5, 0x13, 0xe4, 0x1d, 0x95, 0xd9, 0x86, 0x86, 0x23,
+	0xc1, 0xb9, 0xc4, 0xa9, 0x98, 0xae, 0xfd, 0x41, 0xe6, 0x30, 0x71, 0x71, 0xcd, 0x21, 0x75, 0xfd,
+	0xc4, 0x98, 0x32, 0xfe, 0xcc, 0xcc, 0x67, 0xe0, 0xf0, 0xd9, 0x54, 0xe3, 0x9d, 0x08, 0x2c, 0x7b,
+	0x1b, 0x03, 0x9d, 0xd0, 0x78, 0x59, 0xe2, 0x6d, 0x8c, 0x0a, 0xee, 0x32, 0x3f, 0x20, 0xe0, 0x6b,
+	0x17, 0x35, 0xdd, 0x80, 0xa3, 0xfd, 0xea, 0x53, 0x20, 0xba, 0x77, 0x50, 0x2d, 0x19, 0x55, 0x72,
+	0x33, 0xa6, 0x6b, 0xbf, 0x55, 0xd4, 0x11, 0xc7, 0xb3, 0xa4, 0x5e, 0x3e, 0xc5, 0xbd, 0xfc, 0x16,
+	0x78, 0xd9, 0xbf, 0xe8, 0x59, 0xa2, 0xbe, 0x76, 0x68, 0x0c, 0x39, 0x9e, 0x55, 0xb2, 0xa1, 0x13,
+	0x1a, 0x2f, 0x45, 0x5b, 0xd0, 0xb3, 0x9e, 0xc4, 0x45, 0xb9, 0x92, 0x2e, 0x74, 0xc1, 0xc1, 0xa2,
+	0x3d, 0xe8, 0x1c, 0x17, 0x28, 0xb9, 0xf7, 0x2b, 0x45, 0x1d, 0x8c, 0xdc, 0xc3, 0xb1, 0x2e, 0xb3,
+	0xe5, 0xf9, 0x4c, 0x3f, 0x39, 0xa6, 0x8c, 0x9f, 0x9c, 0xf9, 0x3f, 0x70, 0xad, 0x37, 0x51, 0xb5,
+	0xec, 0xf9, 0xac, 0x1d, 0x1a, 0x03, 0xb9, 0xa9, 0x81, 0xd8, 0x09, 0x8d, 0x17, 0xcb, 0x4e, 0x01,
+	0x22, 0x78, 0x34, 0x35, 0x39, 0x31, 0xf5, 0x7a, 0xe5, 0x28, 0x34, 0x4e, 0xd8, 0x2e, 0x6b, 0xef,
+	0x57, 0x25, 0x6a, 0x64, 0xc4, 0xa3, 0xfd, 0xea, 0x49, 0x2e, 0xba, 0x77, 0x50, 0xcd, 0x59, 0x82,
+	0xca, 0xbc, 0xda, 0x47, 0xc7, 0xd5, 0xb1, 0x82, 0x37, 0xcd, 0xc0, 0x61, 0xb6, 0x85, 0x29, 0x4b,
+	0xe2, 0x86, 0x7e, 0x6a, 0x4c, 0x19, 0x3f, 0x3d, 0xf3, 0x13, 0x70, 0xad, 0x2f, 0x51, 0xb8, 0x34,
+	0x0b, 0x27, 0xb9, 0x1d, 0x1a, 0x83, 0x39, 0xa5, 0x11, 0xb9, 0x13, 0x1a, 0x37, 0xcb, 0xee, 0x45,
+	0x98, 0xe0, 0xe0, 0x3f, 0x34, 0x1a, 0x93, 0x53, 0xb7, 0x6f, 0xdf, 0xba, 0x7e, 0xeb, 0xc6, 0x3f,
+	0xde, 0x8e, 0xbc, 0x6d, 0xef, 0x57, 0xa5, 0x0a, 0xe5, 0xe4, 0xa3, 0xfd, 0xaa, 0x56, 0x56, 0xb2,
+	0x77, 0x50, 0x2d, 0x98, 0x89, 0x9e, 0xcb, 0x0b, 0x27, 0x1e, 0xc6, 0xc1, 0x48, 0x7b, 0xa0, 0x9e,
+	0x69, 0xe2, 0x1d, 0x93, 0x12, 0xb7, 0x6e, 0x6e, 0xd6, 0x5a, 0x54, 0x7f, 0x9a, 0x7f, 0xcc, 0x57,
+	0xda, 0xa1, 0xd1, 0xd3, 0xc4, 0x3b, 0x2b, 0xc4, 0xad, 0xdf, 0xab, 0xb5, 0x20, 0xb8, 0x0c, 0x70,
+	0xb7, 0x04, 0x5a, 0xf2, 0x7d, 0x90, 0xc8, 0x98, 0x28, 0xf4, 0x89, 0xb5, 0x15, 0x29, 0x7c, 0x26,
+	0xa7, 0x10, 0x11, 0x6b, 0xab, 0xa8, 0x30, 0xa1, 0xe5, 0x14, 0x26, 0x44, 0xed, 0xc7, 0x8a, 0x3a,
+	0xe2, 0x13, 0xcb, 0x73, 0x5d, 0x62, 0x41, 0x78, 0x37, 0x6d, 0x97, 0x11, 0x7f, 0x0b, 0x3b, 0x26,
+	0xd5, 0x4f, 0x73, 0xdd, 0xff, 0xcc, 0x83, 0x7a, 0xc2, 0xb2, 0x10, 0xc3, 0x2b, 0x10, 0x3b, 0x44,
+	0xc1, 0x14, 0xe8, 0x84, 0xc6, 0x38, 0x9f, 0x5b, 0x8a, 0x0a, 0x5f, 0xe9, 0xe6, 0x44, 0x62, 0xd2,
+	0xd1, 0x7e, 0xf5, 0xf8, 0xcd, 0x09, 0x1e, 0xdf, 0x4b, 0xf3, 0x20, 0xf9, 0x2c, 0x5a, 0x43, 0xed,
+	0xf3, 0x89, 0x83, 0x77, 0x69, 0x1a, 0x03, 0x54, 0x1e, 0x03, 0xde, 0x6c, 0x87, 0xc6, 0x99, 0x08,
+	0xc9, 0x0e, 0x7a, 0x25, 0x36, 0x48, 0xa0, 0x16, 0x4f, 0x78, 0x72, 0x62, 0x51, 0x5e, 0x58, 0xfb,
+	0xf0, 0xb8, 0x7a, 0x21, 0x9e, 0x28, 0x35, 0x24, 0x5b, 0xa4, 0xa6, 0xde, 0xc3, 0x17, 0xe9, 0xe7,
+	0xb0, 0x87, 0x47, 0x10, 0xf0, 0x95, 0x5c, 0x58, 0x6a, 0x87, 0xc6, 0x88, 0x2f, 0x87, 0xd2, 0x40,
+	0xdb, 0x05, 0x17, 0xac, 0x9c, 0x9c, 0x10, 0x8e, 0x6c, 0x57, 0x7d, 0xdd, 0x21, 0x58, 0xe4, 0x49,
+	0x58, 0xe4, 0x6e, 0x66, 0x22, 0x3d, 0xf2, 0xb3, 0x8c, 0x68, 0x35, 0xf5, 0x0c, 0x65, 0xd8, 0x67,
+	0x66, 0xcd, 0xf7, 0xb6, 0x29, 0xf1, 0xf5, 0x5e, 0xbe, 0xd6, 0x7f, 0xd5, 0x0e, 0x8d, 0x5e, 0x0e,
+	0xcc, 0x44, 0xf4, 0x4e, 0x68, 0x3c, 0xcf, 0xdd, 0x11, 0x89, 0x5d, 0x57, 0x3a, 0x27, 0xaa, 0x7d,
+	0x47, 0x51, 0xcf, 0xb9, 0x98, 0x99, 0xcc, 0xc7, 0x70, 0xab, 0x61, 0x27, 0xfd, 0xb0, 0x7d, 0x7c,
+	0xb2, 0x77, 0x0e, 0x43, 0x43, 0xbd, 0x3f, 0xbd, 0x9a, 0x85, 0x75, 0xd5, 0xc5, 0x2c, 0xfb, 0xc6,
+	0x06, 0x9f, 0x38, 0x23, 0x49, 0x42, 0xb8, 0x28, 0x90, 0x1b, 0x09, 0xe1, 0x5a, 0x98, 0x02, 0x0d,
+	0xba, 0x98, 0xad, 0x26, 0xe6, 0x24, 0x1b, 0xe2, 0xa7, 0x25, 0x3b, 0x1d, 0x82, 0x29, 0x31, 0x9b,
+	0x7a, 0x3f, 0xdf, 0x0a, 0x1f, 0xc3, 0x56, 0x38, 0x7d, 0x7f, 0x7a, 0x75, 0x11, 0xc8, 0xf0, 0xf1,
+	0xfb, 0x5d, 0xcc, 0xa2, 0x81, 0xed, 0x06, 0x8c, 0xd0, 0x74, 0x43, 0x16, 0xe8, 0xd2, 0xb3, 0xd1,
+	0xde, 0xaf, 0x96, 0xe4, 0xcb, 0xa4, 0xf4, 0x04, 0x65, 0x13, 0x23, 0x4d, 0xb4, 0x3e, 0xa2, 0x69,
+	0xbf, 0x54, 0xd4, 0x91, 0xbc, 0xf1, 0x3e, 0x71, 0xc9, 0x36, 0xdf, 0xc9, 0x67, 0xb9, 0xf9, 0x7b,
+	0x60, 0x7e, 0xcf, 0xfd, 0xe9, 0x55, 0x14, 0x01, 0xe0, 0xc0, 0x80, 0x8b, 0x59, 0x32, 0x4c, 0x5d,
+	0xa8, 0x26, 0x2e, 0xe4, 0x11, 0xc1, 0x89, 0xeb, 0xa2, 0x13, 0x12, 0x1d, 0x32, 0x22, 0x38, 0x72,
+	0x1d, 0x1c, 0x11, 0x4d, 0x40, 0x43, 0xa2, 0x2b, 0x09, 0x55, 0xe2, 0x0c, 0xb3, 0x9b, 0xc4, 0x0b,
+	0x98, 0x49, 0xf5, 0x81, 0xbc, 0x33, 0xab, 0x11, 0xb0, 0x12, 0x3b, 0x93, 0x0c, 0x61, 0xa7, 0xd7,
+	0x73, 0xce, 0xe4, 0x91, 0x6e, 0xc7, 0x4f, 0xa2, 0x43, 0x46, 0x4c, 0x8f, 0x9c, 0x68, 0x42, 0xde,
+	0x99, 0x84, 0xaa, 0xfd, 0xbf, 0xa2, 0xea, 0x01, 0xc5, 0xeb, 0xc4, 0xf4, 0x09, 0xdc, 0xfb, 0xb6,
+	0xbb, 0x6e, 0x62, 0xcb, 0x22, 0x2d, 0x46, 0xea, 0xba, 0xc6, 0xbd, 0xc1, 0x70, 0x02, 0xd6, 0xd0,
+	0x74, 0x4c, 0x85, 0x13, 0x10, 0xf8, 0xc9, 0xa8, 0x13, 0x1a, 0x67, 0xb9, 0x13, 0x19, 0x49, 0x30,
+	0x58, 0x64, 0xcc, 0x8d, 0x60, 0xc7, 0x67, 0x2a, 0xd1, 0x30, 0x37, 0x01, 0x25, 0x16, 0x24, 0x74,
+	0xed, 0x3d, 0x75, 0xa8, 0x68, 0x1c, 0x25, 0xc4, 0xd5, 0x07, 0xb9, 0x61, 0x0b, 0x87, 0xa1, 0x71,
+	0x6a, 0x0d, 0xad, 0x10, 0xe2, 0xb6, 0x43, 0xe3, 0x54, 0xe0, 0xc3, 0xaf, 0x4e, 0x68, 0xf4, 0xc6,
+	0x06, 0xc1, 0x50, 0x30, 0x26, 0x61, 0x48, 0x7f, 0xed, 0x1d, 0x54, 0x63, 0x71, 0xa4, 0xe5, 0x0d,
+	0x00, 0x9a, 0xf6, 0xdf, 0x8a, 0xfa, 0x6c, 0x71, 0xf6, 0xc0, 0xb5, 0xdf, 0x09, 0x88, 0x69, 0xd7,
+	0xf5, 0x21, 0x9e, 0x44, 0xbc, 0x1d, 0xad, 0xcd, 0x1a, 0x27, 0x2f, 0xcc, 0x45, 0x6b, 0x13, 0x8f,
+	0xc4, 0xb5, 0x49, 0x18, 0x2a, 0xd1, 0xa2, 0x24, 0xc3, 0x8e, 0x38, 0x8a, 0x17, 0x25, 0xc1, 0x8a,
+	0x8b, 0x92, 0x70, 0x69, 0x5f, 0x2a, 0xea, 0x60, 0xc9, 0x2e, 0xdf, 0xd1, 0xcf, 0x71, 0x8b, 0xfe,
+	0x1d, 0xf6, 0xde, 0xc9, 0x35, 0xb4, 0x86, 0x16, 0xdb, 0xa1, 0x71, 0x32, 0xf0, 0xd7, 0xd0, 0x62,
+	0x27, 0x34, 0x6e, 0x25, 0x86, 0xa0, 0x45, 0x61, 0x77, 0x6d, 0x30, 0xd6, 0xa2, 0xb7, 0xaf, 0xf1,
+	0xb2, 0xec, 0x2a, 0xdd, 0x75, 0x2d, 0xb6, 0x01, 0x15, 0x9f, 0x4b, 0xd8, 0x35, 0x97, 0x6c, 0x03,
+	0x15, 0x0c, 0x8e, 0x95, 0x24, 0x3f, 0x8e, 0xf6, 0xab, 0x4f, 0x20, 0xb8, 0x77, 0x50, 0x8d, 0xac,
+	0x40, 0x03, 0x05, 0x3f, 0x7c, 0x47, 0xfb, 0xbd, 0xa2, 0x1a, 0x45, 0x17, 0x5a, 0x1e, 0x85, 0x1b,
+	0x8e, 0x12, 0x2b, 0xf0, 0x89, 0xb3, 0xab, 0x0f, 0xf3, 0xf0, 0xfb, 0xbf, 0xbc, 0x82, 0x58, 0x43,
+	0xcb, 0x1e, 0x65, 0x0b, 0x29, 0xd8, 0x0e, 0x8d, 0xb3, 0x81, 0x9f, 0xa7, 0x75, 0x42, 0xe3, 0x85,
+	0xd8, 0xc9, 0x3c, 0x20, 0xf8, 0xdb, 0xc0, 0x0e, 0xe5, 0x21, 0xb9, 0x2c, 0x2d, 0xa1, 0x41, 0xe6,
+	0xc9, 0x25, 0xa0, 0x5e, 0x28, 0x9a, 0x80, 0x2e, 0xe6, 0xdd, 0xca, 0xa3, 0xda, 0xef, 0x24, 0x1e,
+	0xda, 0xae, 0xcd, 0x6c, 0xa8, 0x23, 0xe0, 0xbe, 0x33, 0xa9, 0x3e, 0xc2, 0x77, 0xf1, 0xff, 0xf0,
+	0xea, 0x61, 0x0d, 0x2d, 0x44, 0xe8, 0x1c, 0x80, 0x10, 0x30, 0xfa, 0x03, 0x3f, 0x47, 0x4a, 0xc3,
+	0x45, 0x81, 0x2e, 0x06, 0x8b, 0x5b, 0x13, 0xb9, 0x00, 0x5e, 0xd4, 0x50, 0x26, 0xc1, 0x0d, 0x04,
+	0x52, 0x50, 0x30, 0x14, 0x4c, 0x40, 0x17, 0xf2, 0x0e, 0xe6, 0x40, 0xed, 0x13, 0x45, 0x1d, 0xc1,
+	0x01, 0xf3, 0xcc, 0xa0, 0xb5, 0xee, 0xe3, 0x3a, 0xc9, 0x72, 0x93, 0x0d, 0xfd, 0x59, 0xee, 0xd7,
+	0x32, 0x54, 0x40, 0xc0, 0xb2, 0x16, 0x71, 0x24, 0xd7, 0xfa, 0xdd, 0xb4, 0x58, 0x90, 0x81, 0xa2,
+	0x37, 0x53, 0x62, 0xa2, 0x36, 0x39, 0x85, 0xa4, 0xda, 0xb4, 0xa6, 0x3a, 0x92, 0xd8, 0xc0, 0x3c,
+	0xb3, 0xe5, 0xc3, 0x8a, 0xf3, 0xab, 0x91, 0xea, 0xe7, 0xf9, 0x16, 0xba, 0x09, 0x86, 0xc4, 0x2c,
+	0xab, 0xde, 0xb2, 0x4f, 0x50, 0x8c, 0x77, 0x42, 0xe3, 0x7c, 0xb4, 0xa2, 0x12, 0xb0, 0x82, 0xa4,
+	0x32, 0xda, 0x96, 0xaa, 0x6d, 0x12, 0xd2, 0x32, 0x19, 0x69, 0xb6, 0x3c, 0x1f, 0xfb, 0x36, 0xa1,
+	0xe6, 0x86, 0x7e, 0x81, 0xbb, 0x7c, 0x17, 0xf6, 0x25, 0xa0, 0xab, 0x19, 0x08, 0xee, 0x5e, 0xe2,
+	0xb3, 0x14, 0x01, 0xb1, 0x34, 0xba, 0x21, 0xba, 0x3a, 0x75, 0x03, 0x95, 0xb4, 0x68, 0xbb, 0xea,
+	0xa0, 0x85, 0xad, 0x0d, 0x62, 0xda, 0xeb, 0xae, 0xe7, 0x93, 0xba, 0x09, 0x1d, 0x16, 0xaa, 0x5f,
+	0xe4, 0x2e, 0x2e, 0xc0, 0x05, 0xc3, 0xe1, 0x85, 0x08, 0x9d, 0x07, 0x30, 0x5d, 0xe8, 0x12, 0x52,
+	0x3a, 0x12, 0xe9, 0x56, 0x47, 0x65, 0x35, 0xda, 0x7f, 0x2a, 0xea, 0xf9, 0x96, 0xef, 0xad, 0x43,
+	0x6d, 0x61, 0x06, 0xad, 0x3a, 0x66, 0x44, 0xcc, 0xd7, 0x9f, 0xe3, 0xbe, 0xaf, 0x42, 0xba, 0x99,
+	0x70, 0xad, 0x71, 0x26, 0x31, 0x37, 0x8f, 0x6a, 0xde, 0x2e, 0xb8, 0x60, 0xce, 0x6b, 0xc2, 0x42,
+	0x28, 0xaf, 0xa1, 0x6e, 0x1a, 0xb5, 0x0f, 0x15, 0x75, 0xd8, 0xb1, 0x9b, 0x36, 0x33, 0xd3, 0xae,
+	0x93, 0x69, 0xbb, 0xa6, 0x83, 0x5d, 0x7d, 0x94, 0x2f, 0xc9, 0x12, 0xaf, 0xe5, 0x80, 0x63, 0x26,
+	0x61, 0x58, 0x70, 0x17, 0xb1, 0x9b, 0xd5, 0xdf, 0x65, 0xec, 0x11, 0xcb, 0x22, 0x53, 0xa5, 0x7d,
+	0xa0, 0xa8, 0x5a, 0xd3, 0x76, 0xcd, 0x0d, 0xaf, 0x49, 0xa0, 0x3b, 0xb0, 0x69, 0x36, 0x7c, 0x42,
+	0x74, 0x63, 0x4c, 0x19, 0xef, 0x99, 0xea, 0xbd, 0x1a, 0xf5, 0xbc, 0xae, 0xae, 0xd8, 0xef, 0x92,
+	0x99, 0x3b, 0x5f, 0x85, 0xc6, 0x31, 0x38, 0xd5, 0x4d, 0xdb, 0xbd, 0xeb, 0x35, 0xc9, 0x9c, 0x4d,
+	0x37, 0xe7, 0x7d, 0x42, 0xd2, 0xdd, 0x51, 0xa0, 0x8b, 0xe7, 0x60, 0xec, 0x32, 0x18, 0x72, 0x62,
+	0x72, 0xec, 0x32, 0x2a, 0x8a, 0x6b, 0x0f, 0x15, 0xb5, 0x37, 0xd9, 0xef, 0xfc, 0x16, 0x18, 0xe3,
+	0xb7, 0xc0, 0xcf, 0x78, 0x06, 0x92, 0x6c, 0xda, 0xe8, 0x2e, 0xe8, 0xf1, 0xb3, 0x61, 0x27, 0x34,
+	0xe6, 0x92, 0x02, 0x20, 0xa1, 0x49, 0xee, 0x85, 0xf8, 0x04, 0xd0, 0x42, 0x88, 0x6f, 0x12, 0x86,
+	0xaf, 0xfe, 0x13, 0xf5, 0x5c, 0x08, 0xa5, 0x39, 0xb5, 0xf9, 0xe1, 0xd1, 0x7e, 0x75, 0xfc, 0x49,
+	0x55, 0x41, 0xba, 0x22, 0xd8, 0x8b, 0x32, 0x3d, 0xbe, 0xa3, 0xfd, 0x9d, 0x3a, 0x80, 0x9d, 0x6d,
+	0x28, 0x86, 0xa2, 0xe2, 0xde, 0x25, 0x8c, 0xea, 0xcf, 0xf3, 0x9e, 0x1a, 0xd4, 0xa0, 0xfd, 0x11,
+	0xc8, 0x8b, 0xe4, 0xfb, 0x84, 0xc1, 0xc6, 0x1f, 0x8a, 0x22, 0x4c, 0x8e, 0x5e, 0x41, 0x45, 0x46,
+	0xed, 0x4f, 0x8a, 0x3a, 0x0e, 0xed, 0x90, 0x6d, 0xdf, 0x66, 0x10, 0x38, 0x9a, 0x1e, 0x23, 0x66,
+	0x9d, 0x6c, 0xd9, 0x16, 0x31, 0x5d, 0xdc, 0x24, 0xd4, 0xf4, 0x5c, 0x33, 0xae, 0x4b, 0xf4, 0x4a,
+	0xd6, 0xed, 0x19, 0x79, 0x90, 0x08, 0x21, 0x2e, 0x33, 0x47, 0xb6, 0xee, 0x03, 0x7b, 0x3b, 0x34,
+	0x2e, 0x79, 0x25, 0xc8, 0xb6, 0x08, 0x47, 0x1f, 0xb8, 0xb3, 0x91, 0xaa, 0x4e, 0x68, 0xbc, 0xc1,
+	0x0d, 0x7c, 0x02, 0xde, 0xee, 0x9b, 0x12, 0x8a, 0xaa, 0x2e, 0x76, 0xa0, 0x27, 0xb1, 0x42, 0xfb,
+	0x17, 0xf5, 0x1c, 0x84, 0x31, 0xd3, 0x76, 0xeb, 0x64, 0xc7, 0x84, 0x9d, 0x5c, 0x73, 0x3c, 0x6b,
+	0x93, 0xea, 0x97, 0xf8, 0x91, 0x86, 0x4d, 0xa3, 0x01, 0xc3, 0x02, 0xe0, 0x4b, 0xb6, 0x3b, 0xc3,
+	0xd1, 0xb4, 0x89, 0x5a, 0x86, 0xa4, 0x89, 0x6b, 0x94, 0x8e, 0x22, 0x89, 0x26, 0xed, 0x37, 0x90,
+	0x7d, 0xba, 0xd0, 0x0b, 0xae, 0x9b, 0xae, 0xc7, 0xec, 0x86, 0x6d, 0xe1, 0xa8, 0x1d, 0x50, 0xa7,
+	0x7a, 0x95, 0x7f, 0xdf, 0x4f, 0x61, 0xb9, 0x87, 0xd7, 0x22, 0xa6, 0xfb, 0x02, 0xcf, 0xc2, 0x1c,
+	0xac, 0xf6, 0x70, 0x20, 0x45, 0x3a, 0xa1, 0x71, 0x21, 0x0a, 0xed, 0x32, 0x98, 0xb7, 0x0e, 0xa5,
+	0x48, 0x67, 0xbf, 0xda, 0x45, 0xe3, 0xde, 0x41, 0xb5, 0x8b, 0x15, 0x48, 0x2a, 0x51, 0xa7, 0x1a,
+	0x52, 0xcf, 0x30, 0x1f, 0x37, 0x1a, 0xb6, 0x65, 0x5a, 0x0e, 0xa6, 0x54, 0xbf, 0xcc, 0x97, 0xf5,
+	0x0a, 0x94, 0xaf, 0x31, 0x30, 0x0b, 0xf4, 0x4e, 0x68, 0x68, 0xd1, 0x82, 0x0a, 0xc4, 0xb4, 0x6f,
+	0x92, 0x63, 0xd5, 0xde, 0x53, 0x07, 0xe3, 0x25, 0x36, 0x1b, 0x9e, 0x53, 0x27, 0xbe, 0xd9, 0xc2,
+	0x6c, 0x43, 0x7f, 0x81, 0x9f, 0xfa, 0x7b, 0x87, 0xa1, 0x71, 0x61, 0x8e, 0xb4, 0x7c, 0x62, 0x61,
+	0x46, 0xea, 0x73, 0x11, 0xe3, 0x3c, 0xe7, 0x5b, 0xc6, 0x6c, 0xa3, 0x1d, 0x1a, 0xca, 0x95, 0xb4,
+	0x58, 0xae, 0x17, 0xe1, 0x57, 0xbd, 0xa6, 0x0d, 0x1f, 0x89, 0xed, 0x56, 0x74, 0x05, 0x0d, 0x94,
+	0x70, 0x6d, 0x53, 0x3d, 0x4b, 0x09, 0x33, 0x1d, 0x6f, 0xdb, 0x6c, 0xf9, 0xb6, 0xe7, 0xdb, 0x6c,
+	0x57, 0x7f, 0x91, 0x1f, 0x8a, 0xe9, 0x76, 0x68, 0xf4, 0x51, 0xc2, 0x16, 0xbd, 0xed, 0xe5, 0x18,
+	0x49, 0x23, 0x5b, 0x9e, 0xdc, 0xb5, 0x2c, 0x2f, 0x88, 0x6b, 0x9f, 0x29, 0xea, 0x30, 0x34, 0x9d,
+	0x62, 0x37, 0x2d, 0xcf, 0xb5, 0x02, 0xdf, 0x27, 0xae, 0xb5, 0xab, 0x8f, 0xf3, 0x75, 0xa4, 0xbc,
+	0xf7, 0x81, 0xb7, 0x97, 0xf0, 0x4e, 0x64, 0xe3, 0x6c, 0xc6, 0x02, 0x57, 0x7e, 0x53, 0x42, 0x4f,
+	0xaf, 0x7c, 0x19, 0x98, 0x2c, 0x39, 0x6f, 0x56, 0xc8, 0xf5, 0x22, 0xa9, 0x56, 0xe8, 0x11, 0x0f,
+	0x5a, 0x3e, 0xa6, 0x1b, 0x85, 0x94, 0xfc, 0x25, 0xfe, 0x59, 0x3e, 0xe7, 0x29, 0xf9, 0x6c, 0x92,
+	0x92, 0x5b, 0x71, 0x4a, 0x3e, 0x1f, 0xdd, 0xcd, 0x20, 0x96, 0x25, 0xc7, 0xd2, 0x30, 0xcc, 0x79,
+	0xca, 0x69, 0x36, 0x27, 0xc3, 0x5e, 0x1e, 0x28, 0x29, 0x81, 0x64, 0xdd, 0x8a, 0x93, 0xf5, 0xea,
+	0x93, 0xa8, 0x81, 0x74, 0x7d, 0x36, 0x4a, 0xd7, 0x0b, 0xca, 0x7c, 0x47, 0xfb, 0xa6, 0xa2, 0x8e,
+	0x14, 0xdd, 0x4b, 0xba, 0x24, 0x2f, 0xf3, 0xef, 0x6f, 0x43, 0xf3, 0x61, 0x16, 0x09, 0x0d, 0xfe,
+	0xbc, 0x96, 0x62, 0x83, 0x5f, 0x8a, 0x76, 0xdb, 0x1a, 0xd0, 0x5f, 0x48, 0x75, 0x23, 0xb9, 0x66,
+	0xed, 0x5f, 0x15, 0x75, 0x98, 0xb2, 0xc0, 0x35, 0x21, 0x73, 0xc2, 0x8e, 0xbd, 0x45, 0xcc, 0xa8,
+	0x77, 0x44, 0xf5, 0x57, 0xd2, 0x7c, 0x74, 0x10, 0x38, 0xee, 0x25, 0x0c, 0x2b, 0x80, 0xaf, 0xa4,
+	0x59, 0x92, 0x04, 0xcb, 0xe7, 0xd6, 0x42, 0x40, 0x3b, 0x31, 0x79, 0x6b, 0x02, 0xc9, 0xb4, 0x41,
+	0xc9, 0x5a, 0x30, 0x03, 0xe2, 0x2a, 0xd5, 0x5f, 0xe5, 0x46, 0xbc, 0x05, 0x89, 0x5a, 0x4e, 0x6c,
+	0xc9, 0x76, 0xb3, 0xd4, 0xbe, 0x84, 0x88, 0x39, 0x62, 0x2e, 0xa0, 0x4e, 0x4d, 0xa0, 0xb2, 0x1e,
+	0xc8, 0xca, 0x7b, 0xf9, 0xec, 0xc9, 0xbb, 0xd3, 0x15, 0x1e, 0x43, 0xeb, 0xd0, 0xe9, 0x46, 0x78,
+	0x7b, 0x85, 0x05, 0xc2, 0x8b, 0x53, 0x0f, 0xcd, 0x86, 0x69, 0x6f, 0x28, 0xa3, 0x3d, 0xf6, 0x55,
+	0xac, 0xa0, 0x11, 0x89, 0xfa, 0xb4, 0x2d, 0xb5, 0x3f, 0x79, 0xeb, 0x33, 0xa3, 0x77, 0x44, 0xfd,
+	0xea, 0x98, 0x32, 0xde, 0x37, 0xd5, 0x97, 0xa4, 0x45, 0xab, 0x9c, 0xca, 0x9b, 0x79, 0x7d, 0x09,
+	0x6b, 0x44, 0x4b, 0x23, 0x47, 0x9e, 0x5c, 0x19, 0xf3, 0x09, 0xff, 0xa4, 0xf1, 0xf6, 0xf8, 0xe0,
+	0xa0, 0xaa, 0xa0, 0x82, 0xa8, 0xf6, 0x5f, 0xc7, 0xd5, 0x4b, 0x10, 0x35, 0xd2, 0x70, 0x01, 0x35,
+	0xa5, 0xe5, 0x35, 0x61, 0xcb, 0xfa, 0xe4, 0x9d, 0x80, 0x50, 0x66, 0x6e, 0xda, 0x35, 0xfd, 0x1a,
+	0xff, 0x1c, 0xbf, 0x50, 0xe2, 0xa7, 0xc3, 0x25, 0xbc, 0x33, 0xbb, 0x80, 0x22, 0xfc, 0x9e, 0x3d,
+	0xd3, 0x0e, 0x0d, 0xa3, 0x89, 0x77, 0xd2, 0x23, 0xce, 0x16, 0x62, 0x1d, 0x19, 0x4b, 0x7a, 0x0b,
+	0x3e, 0x86, 0x4f, 0xa8, 0xc7, 0x1e, 0xab, 0xf2, 0xf1, 0x2c, 0xf1, 0x63, 0x64, 0xc1, 0x5c, 0xf4,
+	0x18, 0xb1, 0x1a, 0xbc, 0xd5, 0x0d, 0xa7, 0x2f, 0x22, 0x0e, 0x16, 0xdf, 0x50, 0x27, 0xf8, 0x01,
+	0xfe, 0x02, 0x56, 0x62, 0x28, 0x79, 0x51, 0x58, 0x9c, 0xbe, 0x2f, 0x3e, 0xa3, 0x0e, 0x61, 0x09,
+	0x3d, 0x4d, 0xa4, 0x65, 0xa0, 0xec, 0x21, 0x4b, 0xaa, 0xa4, 0x0b, 0x5d, 0x38, 0xfa, 0x52, 0xa3,
+	0x50, 0x26, 0x85, 0x85, 0x37, 0xd8, 0x2d, 0xf5, 0x3c, 0x7f, 0xf4, 0x68, 0x04, 0x8e, 0x13, 0x67,
+	0x35, 0x9e, 0x9b, 0x94, 0xa8, 0xfa, 0x24, 0xf7, 0xf4, 0x36, 0x64, 0x0d, 0xc0, 0x35, 0x1f, 0x38,
+	0x0e, 0xcf, 0x47, 0x1e, 0xb8, 0x71, 0x51, 0xd9, 0x09, 0x8d, 0x8b, 0xf1, 0x95, 0x25, 0x83, 0x2b,
+	0xa8, 0x8b, 0x9c, 0xf6, 0x96, 0x7a, 0xa6, 0x41, 0x30, 0x0b, 0x7c, 0x62, 0x36, 0x1c, 0xbc, 0x4e,
+	0xf5, 0x29, 0x7e, 0xee, 0x2e, 0xc3, 0x4d, 0x1f, 0x03, 0xf3, 0x40, 0x4f, 0x1f, 0x48, 0x04, 0x62,
+	0x05, 0xe5, 0x58, 0xb4, 0x6d, 0x75, 0x44, 0x78, 0x17, 0x89, 0x6a, 0x1c, 0xe2, 0x7a, 0xc1, 0xfa,
+	0x86, 0x7e, 0x9d, 0x6f, 0xda, 0x37, 0x79, 0x78, 0x4d, 0x59, 0x16, 0x81, 0xe3, 0x0e, 0x67, 0x48,
+	0xb3, 0x1e, 0x29, 0x9a, 0x66, 0x14, 0x72, 0x61, 0x6d, 0x53, 0x1d, 0x2a, 0x4d, 0xdc, 0xc4, 0x3b,
+	0xfa, 0x0d, 0x3e, 0xeb, 0x1b, 0x90, 0x0c, 0x16, 0x04, 0x97, 0xf0, 0x4e, 0x27, 0x34, 0x74, 0xd9,
+	0x94, 0x4b, 0x78, 0x27, 0x9d, 0x4f, 0x22, 0xa6, 0x7d, 0x72, 0x5c, 0x35, 0x92, 0x66, 0x8f, 0x89,
+	0x1d, 0x48, 0x29, 0x3c, 0xa7, 0x6e, 0x32, 0x87, 0x9a, 0x10, 0x3f, 0x6c, 0xcf, 0xa5, 0xfa, 0x6b,
+	0xfc, 0x7b, 0x7d, 0x09, 0x3b, 0xf3, 0x42, 0xd2, 0x5a, 0x99, 0x06, 0xd6, 0x07, 0x4e, 0x7d, 0x75,
+	0x71, 0xe5, 0x6f, 0x63, 0xbe, 0x76, 0x68, 0x5c, 0xb0, 0xbb, 0xc3, 0x69, 0xbe, 0xf3, 0x08, 0x1e,
+	0xd8, 0x9f, 0x8f, 0xd4, 0xf1, 0x68, 0x78, 0xef, 0xa0, 0xfa, 0x28, 0x03, 0x51, 0x59, 0xd6, 0xa1,
+	0x09, 0xa8, 0xbd, 0xaf, 0x6a, 0x59, 0x09, 0x1b, 0xff, 0x73, 0x82, 0xea, 0x37, 0xc7, 0x4e, 0x8c,
+	0xf7, 0x4c, 0xe9, 0x49, 0xb0, 0x4c, 0x0b, 0xcf, 0xe5, 0x88, 0x61, 0xe6, 0x7a, 0x5c, 0x4f, 0x0e,
+	0xd4, 0x0a, 0x08, 0xf8, 0x3b, 0xcc, 0xfd, 0x2d, 0x22, 0x15, 0x54, 0x66, 0xd6, 0x3e, 0x52, 0xd4,
+	0xb3, 0x69, 0xa0, 0x8e, 0xff, 0x95, 0xa1, 0xbf, 0xce, 0x23, 0xf5, 0x48, 0x32, 0xf9, 0x5c, 0x8c,
+	0xcf, 0x44, 0x30, 0xdf, 0x80, 0xfd, 0xf5, 0x3c, 0x31, 0xbd, 0xc2, 0x0a, 0x74, 0x69, 0xd0, 0x2e,
+	0x0a, 0x6b, 0x1f, 0x2b, 0xea, 0x70, 0x34, 0x99, 0xb9, 0x61, 0x53, 0xe6, 0xf1, 0x27, 0x76, 0x06,
+	0x8d, 0x0f, 0xfd, 0x56, 0xd6, 0x4c, 0x8a, 0x38, 0xee, 0x46, 0x0c, 0x77, 0x22, 0x3c, 0xeb, 0x71,
+	0x48, 0xc0, 0x47, 0x95, 0x23, 0x52, 0x6d, 0xda, 0xfb, 0x6a, 0x6f, 0xd0, 0x72, 0x5b, 0x69, 0x76,
+	0xf3, 0xdd, 0x79, 0xbe, 0x07, 0xff, 0xfe, 0x30, 0x34, 0xce, 0x65, 0x89, 0xf5, 0xda, 0xb2, 0xbb,
+	0x9c, 0xa5, 0x3a, 0xca, 0x95, 0xf4, 0xdc, 0x81, 0x6c, 0x0c, 0x08, 0xc9, 0xf4, 0xde, 0x41, 0x55,
+	0x2e, 0xac, 0x2b, 0xa8, 0x47, 0x10, 0xd1, 0xbe, 0xad, 0xc4, 0xd3, 0x27, 0x4f, 0x3b, 0x9f, 0xcd,
+	0x73, 0xef, 0x3f, 0xe0, 0xc1, 0x39, 0xaf, 0x22, 0x7d, 0xe6, 0xe1, 0xd3, 0x8f, 0xa5, 0xd3, 0x8b,
+	0xcf, 0x33, 0x82, 0x0d, 0xd9, 0x2d, 0x74, 0xbe, 0x3b, 0x17, 0x44, 0x5b, 0xd9, 0x2c, 0xba, 0x82,
+	0xd4, 0x4c, 0x4a, 0xfb, 0xa1, 0xa2, 0xf6, 0x71, 0x33, 0xb3, 0x47, 0x9c, 0xef, 0x45, 0x86, 0xfe,
+	0x1b, 0x2f, 0xd6, 0xf2, 0x2a, 0x84, 0x07, 0x1d, 0xe5, 0x4a, 0x9a, 0x67, 0x80, 0x7c, 0xfe, 0x09,
+	0x46, 0x6a, 0xec, 0xc5, 0x47, 0xf1, 0x41, 0x49, 0x26, 0x9f, 0x4b, 0x57, 0x50, 0xaf, 0x28, 0x99,
+	0x99, 0x9c, 0x3d, 0xd5, 0x7c, 0xde, 0xdd, 0x64, 0xe1, 0xd9, 0xa6, 0x60, 0x72, 0xfe, 0xa1, 0xa5,
+	0xbb, 0xc9, 0xdd, 0xf8, 0xca, 0x26, 0x27, 0x9c, 0x89, 0xc9, 0xc9, 0x58, 0x6b, 0xa8, 0xd1, 0x93,
+	0x70, 0x9a, 0xcb, 0x7d, 0x7f, 0x9e, 0x5f, 0x2a, 0x7f, 0x9d, 0xb7, 0x97, 0xbf, 0xaa, 0x66, 0x49,
+	0x9d, 0xb0, 0x19, 0xfd, 0x0c, 0xc9, 0x57, 0x76, 0xbd, 0x02, 0x42, 0x79, 0x27, 0xad, 0xdc, 0xc4,
+	0x32, 0x5b, 0x16, 0xd3, 0xbf, 0x80, 0x25, 0x52, 0x66, 0x96, 0x0e, 0x43, 0xe3, 0x62, 0x36, 0xe3,
+	0x52, 0xbe, 0x05, 0xb5, 0x6c, 0xb1, 0xfc, 0x3a, 0x35, 0x4b, 0x78, 0x7e, 0x7a, 0xad, 0xcc, 0x00,
+	0x89, 0xeb, 0x50, 0x21, 0x6d, 0xa3, 0x16, 0x76, 0xa9, 0xfe, 0x83, 0xe8, 0x2b, 0xad, 0x16, 0x4c,
+	0x10, 0xd3, 0x9d, 0x15, 0x60, 0x2c, 0x98, 0x50, 0xc2, 0xcb, 0x9f, 0x8a, 0x5b, 0x52, 0xe2, 0x9b,
+	0xb9, 0xf7, 0xd5, 0xd7, 0xa3, 0xc7, 0x0e, 0xbe, 0x1e, 0x3d, 0xf6, 0xd5, 0xe1, 0xa8, 0x72, 0x70,
+	0x38, 0xaa, 0xfc, 0xc7, 0xc3, 0xd1, 0x63, 0x9f, 0x3e, 0x1c, 0x55, 0x0e, 0x1e, 0x8e, 0x1e, 0xfb,
+	0xf5, 0xc3, 0xd1, 0x63, 0x6f, 0xbf, 0xb4, 0x6e, 0xb3, 0x8d, 0xa0, 0x76, 0xd5, 0xf2, 0x9a, 0xd7,
+	0xd2, 0x62, 0x4a, 0xf8, 0x95, 0xfd, 0xdd, 0xad, 0x76, 0x8a, 0xff, 0xa9, 0xed, 0xfa, 0x5f, 0x06,
+	0x00, 0x6c, 0x09, 0x82, 0x00, 0x85, 0x27, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -435,6 +454,36 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.ConfigHistoryEntries != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConfigHistoryEntries))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.DatabaseBackend != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.DatabaseBackend))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.BandwidthProfiles) > 0 {
+		for iNdEx := len(m.BandwidthProfiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthProfiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if m.InsecureAllowOldTLSVersions {
 		i--
 		if m.InsecureAllowOldTLSVersions {
@@ -1076,6 +1125,18 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.InsecureAllowOldTLSVersions {
 		n += 3
 	}
+	if len(m.BandwidthProfiles) > 0 {
+		for _, e := range m.BandwidthProfiles {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.DatabaseBackend != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.DatabaseBackend))
+	}
+	if m.ConfigHistoryEntries != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ConfigHistoryEntries))
+	}
 	if m.DeprecatedUPnPEnabled {
 		n += 4
 	}
@@ -2292,6 +2353,78 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.InsecureAllowOldTLSVersions = bool(v != 0)
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthProfiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthProfiles = append(m.BandwidthProfiles, BandwidthProfile{})
+			if err := m.BandwidthProfiles[len(m.BandwidthProfiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseBackend", wireType)
+			}
+			m.DatabaseBackend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseBackend |= DatabaseBackend(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHistoryEntries", wireType)
+			}
+			m.ConfigHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigHistoryEntries |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
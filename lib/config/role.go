@@ -0,0 +1,43 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (r Role) String() string {
+	switch r {
+	case RoleReadOnly:
+		return "read-only"
+	case RoleOperator:
+		return "operator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+func (r Role) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Role) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "admin":
+		*r = RoleAdmin
+	case "operator":
+		*r = RoleOperator
+	default:
+		// Anything unknown gets the least access
+		*r = RoleReadOnly
+	}
+	return nil
+}
+
+// Includes returns true if the role grants at least the access of the
+// other role.
+func (r Role) Includes(other Role) bool {
+	return r >= other
+}
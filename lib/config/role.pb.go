@@ -0,0 +1,75 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/role.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role is the level of access to the GUI and REST API. Each role includes
+// the access of those before it.
+type Role int32
+
+const (
+	RoleReadOnly Role = 0
+	RoleOperator Role = 1
+	RoleAdmin    Role = 2
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_READ_ONLY",
+	1: "ROLE_OPERATOR",
+	2: "ROLE_ADMIN",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_READ_ONLY": 0,
+	"ROLE_OPERATOR":  1,
+	"ROLE_ADMIN":     2,
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4da481ac1794d2a6, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.Role", Role_name, Role_value)
+}
+
+func init() { proto.RegisterFile("lib/config/role.proto", fileDescriptor_4da481ac1794d2a6) }
+
+var fileDescriptor_4da481ac1794d2a6 = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcd, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2f, 0xca, 0xcf, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x62, 0x83, 0x08, 0x49, 0x29, 0x17, 0xa5, 0x16, 0xe4, 0x17, 0xeb, 0x83, 0x05, 0x93,
+	0x4a, 0xd3, 0xf4, 0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c, 0x30, 0x0b, 0xa2, 0x58, 0x8a, 0x33, 0xb5,
+	0xa2, 0x04, 0xc2, 0xd4, 0x2a, 0xe3, 0x62, 0x09, 0xca, 0xcf, 0x49, 0x15, 0x52, 0xe1, 0xe2, 0x0b,
+	0xf2, 0xf7, 0x71, 0x8d, 0x0f, 0x72, 0x75, 0x74, 0x89, 0xf7, 0xf7, 0xf3, 0x89, 0x14, 0x60, 0x90,
+	0x12, 0xe8, 0x9a, 0xab, 0xc0, 0x03, 0x92, 0x0d, 0x4a, 0x4d, 0x4c, 0xf1, 0xcf, 0xcb, 0xa9, 0x14,
+	0x52, 0xe6, 0xe2, 0x05, 0xab, 0xf2, 0x0f, 0x70, 0x0d, 0x72, 0x0c, 0xf1, 0x0f, 0x12, 0x60, 0x44,
+	0x28, 0xf2, 0x2f, 0x48, 0x2d, 0x4a, 0x2c, 0xc9, 0x2f, 0x12, 0x92, 0xe5, 0xe2, 0x02, 0x2b, 0x72,
+	0x74, 0xf1, 0xf5, 0xf4, 0x13, 0x60, 0x92, 0xe2, 0xed, 0x9a, 0xab, 0xc0, 0x09, 0x52, 0xe1, 0x98,
+	0x92, 0x9b, 0x99, 0x27, 0xc5, 0xb2, 0x62, 0x89, 0x1c, 0x83, 0x93, 0xf7, 0x89, 0x87, 0x72, 0x0c,
+	0x17, 0x1e, 0xca, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
+	0x0c, 0x0b, 0x1e, 0xcb, 0x31, 0x5e, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66,
+	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x71, 0x65, 0x5e, 0x72, 0x49,
+	0x46, 0x66, 0x5e, 0x3a, 0x12, 0x0b, 0x11, 0x0e, 0x49, 0x6c, 0x60, 0xbf, 0x18, 0x03, 0x06, 0x00,
+	0x89, 0x10, 0x34, 0x0d, 0x1c, 0x01, 0x00, 0x00,
+}
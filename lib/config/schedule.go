@@ -0,0 +1,212 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+var errInvalidScheduleTime = errors.New("invalid time of day, expected HH:MM")
+
+func (s Schedule) Copy() Schedule {
+	c := s
+	c.Windows = make([]ScheduleWindow, len(s.Windows))
+	copy(c.Windows, s.Windows)
+	return c
+}
+
+// IsEnabled returns true when the schedule restricts activity to its
+// windows.
+func (s Schedule) IsEnabled() bool {
+	return len(s.Windows) > 0
+}
+
+// Allows returns true if activity is allowed at the given time, i.e. if
+// the schedule is disabled or the time falls within one of the windows.
+// Invalid windows never match.
+func (s Schedule) Allows(t time.Time) bool {
+	if !s.IsEnabled() {
+		return true
+	}
+	for _, w := range s.Windows {
+		pw, err := w.parse()
+		if err != nil {
+			continue
+		}
+		if pw.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextChange returns the first point in time after t at which the result
+// of Allows changes, or the zero time if it never does.
+func (s Schedule) NextChange(t time.Time) time.Time {
+	if !s.IsEnabled() {
+		return time.Time{}
+	}
+
+	// Window boundaries are the only points where the result can change.
+	// Collect them for the coming week (plus a day for windows that
+	// started yesterday) and check them in order.
+	var candidates []time.Time
+	for _, w := range s.Windows {
+		pw, err := w.parse()
+		if err != nil {
+			continue
+		}
+		for day := -1; day <= 7; day++ {
+			start, end := pw.bounds(t, day)
+			if !pw.days[start.Weekday()] {
+				continue
+			}
+			candidates = append(candidates, start, end)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	current := s.Allows(t)
+	for _, c := range candidates {
+		if c.After(t) && s.Allows(c) != current {
+			return c
+		}
+	}
+	return time.Time{}
+}
+
+// Validate returns an error describing the first invalid window, if any.
+func (s Schedule) Validate() error {
+	for i, w := range s.Windows {
+		if _, err := w.parse(); err != nil {
+			return fmt.Errorf("schedule window %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+type parsedWindow struct {
+	days       [7]bool
+	start, end time.Duration // offsets from midnight
+}
+
+func (w ScheduleWindow) parse() (parsedWindow, error) {
+	var pw parsedWindow
+	var err error
+	if pw.days, err = parseWeekdays(w.Days); err != nil {
+		return pw, err
+	}
+	if pw.start, err = parseTimeOfDay(w.Start); err != nil {
+		return pw, fmt.Errorf("start: %w", err)
+	}
+	if pw.end, err = parseTimeOfDay(w.End); err != nil {
+		return pw, fmt.Errorf("end: %w", err)
+	}
+	return pw, nil
+}
+
+// bounds returns the start and end of the window instance starting on the
+// day the given number of days away from t.
+func (pw parsedWindow) bounds(t time.Time, days int) (time.Time, time.Time) {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d+days, 0, 0, 0, 0, t.Location())
+	start := midnight.Add(pw.start)
+	end := midnight.Add(pw.end)
+	if pw.end <= pw.start {
+		// Extends into the next day
+		end = time.Date(y, m, d+days+1, 0, 0, 0, 0, t.Location()).Add(pw.end)
+	}
+	return start, end
+}
+
+func (pw parsedWindow) contains(t time.Time) bool {
+	// A window instance may have started today or, when it crosses
+	// midnight, yesterday.
+	for _, day := range []int{0, -1} {
+		start, end := pw.bounds(t, day)
+		if !pw.days[start.Weekday()] {
+			continue
+		}
+		if !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWeekdays parses a comma separated list of weekdays or weekday
+// ranges, such as "mon-fri" or "mon,wed,sat-sun". The empty string means
+// every day.
+func parseWeekdays(s string) ([7]bool, error) {
+	var days [7]bool
+	s = strings.TrimSpace(strings.ToLower(s))
+	if s == "" || s == "*" {
+		for i := range days {
+			days[i] = true
+		}
+		return days, nil
+	}
+
+	for _, part := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		first, ok := weekdayNames[shortWeekday(from)]
+		if !ok {
+			return days, fmt.Errorf("invalid weekday %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = weekdayNames[shortWeekday(to)]; !ok {
+				return days, fmt.Errorf("invalid weekday %q", to)
+			}
+		}
+		for d := first; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func shortWeekday(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > 3 {
+		return s[:3]
+	}
+	return s
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	var h, m int
+	if n, err := fmt.Sscanf(strings.TrimSpace(s), "%d:%d", &h, &m); err != nil || n != 2 {
+		return 0, errInvalidScheduleTime
+	}
+	if h == 24 && m == 0 {
+		return 24 * time.Hour, nil
+	}
+	if h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, errInvalidScheduleTime
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleAllows(t *testing.T) {
+	// 2026-10-19 is a Monday
+	at := func(day int, hhmm string) time.Time {
+		tod, err := parseTimeOfDay(hhmm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return time.Date(2026, 10, 19+day, 0, 0, 0, 0, time.Local).Add(tod)
+	}
+
+	offHours := Schedule{Windows: []ScheduleWindow{{Days: "mon-fri", Start: "22:00", End: "06:00"}}}
+	weekend := Schedule{Windows: []ScheduleWindow{{Days: "sat,sun", Start: "00:00", End: "00:00"}}}
+	both := Schedule{Windows: append(offHours.Windows, weekend.Windows...)}
+
+	cases := []struct {
+		sched   Schedule
+		at      time.Time
+		allowed bool
+	}{
+		{Schedule{}, at(0, "12:00"), true},
+		{offHours, at(0, "12:00"), false},
+		{offHours, at(0, "21:59"), false},
+		{offHours, at(0, "22:00"), true},
+		{offHours, at(1, "05:59"), true},
+		{offHours, at(1, "06:00"), false},
+		// Friday night's window extends into Saturday morning, but
+		// Sunday night has no window.
+		{offHours, at(5, "03:00"), true},
+		{offHours, at(6, "23:00"), false},
+		{offHours, at(7, "03:00"), false},
+		{weekend, at(4, "23:59"), false},
+		{weekend, at(5, "12:00"), true},
+		{weekend, at(6, "23:59"), true},
+		{weekend, at(7, "00:00"), false},
+		{both, at(6, "12:00"), true},
+		{both, at(5, "03:00"), true},
+		{both, at(2, "12:00"), false},
+		{Schedule{Windows: []ScheduleWindow{{Start: "bogus", End: "06:00"}}}, at(0, "03:00"), false},
+	}
+
+	for i, tc := range cases {
+		if res := tc.sched.Allows(tc.at); res != tc.allowed {
+			t.Errorf("%d: Allows(%v) = %v, expected %v", i, tc.at, res, tc.allowed)
+		}
+	}
+}
+
+func TestScheduleNextChange(t *testing.T) {
+	// 2026-10-19 is a Monday
+	monday := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+
+	if next := (Schedule{}).NextChange(monday); !next.IsZero() {
+		t.Error("Expected no change for an empty schedule, got", next)
+	}
+
+	offHours := Schedule{Windows: []ScheduleWindow{{Days: "mon-fri", Start: "22:00", End: "06:00"}}}
+	cases := []struct {
+		from, next time.Time
+	}{
+		{monday, time.Date(2026, 10, 19, 22, 0, 0, 0, time.Local)},
+		{time.Date(2026, 10, 19, 22, 0, 0, 0, time.Local), time.Date(2026, 10, 20, 6, 0, 0, 0, time.Local)},
+		// From Saturday morning, the next window opens on Monday evening
+		{time.Date(2026, 10, 24, 6, 0, 0, 0, time.Local), time.Date(2026, 10, 26, 22, 0, 0, 0, time.Local)},
+	}
+	for i, tc := range cases {
+		if next := offHours.NextChange(tc.from); !next.Equal(tc.next) {
+			t.Errorf("%d: NextChange(%v) = %v, expected %v", i, tc.from, next, tc.next)
+		}
+	}
+
+	// Adjacent windows don't cause a change at their common boundary
+	adjacent := Schedule{Windows: []ScheduleWindow{{Start: "08:00", End: "12:00"}, {Start: "12:00", End: "16:00"}}}
+	if next := adjacent.NextChange(time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local)); !next.Equal(time.Date(2026, 10, 19, 16, 0, 0, 0, time.Local)) {
+		t.Error("Unexpected next change for adjacent windows:", next)
+	}
+
+	// A schedule covering all of the time never changes
+	always := Schedule{Windows: []ScheduleWindow{{Start: "00:00", End: "00:00"}}}
+	if next := always.NextChange(monday); !next.IsZero() {
+		t.Error("Expected no change for an always open schedule, got", next)
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	valid := []ScheduleWindow{
+		{Start: "00:00", End: "24:00"},
+		{Days: "Monday-Friday", Start: "9:00", End: "17:30"},
+		{Days: "fri-mon", Start: "22:00", End: "06:00"},
+		{Days: "*", Start: "01:00", End: "02:00"},
+	}
+	for _, w := range valid {
+		if err := (Schedule{Windows: []ScheduleWindow{w}}).Validate(); err != nil {
+			t.Errorf("Unexpected error for %v: %v", w, err)
+		}
+	}
+
+	invalid := []ScheduleWindow{
+		{Start: "", End: "06:00"},
+		{Start: "25:00", End: "06:00"},
+		{Start: "22:00", End: "06:60"},
+		{Days: "someday", Start: "22:00", End: "06:00"},
+		{Days: "mon-", Start: "22:00", End: "06:00"},
+	}
+	for _, w := range invalid {
+		if err := (Schedule{Windows: []ScheduleWindow{w}}).Validate(); err == nil {
+			t.Errorf("Expected error for %v", w)
+		}
+	}
+}
@@ -24,3 +24,12 @@ func TestTuningMatches(t *testing.T) {
 		t.Error("mismatch for TuningLarge")
 	}
 }
+
+func TestDatabaseBackendMatches(t *testing.T) {
+	if int(config.DatabaseBackendLevelDB) != int(backend.TypeLevelDB) {
+		t.Error("mismatch for DatabaseBackendLevelDB")
+	}
+	if int(config.DatabaseBackendPebble) != int(backend.TypePebble) {
+		t.Error("mismatch for DatabaseBackendPebble")
+	}
+}
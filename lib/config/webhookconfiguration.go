@@ -0,0 +1,119 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/util"
+)
+
+// DefaultWebhookEventMask is the events sent to webhooks that don't list
+// any: those sent by the events API by default, except ConfigSaved, whose
+// data is the whole configuration.
+const DefaultWebhookEventMask = events.AllEvents &^ events.LocalChangeDetected &^ events.RemoteChangeDetected &^ events.ConfigSaved
+
+func (w WebhookConfiguration) Copy() WebhookConfiguration {
+	c := w
+	c.Events = make([]string, len(w.Events))
+	copy(c.Events, w.Events)
+	c.Folders = make([]string, len(w.Folders))
+	copy(c.Folders, w.Folders)
+	c.Devices = make([]string, len(w.Devices))
+	copy(c.Devices, w.Devices)
+	return c
+}
+
+func (w *WebhookConfiguration) UnmarshalJSON(data []byte) error {
+	util.SetDefaults(w)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(w)
+	return json.Unmarshal(data, ptr)
+}
+
+func (w *WebhookConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	util.SetDefaults(w)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(w)
+	return d.DecodeElement(ptr, &start)
+}
+
+// EventMask returns the events to send to the webhook.
+func (w WebhookConfiguration) EventMask() events.EventType {
+	if len(w.Events) == 0 {
+		return DefaultWebhookEventMask
+	}
+	var mask events.EventType
+	for _, ev := range w.Events {
+		mask |= events.UnmarshalEventType(ev)
+	}
+	return mask
+}
+
+func (w WebhookConfiguration) Timeout() time.Duration {
+	return time.Duration(w.TimeoutS) * time.Second
+}
+
+// RetryInterval returns how long to wait before the given retry, starting
+// at one. The interval doubles for every attempt, up to the maximum.
+func (w WebhookConfiguration) RetryInterval(retry int) time.Duration {
+	interval := time.Duration(w.RetryIntervalS) * time.Second
+	max := time.Duration(w.MaxRetryIntervalS) * time.Second
+	for i := 1; i < retry && interval < max; i++ {
+		interval *= 2
+	}
+	if interval > max {
+		interval = max
+	}
+	return interval
+}
+
+func (cfg *Configuration) prepareWebhooks() {
+	seen := make(map[string]struct{}, len(cfg.Webhooks))
+	webhooks := cfg.Webhooks[:0]
+	for _, w := range cfg.Webhooks {
+		w.ID = strings.TrimSpace(w.ID)
+		if w.ID == "" {
+			l.Warnln("Ignoring webhook without an ID")
+			continue
+		}
+		if _, ok := seen[w.ID]; ok {
+			l.Warnf("Ignoring duplicate webhook %q", w.ID)
+			continue
+		}
+		if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			l.Warnf("Ignoring webhook %q with invalid URL %q", w.ID, w.URL)
+			continue
+		}
+		seen[w.ID] = struct{}{}
+
+		for _, ev := range w.Events {
+			if events.UnmarshalEventType(ev) == 0 {
+				l.Warnf("Webhook %q: unknown event type %q", w.ID, ev)
+			}
+		}
+		if w.TimeoutS <= 0 {
+			w.TimeoutS = 10
+		}
+		if w.MaxRetries < 0 {
+			w.MaxRetries = 0
+		}
+		if w.RetryIntervalS <= 0 {
+			w.RetryIntervalS = 10
+		}
+		if w.MaxRetryIntervalS < w.RetryIntervalS {
+			w.MaxRetryIntervalS = w.RetryIntervalS
+		}
+		webhooks = append(webhooks, w)
+	}
+	cfg.Webhooks = webhooks
+}
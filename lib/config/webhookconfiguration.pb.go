@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/webhookconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WebhookConfiguration describes an HTTP endpoint that events are POSTed
+// to as they happen.
+type WebhookConfiguration struct {
+	ID      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr"`
+	URL     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url" xml:"url"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled" xml:"enabled,attr" default:"true"`
+	// Event type names; all events are sent when empty.
+	Events []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events" xml:"event"`
+	// Only events concerning one of these folders or devices are sent, when
+	// set.
+	Folders []string `protobuf:"bytes,5,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices []string `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
+	// When set, the payload is signed with HMAC-SHA256 in the
+	// X-Syncthing-Signature header.
+	Secret            string `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret" xml:"secret,omitempty"`
+	TimeoutS          int    `protobuf:"varint,8,opt,name=timeout_s,json=timeoutS,proto3,casttype=int" json:"timeoutS" xml:"timeoutS" default:"10"`
+	MaxRetries        int    `protobuf:"varint,9,opt,name=max_retries,json=maxRetries,proto3,casttype=int" json:"maxRetries" xml:"maxRetries" default:"5"`
+	RetryIntervalS    int    `protobuf:"varint,10,opt,name=retry_interval_s,json=retryIntervalS,proto3,casttype=int" json:"retryIntervalS" xml:"retryIntervalS" default:"10"`
+	MaxRetryIntervalS int    `protobuf:"varint,11,opt,name=max_retry_interval_s,json=maxRetryIntervalS,proto3,casttype=int" json:"maxRetryIntervalS" xml:"maxRetryIntervalS" default:"600"`
+}
+
+func (m *WebhookConfiguration) Reset()         { *m = WebhookConfiguration{} }
+func (m *WebhookConfiguration) String() string { return proto.CompactTextString(m) }
+func (*WebhookConfiguration) ProtoMessage()    {}
+func (*WebhookConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4505edde0bb42548, []int{0}
+}
+func (m *WebhookConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebhookConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebhookConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookConfiguration.Merge(m, src)
+}
+func (m *WebhookConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WebhookConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*WebhookConfiguration)(nil), "config.WebhookConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/webhookconfiguration.proto", fileDescriptor_4505edde0bb42548)
+}
+
+var fileDescriptor_4505edde0bb42548 = []byte{
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0xd9, 0x36, 0xdb, 0x4c, 0xab, 0xd4, 0x50, 0x24, 0xa8, 0x64, 0xd6, 0x90, 0xc2,
+	0x0a, 0xa5, 0x4d, 0xd1, 0x16, 0xd9, 0x8b, 0xb0, 0xf6, 0x60, 0xd1, 0x83, 0x4c, 0x29, 0x82, 0x1e,
+	0x96, 0x64, 0x33, 0x6d, 0x07, 0xf3, 0xa7, 0x24, 0x93, 0xba, 0x3d, 0xf8, 0x1d, 0xa4, 0x9f, 0xc0,
+	0x6f, 0xe1, 0x57, 0xe8, 0x6d, 0xf7, 0xe8, 0x69, 0xa0, 0xbb, 0xb7, 0x1c, 0x73, 0xec, 0x49, 0x66,
+	0x26, 0x69, 0xd3, 0x2a, 0x9e, 0xf2, 0xbe, 0xcf, 0x3b, 0xcf, 0xef, 0x99, 0x09, 0xc3, 0x80, 0xf5,
+	0x90, 0xf8, 0x5b, 0xa3, 0x24, 0x3e, 0x22, 0xc7, 0x5b, 0xdf, 0xb0, 0x7f, 0x92, 0x24, 0x5f, 0x65,
+	0x97, 0xa7, 0x1e, 0x25, 0x49, 0xbc, 0x79, 0x9a, 0x26, 0x34, 0x31, 0x34, 0x29, 0x3e, 0xd1, 0xf1,
+	0x98, 0x4a, 0xc9, 0xfe, 0xd5, 0x01, 0x6b, 0x9f, 0xa4, 0xe3, 0x6d, 0xd3, 0x61, 0xec, 0x01, 0x95,
+	0x04, 0xa6, 0xd2, 0x55, 0x7a, 0xfa, 0xe0, 0xd5, 0x8c, 0x41, 0x75, 0x7f, 0xaf, 0x60, 0x50, 0x25,
+	0x41, 0xc9, 0xe0, 0x83, 0x71, 0x14, 0xf6, 0x6d, 0x12, 0x6c, 0x78, 0x94, 0xa6, 0x76, 0x31, 0x71,
+	0x3a, 0x55, 0x5d, 0x4e, 0x1c, 0x95, 0x04, 0x17, 0x53, 0x47, 0xdd, 0xdf, 0x43, 0x2a, 0x09, 0x8c,
+	0x01, 0x68, 0xe7, 0x69, 0x68, 0xaa, 0x02, 0xe3, 0xce, 0x18, 0x6c, 0x1f, 0xa2, 0x0f, 0x05, 0x83,
+	0x5c, 0x2d, 0x19, 0xd4, 0x05, 0x28, 0x4f, 0x43, 0x0e, 0x11, 0x9a, 0xfc, 0x5c, 0x4c, 0x1d, 0xbe,
+	0x10, 0xf1, 0xda, 0xf0, 0x41, 0x07, 0xc7, 0x9e, 0x1f, 0xe2, 0xc0, 0x6c, 0x77, 0x95, 0xde, 0xd2,
+	0xe0, 0x5d, 0xc1, 0x60, 0x2d, 0x95, 0x0c, 0x3e, 0x17, 0x90, 0xaa, 0x97, 0x5b, 0xea, 0x06, 0xf8,
+	0xc8, 0xcb, 0x43, 0xda, 0xb7, 0x69, 0x9a, 0x63, 0x0e, 0x5f, 0x69, 0xce, 0xaf, 0x27, 0xce, 0x02,
+	0x1f, 0xa0, 0x9a, 0x62, 0xf4, 0x81, 0x86, 0xcf, 0x70, 0x4c, 0x33, 0x73, 0xa1, 0xdb, 0xee, 0xe9,
+	0x03, 0xbb, 0x60, 0xb0, 0x52, 0x4a, 0x06, 0x97, 0x65, 0x02, 0x6f, 0x39, 0x6b, 0x51, 0x54, 0xa8,
+	0x9a, 0x1b, 0x6f, 0x40, 0xe7, 0x28, 0x09, 0x03, 0x9c, 0x66, 0xe6, 0xa2, 0x30, 0xaf, 0xf3, 0xfd,
+	0x55, 0x52, 0xc9, 0xe0, 0x8a, 0x70, 0xcb, 0x9e, 0xdb, 0x35, 0x59, 0xa2, 0x7a, 0x09, 0x07, 0x04,
+	0xf8, 0x8c, 0x8c, 0x70, 0x66, 0x6a, 0xb7, 0x80, 0x4a, 0xba, 0x01, 0xc8, 0x5e, 0x00, 0x64, 0x89,
+	0xea, 0x25, 0xc6, 0x47, 0xa0, 0x65, 0x78, 0x94, 0x62, 0x6a, 0x76, 0xc4, 0x8f, 0x7e, 0xcd, 0x77,
+	0x2f, 0x95, 0x92, 0xc1, 0xc7, 0xc2, 0x2e, 0xdb, 0x8d, 0x24, 0x22, 0x14, 0x47, 0xa7, 0xf4, 0x9c,
+	0x83, 0x56, 0xef, 0x8b, 0xa8, 0x72, 0x19, 0x87, 0x40, 0xa7, 0x24, 0xc2, 0x49, 0x4e, 0x87, 0x99,
+	0xb9, 0xd4, 0x55, 0x7a, 0x8b, 0x02, 0xba, 0x54, 0x89, 0x07, 0x25, 0x83, 0x4f, 0x05, 0xb6, 0x16,
+	0x1a, 0xbf, 0x7c, 0xdb, 0xb5, 0xaf, 0x19, 0x6c, 0x93, 0x98, 0x5e, 0x4f, 0x1c, 0x75, 0xdb, 0x45,
+	0x37, 0x2e, 0xe3, 0x0b, 0x58, 0x8e, 0xbc, 0xf1, 0x30, 0xc5, 0x34, 0x25, 0x38, 0x33, 0x75, 0x01,
+	0xee, 0x17, 0x0c, 0x82, 0xc8, 0x1b, 0x23, 0xa9, 0x96, 0x0c, 0x3e, 0x13, 0xe8, 0x5b, 0xa9, 0x01,
+	0xdf, 0x69, 0xb0, 0x95, 0x1d, 0xd4, 0xf0, 0x19, 0x31, 0x58, 0xe5, 0xe0, 0xf3, 0x21, 0x89, 0x29,
+	0x4e, 0xcf, 0xbc, 0x70, 0x98, 0x99, 0x40, 0x24, 0xf0, 0x9b, 0xfb, 0x50, 0xcc, 0xf6, 0xab, 0xd1,
+	0xc1, 0xcd, 0xbd, 0xb9, 0x2b, 0xff, 0xef, 0x18, 0xf7, 0x08, 0xc6, 0x77, 0xb0, 0x56, 0x1f, 0xe6,
+	0x4e, 0xe6, 0xb2, 0xc8, 0xe4, 0xb7, 0xfc, 0x51, 0xb5, 0xbb, 0x3b, 0xb1, 0xeb, 0xcd, 0xc3, 0xfd,
+	0x33, 0x79, 0xd7, 0x6d, 0x46, 0xb7, 0x77, 0x5d, 0x17, 0xfd, 0x4d, 0x1a, 0xbc, 0xbf, 0xbc, 0xb2,
+	0x5a, 0xd3, 0x2b, 0xab, 0x75, 0x39, 0xb3, 0x94, 0xe9, 0xcc, 0x52, 0x7e, 0xcc, 0xad, 0xd6, 0xcf,
+	0xb9, 0xa5, 0x4c, 0xe7, 0x56, 0xeb, 0xf7, 0xdc, 0x6a, 0x7d, 0x7e, 0x71, 0x4c, 0xe8, 0x49, 0xee,
+	0x6f, 0x8e, 0x92, 0x68, 0x2b, 0x3b, 0x8f, 0x47, 0xf4, 0x84, 0xc4, 0xc7, 0x8d, 0xea, 0xf6, 0xd1,
+	0xf0, 0x35, 0xf1, 0x1a, 0xbc, 0xfc, 0x33, 0x00, 0x32, 0x1d, 0x7a, 0xed, 0x49, 0x04, 0x00, 0x00,
+}
+
+func (m *WebhookConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetryIntervalS != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.MaxRetryIntervalS))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetryIntervalS != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.RetryIntervalS))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutS != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.TimeoutS))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebhookconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebhookconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WebhookConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	if m.TimeoutS != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.TimeoutS))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.MaxRetries))
+	}
+	if m.RetryIntervalS != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.RetryIntervalS))
+	}
+	if m.MaxRetryIntervalS != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.MaxRetryIntervalS))
+	}
+	return n
+}
+
+func sovWebhookconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebhookconfiguration(x uint64) (n int) {
+	return sovWebhookconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WebhookConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebhookconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutS", wireType)
+			}
+			m.TimeoutS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIntervalS", wireType)
+			}
+			m.RetryIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryIntervalS", wireType)
+			}
+			m.MaxRetryIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebhookconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWebhookconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWebhookconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWebhookconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWebhookconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWebhookconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWebhookconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWebhookconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWebhookconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -94,11 +94,14 @@ type Wrapper interface {
 	Save() error
 
 	Modify(ModifyFunction) (Waiter, error)
+	ModifyWithOrigin(origin string, fn ModifyFunction) (Waiter, error)
+	ModifyCommitted(origin string, fn ModifyFunction) (Waiter, Configuration, Configuration, error)
 	RemoveFolder(id string) (Waiter, error)
 	RemoveDevice(id protocol.DeviceID) (Waiter, error)
 
 	GUI() GUIConfiguration
 	LDAP() LDAPConfiguration
+	OIDC() OIDCConfiguration
 	Options() OptionsConfiguration
 	DefaultIgnores() Ignores
 
@@ -117,6 +120,9 @@ type Wrapper interface {
 	IgnoredDevice(id protocol.DeviceID) bool
 	IgnoredFolder(device protocol.DeviceID, folder string) bool
 
+	History() []HistoryEntry
+	HistoryConfig(revision string) (Configuration, bool)
+
 	Subscribe(c Committer) Configuration
 	Unsubscribe(c Committer)
 
@@ -134,6 +140,10 @@ type wrapper struct {
 	subs   []Committer
 	mut    sync.Mutex
 
+	history      []historyEntry
+	historyFile  string // where to keep the history, if anywhere
+	historyDirty bool   // history changed since last save
+
 	requiresRestart uint32 // an atomic bool
 }
 
@@ -142,6 +152,11 @@ type wrapper struct {
 // The returned Wrapper is a suture.Service, thus needs to be started (added to
 // a supervisor).
 func Wrap(path string, cfg Configuration, myID protocol.DeviceID, evLogger events.Logger) Wrapper {
+	return newWrapper(path, cfg, myID, evLogger, "")
+}
+
+// newWrapper is Wrap, keeping the history in the given file if it's not empty.
+func newWrapper(path string, cfg Configuration, myID protocol.DeviceID, evLogger events.Logger, historyFile string) *wrapper {
 	w := &wrapper{
 		cfg:      cfg,
 		path:     path,
@@ -150,6 +165,14 @@ func Wrap(path string, cfg Configuration, myID protocol.DeviceID, evLogger event
 		queue:    make(chan modifyEntry, maxModifications),
 		waiter:   noopWaiter{}, // Noop until first config change
 		mut:      sync.NewMutex(),
+
+		historyFile: historyFile,
+	}
+	if historyFile != "" {
+		w.history = loadHistory(historyFile)
+	}
+	if n := len(w.history); n == 0 || w.history[n-1].Revision != cfg.Revision() {
+		w.recordLocked(OriginStartup, cfg)
 	}
 	return w
 }
@@ -170,7 +193,7 @@ func Load(path string, myID protocol.DeviceID, evLogger events.Logger) (Wrapper,
 		return nil, 0, err
 	}
 
-	return Wrap(path, cfg, myID, evLogger), originalVersion, nil
+	return newWrapper(path, cfg, myID, evLogger, historyPath(path)), originalVersion, nil
 }
 
 func (w *wrapper) ConfigPath() string {
@@ -219,21 +242,38 @@ func (w *wrapper) RawCopy() Configuration {
 }
 
 func (w *wrapper) Modify(fn ModifyFunction) (Waiter, error) {
-	return w.modifyQueued(fn)
+	res := w.modifyQueued("", fn, false)
+	return res.w, res.err
+}
+
+// ModifyWithOrigin is like Modify, recording the origin of the change in
+// the history.
+func (w *wrapper) ModifyWithOrigin(origin string, fn ModifyFunction) (Waiter, error) {
+	res := w.modifyQueued(origin, fn, false)
+	return res.w, res.err
 }
 
-func (w *wrapper) modifyQueued(modifyFunc ModifyFunction) (Waiter, error) {
+// ModifyCommitted is like ModifyWithOrigin, also returning the
+// configuration before and after the change as committed. They are the
+// same if nothing was changed.
+func (w *wrapper) ModifyCommitted(origin string, fn ModifyFunction) (Waiter, Configuration, Configuration, error) {
+	res := w.modifyQueued(origin, fn, true)
+	return res.w, res.from, res.to, res.err
+}
+
+func (w *wrapper) modifyQueued(origin string, modifyFunc ModifyFunction, wantChange bool) modifyResult {
 	e := modifyEntry{
+		origin:     origin,
 		modifyFunc: modifyFunc,
+		wantChange: wantChange,
 		res:        make(chan modifyResult),
 	}
 	select {
 	case w.queue <- e:
 	default:
-		return noopWaiter{}, errTooManyModifications
+		return modifyResult{w: noopWaiter{}, err: errTooManyModifications}
 	}
-	res := <-e.res
-	return res.w, res.err
+	return <-e.res
 }
 
 func (w *wrapper) Serve(ctx context.Context) error {
@@ -254,8 +294,7 @@ func (w *wrapper) Serve(ctx context.Context) error {
 			return ctx.Err()
 		}
 
-		var waiter Waiter = noopWaiter{}
-		var err error
+		res := modifyResult{w: noopWaiter{}}
 
 		// Let the caller modify the config.
 		to := w.RawCopy()
@@ -263,19 +302,26 @@ func (w *wrapper) Serve(ctx context.Context) error {
 
 		// Check if the config was actually changed at all.
 		w.mut.Lock()
+		if e.wantChange {
+			res.from = w.cfg.Copy()
+		}
 		if !reflect.DeepEqual(w.cfg, to) {
-			waiter, err = w.replaceLocked(to)
+			res.w, res.err = w.replaceLocked(to)
+			if res.err == nil {
+				w.recordLocked(e.origin, w.cfg)
+			}
 			if !saveTimerRunning {
 				saveTimer.Reset(minSaveInterval)
 				saveTimerRunning = true
 			}
 		}
+		if e.wantChange {
+			res.to = w.cfg.Copy()
+		}
 		w.mut.Unlock()
 
-		e.res <- modifyResult{
-			w:   waiter,
-			err: err,
-		}
+		e.res <- res
+		waiter := res.w
 
 		// Wait for all subscriber to handle the config change before continuing
 		// to process the next change.
@@ -364,10 +410,8 @@ func (w *wrapper) DeviceList() []DeviceConfiguration {
 
 // RemoveDevice removes the device from the configuration
 func (w *wrapper) RemoveDevice(id protocol.DeviceID) (Waiter, error) {
-	return w.modifyQueued(func(cfg *Configuration) {
-		if _, i, ok := cfg.Device(id); ok {
-			cfg.Devices = append(cfg.Devices[:i], cfg.Devices[i+1:]...)
-		}
+	return w.Modify(func(cfg *Configuration) {
+		cfg.RemoveDevice(id)
 	})
 }
 
@@ -397,10 +441,8 @@ func (w *wrapper) FolderList() []FolderConfiguration {
 
 // RemoveFolder removes the folder from the configuration
 func (w *wrapper) RemoveFolder(id string) (Waiter, error) {
-	return w.modifyQueued(func(cfg *Configuration) {
-		if _, i, ok := cfg.Folder(id); ok {
-			cfg.Folders = append(cfg.Folders[:i], cfg.Folders[i+1:]...)
-		}
+	return w.Modify(func(cfg *Configuration) {
+		cfg.RemoveFolder(id)
 	})
 }
 
@@ -431,6 +473,12 @@ func (w *wrapper) LDAP() LDAPConfiguration {
 	return w.cfg.LDAP.Copy()
 }
 
+func (w *wrapper) OIDC() OIDCConfiguration {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.cfg.OIDC.Copy()
+}
+
 // GUI returns the current GUI configuration object.
 func (w *wrapper) GUI() GUIConfiguration {
 	w.mut.Lock()
@@ -521,6 +569,15 @@ func (w *wrapper) Save() error {
 		return err
 	}
 
+	if w.historyFile != "" && w.historyDirty {
+		if err := saveHistory(w.historyFile, w.history); err != nil {
+			// The configuration itself is saved, so that's no reason to fail.
+			l.Warnln("Saving config history:", err)
+		} else {
+			w.historyDirty = false
+		}
+	}
+
 	w.evLogger.Log(events.ConfigSaved, w.cfg)
 	return nil
 }
@@ -534,11 +591,14 @@ func (w *wrapper) setRequiresRestart() {
 }
 
 type modifyEntry struct {
+	origin     string
 	modifyFunc ModifyFunction
+	wantChange bool
 	res        chan modifyResult
 }
 
 type modifyResult struct {
-	w   Waiter
-	err error
+	w        Waiter
+	err      error
+	from, to Configuration // only set when wantChange
 }
@@ -8,9 +8,11 @@ package connections
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -28,8 +30,34 @@ type limiter struct {
 	limitsLAN           atomicBool
 	deviceReadLimiters  map[protocol.DeviceID]*rate.Limiter
 	deviceWriteLimiters map[protocol.DeviceID]*rate.Limiter
+
+	// Global limits currently in effect and the bandwidth profile, if
+	// any, they come from
+	options  config.OptionsConfiguration
+	override bandwidthOverride
+	profile  string
+	sendKbps int
+	recvKbps int
+	changed  chan struct{}
+}
+
+type bandwidthOverride struct {
+	name  string
+	until time.Time
+}
+
+// BandwidthStatus describes the global rate limits currently in effect.
+// Limits are in KiB/s, zero meaning unlimited.
+type BandwidthStatus struct {
+	Profile       string    `json:"profile"`
+	Override      bool      `json:"override"`
+	OverrideUntil time.Time `json:"overrideUntil"`
+	MaxSendKbps   int       `json:"maxSendKbps"`
+	MaxRecvKbps   int       `json:"maxRecvKbps"`
 }
 
+var errNoSuchBandwidthProfile = errors.New("no such bandwidth profile")
+
 type waiter interface {
 	// This is the rate limiting operation
 	WaitN(ctx context.Context, n int) error
@@ -49,12 +77,13 @@ func newLimiter(myId protocol.DeviceID, cfg config.Wrapper) *limiter {
 		mu:                  sync.NewMutex(),
 		deviceReadLimiters:  make(map[protocol.DeviceID]*rate.Limiter),
 		deviceWriteLimiters: make(map[protocol.DeviceID]*rate.Limiter),
+		sendKbps:            -1,
+		recvKbps:            -1,
+		changed:             make(chan struct{}, 1),
 	}
 
 	cfg.Subscribe(l)
-	prev := config.Configuration{Options: config.OptionsConfiguration{MaxRecvKbps: -1, MaxSendKbps: -1}}
-
-	l.CommitConfiguration(prev, cfg.RawCopy())
+	l.CommitConfiguration(config.Configuration{}, cfg.RawCopy())
 	return l
 }
 
@@ -130,11 +159,85 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 	// Delete, add or update limiters for devices
 	lim.processDevicesConfigurationLocked(from, to)
 
-	if from.Options.MaxRecvKbps == to.Options.MaxRecvKbps &&
-		from.Options.MaxSendKbps == to.Options.MaxSendKbps &&
-		from.Options.LimitBandwidthInLan == to.Options.LimitBandwidthInLan {
-		return true
+	lim.options = to.Options
+	lim.applyGlobalLimitsLocked(time.Now())
+
+	select {
+	case lim.changed <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// serve applies the global limits of scheduled bandwidth profiles as they
+// come into effect, and drops overrides when they expire.
+func (lim *limiter) serve(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-lim.changed:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		lim.mu.Lock()
+		lim.applyGlobalLimitsLocked(now)
+		next := lim.options.NextBandwidthProfileChange(now)
+		if until := lim.override.until; !until.IsZero() && (next.IsZero() || until.Before(next)) {
+			next = until
+		}
+		lim.mu.Unlock()
+
+		if !next.IsZero() {
+			l.Debugln("Next bandwidth profile change at", next)
+			timer.Reset(time.Until(next))
+		}
 	}
+}
+
+// activeProfileLocked returns the profile in effect at the given time, or
+// false when the limits from the options apply. An expired override, or
+// one for a profile that no longer exists, is dropped.
+func (lim *limiter) activeProfileLocked(now time.Time) (config.BandwidthProfile, bool) {
+	if lim.override.name != "" {
+		if now.Before(lim.override.until) {
+			if profile, ok := lim.options.BandwidthProfile(lim.override.name); ok {
+				return profile, true
+			}
+		}
+		l.Infof("Bandwidth profile override %q ended", lim.override.name)
+		lim.override = bandwidthOverride{}
+	}
+	return lim.options.ScheduledBandwidthProfile(now)
+}
+
+func (lim *limiter) applyGlobalLimitsLocked(now time.Time) {
+	sendKbps, recvKbps := lim.options.MaxSendKbps, lim.options.MaxRecvKbps
+	profile, ok := lim.activeProfileLocked(now)
+	if ok {
+		sendKbps, recvKbps = profile.MaxSendKbps, profile.MaxRecvKbps
+	}
+
+	if profile.Name == lim.profile &&
+		sendKbps == lim.sendKbps &&
+		recvKbps == lim.recvKbps &&
+		lim.options.LimitBandwidthInLan == lim.limitsLAN.get() {
+		return
+	}
+	lim.profile = profile.Name
+	lim.sendKbps = sendKbps
+	lim.recvKbps = recvKbps
 
 	limited := false
 	sendLimitStr := "is unlimited"
@@ -142,35 +245,92 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 
 	// The rate variables are in KiB/s in the config (despite the camel casing
 	// of the name). We multiply by 1024 to get bytes/s.
-	if to.Options.MaxRecvKbps <= 0 {
+	if recvKbps <= 0 {
 		lim.read.SetLimit(rate.Inf)
 	} else {
-		lim.read.SetLimit(1024 * rate.Limit(to.Options.MaxRecvKbps))
-		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxRecvKbps)
+		lim.read.SetLimit(1024 * rate.Limit(recvKbps))
+		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", recvKbps)
 		limited = true
 	}
 
-	if to.Options.MaxSendKbps <= 0 {
+	if sendKbps <= 0 {
 		lim.write.SetLimit(rate.Inf)
 	} else {
-		lim.write.SetLimit(1024 * rate.Limit(to.Options.MaxSendKbps))
-		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxSendKbps)
+		lim.write.SetLimit(1024 * rate.Limit(sendKbps))
+		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", sendKbps)
 		limited = true
 	}
 
-	lim.limitsLAN.set(to.Options.LimitBandwidthInLan)
+	lim.limitsLAN.set(lim.options.LimitBandwidthInLan)
 
-	l.Infof("Overall send rate %s, receive rate %s", sendLimitStr, recvLimitStr)
+	if ok {
+		l.Infof("Overall send rate %s, receive rate %s (bandwidth profile %q)", sendLimitStr, recvLimitStr, profile.Name)
+	} else {
+		l.Infof("Overall send rate %s, receive rate %s", sendLimitStr, recvLimitStr)
+	}
 
 	if limited {
-		if to.Options.LimitBandwidthInLan {
+		if lim.options.LimitBandwidthInLan {
 			l.Infoln("Rate limits apply to LAN connections")
 		} else {
 			l.Infoln("Rate limits do not apply to LAN connections")
 		}
 	}
+}
+
+// setOverride puts the named profile in effect for the given duration,
+// regardless of the profile schedules.
+func (lim *limiter) setOverride(name string, d time.Duration) error {
+	if d <= 0 {
+		return errors.New("override duration must be positive")
+	}
 
-	return true
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	if _, ok := lim.options.BandwidthProfile(name); !ok {
+		return fmt.Errorf("%w: %q", errNoSuchBandwidthProfile, name)
+	}
+	lim.override = bandwidthOverride{name: name, until: time.Now().Add(d)}
+	l.Infof("Overriding bandwidth profile with %q until %v", name, lim.override.until.Format(time.RFC3339))
+	lim.applyGlobalLimitsLocked(time.Now())
+
+	select {
+	case lim.changed <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// clearOverride returns to the scheduled profiles.
+func (lim *limiter) clearOverride() {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	if lim.override.name == "" {
+		return
+	}
+	// Expiring the override makes the next application drop it
+	lim.override.until = time.Time{}
+	lim.applyGlobalLimitsLocked(time.Now())
+
+	select {
+	case lim.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (lim *limiter) bandwidthStatus() BandwidthStatus {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	return BandwidthStatus{
+		Profile:       lim.profile,
+		Override:      lim.override.name != "",
+		OverrideUntil: lim.override.until,
+		MaxSendKbps:   lim.sendKbps,
+		MaxRecvKbps:   lim.recvKbps,
+	}
 }
 
 func (*limiter) String() string {
@@ -10,9 +10,11 @@ import (
 	"bytes"
 	"context"
 	crand "crypto/rand"
+	"errors"
 	"io"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
@@ -213,6 +215,91 @@ func TestAddAndRemove(t *testing.T) {
 	checkActualAndExpected(t, actualR, actualW, expectedR, expectedW)
 }
 
+func TestBandwidthProfiles(t *testing.T) {
+	wrapper, wrapperCancel := initConfig()
+	defer wrapperCancel()
+	lim := newLimiter(device1, wrapper)
+
+	waiter, _ := wrapper.Modify(func(cfg *config.Configuration) {
+		cfg.Options.MaxSendKbps = 100
+		cfg.Options.MaxRecvKbps = 200
+		cfg.Options.BandwidthProfiles = []config.BandwidthProfile{
+			{
+				Name:        "office",
+				MaxSendKbps: 10,
+				MaxRecvKbps: 20,
+				Schedule:    config.Schedule{Windows: []config.ScheduleWindow{{Days: "mon-fri", Start: "09:00", End: "17:00"}}},
+			},
+			{Name: "unlimited"},
+		}
+	})
+	waiter.Wait()
+
+	check := func(profile string, send, recv int) {
+		t.Helper()
+		status := lim.bandwidthStatus()
+		if status.Profile != profile || status.MaxSendKbps != send || status.MaxRecvKbps != recv {
+			t.Errorf("Got profile %q, %d/%d KiB/s, expected %q, %d/%d KiB/s", status.Profile, status.MaxSendKbps, status.MaxRecvKbps, profile, send, recv)
+		}
+		expectedW, expectedR := rate.Limit(send)*1024, rate.Limit(recv)*1024
+		if send == 0 {
+			expectedW = rate.Inf
+		}
+		if recv == 0 {
+			expectedR = rate.Inf
+		}
+		if lim.write.Limit() != expectedW || lim.read.Limit() != expectedR {
+			t.Errorf("Got limits %v/%v, expected %v/%v", lim.write.Limit(), lim.read.Limit(), expectedW, expectedR)
+		}
+	}
+
+	// 2026-10-19 is a Monday
+	office := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	evening := time.Date(2026, 10, 19, 20, 0, 0, 0, time.Local)
+
+	lim.mu.Lock()
+	lim.applyGlobalLimitsLocked(office)
+	lim.mu.Unlock()
+	check("office", 10, 20)
+
+	lim.mu.Lock()
+	lim.applyGlobalLimitsLocked(evening)
+	lim.mu.Unlock()
+	check("", 100, 200)
+
+	// An override takes precedence over the schedule until it expires
+	if err := lim.setOverride("unlimited", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	check("unlimited", 0, 0)
+	if status := lim.bandwidthStatus(); !status.Override || status.OverrideUntil.IsZero() {
+		t.Error("Expected an active override, got", status)
+	}
+
+	lim.mu.Lock()
+	lim.applyGlobalLimitsLocked(lim.override.until.Add(time.Second))
+	lim.mu.Unlock()
+	if status := lim.bandwidthStatus(); status.Override || status.Profile == "unlimited" {
+		t.Error("Expected the override to have expired, got", status)
+	}
+
+	if err := lim.setOverride("office", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	check("office", 10, 20)
+	lim.clearOverride()
+	if status := lim.bandwidthStatus(); status.Override {
+		t.Error("Expected the override to be cleared, got", status)
+	}
+
+	if err := lim.setOverride("nonexistent", time.Hour); !errors.Is(err, errNoSuchBandwidthProfile) {
+		t.Error("Expected an error for an unknown profile, got", err)
+	}
+	if err := lim.setOverride("unlimited", 0); err == nil {
+		t.Error("Expected an error for a zero duration")
+	}
+}
+
 func TestLimitedWriterWrite(t *testing.T) {
 	// Check that the limited writer writes the correct data in the correct manner.
 
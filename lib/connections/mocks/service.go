@@ -4,6 +4,7 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/connections"
 )
@@ -19,6 +20,20 @@ type Service struct {
 	allAddressesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	BandwidthStatusStub        func() connections.BandwidthStatus
+	bandwidthStatusMutex       sync.RWMutex
+	bandwidthStatusArgsForCall []struct {
+	}
+	bandwidthStatusReturns struct {
+		result1 connections.BandwidthStatus
+	}
+	bandwidthStatusReturnsOnCall map[int]struct {
+		result1 connections.BandwidthStatus
+	}
+	ClearBandwidthOverrideStub        func()
+	clearBandwidthOverrideMutex       sync.RWMutex
+	clearBandwidthOverrideArgsForCall []struct {
+	}
 	ConnectionStatusStub        func() map[string]connections.ConnectionStatusEntry
 	connectionStatusMutex       sync.RWMutex
 	connectionStatusArgsForCall []struct {
@@ -59,6 +74,18 @@ type Service struct {
 	nATTypeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OverrideBandwidthProfileStub        func(string, time.Duration) error
+	overrideBandwidthProfileMutex       sync.RWMutex
+	overrideBandwidthProfileArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	overrideBandwidthProfileReturns struct {
+		result1 error
+	}
+	overrideBandwidthProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ServeStub        func(context.Context) error
 	serveMutex       sync.RWMutex
 	serveArgsForCall []struct {
@@ -127,6 +154,83 @@ func (fake *Service) AllAddressesReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *Service) BandwidthStatus() connections.BandwidthStatus {
+	fake.bandwidthStatusMutex.Lock()
+	ret, specificReturn := fake.bandwidthStatusReturnsOnCall[len(fake.bandwidthStatusArgsForCall)]
+	fake.bandwidthStatusArgsForCall = append(fake.bandwidthStatusArgsForCall, struct {
+	}{})
+	stub := fake.BandwidthStatusStub
+	fakeReturns := fake.bandwidthStatusReturns
+	fake.recordInvocation("BandwidthStatus", []interface{}{})
+	fake.bandwidthStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) BandwidthStatusCallCount() int {
+	fake.bandwidthStatusMutex.RLock()
+	defer fake.bandwidthStatusMutex.RUnlock()
+	return len(fake.bandwidthStatusArgsForCall)
+}
+
+func (fake *Service) BandwidthStatusCalls(stub func() connections.BandwidthStatus) {
+	fake.bandwidthStatusMutex.Lock()
+	defer fake.bandwidthStatusMutex.Unlock()
+	fake.BandwidthStatusStub = stub
+}
+
+func (fake *Service) BandwidthStatusReturns(result1 connections.BandwidthStatus) {
+	fake.bandwidthStatusMutex.Lock()
+	defer fake.bandwidthStatusMutex.Unlock()
+	fake.BandwidthStatusStub = nil
+	fake.bandwidthStatusReturns = struct {
+		result1 connections.BandwidthStatus
+	}{result1}
+}
+
+func (fake *Service) BandwidthStatusReturnsOnCall(i int, result1 connections.BandwidthStatus) {
+	fake.bandwidthStatusMutex.Lock()
+	defer fake.bandwidthStatusMutex.Unlock()
+	fake.BandwidthStatusStub = nil
+	if fake.bandwidthStatusReturnsOnCall == nil {
+		fake.bandwidthStatusReturnsOnCall = make(map[int]struct {
+			result1 connections.BandwidthStatus
+		})
+	}
+	fake.bandwidthStatusReturnsOnCall[i] = struct {
+		result1 connections.BandwidthStatus
+	}{result1}
+}
+
+func (fake *Service) ClearBandwidthOverride() {
+	fake.clearBandwidthOverrideMutex.Lock()
+	fake.clearBandwidthOverrideArgsForCall = append(fake.clearBandwidthOverrideArgsForCall, struct {
+	}{})
+	stub := fake.ClearBandwidthOverrideStub
+	fake.recordInvocation("ClearBandwidthOverride", []interface{}{})
+	fake.clearBandwidthOverrideMutex.Unlock()
+	if stub != nil {
+		fake.ClearBandwidthOverrideStub()
+	}
+}
+
+func (fake *Service) ClearBandwidthOverrideCallCount() int {
+	fake.clearBandwidthOverrideMutex.RLock()
+	defer fake.clearBandwidthOverrideMutex.RUnlock()
+	return len(fake.clearBandwidthOverrideArgsForCall)
+}
+
+func (fake *Service) ClearBandwidthOverrideCalls(stub func()) {
+	fake.clearBandwidthOverrideMutex.Lock()
+	defer fake.clearBandwidthOverrideMutex.Unlock()
+	fake.ClearBandwidthOverrideStub = stub
+}
+
 func (fake *Service) ConnectionStatus() map[string]connections.ConnectionStatusEntry {
 	fake.connectionStatusMutex.Lock()
 	ret, specificReturn := fake.connectionStatusReturnsOnCall[len(fake.connectionStatusArgsForCall)]
@@ -339,6 +443,68 @@ func (fake *Service) NATTypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *Service) OverrideBandwidthProfile(arg1 string, arg2 time.Duration) error {
+	fake.overrideBandwidthProfileMutex.Lock()
+	ret, specificReturn := fake.overrideBandwidthProfileReturnsOnCall[len(fake.overrideBandwidthProfileArgsForCall)]
+	fake.overrideBandwidthProfileArgsForCall = append(fake.overrideBandwidthProfileArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.OverrideBandwidthProfileStub
+	fakeReturns := fake.overrideBandwidthProfileReturns
+	fake.recordInvocation("OverrideBandwidthProfile", []interface{}{arg1, arg2})
+	fake.overrideBandwidthProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) OverrideBandwidthProfileCallCount() int {
+	fake.overrideBandwidthProfileMutex.RLock()
+	defer fake.overrideBandwidthProfileMutex.RUnlock()
+	return len(fake.overrideBandwidthProfileArgsForCall)
+}
+
+func (fake *Service) OverrideBandwidthProfileCalls(stub func(string, time.Duration) error) {
+	fake.overrideBandwidthProfileMutex.Lock()
+	defer fake.overrideBandwidthProfileMutex.Unlock()
+	fake.OverrideBandwidthProfileStub = stub
+}
+
+func (fake *Service) OverrideBandwidthProfileArgsForCall(i int) (string, time.Duration) {
+	fake.overrideBandwidthProfileMutex.RLock()
+	defer fake.overrideBandwidthProfileMutex.RUnlock()
+	argsForCall := fake.overrideBandwidthProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Service) OverrideBandwidthProfileReturns(result1 error) {
+	fake.overrideBandwidthProfileMutex.Lock()
+	defer fake.overrideBandwidthProfileMutex.Unlock()
+	fake.OverrideBandwidthProfileStub = nil
+	fake.overrideBandwidthProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Service) OverrideBandwidthProfileReturnsOnCall(i int, result1 error) {
+	fake.overrideBandwidthProfileMutex.Lock()
+	defer fake.overrideBandwidthProfileMutex.Unlock()
+	fake.OverrideBandwidthProfileStub = nil
+	if fake.overrideBandwidthProfileReturnsOnCall == nil {
+		fake.overrideBandwidthProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.overrideBandwidthProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Service) Serve(arg1 context.Context) error {
 	fake.serveMutex.Lock()
 	ret, specificReturn := fake.serveReturnsOnCall[len(fake.serveArgsForCall)]
@@ -405,6 +571,10 @@ func (fake *Service) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.allAddressesMutex.RLock()
 	defer fake.allAddressesMutex.RUnlock()
+	fake.bandwidthStatusMutex.RLock()
+	defer fake.bandwidthStatusMutex.RUnlock()
+	fake.clearBandwidthOverrideMutex.RLock()
+	defer fake.clearBandwidthOverrideMutex.RUnlock()
 	fake.connectionStatusMutex.RLock()
 	defer fake.connectionStatusMutex.RUnlock()
 	fake.externalAddressesMutex.RLock()
@@ -413,6 +583,8 @@ func (fake *Service) Invocations() map[string][][]interface{} {
 	defer fake.listenerStatusMutex.RUnlock()
 	fake.nATTypeMutex.RLock()
 	defer fake.nATTypeMutex.RUnlock()
+	fake.overrideBandwidthProfileMutex.RLock()
+	defer fake.overrideBandwidthProfileMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
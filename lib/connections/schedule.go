@@ -0,0 +1,98 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// handleDeviceSchedules disconnects and reconnects devices at the boundaries
+// of their schedule windows. A device outside its window is treated as paused:
+// existing connections are closed and no new ones are made or accepted.
+func (s *service) handleDeviceSchedules(ctx context.Context) error {
+	allowed := make(map[protocol.DeviceID]bool)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-s.scheduleChanged:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		var next time.Time
+		seen := make(map[protocol.DeviceID]struct{})
+		for id, deviceCfg := range s.cfg.Devices() {
+			if id == s.myID {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			isAllowed := deviceCfg.Schedule.Allows(now)
+			if wasAllowed, ok := allowed[id]; (ok && wasAllowed != isAllowed) || (!ok && !isAllowed) {
+				s.deviceScheduleChanged(id, isAllowed)
+			}
+			allowed[id] = isAllowed
+
+			if change := deviceCfg.Schedule.NextChange(now); !change.IsZero() && (next.IsZero() || change.Before(next)) {
+				next = change
+			}
+		}
+		for id := range allowed {
+			if _, ok := seen[id]; !ok {
+				delete(allowed, id)
+			}
+		}
+
+		if !next.IsZero() {
+			l.Debugln("Next device schedule change at", next)
+			timer.Reset(time.Until(next))
+		}
+	}
+}
+
+func (s *service) deviceScheduleChanged(id protocol.DeviceID, allowed bool) {
+	deviceCfg, ok := s.cfg.Device(id)
+	if !ok || deviceCfg.Paused {
+		// Manual pausing takes precedence, nothing changes
+		return
+	}
+
+	// Not DevicePaused/DeviceResumed, as the device isn't (un)paused in the
+	// config.
+	s.evLogger.Log(events.DeviceScheduleChanged, map[string]interface{}{
+		"device":  id.String(),
+		"allowed": allowed,
+	})
+
+	if allowed {
+		l.Infof("Connecting to %s, entering scheduled window", deviceCfg.Description())
+		s.dialNowDevicesMut.Lock()
+		s.dialNowDevices[id] = struct{}{}
+		s.scheduleDialNow()
+		s.dialNowDevicesMut.Unlock()
+		return
+	}
+
+	l.Infof("Disconnecting from %s, outside of scheduled window", deviceCfg.Description())
+	if conn, ok := s.model.Connection(id); ok {
+		conn.Close(errDeviceOutsideSchedule)
+	}
+}
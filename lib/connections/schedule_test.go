@@ -0,0 +1,63 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func TestDeviceScheduleChangedEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+
+	scheduled := protocol.DeviceID{1}
+	paused := protocol.DeviceID{2}
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.SetDevice(config.DeviceConfiguration{DeviceID: scheduled})
+	cfg.SetDevice(config.DeviceConfiguration{DeviceID: paused, Paused: true})
+	s := &service{
+		cfg:               config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger),
+		evLogger:          evLogger,
+		dialNow:           make(chan struct{}, 1),
+		dialNowDevices:    make(map[protocol.DeviceID]struct{}),
+		dialNowDevicesMut: sync.NewMutex(),
+	}
+
+	sub := evLogger.Subscribe(events.DeviceScheduleChanged | events.DevicePaused | events.DeviceResumed)
+	defer sub.Unsubscribe()
+
+	s.deviceScheduleChanged(paused, true)
+	s.deviceScheduleChanged(scheduled, true)
+
+	ev, err := sub.Poll(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != events.DeviceScheduleChanged {
+		t.Fatal("Unexpected event", ev.Type)
+	}
+	data := ev.Data.(map[string]interface{})
+	if data["device"] != scheduled.String() || data["allowed"] != true {
+		t.Error("Unexpected event data", data)
+	}
+	if _, ok := s.dialNowDevices[scheduled]; !ok {
+		t.Error("Device entering its window should be dialed")
+	}
+
+	if ev, err := sub.Poll(100 * time.Millisecond); err == nil {
+		t.Error("Unexpected event", ev.Type)
+	}
+}
@@ -63,6 +63,7 @@ var (
 	errDeviceIgnored          = errors.New("device is ignored")
 	errConnLimitReached       = errors.New("connection limit reached")
 	errDevicePaused           = errors.New("device is paused")
+	errDeviceOutsideSchedule  = errors.New("device is outside of its scheduled window")
 )
 
 const (
@@ -123,6 +124,9 @@ type Service interface {
 	ListenerStatus() map[string]ListenerStatusEntry
 	ConnectionStatus() map[string]ConnectionStatusEntry
 	NATType() string
+	BandwidthStatus() BandwidthStatus
+	OverrideBandwidthProfile(name string, duration time.Duration) error
+	ClearBandwidthOverride()
 }
 
 type ListenerStatusEntry struct {
@@ -166,6 +170,8 @@ type service struct {
 	dialNowDevices    map[protocol.DeviceID]struct{}
 	dialNowDevicesMut sync.Mutex
 
+	scheduleChanged chan struct{}
+
 	listenersMut   sync.RWMutex
 	listeners      map[string]genericListener
 	listenerTokens map[string]suture.ServiceToken
@@ -195,6 +201,8 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 		dialNow:           make(chan struct{}, 1),
 		dialNowDevices:    make(map[protocol.DeviceID]struct{}),
 
+		scheduleChanged: make(chan struct{}, 1),
+
 		listenersMut:   sync.NewRWMutex(),
 		listeners:      make(map[string]genericListener),
 		listenerTokens: make(map[string]suture.ServiceToken),
@@ -216,6 +224,8 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 	service.Add(svcutil.AsService(service.connect, fmt.Sprintf("%s/connect", service)))
 	service.Add(svcutil.AsService(service.handleConns, fmt.Sprintf("%s/handleConns", service)))
 	service.Add(svcutil.AsService(service.handleHellos, fmt.Sprintf("%s/handleHellos", service)))
+	service.Add(svcutil.AsService(service.handleDeviceSchedules, fmt.Sprintf("%s/handleDeviceSchedules", service)))
+	service.Add(svcutil.AsService(service.limiter.serve, fmt.Sprintf("%s/limiter", service)))
 	service.Add(service.natService)
 
 	svcutil.OnSupervisorDone(service.Supervisor, func() {
@@ -303,6 +313,10 @@ func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, c internalCon
 		return errDevicePaused
 	}
 
+	if !cfg.Schedule.Allows(time.Now()) {
+		return errDeviceOutsideSchedule
+	}
+
 	if len(cfg.AllowedNetworks) > 0 && !IsAllowedNetwork(c.RemoteAddr().String(), cfg.AllowedNetworks) {
 		// The connection is not from an allowed network.
 		return errNetworkNotAllowed
@@ -409,7 +423,12 @@ func (s *service) handleHellos(ctx context.Context) error {
 		isLAN := s.isLAN(c.RemoteAddr())
 		rd, wr := s.limiter.getLimiters(remoteID, c, isLAN)
 
-		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, s.cfg.FolderPasswords(remoteID))
+		// Use the configured compression algorithm if the other side
+		// supports it, LZ4 otherwise.
+		compressAlgo := protocol.NegotiateCompressionAlgorithm(deviceCfg.CompressionAlgorithm, hello.CompressionAlgorithms)
+		l.Debugf("Using %v compression for %s", compressAlgo, remoteID)
+
+		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, compressAlgo, s.cfg.FolderPasswords(remoteID))
 		go func() {
 			<-protoConn.Closed()
 			s.dialNowDevicesMut.Lock()
@@ -534,6 +553,11 @@ func (s *service) dialDevices(ctx context.Context, now time.Time, cfg config.Con
 			continue
 		}
 
+		// ... or those outside of their scheduled window.
+		if !deviceCfg.Schedule.Allows(now) {
+			continue
+		}
+
 		// See if we are already connected and, if so, what our cutoff is
 		// for dialer priority.
 		priorityCutoff := worstDialerPriority
@@ -799,6 +823,11 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 
 	s.checkAndSignalConnectLoopOnUpdatedDevices(from, to)
 
+	select {
+	case s.scheduleChanged <- struct{}{}:
+	default:
+	}
+
 	s.listenersMut.Lock()
 	seen := make(map[string]struct{})
 	for _, addr := range to.Options.ListenAddresses() {
@@ -986,6 +1015,22 @@ func (s *service) NATType() string {
 	return "unknown"
 }
 
+// BandwidthStatus returns the global rate limits currently in effect.
+func (s *service) BandwidthStatus() BandwidthStatus {
+	return s.limiter.bandwidthStatus()
+}
+
+// OverrideBandwidthProfile puts the named bandwidth profile in effect for
+// the given duration, after which the scheduled profiles apply again.
+func (s *service) OverrideBandwidthProfile(name string, duration time.Duration) error {
+	return s.limiter.setOverride(name, duration)
+}
+
+// ClearBandwidthOverride ends an override set by OverrideBandwidthProfile.
+func (s *service) ClearBandwidthOverride() {
+	s.limiter.clearOverride()
+}
+
 func getDialerFactory(cfg config.Configuration, uri *url.URL) (dialerFactory, error) {
 	dialerFactory, ok := dialers[uri.Scheme]
 	if !ok {
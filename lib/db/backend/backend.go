@@ -125,8 +125,31 @@ const (
 	TuningLarge
 )
 
-func Open(path string, tuning Tuning) (Backend, error) {
-	return OpenLevelDB(path, tuning)
+type Type int
+
+const (
+	// N.b. these constants must match those in lib/config.DatabaseBackend!
+	TypeLevelDB Type = iota
+	TypePebble
+)
+
+func Open(path string, typ Type, tuning Tuning) (Backend, error) {
+	switch typ {
+	case TypePebble:
+		return OpenPebble(path, tuning)
+	default:
+		return OpenLevelDB(path, tuning)
+	}
+}
+
+// OpenRO opens the database at the given location read only.
+func OpenRO(path string, typ Type) (Backend, error) {
+	switch typ {
+	case TypePebble:
+		return OpenPebbleRO(path)
+	default:
+		return OpenLevelDBRO(path)
+	}
 }
 
 func OpenMemory() Backend {
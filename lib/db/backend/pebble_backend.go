@@ -0,0 +1,331 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// pebbleBackend implements Backend on top of a Pebble database. Unlike
+// leveldb, Pebble panics when used after being closed, so every operation
+// is guarded by the close wait group.
+type pebbleBackend struct {
+	pdb      *pebble.DB
+	closeWG  *closeWaitGroup
+	location string
+}
+
+func newPebbleBackend(pdb *pebble.DB, location string) *pebbleBackend {
+	return &pebbleBackend{
+		pdb:      pdb,
+		closeWG:  &closeWaitGroup{},
+		location: location,
+	}
+}
+
+func (b *pebbleBackend) NewReadTransaction() (ReadTransaction, error) {
+	return b.newSnapshot()
+}
+
+func (b *pebbleBackend) newSnapshot() (*pebbleSnapshot, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleSnapshot{
+		snap: b.pdb.NewSnapshot(),
+		rel:  rel,
+	}, nil
+}
+
+func (b *pebbleBackend) NewWriteTransaction(hooks ...CommitHook) (WriteTransaction, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := b.newSnapshot()
+	if err != nil {
+		rel.Release()
+		return nil, err
+	}
+	return &pebbleTransaction{
+		pebbleSnapshot: snap,
+		pdb:            b.pdb,
+		batch:          b.pdb.NewBatch(),
+		rel:            rel,
+		commitHooks:    hooks,
+		inFlush:        false,
+	}, nil
+}
+
+func (b *pebbleBackend) Close() error {
+	b.closeWG.CloseWait()
+	return wrapPebbleErr(b.pdb.Close())
+}
+
+func (b *pebbleBackend) Get(key []byte) ([]byte, error) {
+	if err := b.closeWG.Add(1); err != nil {
+		return nil, err
+	}
+	defer b.closeWG.Done()
+	return pebbleGet(b.pdb, key)
+}
+
+func (b *pebbleBackend) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	return b.newIterator(prefixOptions(prefix))
+}
+
+func (b *pebbleBackend) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return b.newIterator(&pebble.IterOptions{LowerBound: first, UpperBound: last})
+}
+
+func (b *pebbleBackend) newIterator(opts *pebble.IterOptions) (Iterator, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleIterator{it: b.pdb.NewIter(opts), rel: rel}, nil
+}
+
+func (b *pebbleBackend) Put(key, val []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	return wrapPebbleErr(b.pdb.Set(key, val, pebble.NoSync))
+}
+
+func (b *pebbleBackend) Delete(key []byte) error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+	return wrapPebbleErr(b.pdb.Delete(key, pebble.NoSync))
+}
+
+func (b *pebbleBackend) Compact() error {
+	if err := b.closeWG.Add(1); err != nil {
+		return err
+	}
+	defer b.closeWG.Done()
+
+	// Pebble wants explicit bounds for a manual compaction, so compact from
+	// the first to just past the last key.
+	it := b.pdb.NewIter(nil)
+	if !it.First() {
+		return wrapPebbleErr(it.Close())
+	}
+	first := append([]byte(nil), it.Key()...)
+	it.Last()
+	last := append(append([]byte(nil), it.Key()...), 0)
+	if err := it.Close(); err != nil {
+		return wrapPebbleErr(err)
+	}
+	return wrapPebbleErr(b.pdb.Compact(first, last, true))
+}
+
+func (b *pebbleBackend) Location() string {
+	return b.location
+}
+
+// pebbleSnapshot implements backend.ReadTransaction
+type pebbleSnapshot struct {
+	snap *pebble.Snapshot
+	rel  *releaser
+	once sync.Once
+}
+
+func (s *pebbleSnapshot) Get(key []byte) ([]byte, error) {
+	return pebbleGet(s.snap, key)
+}
+
+func (s *pebbleSnapshot) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	return &pebbleIterator{it: s.snap.NewIter(prefixOptions(prefix))}, nil
+}
+
+func (s *pebbleSnapshot) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return &pebbleIterator{it: s.snap.NewIter(&pebble.IterOptions{LowerBound: first, UpperBound: last})}, nil
+}
+
+func (s *pebbleSnapshot) Release() {
+	// Closing a Pebble snapshot twice panics, but releasing twice is fine
+	// for us.
+	s.once.Do(func() {
+		s.snap.Close()
+		s.rel.Release()
+	})
+}
+
+// pebbleTransaction implements backend.WriteTransaction using a batch on top
+// of a snapshot, so that reads don't observe the transaction's own writes.
+type pebbleTransaction struct {
+	*pebbleSnapshot
+	pdb         *pebble.DB
+	batch       *pebble.Batch
+	rel         *releaser
+	commitHooks []CommitHook
+	inFlush     bool
+	closeOnce   sync.Once
+}
+
+func (t *pebbleTransaction) Delete(key []byte) error {
+	if err := t.batch.Delete(key, nil); err != nil {
+		return wrapPebbleErr(err)
+	}
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *pebbleTransaction) Put(key, val []byte) error {
+	if err := t.batch.Set(key, val, nil); err != nil {
+		return wrapPebbleErr(err)
+	}
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *pebbleTransaction) Checkpoint() error {
+	return t.checkFlush(dbFlushBatchMin)
+}
+
+func (t *pebbleTransaction) Commit() error {
+	err := t.flush()
+	t.Release()
+	return err
+}
+
+func (t *pebbleTransaction) Release() {
+	t.closeOnce.Do(func() {
+		t.batch.Close()
+		t.pebbleSnapshot.Release()
+		t.rel.Release()
+	})
+}
+
+// checkFlush flushes and resets the batch if its size exceeds the given size.
+func (t *pebbleTransaction) checkFlush(size int) error {
+	// Hooks might put values in the database, which triggers a checkFlush
+	// which might trigger a flush, which might trigger the hooks. Don't
+	// recurse...
+	if t.inFlush || len(t.batch.Repr()) < size {
+		return nil
+	}
+	return t.flush()
+}
+
+func (t *pebbleTransaction) flush() error {
+	t.inFlush = true
+	defer func() { t.inFlush = false }()
+
+	for _, hook := range t.commitHooks {
+		if err := hook(t); err != nil {
+			return err
+		}
+	}
+	if t.batch.Empty() {
+		return nil
+	}
+	if err := t.pdb.Apply(t.batch, pebble.NoSync); err != nil {
+		return wrapPebbleErr(err)
+	}
+	t.batch.Reset()
+	return nil
+}
+
+// pebbleIterator adapts a Pebble iterator, which must be positioned
+// explicitly before use, to the Next-first pattern of Iterator.
+type pebbleIterator struct {
+	it      *pebble.Iterator
+	rel     *releaser // nil for iterators in transactions
+	started bool
+	err     error // set on release
+}
+
+func (it *pebbleIterator) Next() bool {
+	if !it.started {
+		it.started = true
+		return it.it.First()
+	}
+	return it.it.Next()
+}
+
+func (it *pebbleIterator) Key() []byte {
+	return it.it.Key()
+}
+
+func (it *pebbleIterator) Value() []byte {
+	return it.it.Value()
+}
+
+func (it *pebbleIterator) Error() error {
+	if it.it == nil {
+		return it.err
+	}
+	return wrapPebbleErr(it.it.Error())
+}
+
+func (it *pebbleIterator) Release() {
+	if it.it == nil {
+		return
+	}
+	it.err = wrapPebbleErr(it.it.Close())
+	it.it = nil
+	if it.rel != nil {
+		it.rel.Release()
+	}
+}
+
+type pebbleReader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+}
+
+// pebbleGet returns a copy of the value, as Pebble's is only valid until
+// the closer is closed.
+func pebbleGet(r pebbleReader, key []byte) ([]byte, error) {
+	val, closer, err := r.Get(key)
+	if err != nil {
+		return nil, wrapPebbleErr(err)
+	}
+	val = append([]byte(nil), val...)
+	return val, wrapPebbleErr(closer.Close())
+}
+
+// prefixOptions returns iterator options for all keys with the given
+// prefix.
+func prefixOptions(prefix []byte) *pebble.IterOptions {
+	if len(prefix) == 0 {
+		return nil
+	}
+	opts := &pebble.IterOptions{LowerBound: prefix}
+	// The upper bound is the first key that doesn't have the prefix, i.e.
+	// the prefix with its last non-0xff byte incremented.
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if c := prefix[i]; c < 0xff {
+			limit := make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			opts.UpperBound = limit
+			break
+		}
+	}
+	return opts
+}
+
+// wrapPebbleErr wraps errors so that the backend package can recognize them
+func wrapPebbleErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, pebble.ErrClosed):
+		return errClosed
+	case errors.Is(err, pebble.ErrNotFound):
+		return errNotFound
+	}
+	return err
+}
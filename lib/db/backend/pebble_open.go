@@ -0,0 +1,134 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+)
+
+// OpenPebble attempts to open the Pebble database at the given location,
+// creating it if it does not exist. If the database is corrupted it is
+// erased and created from scratch.
+func OpenPebble(location string, tuning Tuning) (Backend, error) {
+	opts := pebbleOptsFor(location, tuning)
+	defer opts.Cache.Unref()
+
+	pdb, err := pebble.Open(location, opts)
+	if pebbleIsCorrupted(err) {
+		// Pebble has no recovery mode, so there isn't much to do beyond
+		// dropping the database and reindexing...
+		l.Infoln("Database corruption detected, unable to recover. Reinitializing...")
+		if err := os.RemoveAll(location); err != nil {
+			return nil, &errorSuggestion{err, "failed to delete corrupted database"}
+		}
+		pdb, err = pebble.Open(location, opts)
+	}
+	if err != nil {
+		return nil, &errorSuggestion{err, "is another instance of Syncthing running?"}
+	}
+
+	return newPebbleBackend(pdb, location), nil
+}
+
+// OpenPebbleRO attempts to open the Pebble database at the given location,
+// read only.
+func OpenPebbleRO(location string) (Backend, error) {
+	opts := pebbleOptsFor(location, TuningSmall)
+	defer opts.Cache.Unref()
+	opts.ReadOnly = true
+
+	pdb, err := pebble.Open(location, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newPebbleBackend(pdb, location), nil
+}
+
+// OpenPebbleMemory returns a new Backend referencing an in-memory Pebble
+// database.
+func OpenPebbleMemory() Backend {
+	opts := pebbleOptsFor("", TuningSmall)
+	defer opts.Cache.Unref()
+	opts.FS = vfs.NewMem()
+
+	pdb, err := pebble.Open("", opts)
+	if err != nil {
+		panic(err)
+	}
+	return newPebbleBackend(pdb, "")
+}
+
+// pebbleOptsFor returns the database options to use when opening a database
+// with the given location and tuning. Settings can be overridden by debug
+// environment variables. The returned options hold a reference to a new
+// cache, which the caller must release after opening the database.
+func pebbleOptsFor(location string, tuning Tuning) *pebble.Options {
+	large := false
+	switch tuning {
+	case TuningLarge:
+		large = true
+	case TuningAuto:
+		large = dbIsLarge(location)
+	}
+
+	var (
+		// Set defaults used for small databases.
+		defaultCacheSize                = 8 << MiB
+		defaultMemTableSize             = 16 << MiB
+		defaultL0CompactionThreshold    = 4
+		defaultL0StopWritesThreshold    = 12
+		defaultMaxConcurrentCompactions = 1
+	)
+
+	if large {
+		// Larger memtables mean fewer, larger flushes, and allowing more
+		// L0 files and concurrent compactions keeps writes from stalling
+		// while compaction catches up.
+		l.Infoln("Using large-database tuning")
+
+		defaultCacheSize = 64 << MiB
+		defaultMemTableSize = 64 << MiB
+		defaultL0CompactionThreshold = 8
+		defaultL0StopWritesThreshold = 32
+		defaultMaxConcurrentCompactions = 3
+	}
+
+	opts := &pebble.Options{
+		Cache:                    pebble.NewCache(int64(debugEnvValue("PebbleCacheSize", defaultCacheSize))),
+		MemTableSize:             debugEnvValue("PebbleMemTableSize", defaultMemTableSize),
+		L0CompactionThreshold:    debugEnvValue("PebbleL0CompactionThreshold", defaultL0CompactionThreshold),
+		L0StopWritesThreshold:    debugEnvValue("PebbleL0StopWritesThreshold", defaultL0StopWritesThreshold),
+		MaxConcurrentCompactions: debugEnvValue("PebbleMaxConcurrentCompactions", defaultMaxConcurrentCompactions),
+		MaxOpenFiles:             debugEnvValue("OpenFilesCacheCapacity", dbMaxOpenFiles),
+		Logger:                   pebbleLogger{},
+	}
+
+	return opts
+}
+
+// pebbleIsCorrupted returns true if the error indicates database
+// corruption. Pebble doesn't export its corruption error, but includes it
+// in the error chain.
+func pebbleIsCorrupted(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "pebble: corruption")
+}
+
+// pebbleLogger sends Pebble's informational output to our debug log.
+type pebbleLogger struct{}
+
+func (pebbleLogger) Infof(format string, args ...interface{}) {
+	l.Debugf(format, args...)
+}
+
+func (pebbleLogger) Fatalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
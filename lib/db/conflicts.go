@@ -0,0 +1,81 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"github.com/syncthing/syncthing/lib/db/backend"
+)
+
+// AddConflict remembers a conflict copy created in the folder.
+func (s *FileSet) AddConflict(name string, cr ConflictRecord) error {
+	key, err := s.db.keyer.GenerateConflictKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	bs, err := cr.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.db.Put(key, bs)
+}
+
+// RemoveConflict forgets about a conflict copy. It is allowed to remove a
+// conflict copy that isn't known.
+func (s *FileSet) RemoveConflict(name string) error {
+	key, err := s.db.keyer.GenerateConflictKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	return s.db.Delete(key)
+}
+
+// Conflict returns the record of a single conflict copy, and false if it
+// isn't known.
+func (s *FileSet) Conflict(name string) (ConflictRecord, bool, error) {
+	var cr ConflictRecord
+	key, err := s.db.keyer.GenerateConflictKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return cr, false, err
+	}
+	bs, err := s.db.Get(key)
+	if backend.IsNotFound(err) {
+		return cr, false, nil
+	} else if err != nil {
+		return cr, false, err
+	}
+	if err := cr.Unmarshal(bs); err != nil {
+		return cr, false, err
+	}
+	return cr, true, nil
+}
+
+// Conflicts enumerates the known conflict copies in the folder, by name.
+// Invalid entries are dropped from the database as a side effect.
+func (s *FileSet) Conflicts() (map[string]ConflictRecord, error) {
+	prefix, err := s.db.keyer.GenerateConflictKey(nil, []byte(s.folder), nil)
+	if err != nil {
+		return nil, err
+	}
+	iter, err := s.db.NewPrefixIterator(prefix.WithoutName())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	res := make(map[string]ConflictRecord)
+	for iter.Next() {
+		var cr ConflictRecord
+		if err := cr.Unmarshal(iter.Value()); err != nil {
+			l.Infof("Invalid conflict entry, deleting from database: %x", iter.Key())
+			if err := s.db.Delete(iter.Key()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res[string(s.db.keyer.NameFromConflictKey(iter.Key()))] = cr
+	}
+	return res, iter.Error()
+}
@@ -74,6 +74,12 @@ const (
 
 	// KeyTypePendingDevice <device ID in wire format> = ObservedDevice
 	KeyTypePendingDevice byte = 17
+
+	// KeyTypeConflict <int32 folder ID> <conflict copy file name> = ConflictRecord
+	KeyTypeConflict byte = 18
+
+	// KeyTypeMergeBase <int32 folder ID> <file name> = MergeBaseList
+	KeyTypeMergeBase byte = 19
 )
 
 type keyer interface {
@@ -123,6 +129,13 @@ type keyer interface {
 
 	GeneratePendingDeviceKey(key, device []byte) pendingDeviceKey
 	DeviceFromPendingDeviceKey(key []byte) []byte
+
+	// Conflict copies
+	GenerateConflictKey(key, folder, name []byte) (conflictKey, error)
+	NameFromConflictKey(key []byte) []byte
+
+	// Merge bases
+	GenerateMergeBaseKey(key, folder, name []byte) (mergeBaseKey, error)
 }
 
 // defaultKeyer implements our key scheme. It needs folder and device
@@ -395,6 +408,46 @@ func (defaultKeyer) DeviceFromPendingDeviceKey(key []byte) []byte {
 	return key[keyPrefixLen:]
 }
 
+type conflictKey []byte
+
+func (k defaultKeyer) GenerateConflictKey(key, folder, name []byte) (conflictKey, error) {
+	folderID, err := k.folderIdx.ID(folder)
+	if err != nil {
+		return nil, err
+	}
+	key = resize(key, keyPrefixLen+keyFolderLen+len(name))
+	key[0] = KeyTypeConflict
+	binary.BigEndian.PutUint32(key[keyPrefixLen:], folderID)
+	copy(key[keyPrefixLen+keyFolderLen:], name)
+	return key, nil
+}
+
+func (defaultKeyer) NameFromConflictKey(key []byte) []byte {
+	return key[keyPrefixLen+keyFolderLen:]
+}
+
+func (k conflictKey) WithoutName() []byte {
+	return k[:keyPrefixLen+keyFolderLen]
+}
+
+type mergeBaseKey []byte
+
+func (k defaultKeyer) GenerateMergeBaseKey(key, folder, name []byte) (mergeBaseKey, error) {
+	folderID, err := k.folderIdx.ID(folder)
+	if err != nil {
+		return nil, err
+	}
+	key = resize(key, keyPrefixLen+keyFolderLen+len(name))
+	key[0] = KeyTypeMergeBase
+	binary.BigEndian.PutUint32(key[keyPrefixLen:], folderID)
+	copy(key[keyPrefixLen+keyFolderLen:], name)
+	return key, nil
+}
+
+func (k mergeBaseKey) WithoutName() []byte {
+	return k[:keyPrefixLen+keyFolderLen]
+}
+
 // resize returns a byte slice of the specified size, reusing bs if possible
 func resize(bs []byte, size int) []byte {
 	if cap(bs) < size {
@@ -442,6 +442,24 @@ func (db *Lowlevel) dropFolder(folder []byte) error {
 		return err
 	}
 
+	// Forget about the conflict copies in the folder
+	k6, err := db.keyer.GenerateConflictKey(k5, folder, nil)
+	if err != nil {
+		return err
+	}
+	if err := t.deleteKeyPrefix(k6.WithoutName()); err != nil {
+		return err
+	}
+
+	// Forget about the merge bases in the folder
+	k7, err := db.keyer.GenerateMergeBaseKey(k6, folder, nil)
+	if err != nil {
+		return err
+	}
+	if err := t.deleteKeyPrefix(k7.WithoutName()); err != nil {
+		return err
+	}
+
 	return t.Commit()
 }
 
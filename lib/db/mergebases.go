@@ -0,0 +1,103 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const (
+	// maxMergeBases is the number of past versions of a file remembered as
+	// potential bases for merging conflicting changes.
+	maxMergeBases = 10
+	// maxMergeBaseContents is the number of most recent versions of a file
+	// whose contents are retained in the database. The contents of older
+	// ones must be found elsewhere, e.g. in the versioner archive.
+	maxMergeBaseContents = 3
+)
+
+// HasData returns whether the contents of the base are retained.
+func (b MergeBase) HasData() bool {
+	return b.Size == 0 || len(b.Data) > 0
+}
+
+// AddMergeBase remembers a version of the file, to be used as a common
+// ancestor when merging conflicting changes. Only the most recent few
+// versions are kept.
+func (s *FileSet) AddMergeBase(name string, base MergeBase) error {
+	key, err := s.db.keyer.GenerateMergeBaseKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	list, err := s.mergeBases(key)
+	if err != nil {
+		return err
+	}
+	for _, b := range list.Bases {
+		if b.Version.Equal(base.Version) {
+			return nil
+		}
+	}
+	list.Bases = append(list.Bases, base)
+	if len(list.Bases) > maxMergeBases {
+		list.Bases = list.Bases[len(list.Bases)-maxMergeBases:]
+	}
+	for i := 0; i < len(list.Bases)-maxMergeBaseContents; i++ {
+		list.Bases[i].Data = nil
+	}
+	bs, err := list.Marshal()
+	if err != nil {
+		return err
+	}
+	return s.db.Put(key, bs)
+}
+
+// MergeBase returns the most recent remembered version of the file that
+// both a and b descend from, and false if there is none. The contents of
+// the returned version may not be retained.
+func (s *FileSet) MergeBase(name string, a, b protocol.Vector) (MergeBase, bool, error) {
+	key, err := s.db.keyer.GenerateMergeBaseKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return MergeBase{}, false, err
+	}
+	list, err := s.mergeBases(key)
+	if err != nil {
+		return MergeBase{}, false, err
+	}
+	for i := len(list.Bases) - 1; i >= 0; i-- {
+		base := list.Bases[i]
+		if base.Version.LesserEqual(a) && base.Version.LesserEqual(b) {
+			return base, true, nil
+		}
+	}
+	return MergeBase{}, false, nil
+}
+
+// RemoveMergeBases forgets all remembered versions of the file.
+func (s *FileSet) RemoveMergeBases(name string) error {
+	key, err := s.db.keyer.GenerateMergeBaseKey(nil, []byte(s.folder), []byte(name))
+	if err != nil {
+		return err
+	}
+	return s.db.Delete(key)
+}
+
+func (s *FileSet) mergeBases(key mergeBaseKey) (MergeBaseList, error) {
+	var list MergeBaseList
+	bs, err := s.db.Get(key)
+	if backend.IsNotFound(err) {
+		return list, nil
+	} else if err != nil {
+		return list, err
+	}
+	if err := list.Unmarshal(bs); err != nil {
+		l.Infof("Invalid merge base entry, deleting from database: %x", []byte(key))
+		return MergeBaseList{}, s.db.Delete(key)
+	}
+	return list, nil
+}
@@ -829,7 +829,7 @@ func BenchmarkUpdateOneFile(b *testing.B) {
 		protocol.FileInfo{Name: "zajksdhaskjdh/askjdhaskjdashkajshd/kasjdhaskjdhaskdjhaskdjash/dkjashdaksjdhaskdjahskdjh", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}, Blocks: genBlocks(8)},
 	}
 
-	be, err := backend.Open("testdata/benchmarkupdate.db", backend.TuningAuto)
+	be, err := backend.Open("testdata/benchmarkupdate.db", backend.TypeLevelDB, backend.TuningAuto)
 	if err != nil {
 		b.Fatal(err)
 	}
@@ -1757,6 +1757,110 @@ func TestNoIndexIDResetOnDrop(t *testing.T) {
 	}
 }
 
+func TestConflicts(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	s := newFileSet(t, "test", ldb)
+	other := newFileSet(t, "other", ldb)
+
+	cr := db.ConflictRecord{
+		Time:       time.Unix(1234567890, 0),
+		Original:   "dir/foo.txt",
+		Winner:     remoteDevice0.Short(),
+		Loser:      protocol.LocalDeviceID.Short(),
+		WinnerSize: 42,
+		LoserSize:  23,
+	}
+	name := "dir/foo.sync-conflict-20090213-233130-AIR6LPZ.txt"
+	if err := s.AddConflict(name, cr); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.AddConflict("bar.sync-conflict-20090213-233130-AIR6LPZ", cr); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, ok, err := s.Conflict(name); err != nil || !ok || !got.Time.Equal(cr.Time) || got.Original != cr.Original || got.Winner != cr.Winner || got.LoserSize != cr.LoserSize {
+		t.Errorf("Unexpected conflict %v, %v, %v", got, ok, err)
+	}
+	if _, ok, err := s.Conflict("nonexistent"); err != nil || ok {
+		t.Errorf("Unexpected conflict for nonexistent file, %v, %v", ok, err)
+	}
+	if confls, err := s.Conflicts(); err != nil || len(confls) != 1 || confls[name].Original != cr.Original {
+		t.Errorf("Unexpected conflicts %v, %v", confls, err)
+	}
+
+	if err := s.RemoveConflict(name); err != nil {
+		t.Fatal(err)
+	}
+	if confls, err := s.Conflicts(); err != nil || len(confls) != 0 {
+		t.Errorf("Unexpected conflicts after removal %v, %v", confls, err)
+	}
+
+	db.DropFolder(ldb, "other")
+	other = newFileSet(t, "other", ldb)
+	if confls, err := other.Conflicts(); err != nil || len(confls) != 0 {
+		t.Errorf("Unexpected conflicts after dropping folder %v, %v", confls, err)
+	}
+}
+
+func TestMergeBases(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	s := newFileSet(t, "test", ldb)
+
+	local, remote := protocol.LocalDeviceID.Short(), remoteDevice0.Short()
+	version := func(l, r uint64) protocol.Vector {
+		v := protocol.Vector{Counters: []protocol.Counter{{ID: local, Value: l}}}
+		if r > 0 {
+			v.Counters = append(v.Counters, protocol.Counter{ID: remote, Value: r})
+		}
+		return v
+	}
+	base := func(i uint64) db.MergeBase {
+		data := []byte(fmt.Sprint("version ", i))
+		return db.MergeBase{Version: version(i, 0), Data: data, Modified: time.Unix(int64(i), 0), Size: int64(len(data))}
+	}
+	for i := uint64(1); i <= 12; i++ {
+		if err := s.AddMergeBase("foo", base(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Adding the same version again doesn't push out older ones
+	if err := s.AddMergeBase("foo", base(12)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both sides descend from the eleventh version
+	if b, ok, err := s.MergeBase("foo", version(12, 0), version(11, 1)); err != nil || !ok || !b.HasData() || string(b.Data) != "version 11" {
+		t.Errorf("Unexpected merge base %v, %v, %v", b, ok, err)
+	}
+	if b, ok, err := s.MergeBase("foo", version(12, 0), version(12, 0)); err != nil || !ok || string(b.Data) != "version 12" {
+		t.Errorf("Unexpected merge base %v, %v, %v", b, ok, err)
+	}
+
+	// Older versions are remembered without their contents
+	if b, ok, err := s.MergeBase("foo", version(12, 0), version(5, 1)); err != nil || !ok || b.HasData() || !b.Modified.Equal(time.Unix(5, 0)) || b.Size != 9 {
+		t.Errorf("Unexpected merge base %v, %v, %v", b, ok, err)
+	}
+
+	// The first versions have been dropped
+	if b, ok, err := s.MergeBase("foo", version(12, 0), version(2, 1)); err != nil || ok {
+		t.Errorf("Unexpected merge base %v, %v, %v", b, ok, err)
+	}
+	if _, ok, err := s.MergeBase("bar", version(12, 0), version(12, 0)); err != nil || ok {
+		t.Errorf("Unexpected merge base for unknown file, %v, %v", ok, err)
+	}
+
+	if err := s.RemoveMergeBases("foo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := s.MergeBase("foo", version(12, 0), version(12, 0)); err != nil || ok {
+		t.Errorf("Unexpected merge base after removal, %v, %v", ok, err)
+	}
+}
+
 func TestConcurrentIndexID(t *testing.T) {
 	done := make(chan struct{})
 	var ids [2]protocol.IndexID
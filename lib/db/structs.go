@@ -55,6 +55,10 @@ func (f FileInfoTruncated) IsReceiveOnlyChanged() bool {
 	return f.LocalFlags&protocol.FlagLocalReceiveOnly != 0
 }
 
+func (f FileInfoTruncated) IsPlaceholder() bool {
+	return f.LocalFlags&protocol.FlagLocalPlaceholder != 0
+}
+
 func (f FileInfoTruncated) IsDirectory() bool {
 	return f.Type == protocol.FileInfoTypeDirectory
 }
@@ -479,6 +479,127 @@ func (m *ObservedDevice) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ObservedDevice proto.InternalMessageInfo
 
+type ConflictRecord struct {
+	Time           time.Time                                           `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" xml:"time"`
+	Original       string                                              `protobuf:"bytes,2,opt,name=original,proto3" json:"original" xml:"original"`
+	Winner         github_com_syncthing_syncthing_lib_protocol.ShortID `protobuf:"varint,3,opt,name=winner,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.ShortID" json:"winner" xml:"winner"`
+	Loser          github_com_syncthing_syncthing_lib_protocol.ShortID `protobuf:"varint,4,opt,name=loser,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.ShortID" json:"loser" xml:"loser"`
+	WinnerModified time.Time                                           `protobuf:"bytes,5,opt,name=winner_modified,json=winnerModified,proto3,stdtime" json:"winnerModified" xml:"winnerModified"`
+	LoserModified  time.Time                                           `protobuf:"bytes,6,opt,name=loser_modified,json=loserModified,proto3,stdtime" json:"loserModified" xml:"loserModified"`
+	WinnerSize     int64                                               `protobuf:"varint,7,opt,name=winner_size,json=winnerSize,proto3" json:"winnerSize" xml:"winnerSize"`
+	LoserSize      int64                                               `protobuf:"varint,8,opt,name=loser_size,json=loserSize,proto3" json:"loserSize" xml:"loserSize"`
+}
+
+func (m *ConflictRecord) Reset()         { *m = ConflictRecord{} }
+func (m *ConflictRecord) String() string { return proto.CompactTextString(m) }
+func (*ConflictRecord) ProtoMessage()    {}
+func (*ConflictRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{11}
+}
+func (m *ConflictRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictRecord.Merge(m, src)
+}
+func (m *ConflictRecord) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConflictRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictRecord proto.InternalMessageInfo
+
+type MergeBase struct {
+	Version  protocol.Vector `protobuf:"bytes,1,opt,name=version,proto3" json:"version" xml:"version"`
+	Data     []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data" xml:"data"`
+	Modified time.Time       `protobuf:"bytes,3,opt,name=modified,proto3,stdtime" json:"modified" xml:"modified"`
+	Size     int64           `protobuf:"varint,4,opt,name=size,proto3" json:"size" xml:"size"`
+}
+
+func (m *MergeBase) Reset()         { *m = MergeBase{} }
+func (m *MergeBase) String() string { return proto.CompactTextString(m) }
+func (*MergeBase) ProtoMessage()    {}
+func (*MergeBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{12}
+}
+func (m *MergeBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeBase.Merge(m, src)
+}
+func (m *MergeBase) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MergeBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeBase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeBase proto.InternalMessageInfo
+
+type MergeBaseList struct {
+	Bases []MergeBase `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases" xml:"base"`
+}
+
+func (m *MergeBaseList) Reset()         { *m = MergeBaseList{} }
+func (m *MergeBaseList) String() string { return proto.CompactTextString(m) }
+func (*MergeBaseList) ProtoMessage()    {}
+func (*MergeBaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{13}
+}
+func (m *MergeBaseList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeBaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeBaseList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeBaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeBaseList.Merge(m, src)
+}
+func (m *MergeBaseList) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MergeBaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeBaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeBaseList proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FileVersion)(nil), "db.FileVersion")
 	proto.RegisterType((*VersionList)(nil), "db.VersionList")
@@ -491,107 +612,128 @@ func init() {
 	proto.RegisterType((*VersionListDeprecated)(nil), "db.VersionListDeprecated")
 	proto.RegisterType((*ObservedFolder)(nil), "db.ObservedFolder")
 	proto.RegisterType((*ObservedDevice)(nil), "db.ObservedDevice")
+	proto.RegisterType((*ConflictRecord)(nil), "db.ConflictRecord")
+	proto.RegisterType((*MergeBase)(nil), "db.MergeBase")
+	proto.RegisterType((*MergeBaseList)(nil), "db.MergeBaseList")
 }
 
 func init() { proto.RegisterFile("lib/db/structs.proto", fileDescriptor_5465d80e8cba02e3) }
 
 var fileDescriptor_5465d80e8cba02e3 = []byte{
-	// 1510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x96, 0x46, 0xf2, 0x17, 0xb3, 0x36, 0xb8, 0xde, 0x5d, 0x8d, 0x76, 0xe2,
-	0x00, 0xda, 0x0f, 0xc8, 0x80, 0x83, 0x18, 0x8b, 0x00, 0xdb, 0x20, 0x8c, 0xeb, 0xc4, 0x41, 0x9a,
-	0x04, 0xe3, 0x20, 0x29, 0xda, 0x83, 0xc0, 0x8f, 0xb1, 0x4c, 0x84, 0x22, 0x55, 0x92, 0xb6, 0xa3,
-	0xdc, 0x7a, 0x29, 0xd0, 0x5b, 0x10, 0xf4, 0x50, 0x14, 0x45, 0x91, 0x53, 0xff, 0x84, 0xfe, 0x05,
-	0x45, 0x91, 0xa3, 0x8f, 0x45, 0x0f, 0x2c, 0x62, 0x5f, 0x5a, 0x1d, 0x75, 0xec, 0xa9, 0x98, 0x37,
-	0xc3, 0x21, 0x65, 0x23, 0x45, 0x92, 0xfa, 0xc6, 0xf7, 0x7b, 0xbf, 0xf7, 0x24, 0xbe, 0xf9, 0xbd,
-	0x37, 0x8f, 0xe8, 0x2f, 0xbe, 0x67, 0xaf, 0xb9, 0xf6, 0x5a, 0x9c, 0x44, 0xfb, 0x4e, 0x12, 0xb7,
-	0xfb, 0x51, 0x98, 0x84, 0xfa, 0xa4, 0x6b, 0xaf, 0x5c, 0x8c, 0x58, 0x3f, 0x8c, 0xd7, 0x00, 0xb0,
-	0xf7, 0x77, 0xd7, 0xba, 0x61, 0x37, 0x04, 0x03, 0x9e, 0x04, 0x71, 0x05, 0x77, 0xc3, 0xb0, 0xeb,
-	0xb3, 0x9c, 0x95, 0x78, 0x3d, 0x16, 0x27, 0x56, 0xaf, 0x2f, 0x09, 0xcb, 0x3c, 0x3f, 0x3c, 0x3a,
-	0xa1, 0xbf, 0x66, 0xb3, 0x0c, 0xaf, 0xb2, 0x27, 0x89, 0x78, 0x24, 0xdf, 0x4c, 0xa2, 0xda, 0x96,
-	0xe7, 0xb3, 0x87, 0x2c, 0x8a, 0xbd, 0x30, 0xd0, 0xef, 0xa0, 0x99, 0x03, 0xf1, 0x68, 0x68, 0x4d,
-	0xad, 0x55, 0x5b, 0x5f, 0x68, 0x67, 0x09, 0xda, 0x0f, 0x99, 0x93, 0x84, 0x91, 0xd9, 0x7c, 0x99,
-	0xe2, 0x89, 0x61, 0x8a, 0x33, 0xe2, 0x28, 0xc5, 0xb3, 0x4f, 0x7a, 0xfe, 0x55, 0x22, 0x6d, 0x42,
-	0x33, 0x8f, 0xbe, 0x81, 0x66, 0x5c, 0xe6, 0xb3, 0x84, 0xb9, 0xc6, 0x64, 0x53, 0x6b, 0x55, 0xcc,
-	0xbf, 0xf3, 0x38, 0x09, 0xa9, 0x38, 0x69, 0x13, 0x9a, 0x79, 0xf4, 0x2b, 0x3c, 0xee, 0xc0, 0x73,
-	0x58, 0x6c, 0x94, 0x9a, 0xa5, 0x56, 0xdd, 0xfc, 0x9b, 0x88, 0x03, 0x68, 0x94, 0xe2, 0xba, 0x8c,
-	0xe3, 0x36, 0x84, 0x81, 0x43, 0xa7, 0x68, 0xde, 0x0b, 0x0e, 0x2c, 0xdf, 0x73, 0x3b, 0x59, 0x78,
-	0x19, 0xc2, 0xff, 0x35, 0x4c, 0xf1, 0x9c, 0x74, 0x6d, 0xaa, 0x2c, 0x17, 0x20, 0xcb, 0x18, 0x4c,
-	0xe8, 0x29, 0x1a, 0xf9, 0x54, 0x43, 0x35, 0x59, 0x9c, 0x3b, 0x5e, 0x9c, 0xe8, 0x3e, 0xaa, 0xc8,
-	0xb7, 0x8b, 0x0d, 0xad, 0x59, 0x6a, 0xd5, 0xd6, 0xe7, 0xdb, 0xae, 0xdd, 0x2e, 0xd4, 0xd0, 0xbc,
-	0xc6, 0x0b, 0x74, 0x9c, 0xe2, 0x1a, 0xb5, 0x0e, 0x25, 0x16, 0x0f, 0x53, 0xac, 0xe2, 0xce, 0x14,
-	0xec, 0xf9, 0xd1, 0x6a, 0x91, 0x4b, 0x15, 0xf3, 0x6a, 0xf9, 0xcb, 0x17, 0x78, 0x82, 0x1c, 0xd5,
-	0xd0, 0x22, 0xff, 0x81, 0xed, 0x60, 0x37, 0x7c, 0x10, 0xed, 0x07, 0x8e, 0xc5, 0x8b, 0xf4, 0x6f,
-	0x54, 0x0e, 0xac, 0x1e, 0x83, 0x73, 0xaa, 0x9a, 0xcb, 0xc3, 0x14, 0x83, 0x3d, 0x4a, 0x31, 0x82,
-	0xec, 0xdc, 0x20, 0x14, 0x30, 0xce, 0x8d, 0xbd, 0xa7, 0xcc, 0x28, 0x35, 0xb5, 0x56, 0x49, 0x70,
-	0xb9, 0xad, 0xb8, 0xdc, 0x20, 0x14, 0x30, 0xfd, 0x1a, 0x42, 0xbd, 0xd0, 0xf5, 0x76, 0x3d, 0xe6,
-	0x76, 0x62, 0x63, 0x0a, 0x22, 0x9a, 0xc3, 0x14, 0x57, 0x33, 0x74, 0x67, 0x94, 0xe2, 0x79, 0x08,
-	0x53, 0x08, 0xa1, 0xb9, 0x57, 0xff, 0x4e, 0x43, 0x35, 0x95, 0xc1, 0x1e, 0x18, 0xf5, 0xa6, 0xd6,
-	0x2a, 0x9b, 0x5f, 0x68, 0xbc, 0x2c, 0x3f, 0xa5, 0xf8, 0x72, 0xd7, 0x4b, 0xf6, 0xf6, 0xed, 0xb6,
-	0x13, 0xf6, 0xd6, 0xe2, 0x41, 0xe0, 0x24, 0x7b, 0x5e, 0xd0, 0x2d, 0x3c, 0x15, 0x45, 0xdb, 0xde,
-	0xd9, 0x0b, 0xa3, 0x64, 0x7b, 0x73, 0x98, 0x62, 0xf5, 0xa7, 0xcc, 0xc1, 0x28, 0xc5, 0x0b, 0x63,
-	0xbf, 0x6f, 0x0e, 0xc8, 0x57, 0x47, 0xab, 0xef, 0x92, 0x98, 0x16, 0xd2, 0x16, 0xc5, 0x5f, 0xfd,
-	0xf3, 0xe2, 0xbf, 0x8a, 0x2a, 0x31, 0xfb, 0x64, 0x9f, 0x05, 0x0e, 0x33, 0x10, 0x54, 0xb1, 0xc1,
-	0x55, 0x90, 0x61, 0xa3, 0x14, 0xcf, 0x89, 0xda, 0x4b, 0x80, 0x50, 0xe5, 0xd3, 0xef, 0xa1, 0xb9,
-	0x78, 0xd0, 0xf3, 0xbd, 0xe0, 0x71, 0x27, 0xb1, 0xa2, 0x2e, 0x4b, 0x8c, 0x45, 0x38, 0xe5, 0xd6,
-	0x30, 0xc5, 0xb3, 0xd2, 0xf3, 0x00, 0x1c, 0x4a, 0xc7, 0x63, 0x28, 0xa1, 0xe3, 0x2c, 0xfd, 0x06,
-	0xaa, 0xd9, 0x7e, 0xe8, 0x3c, 0x8e, 0x3b, 0x7b, 0x56, 0xbc, 0x67, 0xe8, 0x4d, 0xad, 0x55, 0x37,
-	0x09, 0x2f, 0xab, 0x80, 0x6f, 0x59, 0xf1, 0x9e, 0x2a, 0x6b, 0x0e, 0x11, 0x5a, 0xf0, 0xeb, 0xef,
-	0xa1, 0x2a, 0x0b, 0x9c, 0x68, 0xd0, 0xe7, 0x0d, 0x7d, 0x01, 0x52, 0x80, 0x30, 0x14, 0xa8, 0x84,
-	0xa1, 0x10, 0x42, 0x73, 0xaf, 0x6e, 0xa2, 0x72, 0x32, 0xe8, 0x33, 0x98, 0x05, 0x73, 0xeb, 0xcb,
-	0x79, 0x71, 0x95, 0xb8, 0x07, 0x7d, 0x26, 0xd4, 0xc9, 0x79, 0x4a, 0x9d, 0xdc, 0x20, 0x14, 0x30,
-	0x7d, 0x0b, 0xd5, 0xfa, 0x2c, 0xea, 0x79, 0xb1, 0x68, 0xc1, 0x72, 0x53, 0x6b, 0xcd, 0x9a, 0xab,
-	0xc3, 0x14, 0x17, 0xe1, 0x51, 0x8a, 0x17, 0x21, 0xb2, 0x80, 0x11, 0x5a, 0x64, 0xe8, 0xb7, 0x0b,
-	0x1a, 0x0d, 0x62, 0xa3, 0xd6, 0xd4, 0x5a, 0x53, 0x30, 0x27, 0x94, 0x20, 0xee, 0xc6, 0x67, 0x74,
-	0x76, 0x37, 0x26, 0xbf, 0xa5, 0xb8, 0xe4, 0x05, 0x09, 0x2d, 0xd0, 0xf4, 0x5d, 0x24, 0xaa, 0xd4,
-	0x81, 0x1e, 0x9b, 0x85, 0x54, 0x37, 0x8f, 0x53, 0x5c, 0xa7, 0xd6, 0xa1, 0xc9, 0x1d, 0x3b, 0xde,
-	0x53, 0xc6, 0x0b, 0x65, 0x67, 0x86, 0x2a, 0x94, 0x42, 0xb2, 0xc4, 0xcf, 0x8f, 0x56, 0xc7, 0xc2,
-	0x68, 0x1e, 0xa4, 0x3f, 0x44, 0x95, 0xbe, 0x6f, 0x25, 0xbb, 0x61, 0xd4, 0x33, 0xe6, 0x40, 0xa0,
-	0x85, 0x1a, 0xde, 0x97, 0x9e, 0x4d, 0x2b, 0xb1, 0x4c, 0x22, 0x65, 0xaa, 0xf8, 0x4a, 0x6d, 0x19,
-	0x40, 0xa8, 0xf2, 0xe9, 0x9b, 0xa8, 0xe6, 0x87, 0x8e, 0xe5, 0x77, 0x76, 0x7d, 0xab, 0x1b, 0x1b,
-	0xbf, 0xcc, 0x40, 0x51, 0x41, 0x1d, 0x80, 0x6f, 0x71, 0x58, 0x15, 0x23, 0x87, 0x08, 0x2d, 0xf8,
-	0xf5, 0x5b, 0xa8, 0x2e, 0xa5, 0x2f, 0x34, 0xf6, 0xeb, 0x0c, 0x28, 0x04, 0xce, 0x46, 0x3a, 0xa4,
-	0xca, 0x16, 0x8b, 0x1d, 0x23, 0x64, 0x56, 0x64, 0x14, 0xaf, 0x8d, 0xe9, 0xb7, 0xb9, 0x36, 0x28,
-	0x9a, 0x91, 0xd3, 0xdb, 0x98, 0x81, 0xb8, 0xff, 0x1d, 0xa7, 0x18, 0x51, 0xeb, 0x70, 0x5b, 0xa0,
-	0x3c, 0x8b, 0x24, 0xa8, 0x2c, 0xd2, 0xe6, 0x33, 0xb8, 0xc0, 0xa4, 0x19, 0x8f, 0x77, 0x62, 0x10,
-	0x76, 0x8a, 0x92, 0xab, 0x40, 0x6a, 0xe8, 0xc4, 0x20, 0xbc, 0x3f, 0x26, 0x3a, 0xd1, 0x89, 0x63,
-	0x28, 0xa1, 0xe3, 0x2c, 0x39, 0xd2, 0x1f, 0xa1, 0x2a, 0x1c, 0x31, 0xdc, 0x29, 0xb7, 0xd1, 0xb4,
-	0xe8, 0x32, 0x79, 0xa3, 0x5c, 0xc8, 0x4f, 0x15, 0x48, 0xbc, 0x35, 0xcc, 0x7f, 0xc8, 0x23, 0x95,
-	0xd4, 0x51, 0x8a, 0x6b, 0xb9, 0x82, 0x08, 0x95, 0x30, 0xf9, 0x56, 0x43, 0x4b, 0xdb, 0x81, 0xeb,
-	0x45, 0xcc, 0x49, 0x64, 0x3d, 0x59, 0x7c, 0x2f, 0xf0, 0x07, 0xe7, 0x33, 0x02, 0xce, 0xed, 0x90,
-	0xc9, 0xd7, 0x65, 0x34, 0x7d, 0x23, 0xdc, 0x0f, 0x92, 0x58, 0xbf, 0x82, 0xa6, 0x76, 0x3d, 0x9f,
-	0xc5, 0x70, 0x95, 0x4d, 0x99, 0x78, 0x98, 0x62, 0x01, 0xa8, 0x97, 0x04, 0x4b, 0xf5, 0x9e, 0x70,
-	0xea, 0x1f, 0xa0, 0x9a, 0x78, 0xcf, 0x30, 0xf2, 0x58, 0x0c, 0x53, 0x65, 0xca, 0xfc, 0x0f, 0xff,
-	0x27, 0x05, 0x58, 0xfd, 0x93, 0x02, 0xa6, 0x12, 0x15, 0x89, 0xfa, 0x75, 0x54, 0x91, 0x33, 0x33,
-	0x86, 0x7b, 0x72, 0xca, 0xbc, 0x04, 0xf3, 0x5a, 0x62, 0xf9, 0xbc, 0x96, 0x80, 0xca, 0xa2, 0x28,
-	0xfa, 0xff, 0x73, 0xe1, 0x96, 0x21, 0xc3, 0xc5, 0x3f, 0x12, 0x6e, 0x16, 0xaf, 0xf4, 0xdb, 0x46,
-	0x53, 0xf6, 0x20, 0x61, 0xd9, 0xa5, 0x6b, 0xf0, 0x3a, 0x00, 0x90, 0x1f, 0x36, 0xb7, 0x08, 0x15,
-	0xe8, 0xd8, 0x0d, 0x33, 0xfd, 0x96, 0x37, 0xcc, 0x0e, 0xaa, 0x8a, 0x1d, 0xa9, 0xe3, 0xb9, 0x70,
-	0xb9, 0xd4, 0xcd, 0x8d, 0xe3, 0x14, 0x57, 0xc4, 0xde, 0x03, 0x37, 0x6e, 0x45, 0x10, 0xb6, 0x5d,
-	0x95, 0x28, 0x03, 0x78, 0xb7, 0x28, 0x26, 0x55, 0x3c, 0x2e, 0xb1, 0xe2, 0x20, 0xd1, 0xdf, 0x65,
-	0x8e, 0xc8, 0x06, 0xf9, 0x4c, 0x43, 0x55, 0x21, 0x8f, 0x1d, 0x96, 0xe8, 0xd7, 0xd1, 0xb4, 0x03,
-	0x86, 0xec, 0x10, 0xc4, 0x77, 0x2e, 0xe1, 0xce, 0x1b, 0x43, 0x30, 0x54, 0xad, 0xc0, 0x24, 0x54,
-	0xc2, 0x7c, 0xa8, 0x38, 0x11, 0xb3, 0xb2, 0x5d, 0xb4, 0x24, 0x86, 0x8a, 0x84, 0xd4, 0xd9, 0x48,
-	0x9b, 0xd0, 0xcc, 0x43, 0x3e, 0x9f, 0x44, 0x4b, 0x85, 0xed, 0x6e, 0x93, 0xf5, 0x23, 0x26, 0x16,
-	0xb0, 0xf3, 0xdd, 0x95, 0xd7, 0xd1, 0xb4, 0xa8, 0x23, 0xfc, 0xbd, 0xba, 0xb9, 0xc2, 0x5f, 0x49,
-	0x20, 0x67, 0x36, 0x5e, 0x89, 0xf3, 0x77, 0xca, 0x06, 0x5e, 0x29, 0x1f, 0x94, 0xaf, 0x1b, 0x71,
-	0xf9, 0x50, 0xdb, 0x18, 0xd7, 0xe9, 0x9b, 0x0e, 0x58, 0x72, 0x88, 0x96, 0x0a, 0xbb, 0x70, 0xa1,
-	0x14, 0x1f, 0x9e, 0xd9, 0x8a, 0xff, 0x7a, 0x6a, 0x2b, 0xce, 0xc9, 0xe6, 0x3f, 0xb3, 0xcb, 0xe9,
-	0xb5, 0x0b, 0xf1, 0x99, 0x0d, 0xf8, 0x87, 0x49, 0x34, 0x77, 0xcf, 0x8e, 0x59, 0x74, 0xc0, 0xdc,
-	0xad, 0xd0, 0x77, 0x59, 0xa4, 0xdf, 0x45, 0x65, 0xfe, 0xbd, 0x23, 0x4b, 0xbf, 0xd2, 0x16, 0x1f,
-	0x43, 0xed, 0xec, 0x63, 0xa8, 0xfd, 0x20, 0xfb, 0x18, 0x32, 0x1b, 0xf2, 0xf7, 0x80, 0x9f, 0x2f,
-	0x15, 0x5e, 0x8f, 0x91, 0x67, 0x3f, 0x63, 0x8d, 0x02, 0xce, 0x9b, 0xcf, 0xb7, 0x6c, 0xe6, 0x43,
-	0xf9, 0xab, 0xa2, 0xf9, 0x00, 0x50, 0x82, 0x02, 0x8b, 0x50, 0x81, 0xea, 0x1f, 0xa3, 0xc5, 0x88,
-	0x39, 0xcc, 0x3b, 0x60, 0x9d, 0x7c, 0x29, 0x12, 0xa7, 0xd0, 0x1e, 0xa6, 0x78, 0x41, 0x3a, 0xdf,
-	0x2f, 0xec, 0x46, 0xcb, 0x90, 0xe6, 0xb4, 0x83, 0xd0, 0x33, 0x5c, 0xfd, 0x11, 0x5a, 0x88, 0x58,
-	0x2f, 0x4c, 0x8a, 0xb9, 0xc5, 0x49, 0xfd, 0x77, 0x98, 0xe2, 0x79, 0xe1, 0x2b, 0xa6, 0x5e, 0x92,
-	0xa9, 0xc7, 0x70, 0x42, 0x4f, 0x33, 0xc9, 0xf7, 0x5a, 0x5e, 0x48, 0xd1, 0xc0, 0xe7, 0x5e, 0xc8,
-	0xec, 0xbb, 0x64, 0xf2, 0x0d, 0xbe, 0x4b, 0x36, 0xd0, 0x8c, 0xe5, 0xba, 0x11, 0x8b, 0xc5, 0xc8,
-	0xad, 0x0a, 0x21, 0x4a, 0x48, 0xc9, 0x42, 0xda, 0x84, 0x66, 0x1e, 0xf3, 0xe6, 0xcb, 0x57, 0x8d,
-	0x89, 0xa3, 0x57, 0x8d, 0x89, 0x97, 0xc7, 0x0d, 0xed, 0xe8, 0xb8, 0xa1, 0x3d, 0x3b, 0x69, 0x4c,
-	0xbc, 0x38, 0x69, 0x68, 0x47, 0x27, 0x8d, 0x89, 0x1f, 0x4f, 0x1a, 0x13, 0x1f, 0x5d, 0x7a, 0x83,
-	0x8f, 0x01, 0xd7, 0xb6, 0xa7, 0xe1, 0x35, 0x2f, 0xff, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x95, 0x1d,
-	0x77, 0x00, 0x8b, 0x0f, 0x00, 0x00,
+	// 1808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x77, 0x7b, 0x3e, 0x3c, 0x53, 0x63, 0x8f, 0xed, 0xda, 0xec, 0x6a, 0x58, 0x60, 0x7a, 0xa8,
+	0x75, 0xd0, 0x10, 0xd0, 0x18, 0x39, 0xca, 0x0a, 0xad, 0x04, 0x51, 0x7a, 0xcd, 0x66, 0x1d, 0x65,
+	0x77, 0xa3, 0xf2, 0x6a, 0xc3, 0x87, 0xc4, 0xa8, 0x3f, 0xca, 0xe3, 0x56, 0x7a, 0xba, 0x87, 0xae,
+	0xb6, 0x9d, 0xc9, 0x8d, 0x0b, 0x12, 0x88, 0x43, 0x88, 0x38, 0x20, 0x84, 0x50, 0x38, 0xc0, 0x9f,
+	0xc0, 0x5f, 0x80, 0xd0, 0xde, 0xf0, 0x11, 0x21, 0xd4, 0x28, 0xde, 0x0b, 0xcc, 0x71, 0x8e, 0x9c,
+	0x50, 0xbd, 0xaa, 0xae, 0xae, 0xb1, 0x95, 0xec, 0x97, 0x6f, 0xf3, 0x7e, 0xef, 0xa3, 0xab, 0x5e,
+	0xfd, 0xde, 0x7b, 0x55, 0x83, 0x5e, 0x89, 0x42, 0x6f, 0x3b, 0xf0, 0xb6, 0x79, 0x96, 0x1e, 0xf9,
+	0x19, 0x1f, 0x4c, 0xd2, 0x24, 0x4b, 0xf0, 0x72, 0xe0, 0x5d, 0xbf, 0x91, 0xb2, 0x49, 0xc2, 0xb7,
+	0x01, 0xf0, 0x8e, 0x0e, 0xb6, 0x47, 0xc9, 0x28, 0x01, 0x01, 0x7e, 0x49, 0xc3, 0xeb, 0xf6, 0x28,
+	0x49, 0x46, 0x11, 0x2b, 0xad, 0xb2, 0x70, 0xcc, 0x78, 0xe6, 0x8e, 0x27, 0xca, 0xe0, 0x9a, 0x88,
+	0x0f, 0x3f, 0xfd, 0x24, 0xda, 0xf6, 0x58, 0x81, 0x37, 0xd9, 0x87, 0x99, 0xfc, 0x49, 0xfe, 0xb0,
+	0x8c, 0x5a, 0x77, 0xc2, 0x88, 0x3d, 0x62, 0x29, 0x0f, 0x93, 0x18, 0xbf, 0x8b, 0x56, 0x8e, 0xe5,
+	0xcf, 0x8e, 0xd5, 0xb3, 0xfa, 0xad, 0x9d, 0x8d, 0x41, 0x11, 0x60, 0xf0, 0x88, 0xf9, 0x59, 0x92,
+	0x3a, 0xbd, 0xc7, 0xb9, 0xbd, 0x34, 0xcb, 0xed, 0xc2, 0x70, 0x9e, 0xdb, 0x6b, 0x1f, 0x8e, 0xa3,
+	0x5b, 0x44, 0xc9, 0x84, 0x16, 0x1a, 0x7c, 0x13, 0xad, 0x04, 0x2c, 0x62, 0x19, 0x0b, 0x3a, 0xcb,
+	0x3d, 0xab, 0xdf, 0x70, 0xbe, 0x22, 0xfc, 0x14, 0xa4, 0xfd, 0x94, 0x4c, 0x68, 0xa1, 0xc1, 0x6f,
+	0x08, 0xbf, 0xe3, 0xd0, 0x67, 0xbc, 0x53, 0xe9, 0x55, 0xfa, 0xab, 0xce, 0x97, 0xa5, 0x1f, 0x40,
+	0xf3, 0xdc, 0x5e, 0x55, 0x7e, 0x42, 0x06, 0x37, 0x50, 0x60, 0x8a, 0xd6, 0xc3, 0xf8, 0xd8, 0x8d,
+	0xc2, 0x60, 0x58, 0xb8, 0x57, 0xc1, 0xfd, 0x1b, 0xb3, 0xdc, 0x6e, 0x2b, 0xd5, 0xae, 0x8e, 0x72,
+	0x05, 0xa2, 0x2c, 0xc0, 0x84, 0x9e, 0x33, 0x23, 0x3f, 0xb3, 0x50, 0x4b, 0x25, 0xe7, 0xdd, 0x90,
+	0x67, 0x38, 0x42, 0x0d, 0xb5, 0x3b, 0xde, 0xb1, 0x7a, 0x95, 0x7e, 0x6b, 0x67, 0x7d, 0x10, 0x78,
+	0x03, 0x23, 0x87, 0xce, 0x9b, 0x22, 0x41, 0x67, 0xb9, 0xdd, 0xa2, 0xee, 0x89, 0xc2, 0xf8, 0x2c,
+	0xb7, 0xb5, 0xdf, 0x85, 0x84, 0x7d, 0x72, 0xba, 0x65, 0xda, 0x52, 0x6d, 0x79, 0xab, 0xfa, 0xdb,
+	0x4f, 0xed, 0x25, 0x72, 0xda, 0x42, 0x9b, 0xe2, 0x03, 0x7b, 0xf1, 0x41, 0xf2, 0x30, 0x3d, 0x8a,
+	0x7d, 0x57, 0x24, 0xe9, 0x35, 0x54, 0x8d, 0xdd, 0x31, 0x83, 0x73, 0x6a, 0x3a, 0xd7, 0x66, 0xb9,
+	0x0d, 0xf2, 0x3c, 0xb7, 0x11, 0x44, 0x17, 0x02, 0xa1, 0x80, 0x09, 0x5b, 0x1e, 0x7e, 0xc4, 0x3a,
+	0x95, 0x9e, 0xd5, 0xaf, 0x48, 0x5b, 0x21, 0x6b, 0x5b, 0x21, 0x10, 0x0a, 0x18, 0x7e, 0x13, 0xa1,
+	0x71, 0x12, 0x84, 0x07, 0x21, 0x0b, 0x86, 0xbc, 0x53, 0x03, 0x8f, 0xde, 0x2c, 0xb7, 0x9b, 0x05,
+	0xba, 0x3f, 0xcf, 0xed, 0x75, 0x70, 0xd3, 0x08, 0xa1, 0xa5, 0x16, 0xff, 0xc5, 0x42, 0x2d, 0x1d,
+	0xc1, 0x9b, 0x76, 0x56, 0x7b, 0x56, 0xbf, 0xea, 0xfc, 0xc6, 0x12, 0x69, 0xf9, 0x67, 0x6e, 0xbf,
+	0x3e, 0x0a, 0xb3, 0xc3, 0x23, 0x6f, 0xe0, 0x27, 0xe3, 0x6d, 0x3e, 0x8d, 0xfd, 0xec, 0x30, 0x8c,
+	0x47, 0xc6, 0x2f, 0x93, 0xb4, 0x83, 0xfd, 0xc3, 0x24, 0xcd, 0xf6, 0x76, 0x67, 0xb9, 0xad, 0x17,
+	0xe5, 0x4c, 0xe7, 0xb9, 0xbd, 0xb1, 0xf0, 0x7d, 0x67, 0x4a, 0x7e, 0x77, 0xba, 0xf5, 0x22, 0x81,
+	0xa9, 0x11, 0xd6, 0x24, 0x7f, 0xf3, 0xe5, 0xc9, 0x7f, 0x0b, 0x35, 0x38, 0xfb, 0xe9, 0x11, 0x8b,
+	0x7d, 0xd6, 0x41, 0x90, 0xc5, 0xae, 0x60, 0x41, 0x81, 0xcd, 0x73, 0xbb, 0x2d, 0x73, 0xaf, 0x00,
+	0x42, 0xb5, 0x0e, 0x3f, 0x40, 0x6d, 0x3e, 0x1d, 0x47, 0x61, 0xfc, 0xc1, 0x30, 0x73, 0xd3, 0x11,
+	0xcb, 0x3a, 0x9b, 0x70, 0xca, 0xfd, 0x59, 0x6e, 0xaf, 0x29, 0xcd, 0x43, 0x50, 0x68, 0x1e, 0x2f,
+	0xa0, 0x84, 0x2e, 0x5a, 0xe1, 0xdb, 0xa8, 0xe5, 0x45, 0x89, 0xff, 0x01, 0x1f, 0x1e, 0xba, 0xfc,
+	0xb0, 0x83, 0x7b, 0x56, 0x7f, 0xd5, 0x21, 0x22, 0xad, 0x12, 0xbe, 0xeb, 0xf2, 0x43, 0x9d, 0xd6,
+	0x12, 0x22, 0xd4, 0xd0, 0xe3, 0xef, 0xa1, 0x26, 0x8b, 0xfd, 0x74, 0x3a, 0x11, 0x05, 0x7d, 0x05,
+	0x42, 0x00, 0x31, 0x34, 0xa8, 0x89, 0xa1, 0x11, 0x42, 0x4b, 0x2d, 0x76, 0x50, 0x35, 0x9b, 0x4e,
+	0x18, 0xf4, 0x82, 0xf6, 0xce, 0xb5, 0x32, 0xb9, 0x9a, 0xdc, 0xd3, 0x09, 0x93, 0xec, 0x14, 0x76,
+	0x9a, 0x9d, 0x42, 0x20, 0x14, 0x30, 0x7c, 0x07, 0xb5, 0x26, 0x2c, 0x1d, 0x87, 0x5c, 0x96, 0x60,
+	0xb5, 0x67, 0xf5, 0xd7, 0x9c, 0xad, 0x59, 0x6e, 0x9b, 0xf0, 0x3c, 0xb7, 0x37, 0xc1, 0xd3, 0xc0,
+	0x08, 0x35, 0x2d, 0xf0, 0x3b, 0x06, 0x47, 0x63, 0xde, 0x69, 0xf5, 0xac, 0x7e, 0x0d, 0xfa, 0x84,
+	0x26, 0xc4, 0x7d, 0x7e, 0x81, 0x67, 0xf7, 0x39, 0xf9, 0x5f, 0x6e, 0x57, 0xc2, 0x38, 0xa3, 0x86,
+	0x19, 0x3e, 0x40, 0x32, 0x4b, 0x43, 0xa8, 0xb1, 0x35, 0x08, 0xf5, 0xf6, 0x59, 0x6e, 0xaf, 0x52,
+	0xf7, 0xc4, 0x11, 0x8a, 0xfd, 0xf0, 0x23, 0x26, 0x12, 0xe5, 0x15, 0x82, 0x4e, 0x94, 0x46, 0x8a,
+	0xc0, 0x9f, 0x9c, 0x6e, 0x2d, 0xb8, 0xd1, 0xd2, 0x09, 0x3f, 0x42, 0x8d, 0x49, 0xe4, 0x66, 0x07,
+	0x49, 0x3a, 0xee, 0xb4, 0x81, 0xa0, 0x46, 0x0e, 0xdf, 0x53, 0x9a, 0x5d, 0x37, 0x73, 0x1d, 0xa2,
+	0x68, 0xaa, 0xed, 0x35, 0xdb, 0x0a, 0x80, 0x50, 0xad, 0xc3, 0xbb, 0xa8, 0x15, 0x25, 0xbe, 0x1b,
+	0x0d, 0x0f, 0x22, 0x77, 0xc4, 0x3b, 0xff, 0x59, 0x81, 0xa4, 0x02, 0x3b, 0x00, 0xbf, 0x23, 0x60,
+	0x9d, 0x8c, 0x12, 0x22, 0xd4, 0xd0, 0xe3, 0xbb, 0x68, 0x55, 0x51, 0x5f, 0x72, 0xec, 0xbf, 0x2b,
+	0xc0, 0x10, 0x38, 0x1b, 0xa5, 0x50, 0x2c, 0xdb, 0x34, 0x2b, 0x46, 0xd2, 0xcc, 0xb4, 0x30, 0xc7,
+	0x46, 0xfd, 0x79, 0xc6, 0x06, 0x45, 0x2b, 0xaa, 0x7b, 0x77, 0x56, 0xc0, 0xef, 0x3b, 0x67, 0xb9,
+	0x8d, 0xa8, 0x7b, 0xb2, 0x27, 0x51, 0x11, 0x45, 0x19, 0xe8, 0x28, 0x4a, 0x16, 0x3d, 0xd8, 0xb0,
+	0xa4, 0x85, 0x9d, 0xa8, 0xc4, 0x38, 0x19, 0x9a, 0x94, 0x6b, 0x40, 0x68, 0xa8, 0xc4, 0x38, 0x79,
+	0x6f, 0x81, 0x74, 0xb2, 0x12, 0x17, 0x50, 0x42, 0x17, 0xad, 0x54, 0x4b, 0x7f, 0x1f, 0x35, 0xe1,
+	0x88, 0x61, 0xa6, 0xbc, 0x83, 0xea, 0xb2, 0xca, 0xd4, 0x44, 0xb9, 0x52, 0x9e, 0x2a, 0x18, 0x89,
+	0xd2, 0x70, 0xbe, 0xaa, 0x8e, 0x54, 0x99, 0xce, 0x73, 0xbb, 0x55, 0x32, 0x88, 0x50, 0x05, 0x93,
+	0x3f, 0x5b, 0xe8, 0xea, 0x5e, 0x1c, 0x84, 0x29, 0xf3, 0x33, 0x95, 0x4f, 0xc6, 0x1f, 0xc4, 0xd1,
+	0xf4, 0x72, 0x5a, 0xc0, 0xa5, 0x1d, 0x32, 0xf9, 0x7d, 0x15, 0xd5, 0x6f, 0x27, 0x47, 0x71, 0xc6,
+	0xf1, 0x1b, 0xa8, 0x76, 0x10, 0x46, 0x8c, 0xc3, 0x28, 0xab, 0x39, 0xf6, 0x2c, 0xb7, 0x25, 0xa0,
+	0x37, 0x09, 0x92, 0xae, 0x3d, 0xa9, 0xc4, 0xf7, 0x50, 0x4b, 0xee, 0x33, 0x49, 0x43, 0xc6, 0xa1,
+	0xab, 0xd4, 0x9c, 0x6f, 0x8a, 0x95, 0x18, 0xb0, 0x5e, 0x89, 0x81, 0xe9, 0x40, 0xa6, 0x21, 0x7e,
+	0x0b, 0x35, 0x54, 0xcf, 0xe4, 0x30, 0x27, 0x6b, 0xce, 0xab, 0xd0, 0xaf, 0x15, 0x56, 0xf6, 0x6b,
+	0x05, 0xe8, 0x28, 0xda, 0x04, 0x7f, 0xb7, 0x24, 0x6e, 0x15, 0x22, 0xdc, 0xf8, 0x22, 0xe2, 0x16,
+	0xfe, 0x9a, 0xbf, 0x03, 0x54, 0xf3, 0xa6, 0x19, 0x2b, 0x86, 0x6e, 0x47, 0xe4, 0x01, 0x80, 0xf2,
+	0xb0, 0x85, 0x44, 0xa8, 0x44, 0x17, 0x26, 0x4c, 0xfd, 0x39, 0x27, 0xcc, 0x3e, 0x6a, 0xca, 0x3b,
+	0xd2, 0x30, 0x0c, 0x60, 0xb8, 0xac, 0x3a, 0x37, 0xcf, 0x72, 0xbb, 0x21, 0xef, 0x3d, 0x30, 0x71,
+	0x1b, 0xd2, 0x60, 0x2f, 0xd0, 0x81, 0x0a, 0x40, 0x54, 0x8b, 0xb6, 0xa4, 0xda, 0x4e, 0x50, 0xcc,
+	0x6c, 0x24, 0xf8, 0x45, 0xfa, 0x88, 0x2a, 0x90, 0x9f, 0x5b, 0xa8, 0x29, 0xe9, 0xb1, 0xcf, 0x32,
+	0xfc, 0x16, 0xaa, 0xfb, 0x20, 0xa8, 0x0a, 0x41, 0xe2, 0xce, 0x25, 0xd5, 0x65, 0x61, 0x48, 0x0b,
+	0x9d, 0x2b, 0x10, 0x09, 0x55, 0xb0, 0x68, 0x2a, 0x7e, 0xca, 0xdc, 0xe2, 0x2e, 0x5a, 0x91, 0x4d,
+	0x45, 0x41, 0xfa, 0x6c, 0x94, 0x4c, 0x68, 0xa1, 0x21, 0xbf, 0x58, 0x46, 0x57, 0x8d, 0xdb, 0xdd,
+	0x2e, 0x9b, 0xa4, 0x4c, 0x5e, 0xc0, 0x2e, 0xf7, 0xae, 0xbc, 0x83, 0xea, 0x32, 0x8f, 0xb0, 0xbc,
+	0x55, 0xe7, 0xba, 0xd8, 0x92, 0x44, 0x2e, 0xdc, 0x78, 0x15, 0x2e, 0xf6, 0x54, 0x34, 0xbc, 0x4a,
+	0xd9, 0x28, 0x3f, 0xaf, 0xc5, 0x95, 0x4d, 0xed, 0xe6, 0x22, 0x4f, 0x9f, 0xb5, 0xc1, 0x92, 0x13,
+	0x74, 0xd5, 0xb8, 0x0b, 0x1b, 0xa9, 0xf8, 0xc1, 0x85, 0x5b, 0xf1, 0x97, 0xce, 0xdd, 0x8a, 0x4b,
+	0x63, 0xe7, 0x6b, 0xc5, 0x70, 0xfa, 0xdc, 0x0b, 0xf1, 0x85, 0x1b, 0xf0, 0xdf, 0x96, 0x51, 0xfb,
+	0x81, 0xc7, 0x59, 0x7a, 0xcc, 0x82, 0x3b, 0x49, 0x14, 0xb0, 0x14, 0xdf, 0x47, 0x55, 0xf1, 0xde,
+	0x51, 0xa9, 0xbf, 0x3e, 0x90, 0x8f, 0xa1, 0x41, 0xf1, 0x18, 0x1a, 0x3c, 0x2c, 0x1e, 0x43, 0x4e,
+	0x57, 0x7d, 0x0f, 0xec, 0xcb, 0x4b, 0x45, 0x38, 0x66, 0xe4, 0xe3, 0x7f, 0xdb, 0x16, 0x05, 0x5c,
+	0x14, 0x5f, 0xe4, 0x7a, 0x2c, 0x82, 0xf4, 0x37, 0x65, 0xf1, 0x01, 0xa0, 0x09, 0x05, 0x12, 0xa1,
+	0x12, 0xc5, 0x3f, 0x46, 0x9b, 0x29, 0xf3, 0x59, 0x78, 0xcc, 0x86, 0xe5, 0xa5, 0x48, 0x9e, 0xc2,
+	0x60, 0x96, 0xdb, 0x1b, 0x4a, 0xf9, 0x7d, 0xe3, 0x6e, 0x74, 0x0d, 0xc2, 0x9c, 0x57, 0x10, 0x7a,
+	0xc1, 0x16, 0xbf, 0x8f, 0x36, 0x52, 0x36, 0x4e, 0x32, 0x33, 0xb6, 0x3c, 0xa9, 0x6f, 0xcd, 0x72,
+	0x7b, 0x5d, 0xea, 0xcc, 0xd0, 0x57, 0x55, 0xe8, 0x05, 0x9c, 0xd0, 0xf3, 0x96, 0xe4, 0xaf, 0x56,
+	0x99, 0x48, 0x59, 0xc0, 0x97, 0x9e, 0xc8, 0xe2, 0x5d, 0xb2, 0xfc, 0x0c, 0xef, 0x92, 0x9b, 0x68,
+	0xc5, 0x0d, 0x82, 0x94, 0x71, 0xd9, 0x72, 0x9b, 0x92, 0x88, 0x0a, 0xd2, 0xb4, 0x50, 0x32, 0xa1,
+	0x85, 0x86, 0xfc, 0xab, 0x8e, 0xda, 0xb7, 0x93, 0xf8, 0x20, 0x0a, 0xfd, 0x8c, 0x32, 0x3f, 0x49,
+	0x83, 0x4b, 0xdf, 0xc6, 0x2d, 0xd4, 0x48, 0xd2, 0x70, 0x14, 0xc6, 0x6e, 0x41, 0x09, 0x68, 0xae,
+	0x05, 0xa6, 0x7b, 0x62, 0x01, 0x10, 0xaa, 0x75, 0xf8, 0x4f, 0x16, 0xaa, 0x9f, 0x84, 0x71, 0xcc,
+	0x52, 0xd8, 0x56, 0xd5, 0xf9, 0xd5, 0x4b, 0x3e, 0x7e, 0x54, 0x34, 0xdd, 0x08, 0xa4, 0xf8, 0xc2,
+	0x8f, 0x1e, 0x15, 0x0e, 0xff, 0xd1, 0x42, 0xb5, 0x28, 0xe1, 0x2c, 0x05, 0x72, 0x55, 0x9d, 0x5f,
+	0xbe, 0xe4, 0x32, 0x65, 0xb0, 0xb2, 0x60, 0x12, 0xfe, 0x12, 0x8b, 0x94, 0xc1, 0xf0, 0x09, 0x5a,
+	0x97, 0xab, 0x1d, 0x16, 0x37, 0xee, 0x4e, 0xed, 0xa9, 0x47, 0xbc, 0xa3, 0x8e, 0xb8, 0x2d, 0x5d,
+	0xef, 0x29, 0xcf, 0x79, 0x6e, 0xbf, 0x62, 0xe4, 0xaf, 0x80, 0xe5, 0xb1, 0x9f, 0xb3, 0xc5, 0x1c,
+	0xb5, 0x61, 0x05, 0xe5, 0x77, 0xeb, 0x4f, 0xfd, 0xee, 0xb7, 0xd5, 0x77, 0xd7, 0xc0, 0xd3, 0xf8,
+	0xec, 0x95, 0x32, 0x21, 0x8b, 0x5f, 0x5d, 0xb4, 0x14, 0x13, 0x54, 0xed, 0x16, 0xde, 0x12, 0x2b,
+	0x30, 0xa9, 0x60, 0x82, 0x4a, 0x58, 0x3d, 0x1e, 0x36, 0x8c, 0x5d, 0xec, 0xc3, 0xdb, 0xdd, 0xd0,
+	0x8b, 0x17, 0xbc, 0x5c, 0x39, 0xc4, 0x68, 0x94, 0x2f, 0x78, 0x40, 0x17, 0xde, 0x1f, 0x1a, 0x21,
+	0xb4, 0xd4, 0x92, 0x5f, 0x2f, 0xa3, 0xe6, 0x3d, 0x96, 0x8e, 0x98, 0xe3, 0x72, 0x76, 0xc9, 0x73,
+	0xee, 0x35, 0x54, 0x0d, 0xdc, 0xcc, 0x55, 0x53, 0x0e, 0xda, 0x83, 0x90, 0x75, 0x1d, 0x0a, 0x81,
+	0x50, 0xc0, 0xf0, 0x4f, 0x50, 0x43, 0x27, 0xbf, 0xf2, 0xd4, 0xe4, 0x7f, 0xbd, 0x98, 0x2b, 0xe3,
+	0x32, 0xef, 0xed, 0x85, 0xf7, 0x9b, 0x4c, 0xb9, 0xd6, 0xeb, 0xbf, 0x45, 0xaa, 0x4f, 0xff, 0x5b,
+	0x84, 0xfc, 0x10, 0xad, 0xe9, 0x94, 0xc0, 0xad, 0xfd, 0x2e, 0xaa, 0x79, 0x2e, 0x67, 0xc5, 0xc0,
+	0x5b, 0x13, 0x03, 0x4f, 0x5b, 0x38, 0x37, 0xd4, 0x62, 0xa4, 0x8d, 0x8e, 0x28, 0x24, 0x32, 0xfb,
+	0xfb, 0x56, 0x55, 0xfc, 0xa0, 0x52, 0xe9, 0xbc, 0xfd, 0xf8, 0xb3, 0xee, 0xd2, 0xe9, 0x67, 0xdd,
+	0xa5, 0xc7, 0x67, 0x5d, 0xeb, 0xf4, 0xac, 0x6b, 0x7d, 0xfc, 0xa4, 0xbb, 0xf4, 0xe9, 0x93, 0xae,
+	0x75, 0xfa, 0xa4, 0xbb, 0xf4, 0x8f, 0x27, 0xdd, 0xa5, 0x1f, 0xbd, 0xfa, 0x0c, 0x05, 0x14, 0x78,
+	0x5e, 0x1d, 0xb2, 0xf2, 0xfa, 0xff, 0x07, 0x00, 0x99, 0x88, 0xda, 0x5c, 0x59, 0x14, 0x00, 0x00,
 }
 
 func (m *FileVersion) Marshal() (dAtA []byte, err error) {
@@ -1244,6 +1386,170 @@ func (m *ObservedDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConflictRecord) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LoserSize != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.LoserSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.WinnerSize != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.WinnerSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LoserModified, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LoserModified):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStructs(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WinnerModified, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WinnerModified):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStructs(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.Loser != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Loser))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Winner != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Winner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Original) > 0 {
+		i -= len(m.Original)
+		copy(dAtA[i:], m.Original)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Original)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStructs(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeBase) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x20
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Modified, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Modified):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStructs(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeBaseList) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeBaseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeBaseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bases) > 0 {
+		for iNdEx := len(m.Bases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStructs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStructs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStructs(v)
 	base := offset
@@ -1525,6 +1831,72 @@ func (m *ObservedDevice) ProtoSize() (n int) {
 	return n
 }
 
+func (m *ConflictRecord) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStructs(uint64(l))
+	l = len(m.Original)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	if m.Winner != 0 {
+		n += 1 + sovStructs(uint64(m.Winner))
+	}
+	if m.Loser != 0 {
+		n += 1 + sovStructs(uint64(m.Loser))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WinnerModified)
+	n += 1 + l + sovStructs(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LoserModified)
+	n += 1 + l + sovStructs(uint64(l))
+	if m.WinnerSize != 0 {
+		n += 1 + sovStructs(uint64(m.WinnerSize))
+	}
+	if m.LoserSize != 0 {
+		n += 1 + sovStructs(uint64(m.LoserSize))
+	}
+	return n
+}
+
+func (m *MergeBase) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Version.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Modified)
+	n += 1 + l + sovStructs(uint64(l))
+	if m.Size != 0 {
+		n += 1 + sovStructs(uint64(m.Size))
+	}
+	return n
+}
+
+func (m *MergeBaseList) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bases) > 0 {
+		for _, e := range m.Bases {
+			l = e.ProtoSize()
+			n += 1 + l + sovStructs(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovStructs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3360,6 +3732,516 @@ func (m *ObservedDevice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConflictRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Original", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Original = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			m.Winner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Winner |= github_com_syncthing_syncthing_lib_protocol.ShortID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loser", wireType)
+			}
+			m.Loser = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Loser |= github_com_syncthing_syncthing_lib_protocol.ShortID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerModified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WinnerModified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoserModified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LoserModified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerSize", wireType)
+			}
+			m.WinnerSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinnerSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoserSize", wireType)
+			}
+			m.LoserSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoserSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size", wireType)
+			}
+			m.Size = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeBaseList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeBaseList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeBaseList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bases = append(m.Bases, MergeBase{})
+			if err := m.Bases[len(m.Bases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStructs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58,6 +58,9 @@ const (
 	ListenAddressesChanged
 	LoginAttempt
 	Failure
+	ConflictDetected
+	ConflictMergeAttempted
+	DeviceScheduleChanged
 
 	AllEvents = (1 << iota) - 1
 )
@@ -135,6 +138,12 @@ func (t EventType) String() string {
 		return "FolderWatchStateChanged"
 	case Failure:
 		return "Failure"
+	case ConflictDetected:
+		return "ConflictDetected"
+	case ConflictMergeAttempted:
+		return "ConflictMergeAttempted"
+	case DeviceScheduleChanged:
+		return "DeviceScheduleChanged"
 	default:
 		return "Unknown"
 	}
@@ -222,6 +231,12 @@ func UnmarshalEventType(s string) EventType {
 		return FolderWatchStateChanged
 	case "Failure":
 		return Failure
+	case "ConflictDetected":
+		return ConflictDetected
+	case "ConflictMergeAttempted":
+		return ConflictMergeAttempted
+	case "DeviceScheduleChanged":
+		return DeviceScheduleChanged
 	default:
 		return 0
 	}
@@ -255,6 +270,49 @@ type Event struct {
 	Data     interface{} `json:"data"`
 }
 
+// Folder returns the ID of the folder the event concerns, or the empty
+// string if it doesn't concern a folder.
+func (e Event) Folder() string {
+	return e.dataField("folder")
+}
+
+// Device returns the ID of the device the event concerns, or the empty
+// string if it doesn't concern a device.
+func (e Event) Device() string {
+	if dev := e.dataField("device"); dev != "" {
+		return dev
+	}
+	if e.Type&(DeviceConnected|DeviceDisconnected) != 0 {
+		// These predate the convention of calling it "device".
+		return e.dataField("id")
+	}
+	return ""
+}
+
+func (e Event) dataField(name string) string {
+	switch data := e.Data.(type) {
+	case map[string]string:
+		return data[name]
+	case map[string]interface{}:
+		val, _ := data[name].(string)
+		return val
+	case nil:
+		return ""
+	}
+	// Some events have a struct as data; look at it the way API consumers
+	// would.
+	bs, err := json.Marshal(e.Data)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bs, &fields); err != nil {
+		return ""
+	}
+	val, _ := fields[name].(string)
+	return val
+}
+
 type Subscription interface {
 	C() <-chan Event
 	Poll(timeout time.Duration) (Event, error)
@@ -334,6 +392,7 @@ func (l *logger) sendEvent(e Event) {
 	dl.Debugln("log", l.nextGlobalID, e.Type, e.Data)
 
 	e.GlobalID = l.nextGlobalID
+	metricEvents.WithLabelValues(e.Type.String(), metricEventStateCreated).Inc()
 
 	for i, s := range l.subs {
 		if s.mask&e.Type != 0 {
@@ -356,6 +415,12 @@ func (l *logger) sendEvent(e Event) {
 			if !l.timeout.Stop() && !timedOut {
 				<-l.timeout.C
 			}
+
+			if timedOut {
+				metricEvents.WithLabelValues(e.Type.String(), metricEventStateDropped).Inc()
+			} else {
+				metricEvents.WithLabelValues(e.Type.String(), metricEventStateDelivered).Inc()
+			}
 		}
 	}
 }
@@ -363,6 +363,35 @@ func TestUnmarshalEvent(t *testing.T) {
 	}
 }
 
+func TestEventFolderDevice(t *testing.T) {
+	type structData struct {
+		Folder string `json:"folder"`
+		Device string `json:"device"`
+	}
+	cases := []struct {
+		event  Event
+		folder string
+		device string
+	}{
+		{Event{Type: StateChanged, Data: map[string]interface{}{"folder": "a", "from": "idle"}}, "a", ""},
+		{Event{Type: LocalChangeDetected, Data: map[string]string{"folder": "b"}}, "b", ""},
+		{Event{Type: RemoteIndexUpdated, Data: map[string]interface{}{"folder": "c", "device": "dev"}}, "c", "dev"},
+		{Event{Type: DeviceConnected, Data: map[string]string{"id": "dev"}}, "", "dev"},
+		{Event{Type: DeviceDiscovered, Data: map[string]interface{}{"id": "dev"}}, "", ""},
+		{Event{Type: FolderCompletion, Data: structData{Folder: "d", Device: "dev"}}, "d", "dev"},
+		{Event{Type: Starting, Data: nil}, "", ""},
+		{Event{Type: FolderErrors, Data: map[string]interface{}{"folder": 1}}, "", ""},
+	}
+	for i, tc := range cases {
+		if folder := tc.event.Folder(); folder != tc.folder {
+			t.Errorf("%d: folder %q != %q", i, folder, tc.folder)
+		}
+		if device := tc.event.Device(); device != tc.device {
+			t.Errorf("%d: device %q != %q", i, device, tc.device)
+		}
+	}
+}
+
 func TestUnsubscribeContention(t *testing.T) {
 	// Check that we can unsubscribe without blocking the whole system.
 
@@ -21,9 +21,11 @@ func TestIsInternal(t *testing.T) {
 		{".stfolder", true},
 		{".stignore", true},
 		{".stversions", true},
+		{".stplaceholders", true},
 		{".stfolder/foo", true},
 		{".stignore/foo", true},
 		{".stversions/foo", true},
+		{".stplaceholders/foo", true},
 
 		{".stfolderfoo", false},
 		{".stignorefoo", false},
@@ -0,0 +1,100 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"time"
+)
+
+// PlaceholdersDir is where the metadata of placeholder files is kept,
+// relative to the folder root. Each placeholder has a sidecar file at the
+// same relative path below this directory.
+const PlaceholdersDir = ".stplaceholders"
+
+// Placeholder describes the file that an empty placeholder file stands in
+// for.
+type Placeholder struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+func placeholderName(name string) string {
+	return filepath.Join(PlaceholdersDir, name)
+}
+
+// WritePlaceholder records the metadata for the placeholder at name. It
+// does not touch the placeholder file itself.
+func WritePlaceholder(filesystem Filesystem, name string, p Placeholder) error {
+	bs, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	sidecar := placeholderName(name)
+	if err := filesystem.MkdirAll(filepath.Dir(sidecar), 0o755); err != nil {
+		return err
+	}
+	_ = filesystem.Hide(PlaceholdersDir)
+	fd, err := filesystem.Create(sidecar)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// ReadPlaceholder returns the metadata recorded for the placeholder at
+// name, and false if there is none.
+func ReadPlaceholder(filesystem Filesystem, name string) (Placeholder, bool) {
+	fd, err := filesystem.Open(placeholderName(name))
+	if err != nil {
+		return Placeholder{}, false
+	}
+	defer fd.Close()
+	bs, err := io.ReadAll(fd)
+	if err != nil {
+		return Placeholder{}, false
+	}
+	var p Placeholder
+	if err := json.Unmarshal(bs, &p); err != nil {
+		return Placeholder{}, false
+	}
+	return p, true
+}
+
+// IsPlaceholder returns true if the file at name, as described by info, is
+// an untouched placeholder: an empty regular file with recorded metadata
+// and the modification time that was set when it was created.
+func IsPlaceholder(filesystem Filesystem, name string, info FileInfo) bool {
+	if !info.IsRegular() || info.Size() != 0 {
+		return false
+	}
+	p, ok := ReadPlaceholder(filesystem, name)
+	return ok && p.ModTime.Equal(info.ModTime())
+}
+
+// RemovePlaceholder removes the metadata recorded for the placeholder at
+// name, along with any directories left empty by doing so. It is not an
+// error if there is no such metadata.
+func RemovePlaceholder(filesystem Filesystem, name string) error {
+	sidecar := placeholderName(name)
+	if err := filesystem.Remove(sidecar); err != nil && !IsNotExist(err) {
+		return err
+	}
+	for dir := filepath.Dir(sidecar); dir != PlaceholdersDir && dir != "."; dir = filepath.Dir(dir) {
+		if err := filesystem.Remove(dir); err != nil {
+			// Not empty, or otherwise not ours to remove.
+			break
+		}
+	}
+	return nil
+}
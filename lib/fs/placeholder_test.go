@@ -0,0 +1,93 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPlaceholder(t *testing.T) {
+	ffs := NewFilesystem(FilesystemTypeBasic, t.TempDir())
+
+	name := filepath.Join("dir", "file")
+	modTime := time.Unix(1234567890, 123456789)
+
+	if err := ffs.Mkdir("dir", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := ffs.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+	if err := ffs.Chtimes(name, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	isPlaceholder := func() bool {
+		t.Helper()
+		info, err := ffs.Lstat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return IsPlaceholder(ffs, name, info)
+	}
+
+	if isPlaceholder() {
+		t.Error("file without metadata is a placeholder")
+	}
+
+	if err := WritePlaceholder(ffs, name, Placeholder{Size: 1000, ModTime: modTime}); err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := ReadPlaceholder(ffs, name); !ok || p.Size != 1000 || !p.ModTime.Equal(modTime) {
+		t.Errorf("unexpected placeholder %v (%v)", p, ok)
+	}
+	if !isPlaceholder() {
+		t.Error("expected placeholder")
+	}
+
+	// A touched placeholder no longer counts.
+	if err := ffs.Chtimes(name, modTime, modTime.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if isPlaceholder() {
+		t.Error("placeholder with modified mtime is a placeholder")
+	}
+
+	// Neither does one that was written to.
+	if err := ffs.Chtimes(name, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	fd, err = ffs.OpenFile(name, OptWriteOnly, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Write([]byte("data"))
+	fd.Close()
+	if err := ffs.Chtimes(name, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	if isPlaceholder() {
+		t.Error("placeholder with contents is a placeholder")
+	}
+
+	if err := RemovePlaceholder(ffs, name); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ReadPlaceholder(ffs, name); ok {
+		t.Error("metadata still present after removal")
+	}
+	if _, err := ffs.Lstat(filepath.Join(PlaceholdersDir, "dir")); !IsNotExist(err) {
+		t.Error("expected empty metadata dir to be removed, got", err)
+	}
+	if err := RemovePlaceholder(ffs, name); err != nil {
+		t.Error("removing missing metadata:", err)
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+)
+
+// gitignoreFile is the name of git's ignore files, in the folder root and
+// in any directory below it.
+const gitignoreFile = ".gitignore"
+
+// gitIgnores are the patterns of a .gitignore file, which apply to the
+// contents of its directory.
+type gitIgnores struct {
+	patterns []gitPattern
+	stale    bool // needs to be reloaded, but is used until then
+}
+
+// A gitPattern is a line of a .gitignore file, interpreted the way git
+// does it.
+type gitPattern struct {
+	pattern  string // without the negation and the leading and trailing slash
+	negate   bool
+	dirOnly  bool // had a trailing slash
+	basename bool // had no slash, matches the name at any depth
+	source   *source
+}
+
+// matches returns whether the pattern matches the path, relative to the
+// directory of the .gitignore file. isDir is only called when needed.
+func (p gitPattern) matches(rel string, isDir func() bool) bool {
+	fold := defaultResult.IsCaseFolded()
+	if p.basename {
+		rel = path.Base(rel)
+	}
+	if dowild(p.pattern, rel, fold) != wmMatch {
+		return false
+	}
+	return !p.dirOnly || isDir()
+}
+
+// parseGitignore parses a .gitignore file. Git never rejects a pattern;
+// the ones that make no sense simply don't match anything.
+func parseGitignore(r io.Reader, file string) ([]gitPattern, error) {
+	var patterns []gitPattern
+	scanner := bufio.NewScanner(r)
+	for i := 1; scanner.Scan(); i++ {
+		line := scanner.Text()
+		if i == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		text := trimTrailingSpaces(line)
+		p := gitPattern{source: &source{file: file, line: i, text: text}}
+		if strings.HasPrefix(text, "!") {
+			p.negate = true
+			text = text[1:]
+		}
+		if strings.HasSuffix(text, "/") {
+			p.dirOnly = true
+			text = text[:len(text)-1]
+		}
+		p.basename = !strings.Contains(text, "/")
+		p.pattern = strings.TrimPrefix(text, "/")
+		if p.pattern == "" {
+			continue
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns, scanner.Err()
+}
+
+// trimTrailingSpaces removes trailing spaces that aren't escaped with a
+// backslash.
+func trimTrailingSpaces(line string) string {
+	lastSpace := -1
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			if lastSpace < 0 {
+				lastSpace = i
+			}
+		case '\\':
+			i++
+			if i == len(line) {
+				return line
+			}
+			lastSpace = -1
+		default:
+			lastSpace = -1
+		}
+	}
+	if lastSpace >= 0 {
+		return line[:lastSpace]
+	}
+	return line
+}
+
+// Results of dowild. The aborts let the callers up the stack know that
+// trying further positions in the text is pointless.
+const (
+	wmMatch = iota
+	wmNoMatch
+	wmAbortAll
+	wmAbortToStarStar
+)
+
+// dowild matches the text against the pattern like git's wildmatch with
+// WM_PATHNAME: "*", "?" and bracket expressions don't match a slash, and
+// "**" matches across directories when it's a whole path component.
+func dowild(pattern, text string, fold bool) int {
+	p, t := 0, 0
+	for ; p < len(pattern); p, t = p+1, t+1 {
+		pch := pattern[p]
+		if t == len(text) && pch != '*' {
+			return wmAbortAll
+		}
+		var tch byte
+		if t < len(text) {
+			tch = text[t]
+		}
+		if fold {
+			tch = toLower(tch)
+			pch = toLower(pch)
+		}
+
+		switch pch {
+		case '\\':
+			// Literal match with the following character
+			p++
+			if p == len(pattern) || tch != pattern[p] {
+				return wmNoMatch
+			}
+
+		case '?':
+			if tch == '/' {
+				return wmNoMatch
+			}
+
+		case '*':
+			matchSlash := false
+			p++
+			if p < len(pattern) && pattern[p] == '*' {
+				prev := p - 2
+				for p < len(pattern) && pattern[p] == '*' {
+					p++
+				}
+				rest := pattern[p:]
+				if (prev < 0 || pattern[prev] == '/') && (rest == "" || rest[0] == '/' || strings.HasPrefix(rest, `\/`)) {
+					// "**/" also matches no directory at all
+					if rest != "" && rest[0] == '/' && dowild(rest[1:], text[t:], fold) == wmMatch {
+						return wmMatch
+					}
+					matchSlash = true
+				}
+			}
+			if p == len(pattern) {
+				// A trailing "**" matches everything, a trailing "*"
+				// everything but directories.
+				if !matchSlash && strings.IndexByte(text[t:], '/') >= 0 {
+					return wmNoMatch
+				}
+				return wmMatch
+			}
+			if !matchSlash && pattern[p] == '/' {
+				// A single asterisk followed by a slash matches up to the
+				// next slash, which the loop consumes.
+				i := strings.IndexByte(text[t:], '/')
+				if i < 0 {
+					return wmNoMatch
+				}
+				t += i
+				continue
+			}
+			for ; t < len(text); t++ {
+				if c := pattern[p]; !isGlobSpecial(c) {
+					// Advance to the next occurrence of the literal
+					// following the asterisk.
+					if fold {
+						c = toLower(c)
+					}
+					for t < len(text) && (matchSlash || text[t] != '/') {
+						if tc := text[t]; tc == c || fold && toLower(tc) == c {
+							break
+						}
+						t++
+					}
+					if t == len(text) || !(text[t] == c || fold && toLower(text[t]) == c) {
+						return wmNoMatch
+					}
+				}
+				if matched := dowild(pattern[p:], text[t:], fold); matched != wmNoMatch {
+					if !matchSlash || matched != wmAbortToStarStar {
+						return matched
+					}
+				} else if !matchSlash && text[t] == '/' {
+					return wmAbortToStarStar
+				}
+			}
+			return wmAbortAll
+
+		case '[':
+			p++
+			if p == len(pattern) {
+				return wmAbortAll
+			}
+			pch = pattern[p]
+			if pch == '^' {
+				pch = '!'
+			}
+			negated := pch == '!'
+			if negated {
+				p++
+			}
+			matched := false
+			var prev byte
+			for {
+				if p == len(pattern) {
+					return wmAbortAll
+				}
+				pch = pattern[p]
+				switch {
+				case pch == '\\':
+					p++
+					if p == len(pattern) {
+						return wmAbortAll
+					}
+					pch = pattern[p]
+					if tch == pch {
+						matched = true
+					}
+				case pch == '-' && prev != 0 && p+1 < len(pattern) && pattern[p+1] != ']':
+					p++
+					pch = pattern[p]
+					if pch == '\\' {
+						p++
+						if p == len(pattern) {
+							return wmAbortAll
+						}
+						pch = pattern[p]
+					}
+					if tch <= pch && tch >= prev {
+						matched = true
+					} else if fold && isLower(tch) {
+						if u := tch - 'a' + 'A'; u <= pch && u >= prev {
+							matched = true
+						}
+					}
+					pch = 0 // a range can't start right after a range
+				case pch == '[' && p+1 < len(pattern) && pattern[p+1] == ':':
+					s := p + 2
+					e := strings.IndexByte(pattern[s:], ']')
+					if e < 0 {
+						return wmAbortAll
+					}
+					e += s
+					if e-s-1 < 0 || pattern[e-1] != ':' {
+						// Not a character class after all, just a '['.
+						if tch == '[' {
+							matched = true
+						}
+						break
+					}
+					ok, valid := matchCharClass(pattern[s:e-1], tch, fold)
+					if !valid {
+						return wmAbortAll
+					}
+					if ok {
+						matched = true
+					}
+					p = e
+					pch = 0
+				default:
+					if tch == pch {
+						matched = true
+					}
+				}
+				prev = pch
+				p++
+				if p < len(pattern) && pattern[p] == ']' {
+					break
+				}
+			}
+			if matched == negated || tch == '/' {
+				return wmNoMatch
+			}
+
+		default:
+			if tch != pch {
+				return wmNoMatch
+			}
+		}
+	}
+
+	if t < len(text) {
+		return wmNoMatch
+	}
+	return wmMatch
+}
+
+func isGlobSpecial(c byte) bool {
+	return c == '*' || c == '?' || c == '[' || c == '\\'
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func toLower(c byte) byte {
+	if isUpper(c) {
+		return c - 'A' + 'a'
+	}
+	return c
+}
+
+// matchCharClass matches c against a POSIX character class like "alpha".
+// It returns false for valid if there's no such class.
+func matchCharClass(class string, c byte, fold bool) (ok, valid bool) {
+	switch class {
+	case "alnum":
+		return isLower(c) || isUpper(c) || isDigit(c), true
+	case "alpha":
+		return isLower(c) || isUpper(c), true
+	case "blank":
+		return c == ' ' || c == '\t', true
+	case "cntrl":
+		return c < 0x20 || c == 0x7f, true
+	case "digit":
+		return isDigit(c), true
+	case "graph":
+		return c > 0x20 && c < 0x7f, true
+	case "lower":
+		return isLower(c) || fold && isUpper(c), true
+	case "print":
+		return c >= 0x20 && c < 0x7f, true
+	case "punct":
+		return c > 0x20 && c < 0x7f && !isLower(c) && !isUpper(c) && !isDigit(c), true
+	case "space":
+		return c == ' ' || c >= '\t' && c <= '\r', true
+	case "upper":
+		return isUpper(c) || fold && isLower(c), true
+	case "xdigit":
+		return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F', true
+	}
+	return false, false
+}
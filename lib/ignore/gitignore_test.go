@@ -0,0 +1,260 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestWildmatch(t *testing.T) {
+	// Mostly from git's t3070-wildmatch.sh, with pathname semantics
+	cases := []struct {
+		pattern string
+		text    string
+		fold    bool
+		match   bool
+	}{
+		{"foo", "foo", false, true},
+		{"bar", "foo", false, false},
+		{"", "", false, true},
+		{"???", "foo", false, true},
+		{"??", "foo", false, false},
+		{"*", "foo", false, true},
+		{"f*", "foo", false, true},
+		{"*f", "foo", false, false},
+		{"*foo*", "foo", false, true},
+		{"*ob*a*r*", "foobar", false, true},
+		{"*ab", "aaaaaaabababab", false, true},
+		{`foo\*`, "foo*", false, true},
+		{`foo\*bar`, "foobar", false, false},
+		{`f\\oo`, `f\oo`, false, true},
+		{"*[al]?", "ball", false, true},
+		{"[ten]", "ten", false, false},
+		{"**[!te]", "ten", false, true},
+		{"**[!ten]", "ten", false, false},
+		{"t[a-g]n", "ten", false, true},
+		{"t[!a-g]n", "ten", false, false},
+		{"t[!a-g]n", "ton", false, true},
+		{"t[^a-g]n", "ton", false, true},
+		{"a[]]b", "a]b", false, true},
+		{"a[]-]b", "a-b", false, true},
+		{"a[]-]b", "a]b", false, true},
+		{"a[]-]b", "aab", false, false},
+		{"a[]a-]b", "aab", false, true},
+		{"]", "]", false, true},
+		{"foo*bar", "foo/baz/bar", false, false},
+		{"foo**bar", "foo/baz/bar", false, false},
+		{"foo**bar", "foobazbar", false, true},
+		{"foo/**/bar", "foo/baz/bar", false, true},
+		{"foo/**/**/bar", "foo/baz/bar", false, true},
+		{"foo/**/bar", "foo/b/a/z/bar", false, true},
+		{"foo/**/bar", "foo/bar", false, true},
+		{"foo/**/**/bar", "foo/bar", false, true},
+		{"foo?bar", "foo/bar", false, false},
+		{"foo[/]bar", "foo/bar", false, false},
+		{"foo[^a-z]bar", "foo/bar", false, false},
+		{"f[^eiu][^eiu][^eiu][^eiu][^eiu]r", "foo-bar", false, true},
+		{"**/foo", "foo", false, true},
+		{"**/foo", "XXX/foo", false, true},
+		{"**/foo", "bar/baz/foo", false, true},
+		{"*/foo", "bar/baz/foo", false, false},
+		{"**/bar*", "foo/bar/baz", false, false},
+		{"**/bar/*", "deep/foo/bar/baz", false, true},
+		{"**/bar/*", "deep/foo/bar/baz/", false, false},
+		{"**/bar/**", "deep/foo/bar/baz/", false, true},
+		{"**/bar/*", "deep/foo/bar", false, false},
+		{"**/bar/**", "deep/foo/bar/", false, true},
+		{"**/bar**", "foo/bar/baz", false, false},
+		{"*/bar/**", "foo/bar/baz/x", false, true},
+		{"*/bar/**", "deep/foo/bar/baz/x", false, false},
+		{"**/bar/*/*", "deep/foo/bar/baz/x", false, true},
+		{"a[c-c]st", "acrt", false, false},
+		{"a[c-c]rt", "acrt", false, true},
+		{"[!]-]", "]", false, false},
+		{"[!]-]", "a", false, true},
+		{`\`, "", false, false},
+		{`\`, `\`, false, false},
+		{`*/\`, `XXX/\`, false, false},
+		{`*/\\`, `XXX/\`, false, true},
+		{`\[ab]`, "[ab]", false, true},
+		{"[[]ab]", "[ab]", false, true},
+		{"[[:]ab]", "[ab]", false, true},
+		{"[[::]ab]", "[ab]", false, false},
+		{"[[:digit]ab]", "[ab]", false, true},
+		{`[\[:]ab]`, "[ab]", false, true},
+		{`\??\?b`, "?a?b", false, true},
+		{`\a\b\c`, "abc", false, true},
+		{"[[:alpha:]][[:digit:]][[:upper:]]", "a1B", false, true},
+		{"[[:digit:][:upper:][:space:]]", "a", false, false},
+		{"[[:digit:][:upper:][:space:]]", "A", false, true},
+		{"-*-*-*-*-*-*-12-*-*-*-m-*-*-*", "-adobe-courier-bold-o-normal--12-120-75-75-m-70-iso8859-1", false, true},
+		{"XXX/*/*/*/*/*/*/12/*/*/*/m/*/*/*", "XXX/adobe/courier/bold/o/normal//12/120/75/75/m/70/iso8859/1", false, true},
+		{"**/*a*b*g*n*t", "abcd/abcdefg/abcdefghijk/abcdefghijklmnop.txt", false, true},
+		{"**/*a*b*g*n*t", "abcd/abcdefg/abcdefghijk/abcdefghijklmnop.txtz", false, false},
+		{"*/*/*", "foo/bba/arr", false, true},
+		{"*/*/*", "foo/bb/aa/rr", false, false},
+		{"**/**/**", "foo/bb/aa/rr", false, true},
+		{"*/*X*/*/*i", "ab/cXd/efXg/hi", false, true},
+		{"**/*X*/**/*i", "ab/cXd/efXg/hi", false, true},
+		{"a", "A", false, false},
+		{"a", "A", true, true},
+		{"[A-Z]", "a", true, true},
+		{"[[:upper:]]", "a", true, true},
+		{"*.LOG", "x.log", true, true},
+	}
+
+	for _, tc := range cases {
+		if match := dowild(tc.pattern, tc.text, tc.fold) == wmMatch; match != tc.match {
+			t.Errorf("%q against %q (fold %v): got %v, expected %v", tc.pattern, tc.text, tc.fold, match, tc.match)
+		}
+	}
+}
+
+func TestTrimTrailingSpaces(t *testing.T) {
+	cases := map[string]string{
+		"foo":      "foo",
+		"foo  ":    "foo",
+		`foo\ `:    `foo\ `,
+		`foo\  `:   `foo\ `,
+		"foo bar ": "foo bar",
+		`foo\`:     `foo\`,
+	}
+	for in, out := range cases {
+		if res := trimTrailingSpaces(in); res != out {
+			t.Errorf("%q: got %q, expected %q", in, res, out)
+		}
+	}
+}
+
+func TestGitignore(t *testing.T) {
+	dir := t.TempDir()
+	ffs := fs.NewFilesystem(fs.FilesystemTypeBasic, dir)
+	mtime := time.Now()
+	write := func(name, content string) {
+		t.Helper()
+		if err := ffs.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		fd, err := ffs.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fd.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+		// Make sure the modification time changes
+		mtime = mtime.Add(time.Second)
+		ffs.Chtimes(name, mtime, mtime)
+	}
+	write(".stignore", "!a.tmp\n")
+	write(".gitignore", "# comment\n*.log\n!important.log\nbuild/\n/rooted\nlogs/\n!logs/keep.log\nescaped\\ \n*.tmp\n")
+	write("sub/.gitignore", "!*.log\n/local\n")
+	write("other/build", "")
+	for _, d := range []string{"build", "sub/build", "logs"} {
+		if err := ffs.MkdirAll(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := New(ffs, WithCache(true), WithGitignore(true))
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.LoadNested("sub"); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		file    string
+		ignored bool
+		rule    string
+		path    string
+	}{
+		{"a.log", true, "*.log", "a.log"},
+		{"deep/down/a.log", true, "*.log", "deep/down/a.log"},
+		{"important.log", false, "!important.log", "important.log"},
+		{"build", true, "build/", "build"},
+		{"build/x", true, "build/", "build"},
+		{"sub/build/x", true, "build/", "sub/build"},
+		{"other/build", false, "", ""},
+		{"rooted", true, "/rooted", "rooted"},
+		{"sub/rooted", false, "", ""},
+		{"logs/keep.log", true, "logs/", "logs"},
+		{"escaped ", true, `escaped\ `, "escaped "},
+		{"escaped", false, "", ""},
+		{"sub/x.log", false, "!*.log", "sub/x.log"},
+		{"sub/local", true, "/local", "sub/local"},
+		{"local", false, "", ""},
+		{"a.tmp", false, "!a.tmp", "a.tmp"},
+		{"b.tmp", true, "*.tmp", "b.tmp"},
+	}
+	for _, tc := range cases {
+		if ignored := m.Match(tc.file).IsIgnored(); ignored != tc.ignored {
+			t.Errorf("%s: ignored %v, expected %v", tc.file, ignored, tc.ignored)
+		}
+		if e := m.Explain(tc.file, nil); e.Ignored != tc.ignored || e.Rule != tc.rule || e.Path != tc.path {
+			t.Errorf("%s: unexpected explanation %+v", tc.file, e)
+		}
+	}
+
+	if e := m.Explain("a.log", nil); e.File != ".gitignore" || e.Line != 2 {
+		t.Errorf("unexpected rule location %+v", e)
+	}
+	if e := m.Explain("sub/local", nil); e.File != "sub/.gitignore" || e.Line != 2 {
+		t.Errorf("unexpected rule location %+v", e)
+	}
+	if e := m.Explain("a.tmp", nil); e.File != ".stignore" || e.Line != 1 {
+		t.Errorf("unexpected rule location %+v", e)
+	}
+
+	// Changes are picked up when loading again
+
+	hash := m.Hash()
+	write(".gitignore", "*.txt\n")
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Hash() == hash {
+		t.Error("hash didn't change")
+	}
+	if m.Match("a.log").IsIgnored() || !m.Match("a.txt").IsIgnored() {
+		t.Error("changed .gitignore not in effect")
+	}
+
+	if err := ffs.Remove(".gitignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Match("a.txt").IsIgnored() {
+		t.Error("removed .gitignore still in effect")
+	}
+
+	write(".gitignore", "*.bin\n")
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Match("a.bin").IsIgnored() {
+		t.Error("new .gitignore not in effect")
+	}
+
+	// Disabled by default
+
+	m = New(ffs)
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Match("a.bin").IsIgnored() {
+		t.Error(".gitignore in effect without being enabled")
+	}
+}
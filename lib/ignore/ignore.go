@@ -12,7 +12,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,6 +34,9 @@ const (
 	resultFoldCase          = 1 << iota
 )
 
+// nestedIgnoreFile is the name of ignore files below the folder root.
+const nestedIgnoreFile = ".stignore"
+
 var defaultResult Result = resultInclude
 
 func init() {
@@ -68,9 +73,32 @@ func parseError(err error) error {
 }
 
 type Pattern struct {
-	pattern string
-	match   glob.Glob
-	result  Result
+	pattern    string
+	match      glob.Glob
+	result     Result
+	predicates []predicate
+	source     *source
+}
+
+// A source is the line of an ignore file a pattern was read from.
+type source struct {
+	file string
+	line int
+	text string
+}
+
+// An Explanation tells whether a path is ignored and which rule, if any,
+// decides it.
+type Explanation struct {
+	Ignored   bool `json:"ignored"`
+	Deletable bool `json:"deletable"`
+	// The ignore file and line of the rule, and the rule as written.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+	Rule string `json:"rule,omitempty"`
+	// The path the rule matched, which is a parent directory when that is
+	// ignored as a whole.
+	Path string `json:"path,omitempty"`
 }
 
 func (p Pattern) String() string {
@@ -84,9 +112,34 @@ func (p Pattern) String() string {
 	if p.result&resultDeletable == resultDeletable {
 		ret = "(?d)" + ret
 	}
+	for i := len(p.predicates) - 1; i >= 0; i-- {
+		ret = "(?" + p.predicates[i].text + ")" + ret
+	}
 	return ret
 }
 
+// matchesAttributes returns whether the predicates of the pattern, if any,
+// are all true for the file.
+func (p Pattern) matchesAttributes(attrs AttributesFunc) bool {
+	if len(p.predicates) == 0 {
+		return true
+	}
+	if attrs == nil {
+		return false
+	}
+	fa, ok := attrs()
+	if !ok {
+		return false
+	}
+	now := clock.Now()
+	for _, pred := range p.predicates {
+		if !pred.matches(fa, now) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p Pattern) allowsSkippingIgnoredDirs() bool {
 	if p.result.IsIgnored() {
 		return true
@@ -131,15 +184,29 @@ type Matcher struct {
 	fs              fs.Filesystem
 	lines           []string  // exact lines read from .stignore
 	patterns        []Pattern // patterns including those from included files
+	nested          map[string]*nestedIgnores
+	gitignores      map[string]*gitIgnores
+	withNested      bool
+	withGitignore   bool
+	withSelection   bool
+	selection       []string // selected paths, with slashes
 	withCache       bool
 	matches         *cache
 	curHash         string
 	stop            chan struct{}
 	changeDetector  ChangeDetector
 	skipIgnoredDirs bool
+	hasPredicates   bool
 	mut             sync.Mutex
 }
 
+// nestedIgnores are the patterns of an ignore file in a directory below
+// the folder root, which apply to the contents of that directory.
+type nestedIgnores struct {
+	patterns []Pattern
+	stale    bool // needs to be reloaded, but is used until then
+}
+
 // An Option can be passed to New()
 type Option func(*Matcher)
 
@@ -150,6 +217,38 @@ func WithCache(v bool) Option {
 	}
 }
 
+// WithNestedIgnores enables or disables ignore files in directories below
+// the folder root, see LoadNested. The default is disabled.
+func WithNestedIgnores(v bool) Option {
+	return func(m *Matcher) {
+		m.withNested = v
+	}
+}
+
+// WithGitignore enables or disables honoring .gitignore files, in the
+// folder root and below, with git's semantics. Patterns in .stignore files
+// take precedence. The default is disabled.
+func WithGitignore(v bool) Option {
+	return func(m *Matcher) {
+		m.withGitignore = v
+	}
+}
+
+// WithSelection restricts the folder to the given paths and what is below
+// them, as if everything else was ignored. The directories leading up to a
+// selected path are selected too. Unlike patterns, the selection isn't read
+// from ignore files and can't be overridden by them. The default is to
+// select everything.
+func WithSelection(paths []string) Option {
+	return func(m *Matcher) {
+		m.withSelection = true
+		m.selection = make([]string, len(paths))
+		for i, p := range paths {
+			m.selection[i] = filepath.ToSlash(p)
+		}
+	}
+}
+
 // WithChangeDetector sets a custom ChangeDetector. The default is to simply
 // use the on disk modtime for comparison.
 func WithChangeDetector(cd ChangeDetector) Option {
@@ -186,17 +285,31 @@ func (m *Matcher) Load(file string) error {
 	defer m.mut.Unlock()
 
 	if m.changeDetector.Seen(m.fs, file) && !m.changeDetector.Changed() {
-		return nil
+		// A .gitignore may have appeared nonetheless.
+		return m.loadGitignoreLocked(".")
+	}
+
+	err := m.loadLocked(file)
+	if gerr := m.loadGitignoreLocked("."); gerr != nil && (err == nil || fs.IsNotExist(err)) {
+		return gerr
 	}
+	return err
+}
 
+func (m *Matcher) loadLocked(file string) error {
 	fd, info, err := loadIgnoreFile(m.fs, file)
 	if err != nil {
+		if (len(m.nested) > 0 || len(m.gitignores) > 0) && m.changeDetector.Changed() {
+			m.changeDetector.Reset()
+			m.markStaleLocked()
+		}
 		m.parseLocked(&bytes.Buffer{}, file)
 		return err
 	}
 	defer fd.Close()
 
 	m.changeDetector.Reset()
+	m.markStaleLocked()
 
 	err = m.parseLocked(fd, file)
 	// If we failed to parse, don't cache, as next time Load is called
@@ -211,7 +324,11 @@ func (m *Matcher) Load(file string) error {
 func (m *Matcher) Parse(r io.Reader, file string) error {
 	m.mut.Lock()
 	defer m.mut.Unlock()
-	return m.parseLocked(r, file)
+	err := m.parseLocked(r, file)
+	if gerr := m.loadGitignoreLocked("."); err == nil {
+		err = gerr
+	}
+	return err
 }
 
 func (m *Matcher) parseLocked(r io.Reader, file string) error {
@@ -220,14 +337,45 @@ func (m *Matcher) parseLocked(r io.Reader, file string) error {
 	// (possibly blank) anyway.
 
 	m.lines = lines
+	m.patterns = patterns
+	m.updateLocked()
 
-	newHash := hashPatterns(patterns)
+	return err
+}
+
+// updateLocked takes the current patterns into use.
+func (m *Matcher) updateLocked() {
+	newHash := m.hashLocked()
 	if newHash == m.curHash {
 		// We've already loaded exactly these patterns.
-		return err
+		return
+	}
+
+	m.skipIgnoredDirs = allowSkippingIgnoredDirs(m.patterns)
+	m.hasPredicates = hasPredicates(m.patterns)
+	for _, n := range m.nested {
+		m.skipIgnoredDirs = m.skipIgnoredDirs && allowSkippingIgnoredDirs(n.patterns)
+		m.hasPredicates = m.hasPredicates || hasPredicates(n.patterns)
+	}
+
+	m.curHash = newHash
+	m.matches = nil
+	// Results depending on the attributes of files can't be cached by name.
+	if m.withCache && !m.hasPredicates {
+		m.matches = newCache(m.patterns)
+	}
+}
+
+func hasPredicates(patterns []Pattern) bool {
+	for _, p := range patterns {
+		if len(p.predicates) > 0 {
+			return true
+		}
 	}
+	return false
+}
 
-	m.skipIgnoredDirs = true
+func allowSkippingIgnoredDirs(patterns []Pattern) bool {
 	var previous string
 	for _, p := range patterns {
 		// We automatically add patterns with a /** suffix, which normally
@@ -238,22 +386,134 @@ func (m *Matcher) parseLocked(r io.Reader, file string) error {
 			continue
 		}
 		if !p.allowsSkippingIgnoredDirs() {
-			m.skipIgnoredDirs = false
-			break
+			return false
 		}
 		previous = p.pattern
 	}
+	return true
+}
 
-	m.curHash = newHash
-	m.patterns = patterns
-	if m.withCache {
-		m.matches = newCache(patterns)
+// LoadNested loads the ignore files in the given directory below the folder
+// root, if nested ignore files or .gitignore files are enabled. Their
+// patterns apply to the contents of the directory, relative to it, and take
+// precedence over those of the directories above. The scanner calls this
+// for each directory before walking into it, so that ignore files are
+// discovered as they appear. Files already loaded are only read again after
+// Load found something changed. A parse error leaves the previous patterns
+// of the directory, if any, in effect.
+func (m *Matcher) LoadNested(dir string) error {
+	if !m.withNested && !m.withGitignore || dir == "." || dir == "" {
+		return nil
 	}
 
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	var err error
+	if m.withNested {
+		err = m.loadNestedLocked(dir)
+	}
+	if gerr := m.loadGitignoreLocked(dir); err == nil {
+		err = gerr
+	}
 	return err
 }
 
+func (m *Matcher) loadNestedLocked(dir string) error {
+	key := filepath.ToSlash(dir)
+	n, ok := m.nested[key]
+	if ok && !n.stale {
+		return nil
+	}
+
+	file := filepath.Join(dir, nestedIgnoreFile)
+	fd, info, err := loadIgnoreFile(m.fs, file)
+	if fs.IsNotExist(err) {
+		if ok {
+			delete(m.nested, key)
+			m.updateLocked()
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	cd := &includeDetector{ChangeDetector: m.changeDetector, seen: make(map[modtimeCheckerKey]struct{})}
+	cd.Remember(m.fs, file, info.ModTime())
+	_, patterns, err := parseIgnoreFile(m.fs, fd, file, cd, make(map[string]struct{}))
+	if err != nil {
+		return err
+	}
+
+	if m.nested == nil {
+		m.nested = make(map[string]*nestedIgnores)
+	}
+	m.nested[key] = &nestedIgnores{patterns: patterns}
+	m.updateLocked()
+	return nil
+}
+
+// loadGitignoreLocked loads the .gitignore file in the given directory, or
+// the folder root for ".", if .gitignore files are enabled.
+func (m *Matcher) loadGitignoreLocked(dir string) error {
+	if !m.withGitignore {
+		return nil
+	}
+
+	key := filepath.ToSlash(dir)
+	g, ok := m.gitignores[key]
+	if ok && !g.stale {
+		return nil
+	}
+
+	file := filepath.Join(dir, gitignoreFile)
+	fd, info, err := loadIgnoreFile(m.fs, file)
+	if fs.IsNotExist(err) {
+		if ok {
+			delete(m.gitignores, key)
+			m.updateLocked()
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	patterns, err := parseGitignore(fd, filepath.ToSlash(file))
+	if err != nil {
+		return err
+	}
+	m.changeDetector.Remember(m.fs, file, info.ModTime())
+
+	if m.gitignores == nil {
+		m.gitignores = make(map[string]*gitIgnores)
+	}
+	m.gitignores[key] = &gitIgnores{patterns: patterns}
+	m.updateLocked()
+	return nil
+}
+
+// markStaleLocked makes LoadNested read the nested ignore and .gitignore
+// files again, as something changed.
+func (m *Matcher) markStaleLocked() {
+	for _, n := range m.nested {
+		n.stale = true
+	}
+	for _, g := range m.gitignores {
+		g.stale = true
+	}
+}
+
+// Match returns the result for the file, without taking patterns with
+// predicates into account.
 func (m *Matcher) Match(file string) (result Result) {
+	return m.MatchFile(file, nil)
+}
+
+// MatchFile returns the result for the file, evaluating the predicates of
+// patterns against the attributes of the file.
+func (m *Matcher) MatchFile(file string, attrs AttributesFunc) (result Result) {
 	if file == "." {
 		return resultNotMatched
 	}
@@ -261,7 +521,7 @@ func (m *Matcher) Match(file string) (result Result) {
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
-	if len(m.patterns) == 0 {
+	if len(m.patterns) == 0 && len(m.nested) == 0 && len(m.gitignores) == 0 && !m.withSelection {
 		return resultNotMatched
 	}
 
@@ -278,24 +538,147 @@ func (m *Matcher) Match(file string) (result Result) {
 		}()
 	}
 
-	// Check all the patterns for a match.
+	result, _, _ = m.matchLocked(file, attrs.memoize())
+	return result
+}
+
+// Explain is like MatchFile, but also tells which rule decides the result.
+func (m *Matcher) Explain(file string, attrs AttributesFunc) Explanation {
+	if file == "." {
+		return Explanation{}
+	}
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+
+	result, src, matched := m.matchLocked(file, attrs.memoize())
+	e := Explanation{
+		Ignored:   result.IsIgnored(),
+		Deletable: result.IsDeletable(),
+	}
+	if src != nil {
+		e.File = src.file
+		e.Line = src.line
+		e.Rule = src.text
+		e.Path = matched
+	}
+	return e
+}
+
+// matchLocked returns the result for the file, the source of the pattern
+// deciding it and the path that pattern matched, if any.
+func (m *Matcher) matchLocked(file string, attrs AttributesFunc) (Result, *source, string) {
 	file = filepath.ToSlash(file)
+
+	// Things outside the selection are ignored, whatever the patterns say.
+	// There is no point in keeping them around when their parent goes away.
+	if m.withSelection && !m.selectedLocked(file) {
+		return resultInclude | resultDeletable, selectionSource, file
+	}
+
+	// Nested ignore files apply to the paths below their directory, the
+	// closest one first.
+	if len(m.nested) > 0 {
+		for dir := path.Dir(file); dir != "."; dir = path.Dir(dir) {
+			if n, ok := m.nested[dir]; ok {
+				if p, ok := matchPatterns(n.patterns, file[len(dir)+1:], attrs); ok {
+					return p.result, p.source, file
+				}
+			}
+		}
+	}
+
+	if p, ok := matchPatterns(m.patterns, file, attrs); ok {
+		return p.result, p.source, file
+	}
+
+	if len(m.gitignores) > 0 {
+		if p, matched := m.matchGitignoreLocked(file); p != nil {
+			if p.negate {
+				return resultNotMatched, p.source, matched
+			}
+			return resultInclude, p.source, matched
+		}
+	}
+
+	// Default to not matching.
+	return resultNotMatched, nil, ""
+}
+
+// selectionSource is reported as the reason for ignoring unselected paths.
+var selectionSource = &source{text: "(not selected)"}
+
+// selectedLocked returns whether the file is selected, or a directory on
+// the way to something selected.
+func (m *Matcher) selectedLocked(file string) bool {
+	for _, sel := range m.selection {
+		if file == sel || strings.HasPrefix(file, sel+"/") || strings.HasPrefix(sel, file+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPatterns returns the first pattern matching the file, if any.
+func matchPatterns(patterns []Pattern, file string, attrs AttributesFunc) (Pattern, bool) {
 	var lowercaseFile string
-	for _, pattern := range m.patterns {
+	for _, pattern := range patterns {
 		if pattern.result.IsCaseFolded() {
 			if lowercaseFile == "" {
 				lowercaseFile = strings.ToLower(file)
 			}
-			if pattern.match.Match(lowercaseFile) {
-				return pattern.result
+			if pattern.match.Match(lowercaseFile) && pattern.matchesAttributes(attrs) {
+				return pattern, true
 			}
-		} else if pattern.match.Match(file) {
-			return pattern.result
+		} else if pattern.match.Match(file) && pattern.matchesAttributes(attrs) {
+			return pattern, true
 		}
 	}
+	return Pattern{}, false
+}
 
-	// Default to not matching.
-	return resultNotMatched
+// matchGitignoreLocked returns the .gitignore pattern deciding about the
+// file like git would, and the path it matched. As in git, nothing can be
+// re-included below an ignored directory, so the directories above the
+// file are checked first.
+func (m *Matcher) matchGitignoreLocked(file string) (*gitPattern, string) {
+	isDir := func() bool { return true }
+	for i := 0; i < len(file); i++ {
+		if file[i] != '/' {
+			continue
+		}
+		if p := m.lastGitignoreMatchLocked(file[:i], isDir); p != nil && !p.negate {
+			return p, file[:i]
+		}
+	}
+
+	// Only stat the file when a pattern is for directories only.
+	isDir = func() bool {
+		info, err := m.fs.Lstat(filepath.FromSlash(file))
+		return err == nil && info.IsDir()
+	}
+	return m.lastGitignoreMatchLocked(file, isDir), file
+}
+
+// lastGitignoreMatchLocked returns the last matching pattern of the closest
+// .gitignore file with a matching pattern, if any.
+func (m *Matcher) lastGitignoreMatchLocked(file string, isDir func() bool) *gitPattern {
+	for dir := path.Dir(file); ; dir = path.Dir(dir) {
+		if g, ok := m.gitignores[dir]; ok {
+			rel := file
+			if dir != "." {
+				rel = file[len(dir)+1:]
+			}
+			for i := len(g.patterns) - 1; i >= 0; i-- {
+				if g.patterns[i].matches(rel, isDir) {
+					return &g.patterns[i]
+				}
+			}
+		}
+		if dir == "." {
+			return nil
+		}
+	}
 }
 
 // Lines return a list of the unprocessed lines in .stignore at last load
@@ -370,15 +753,39 @@ func (m *Matcher) SkipIgnoredDirs() bool {
 	return m.skipIgnoredDirs
 }
 
-func hashPatterns(patterns []Pattern) string {
+func (m *Matcher) hashLocked() string {
 	h := sha256.New()
-	for _, pat := range patterns {
-		h.Write([]byte(pat.String()))
-		h.Write([]byte("\n"))
+	writePatterns(h, m.patterns)
+	dirs := make([]string, 0, len(m.nested))
+	for dir := range m.nested {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		fmt.Fprintf(h, "[%s]\n", dir)
+		writePatterns(h, m.nested[dir].patterns)
+	}
+	dirs = dirs[:0]
+	for dir := range m.gitignores {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		fmt.Fprintf(h, "[%s/%s]\n", dir, gitignoreFile)
+		for _, p := range m.gitignores[dir].patterns {
+			fmt.Fprintln(h, p.source.text)
+		}
 	}
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
+func writePatterns(w io.Writer, patterns []Pattern) {
+	for _, pat := range patterns {
+		w.Write([]byte(pat.String()))
+		w.Write([]byte("\n"))
+	}
+}
+
 func loadIgnoreFile(fs fs.Filesystem, file string) (fs.File, fs.FileInfo, error) {
 	fd, err := fs.Open(file)
 	if err != nil {
@@ -442,13 +849,27 @@ func parseLine(line string) ([]Pattern, error) {
 			seenPrefix[2] = true
 			pattern.result |= resultDeletable
 			line = line[4:]
+		} else if end := strings.IndexByte(line, ')'); strings.HasPrefix(line, "(?") && end > 0 {
+			pred, ok, err := parsePredicate(line[2:end])
+			if err != nil {
+				return nil, parseError(err)
+			}
+			if !ok {
+				break
+			}
+			pattern.predicates = append(pattern.predicates, pred)
+			line = line[end+1:]
 		} else {
 			break
 		}
 	}
 
 	if line == "" {
-		return nil, parseError(errors.New("missing pattern"))
+		if len(pattern.predicates) == 0 {
+			return nil, parseError(errors.New("missing pattern"))
+		}
+		// Only predicates, for all files
+		line = "**"
 	}
 
 	if pattern.result.IsCaseFolded() {
@@ -502,11 +923,17 @@ func parseLine(line string) ([]Pattern, error) {
 func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd ChangeDetector, linesSeen map[string]struct{}) ([]string, []Pattern, error) {
 	var patterns []Pattern
 
+	var src *source
+	var hasPredicates bool
 	addPattern := func(line string) error {
 		newPatterns, err := parseLine(line)
 		if err != nil {
 			return fmt.Errorf("invalid pattern %q in ignore file: %w", line, err)
 		}
+		for i := range newPatterns {
+			newPatterns[i].source = src
+		}
+		hasPredicates = len(newPatterns) > 0 && len(newPatterns[0].predicates) > 0
 		patterns = append(patterns, newPatterns...)
 		return nil
 	}
@@ -522,7 +949,7 @@ func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd Chan
 	}
 
 	var err error
-	for _, line := range lines {
+	for i, line := range lines {
 		if _, ok := linesSeen[line]; ok {
 			continue
 		}
@@ -533,6 +960,7 @@ func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd Chan
 		case strings.HasPrefix(line, "//"):
 			continue
 		}
+		src = &source{file: filepath.ToSlash(currentFile), line: i + 1, text: line}
 
 		line = filepath.ToSlash(line)
 		switch {
@@ -566,7 +994,9 @@ func parseIgnoreFile(fs fs.Filesystem, fd io.Reader, currentFile string, cd Chan
 			err = addPattern(line + "**")
 		default:
 			err = addPattern(line)
-			if err == nil {
+			// Predicates apply to what the pattern matches, not to the
+			// contents of directories.
+			if err == nil && !hasPredicates {
 				err = addPattern(line + "/**")
 			}
 		}
@@ -648,3 +1078,21 @@ func (c *modtimeChecker) Changed() bool {
 
 	return false
 }
+
+// includeDetector catches files included more than once by a nested ignore
+// file, independently of other ignore files that may include them too, and
+// passes what it remembers on to the matcher's ChangeDetector.
+type includeDetector struct {
+	ChangeDetector
+	seen map[modtimeCheckerKey]struct{}
+}
+
+func (d *includeDetector) Remember(fs fs.Filesystem, name string, modtime time.Time) {
+	d.seen[modtimeCheckerKey{fs, name}] = struct{}{}
+	d.ChangeDetector.Remember(fs, name, modtime)
+}
+
+func (d *includeDetector) Seen(fs fs.Filesystem, name string) bool {
+	_, ok := d.seen[modtimeCheckerKey{fs, name}]
+	return ok
+}
@@ -1237,3 +1237,160 @@ func TestWindowsLineEndings(t *testing.T) {
 		t.Error("expected there to be a non-zero number of Windows line endings")
 	}
 }
+
+func TestNestedIgnores(t *testing.T) {
+	dir := t.TempDir()
+	ffs := fs.NewFilesystem(fs.FilesystemTypeBasic, dir)
+	write := func(name, content string) {
+		t.Helper()
+		if err := ffs.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		fd, err := ffs.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fd.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+		// Make sure the modification time changes
+		future := time.Now().Add(time.Duration(len(content)) * time.Second)
+		ffs.Chtimes(name, future, future)
+	}
+	write(".stignore", "*.log\n#include common\n")
+	write("common", "*.tmp\n")
+	write("sub/.stignore", "/build\n!keep.log\n#include ../common\n")
+	write("sub/deeper/.stignore", "keep.log\n")
+
+	m := New(ffs, WithCache(true), WithNestedIgnores(true))
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	rootHash := m.Hash()
+	if m.Match("sub/build").IsIgnored() {
+		t.Error("nested ignore file in effect before being loaded")
+	}
+
+	for _, dir := range []string{"sub", "sub/deeper"} {
+		if err := m.LoadNested(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if m.Hash() == rootHash {
+		t.Error("hash didn't change with nested ignores")
+	}
+	cases := []struct {
+		file    string
+		ignored bool
+	}{
+		{"sub/build", true},
+		{"build", false},
+		{"sub/x/build", false},
+		{"keep.log", true},
+		{"sub/keep.log", false},
+		{"sub/x/keep.log", false},
+		{"sub/deeper/keep.log", true},
+		{"sub/a.tmp", true},
+		{"sub/deeper/a.log", true},
+	}
+	for _, tc := range cases {
+		if ignored := m.Match(tc.file).IsIgnored(); ignored != tc.ignored {
+			t.Errorf("%s: ignored %v, expected %v", tc.file, ignored, tc.ignored)
+		}
+	}
+
+	// Changes are noticed by Load and picked up by LoadNested, until then
+	// the previous patterns are in effect.
+	write("sub/.stignore", "!keep.log\n")
+	if err := ffs.Remove("sub/deeper/.stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Match("sub/build").IsIgnored() {
+		t.Error("previous nested patterns not in effect")
+	}
+	for _, dir := range []string{"sub", "sub/deeper"} {
+		if err := m.LoadNested(dir); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if m.Match("sub/build").IsIgnored() {
+		t.Error("changed nested ignore file not reloaded")
+	}
+	if m.Match("sub/deeper/keep.log").IsIgnored() {
+		t.Error("removed nested ignore file still in effect")
+	}
+
+	// A broken nested ignore file is an error, leaving the previous
+	// patterns in effect.
+	write("sub/.stignore", "!keep.log\n#include nonexistent\n")
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.LoadNested("sub"); err == nil {
+		t.Error("expected an error for a broken nested ignore file")
+	}
+	if m.Match("sub/keep.log").IsIgnored() {
+		t.Error("previous nested patterns not in effect")
+	}
+
+	// Nested ignore files are only considered when enabled.
+	m = New(ffs)
+	if err := m.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.LoadNested("sub"); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Match("sub/keep.log").IsIgnored() || m.Hash() != rootHash {
+		t.Error("nested ignore file used while disabled")
+	}
+}
+
+func TestSelection(t *testing.T) {
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""), WithSelection([]string{"docs", filepath.FromSlash("src/app")}))
+	if err := pats.Parse(bytes.NewBufferString("!*.keep\n*.tmp\n"), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		file    string
+		ignored bool
+	}{
+		{"docs", false},
+		{"docs/readme", false},
+		{"docs/sub/readme", false},
+		{"docs/draft.tmp", true},
+		{"src", false},
+		{"src/app", false},
+		{"src/app/main.go", false},
+		{"src/lib", true},
+		{"src/lib/lib.go", true},
+		{"src/application", true},
+		{"docsx", true},
+		{"readme", true},
+		{"readme.keep", true},
+	}
+	for _, tc := range cases {
+		res := pats.Match(filepath.FromSlash(tc.file))
+		if res.IsIgnored() != tc.ignored {
+			t.Errorf("%s: ignored %v, expected %v", tc.file, res.IsIgnored(), tc.ignored)
+		}
+		if tc.ignored && tc.file != "docs/draft.tmp" && !res.IsDeletable() {
+			t.Errorf("%s: expected unselected item to be deletable", tc.file)
+		}
+	}
+
+	if e := pats.Explain("readme", nil); !e.Ignored || e.Rule != "(not selected)" || e.File != "" {
+		t.Errorf("unexpected explanation %+v", e)
+	}
+
+	// An empty selection leaves nothing
+	pats = New(fs.NewFilesystem(fs.FilesystemTypeFake, ""), WithSelection(nil))
+	if !pats.Match("docs").IsIgnored() {
+		t.Error("expected everything to be ignored with an empty selection")
+	}
+}
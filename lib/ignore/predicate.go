@@ -0,0 +1,245 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+type FileType int
+
+const (
+	FileTypeFile FileType = iota
+	FileTypeDirectory
+	FileTypeSymlink
+)
+
+var fileTypeNames = map[string]FileType{
+	"file":    FileTypeFile,
+	"dir":     FileTypeDirectory,
+	"symlink": FileTypeSymlink,
+}
+
+// FileAttributes are the properties of a file that predicates, like
+// (?size>1G), look at.
+type FileAttributes struct {
+	Type    FileType
+	Size    int64
+	ModTime time.Time
+}
+
+// An AttributesFunc returns the attributes of a file, or false if they
+// aren't available. It's only called when a pattern with predicates matches
+// the name of the file.
+type AttributesFunc func() (FileAttributes, bool)
+
+// InfoAttributes returns the attributes of the given file info.
+func InfoAttributes(info fs.FileInfo) AttributesFunc {
+	return func() (FileAttributes, bool) {
+		if info == nil {
+			return FileAttributes{}, false
+		}
+		attrs := FileAttributes{
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		}
+		switch {
+		case info.IsDir():
+			attrs.Type = FileTypeDirectory
+		case info.IsSymlink():
+			attrs.Type = FileTypeSymlink
+		case !info.IsRegular():
+			return FileAttributes{}, false
+		}
+		return attrs, true
+	}
+}
+
+// LstatAttributes returns the attributes of the file on disk.
+func LstatAttributes(filesystem fs.Filesystem, name string) AttributesFunc {
+	return func() (FileAttributes, bool) {
+		info, err := filesystem.Lstat(name)
+		if err != nil {
+			return FileAttributes{}, false
+		}
+		return InfoAttributes(info)()
+	}
+}
+
+// memoize makes sure the attributes are only looked up once.
+func (fn AttributesFunc) memoize() AttributesFunc {
+	if fn == nil {
+		return nil
+	}
+	var attrs FileAttributes
+	var ok, done bool
+	return func() (FileAttributes, bool) {
+		if !done {
+			attrs, ok = fn()
+			done = true
+		}
+		return attrs, ok
+	}
+}
+
+// A predicate is a condition on the attributes of a file, given as a
+// prefix to a pattern:
+//
+//	(?size>1G)    larger than a size, in bytes or with a K, M, G or T suffix
+//	(?size<100k)  smaller than a size
+//	(?mtime<30d)  last modified before a time ago, in s, m, h, d or w
+//	(?mtime>12h)  last modified within a time ago
+//	(?type=dir)   of a type, file, dir or symlink
+//	(?type!=file) not of a type
+//
+// Size and modification time predicates only match regular files.
+type predicate struct {
+	text  string // as written, without the parentheses
+	attr  string
+	op    string
+	size  int64
+	age   time.Duration
+	ftype FileType
+}
+
+// parsePredicate parses the inside of a (?...) prefix. It returns false if
+// it isn't a predicate at all, and an error if it is one but is invalid.
+func parsePredicate(s string) (predicate, bool, error) {
+	var attr string
+	for _, a := range []string{"size", "mtime", "type"} {
+		if strings.HasPrefix(s, a) {
+			attr = a
+			break
+		}
+	}
+	if attr == "" {
+		return predicate{}, false, nil
+	}
+
+	rest := s[len(attr):]
+	var op string
+	for _, o := range []string{"<=", ">=", "!=", "<", ">", "="} {
+		if strings.HasPrefix(rest, o) {
+			op = o
+			break
+		}
+	}
+	if op == "" {
+		return predicate{}, false, nil
+	}
+
+	p := predicate{text: s, attr: attr, op: op}
+	value := rest[len(op):]
+	var err error
+	switch attr {
+	case "size":
+		if op == "=" || op == "!=" {
+			return p, true, fmt.Errorf("invalid operator %q for size in (?%s)", op, s)
+		}
+		p.size, err = parseSize(value)
+	case "mtime":
+		if op == "=" || op == "!=" {
+			return p, true, fmt.Errorf("invalid operator %q for mtime in (?%s)", op, s)
+		}
+		p.age, err = parseAge(value)
+	case "type":
+		if op != "=" && op != "!=" {
+			return p, true, fmt.Errorf("invalid operator %q for type in (?%s)", op, s)
+		}
+		var ok bool
+		if p.ftype, ok = fileTypeNames[value]; !ok {
+			err = fmt.Errorf("unknown file type %q", value)
+		}
+	}
+	if err != nil {
+		return p, true, fmt.Errorf("invalid predicate (?%s): %w", s, err)
+	}
+	return p, true, nil
+}
+
+func (p predicate) matches(attrs FileAttributes, now time.Time) bool {
+	switch p.attr {
+	case "size":
+		return attrs.Type == FileTypeFile && compare(p.op, attrs.Size, p.size)
+	case "mtime":
+		// Older means smaller, as with the modification times themselves.
+		return attrs.Type == FileTypeFile && compare(p.op, attrs.ModTime.UnixNano(), now.Add(-p.age).UnixNano())
+	case "type":
+		return (attrs.Type == p.ftype) == (p.op == "=")
+	}
+	return false
+}
+
+func compare(op string, a, b int64) bool {
+	switch op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+// parseSize parses sizes like "512", "100k", "1.5G" or "2GiB", with binary
+// multiples.
+func parseSize(s string) (int64, error) {
+	num := strings.TrimSuffix(strings.ToLower(s), "b")
+	hasI := strings.HasSuffix(num, "i")
+	num = strings.TrimSuffix(num, "i")
+	mult := 1.0
+	if num != "" {
+		if i := strings.IndexByte("kmgt", num[len(num)-1]); i >= 0 {
+			for ; i >= 0; i-- {
+				mult *= 1024
+			}
+			num = num[:len(num)-1]
+		} else if hasI {
+			return 0, fmt.Errorf("invalid size %q", s)
+		}
+	}
+	val, err := strconv.ParseFloat(num, 64)
+	if err != nil || val < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(val * mult), nil
+}
+
+// parseAge parses ages like "90s", "12h", "30d" or "1.5w".
+func parseAge(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("invalid age %q, missing unit", s)
+	}
+	val, err := strconv.ParseFloat(s[:len(s)-1], 64)
+	if err != nil || val < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	return time.Duration(val * float64(unit)), nil
+}
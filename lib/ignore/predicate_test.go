@@ -0,0 +1,166 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package ignore
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestParseSize(t *testing.T) {
+	cases := map[string]int64{
+		"0":     0,
+		"512":   512,
+		"512b":  512,
+		"100k":  100 << 10,
+		"100K":  100 << 10,
+		"1.5M":  3 << 19,
+		"2GiB":  2 << 30,
+		"1gb":   1 << 30,
+		"1T":    1 << 40,
+		"0.5kb": 512,
+	}
+	for in, out := range cases {
+		if res, err := parseSize(in); err != nil || res != out {
+			t.Errorf("%q: got %d, %v, expected %d", in, res, err, out)
+		}
+	}
+
+	for _, in := range []string{"", "k", "-1", "1x", "1i", "1 G"} {
+		if _, err := parseSize(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	cases := map[string]time.Duration{
+		"90s":  90 * time.Second,
+		"5m":   5 * time.Minute,
+		"12h":  12 * time.Hour,
+		"30d":  30 * 24 * time.Hour,
+		"1.5w": 252 * time.Hour,
+	}
+	for in, out := range cases {
+		if res, err := parseAge(in); err != nil || res != out {
+			t.Errorf("%q: got %v, %v, expected %v", in, res, err, out)
+		}
+	}
+
+	for _, in := range []string{"", "d", "30", "-1d", "1y"} {
+		if _, err := parseAge(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestParsePredicateErrors(t *testing.T) {
+	for _, line := range []string{
+		"(?size=1G)foo",
+		"(?mtime!=1d)foo",
+		"(?type>dir)foo",
+		"(?type=fifo)foo",
+		"(?size>lots)foo",
+		"(?mtime<30)foo",
+	} {
+		pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+		if err := pats.Parse(strings.NewReader(line), ".stignore"); err == nil || !IsParseError(err) {
+			t.Errorf("%q: expected parse error, got %v", line, err)
+		}
+	}
+
+	// Something that isn't a predicate is left as part of the pattern
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+	if err := pats.Parse(strings.NewReader("(?foo)bar"), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	if !pats.Match("(?foo)bar").IsIgnored() {
+		t.Error("expected literal pattern to match")
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	stignore := `
+	!(?size<1k)*.iso
+	(?size>=1G)
+	(?mtime<30d)logs/**
+	(?type=dir)(?i)Build
+	(?type!=file)link*
+	(?mtime>1h)(?size>0)*.tmpfile
+	`
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+	if err := pats.Parse(strings.NewReader(stignore), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	file := func(size int64, age time.Duration) AttributesFunc {
+		return func() (FileAttributes, bool) {
+			return FileAttributes{Type: FileTypeFile, Size: size, ModTime: now.Add(-age)}, true
+		}
+	}
+	dir := func() (FileAttributes, bool) {
+		return FileAttributes{Type: FileTypeDirectory, ModTime: now.Add(-100 * 24 * time.Hour)}, true
+	}
+	symlink := func() (FileAttributes, bool) {
+		return FileAttributes{Type: FileTypeSymlink, Size: 5 << 30}, true
+	}
+	unknown := func() (FileAttributes, bool) {
+		return FileAttributes{}, false
+	}
+
+	cases := []struct {
+		file    string
+		attrs   AttributesFunc
+		ignored bool
+	}{
+		{"small.iso", file(100, 0), false},
+		{"big.iso", file(2<<30, 0), true},
+		{"big.bin", file(1<<30, 0), true},
+		{"sub/big.bin", file(1<<30, 0), true},
+		{"small.bin", file(1<<30-1, 0), false},
+		{"bigdir", dir, false},
+		{"biglink", symlink, false},
+		{"logs/old.log", file(10, 40*24*time.Hour), true},
+		{"logs/new.log", file(10, 24*time.Hour), false},
+		{"logs/sub", dir, false},
+		{"build", dir, true},
+		{"BUILD", dir, true},
+		{"build", file(10, 0), false},
+		{"linkdir", dir, true},
+		{"linklink", symlink, true},
+		{"linkfile", file(10, 0), false},
+		{"a.tmpfile", file(10, time.Minute), true},
+		{"b.tmpfile", file(0, time.Minute), false},
+		{"c.tmpfile", file(10, 2*time.Hour), false},
+		{"big.bin", unknown, false},
+		{"big.bin", nil, false},
+	}
+	for _, tc := range cases {
+		if ignored := pats.MatchFile(tc.file, tc.attrs).IsIgnored(); ignored != tc.ignored {
+			t.Errorf("%s: ignored %v, expected %v", tc.file, ignored, tc.ignored)
+		}
+	}
+
+	if e := pats.Explain("build", dir); !e.Ignored || e.Rule != "(?type=dir)(?i)Build" || e.Line != 5 {
+		t.Errorf("unexpected explanation %+v", e)
+	}
+}
+
+func TestPredicatesPatternString(t *testing.T) {
+	pats := New(fs.NewFilesystem(fs.FilesystemTypeFake, ""))
+	if err := pats.Parse(strings.NewReader("(?size>1G)(?type=file)(?d)/foo"), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+	patterns := pats.Patterns()
+	if len(patterns) != 1 || patterns[0] != "(?size>1G)(?type=file)(?d)/foo" {
+		t.Errorf("unexpected patterns %q", patterns)
+	}
+}
@@ -23,18 +23,20 @@ type LocationEnum string
 // Use strings as keys to make printout and serialization of the locations map
 // more meaningful.
 const (
-	ConfigFile    LocationEnum = "config"
-	CertFile      LocationEnum = "certFile"
-	KeyFile       LocationEnum = "keyFile"
-	HTTPSCertFile LocationEnum = "httpsCertFile"
-	HTTPSKeyFile  LocationEnum = "httpsKeyFile"
-	Database      LocationEnum = "database"
-	LogFile       LocationEnum = "logFile"
-	CsrfTokens    LocationEnum = "csrfTokens"
-	PanicLog      LocationEnum = "panicLog"
-	AuditLog      LocationEnum = "auditLog"
-	GUIAssets     LocationEnum = "guiAssets"
-	DefFolder     LocationEnum = "defFolder"
+	ConfigFile     LocationEnum = "config"
+	CertFile       LocationEnum = "certFile"
+	KeyFile        LocationEnum = "keyFile"
+	HTTPSCertFile  LocationEnum = "httpsCertFile"
+	HTTPSKeyFile   LocationEnum = "httpsKeyFile"
+	Database       LocationEnum = "database"
+	DatabasePebble LocationEnum = "databasePebble"
+	LogFile        LocationEnum = "logFile"
+	CsrfTokens     LocationEnum = "csrfTokens"
+	PanicLog       LocationEnum = "panicLog"
+	AuditLog       LocationEnum = "auditLog"
+	AdminAuditLog  LocationEnum = "adminAuditLog"
+	GUIAssets      LocationEnum = "guiAssets"
+	DefFolder      LocationEnum = "defFolder"
 )
 
 type BaseDirEnum string
@@ -47,6 +49,7 @@ const (
 	UserHomeBaseDir BaseDirEnum = "userHome"
 
 	LevelDBDir = "index-v0.14.0.db"
+	PebbleDir  = "index-pebble.db"
 )
 
 // Platform dependent directories
@@ -110,18 +113,20 @@ func GetBaseDir(baseDir BaseDirEnum) string {
 
 // Use the variables from baseDirs here
 var locationTemplates = map[LocationEnum]string{
-	ConfigFile:    "${config}/config.xml",
-	CertFile:      "${config}/cert.pem",
-	KeyFile:       "${config}/key.pem",
-	HTTPSCertFile: "${config}/https-cert.pem",
-	HTTPSKeyFile:  "${config}/https-key.pem",
-	Database:      "${data}/" + LevelDBDir,
-	LogFile:       "${data}/syncthing.log", // --logfile on Windows
-	CsrfTokens:    "${data}/csrftokens.txt",
-	PanicLog:      "${data}/panic-${timestamp}.log",
-	AuditLog:      "${data}/audit-${timestamp}.log",
-	GUIAssets:     "${config}/gui",
-	DefFolder:     "${userHome}/Sync",
+	ConfigFile:     "${config}/config.xml",
+	CertFile:       "${config}/cert.pem",
+	KeyFile:        "${config}/key.pem",
+	HTTPSCertFile:  "${config}/https-cert.pem",
+	HTTPSKeyFile:   "${config}/https-key.pem",
+	Database:       "${data}/" + LevelDBDir,
+	DatabasePebble: "${data}/" + PebbleDir,
+	LogFile:        "${data}/syncthing.log", // --logfile on Windows
+	CsrfTokens:     "${data}/csrftokens.txt",
+	PanicLog:       "${data}/panic-${timestamp}.log",
+	AuditLog:       "${data}/audit-${timestamp}.log",
+	AdminAuditLog:  "${data}/admin-audit.log",
+	GUIAssets:      "${config}/gui",
+	DefFolder:      "${userHome}/Sync",
 }
 
 var locations = make(map[LocationEnum]string)
@@ -167,6 +172,7 @@ func PrettyPaths() string {
 	fmt.Fprintf(&b, "Log file:\n\t%s\n\n", Get(LogFile))
 	fmt.Fprintf(&b, "GUI override directory:\n\t%s\n\n", Get(GUIAssets))
 	fmt.Fprintf(&b, "CSRF tokens file:\n\t%s\n\n", Get(CsrfTokens))
+	fmt.Fprintf(&b, "Administrative audit log:\n\t%s\n\n", Get(AdminAuditLog))
 	fmt.Fprintf(&b, "Default sync folder directory:\n\t%s\n\n", Get(DefFolder))
 	return b.String()
 }
@@ -204,6 +210,9 @@ func defaultDataDir(userHome, config string) string {
 	if _, err := os.Lstat(filepath.Join(config, LevelDBDir)); err == nil {
 		return config
 	}
+	if _, err := os.Lstat(filepath.Join(config, PebbleDir)); err == nil {
+		return config
+	}
 	// Always use this env var, as it's explicitly set by the user
 	if xdgHome := os.Getenv("XDG_DATA_HOME"); xdgHome != "" {
 		return filepath.Join(xdgHome, "syncthing")
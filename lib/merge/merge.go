@@ -0,0 +1,173 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package merge implements a line based three-way merge of text files.
+package merge
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// maxEdits limits the work spent on diffing. Files that differ in more
+// lines than this are not merged.
+const maxEdits = 2000
+
+// IsText returns whether the data looks like text, that is valid UTF-8
+// without NUL characters.
+func IsText(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) < 0
+}
+
+// Merge3 applies the changes from base to a and from base to b together. It
+// returns false if the changes overlap, or the files are too different to
+// tell.
+func Merge3(base, a, b []byte) ([]byte, bool) {
+	o, al, bl := splitLines(base), splitLines(a), splitLines(b)
+	ma, ok := matches(o, al)
+	if !ok {
+		return nil, false
+	}
+	mb, ok := matches(o, bl)
+	if !ok {
+		return nil, false
+	}
+
+	var res bytes.Buffer
+	i, ia, ib := 0, 0, 0
+	for {
+		if i < len(o) && ma[i] == ia && mb[i] == ib {
+			// Unchanged on both sides
+			res.WriteString(o[i])
+			i, ia, ib = i+1, ia+1, ib+1
+			continue
+		}
+
+		// Find the next line of the base that is unchanged on both sides,
+		// and decide what to do with everything up to it.
+		k := i
+		for k < len(o) && (ma[k] < 0 || mb[k] < 0) {
+			k++
+		}
+		ea, eb := len(al), len(bl)
+		if k < len(o) {
+			ea, eb = ma[k], mb[k]
+		}
+		chunkO, chunkA, chunkB := o[i:k], al[ia:ea], bl[ib:eb]
+		switch {
+		case equal(chunkA, chunkO):
+			writeLines(&res, chunkB)
+		case equal(chunkB, chunkO), equal(chunkA, chunkB):
+			writeLines(&res, chunkA)
+		default:
+			return nil, false
+		}
+
+		if k == len(o) {
+			return res.Bytes(), true
+		}
+		i, ia, ib = k, ea, eb
+	}
+}
+
+// splitLines splits the data into lines, including the line endings.
+func splitLines(data []byte) []string {
+	var lines []string
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			lines = append(lines, string(data))
+			break
+		}
+		lines = append(lines, string(data[:i+1]))
+		data = data[i+1:]
+	}
+	return lines
+}
+
+func writeLines(buf *bytes.Buffer, lines []string) {
+	for _, line := range lines {
+		buf.WriteString(line)
+	}
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns, for every line of a, the index of the same line in b or
+// -1, according to a longest common subsequence. It uses Myers' algorithm,
+// keeping the frontier of every step for backtracking.
+func matches(a, b []string) ([]int, bool) {
+	n, m := len(a), len(b)
+	res := make([]int, n)
+	for i := range res {
+		res[i] = -1
+	}
+
+	maxD := n + m
+	if maxD > maxEdits {
+		maxD = maxEdits
+	}
+	off := maxD + 1
+	v := make([]int, 2*maxD+3)
+	var trace [][]int
+
+	for d := 0; d <= maxD; d++ {
+		// Remember the frontier before this step, covering the diagonals
+		// this step reads from.
+		trace = append(trace, append([]int(nil), v[off-d-1:off+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				backtrack(trace, res, n, m)
+				return res, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func backtrack(trace [][]int, res []int, x, y int) {
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		get := func(k int) int { return v[k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && get(k-1) < get(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := get(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			res[x] = y
+		}
+		if d > 0 {
+			x, y = prevX, prevY
+		}
+	}
+}
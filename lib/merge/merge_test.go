@@ -0,0 +1,167 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package merge
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected []int
+	}{
+		{"", "", []int{}},
+		{"abc", "abc", []int{0, 1, 2}},
+		{"abc", "", []int{-1, -1, -1}},
+		{"", "abc", []int{}},
+		{"abcabba", "cbabac", []int{-1, -1, 0, 2, 3, -1, 4}},
+		{"abc", "xaybzc", []int{1, 3, 5}},
+		{"xaybzc", "abc", []int{-1, 0, -1, 1, -1, 2}},
+	}
+	for _, tc := range cases {
+		res, ok := matches(strings.Split(tc.a, ""), strings.Split(tc.b, ""))
+		if !ok {
+			t.Errorf("%q, %q: unexpected failure", tc.a, tc.b)
+			continue
+		}
+		if fmt.Sprint(res) != fmt.Sprint(tc.expected) {
+			t.Errorf("%q, %q: got %v, expected %v", tc.a, tc.b, res, tc.expected)
+		}
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	cases := []struct {
+		name           string
+		base, a, b     string
+		merged         string
+		expectConflict bool
+	}{
+		{
+			name:   "unchanged",
+			base:   "one\ntwo\nthree\n",
+			a:      "one\ntwo\nthree\n",
+			b:      "one\ntwo\nthree\n",
+			merged: "one\ntwo\nthree\n",
+		},
+		{
+			name:   "one side",
+			base:   "one\ntwo\nthree\n",
+			a:      "one\n2\nthree\n",
+			b:      "one\ntwo\nthree\n",
+			merged: "one\n2\nthree\n",
+		},
+		{
+			name:   "separate edits",
+			base:   "one\ntwo\nthree\nfour\nfive\n",
+			a:      "1\ntwo\nthree\nfour\nfive\n",
+			b:      "one\ntwo\nthree\nfour\n5\n",
+			merged: "1\ntwo\nthree\nfour\n5\n",
+		},
+		{
+			name:   "insertions and deletions",
+			base:   "one\ntwo\nthree\nfour\n",
+			a:      "zero\none\ntwo\nthree\nfour\n",
+			b:      "one\nthree\nfour\nfive\n",
+			merged: "zero\none\nthree\nfour\nfive\n",
+		},
+		{
+			name:   "same change on both sides",
+			base:   "one\ntwo\nthree\n",
+			a:      "one\n2\nthree\n",
+			b:      "one\n2\nthree\n",
+			merged: "one\n2\nthree\n",
+		},
+		{
+			name:   "empty base",
+			base:   "",
+			a:      "one\n",
+			b:      "",
+			merged: "one\n",
+		},
+		{
+			name:           "overlapping edits",
+			base:           "one\ntwo\nthree\n",
+			a:              "one\n2\nthree\n",
+			b:              "one\nTWO\nthree\n",
+			expectConflict: true,
+		},
+		{
+			name:           "adjacent insertions",
+			base:           "one\ntwo\n",
+			a:              "one\na\ntwo\n",
+			b:              "one\nb\ntwo\n",
+			expectConflict: true,
+		},
+		{
+			name:           "edit and delete",
+			base:           "one\ntwo\nthree\n",
+			a:              "one\nthree\n",
+			b:              "one\n2\nthree\n",
+			expectConflict: true,
+		},
+		{
+			name:   "no trailing newline",
+			base:   "one\ntwo\nthree",
+			a:      "1\ntwo\nthree",
+			b:      "one\ntwo\n3",
+			merged: "1\ntwo\n3",
+		},
+		{
+			name:   "windows line endings",
+			base:   "one\r\ntwo\r\nthree\r\n",
+			a:      "1\r\ntwo\r\nthree\r\n",
+			b:      "one\r\ntwo\r\nthree\r\nfour\r\n",
+			merged: "1\r\ntwo\r\nthree\r\nfour\r\n",
+		},
+	}
+
+	for _, tc := range cases {
+		res, ok := Merge3([]byte(tc.base), []byte(tc.a), []byte(tc.b))
+		if ok == tc.expectConflict {
+			t.Errorf("%s: merge succeeded %v, got %q", tc.name, ok, res)
+			continue
+		}
+		if ok && string(res) != tc.merged {
+			t.Errorf("%s: got %q, expected %q", tc.name, res, tc.merged)
+		}
+		// Merging is symmetric
+		res2, ok2 := Merge3([]byte(tc.base), []byte(tc.b), []byte(tc.a))
+		if ok2 != ok || string(res2) != string(res) {
+			t.Errorf("%s: reversed merge got %q, %v", tc.name, res2, ok2)
+		}
+	}
+}
+
+func TestMerge3TooDifferent(t *testing.T) {
+	var base, a strings.Builder
+	for i := 0; i < maxEdits+1; i++ {
+		fmt.Fprintf(&base, "base %d\n", i)
+		fmt.Fprintf(&a, "a %d\n", i)
+	}
+	if _, ok := Merge3([]byte(base.String()), []byte(a.String()), []byte(base.String())); ok {
+		t.Error("expected merge to give up")
+	}
+}
+
+func TestIsText(t *testing.T) {
+	cases := map[string]bool{
+		"":                   true,
+		"hello\nworld\n":     true,
+		"räksmörgås":         true,
+		"nul\x00byte":        false,
+		"invalid \xff utf-8": false,
+	}
+	for in, out := range cases {
+		if res := IsText([]byte(in)); res != out {
+			t.Errorf("%q: got %v, expected %v", in, res, out)
+		}
+	}
+}
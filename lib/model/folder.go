@@ -9,6 +9,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"path/filepath"
 	"sort"
@@ -23,6 +24,7 @@ import (
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
 	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/merge"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
@@ -66,6 +68,8 @@ type folder struct {
 	pullPause     time.Duration
 	pullFailTimer *time.Timer
 
+	scheduleTimer *time.Timer // fires when the sync schedule window opens or closes
+
 	scanErrors []FileError
 	pullErrors []FileError
 	errorsMut  sync.Mutex
@@ -139,6 +143,11 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 	f.pullPause = f.pullBasePause()
 	f.pullFailTimer = time.NewTimer(0)
 	<-f.pullFailTimer.C
+	f.scheduleTimer = time.NewTimer(0)
+	<-f.scheduleTimer.C
+
+	registerFolderMetrics(f.ID)
+
 	return f
 }
 
@@ -154,6 +163,7 @@ func (f *folder) Serve(ctx context.Context) error {
 	defer func() {
 		f.scanTimer.Stop()
 		f.versionCleanupTimer.Stop()
+		f.scheduleTimer.Stop()
 		f.setState(FolderIdle)
 	}()
 
@@ -169,6 +179,8 @@ func (f *folder) Serve(ctx context.Context) error {
 		}
 	}
 
+	f.resetScheduleTimer()
+
 	initialCompleted := f.initialScanFinished
 
 	for {
@@ -226,6 +238,11 @@ func (f *folder) Serve(ctx context.Context) error {
 		case <-f.versionCleanupTimer.C:
 			l.Debugln(f, "Doing version cleanup")
 			f.versionCleanupTimerFired()
+
+		case <-f.scheduleTimer.C:
+			l.Debugln(f, "Sync schedule window changed")
+			f.resetScheduleTimer()
+			_, err = f.pull()
 		}
 
 		if err != nil {
@@ -378,9 +395,19 @@ func (f *folder) pull() (success bool, err error) {
 		f.errorsMut.Lock()
 		f.pullErrors = nil
 		f.errorsMut.Unlock()
+		f.setIdleState(FolderIdle)
 		return true, nil
 	}
 
+	// Outside of the sync schedule we wait for the next window to open,
+	// which triggers a pull by way of the schedule timer.
+	if !f.Schedule.Allows(time.Now()) {
+		l.Debugln("Skipping pull of", f.Description(), "outside of sync schedule")
+		f.setIdleState(FolderSyncScheduled)
+		return true, nil
+	}
+	f.setIdleState(FolderIdle)
+
 	// Abort early (before acquiring a token) if there's a folder error
 	err = f.getHealthErrorWithoutIgnores()
 	if err != nil {
@@ -400,6 +427,10 @@ func (f *folder) pull() (success bool, err error) {
 	}
 
 	startTime := time.Now()
+	defer func() {
+		metricFolderPulls.WithLabelValues(f.ID).Inc()
+		metricFolderPullSeconds.WithLabelValues(f.ID).Add(time.Since(startTime).Seconds())
+	}()
 
 	// Check if the ignore patterns changed.
 	oldHash := f.ignores.Hash()
@@ -469,6 +500,12 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 			break
 		}
 
+		if base := filepath.Base(sub); (f.NestedIgnoreFiles && base == ".stignore" || f.GitignoreFiles && base == ".gitignore") && filepath.Dir(sub) != "." {
+			// A changed nested ignore file may change what's ignored
+			// anywhere in its directory.
+			sub = filepath.Dir(sub)
+		}
+
 		subDirs[i] = sub
 	}
 
@@ -488,6 +525,12 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	f.setState(FolderScanning)
 	f.clearScanErrors(subDirs)
 
+	scanStart := time.Now()
+	defer func() {
+		metricFolderScans.WithLabelValues(f.ID).Inc()
+		metricFolderScanSeconds.WithLabelValues(f.ID).Add(time.Since(scanStart).Seconds())
+	}()
+
 	batch := f.newScanBatch()
 
 	// Schedule a pull after scanning, but only if we actually detected any
@@ -732,7 +775,7 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch
 				ignoredParent = ""
 			}
 
-			switch ignored := f.ignores.Match(file.Name).IsIgnored(); {
+			switch ignored := f.ignores.MatchFile(file.Name, ignore.LstatAttributes(f.mtimefs, file.Name)).IsIgnored(); {
 			case file.IsIgnored() && ignored:
 				return true
 			case !file.IsIgnored() && ignored:
@@ -778,6 +821,13 @@ func (f *folder) scanSubdirsDeletedAndIgnored(subDirs []string, batch *scanBatch
 					// the deleted file. Setting to an empty version makes
 					// sure the file gets in sync on the following pull.
 					nf.Version = protocol.Vector{}
+				} else if file.IsPlaceholder() {
+					// Removing a placeholder doesn't delete contents we
+					// never had, it is brought back on the following pull.
+					nf.Version = protocol.Vector{}
+					if err := fs.RemovePlaceholder(f.mtimefs, file.Name); err != nil {
+						l.Debugln(f, "removing placeholder metadata:", err)
+					}
 				}
 				l.Debugln("marking file as deleted", nf)
 				if batch.Update(nf, snap) {
@@ -873,7 +923,7 @@ func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUs
 			return true
 		}
 
-		if f.ignores.Match(fi.Name).IsIgnored() {
+		if f.ignores.MatchFile(fi.Name, fileAttributes(fi)).IsIgnored() {
 			return true
 		}
 
@@ -900,6 +950,39 @@ func (f *folder) findRename(snap *db.Snapshot, file protocol.FileInfo, alreadyUs
 	return nf, found
 }
 
+// shouldIgnore returns whether a file from the database, like one needed
+// from a remote device, is ignored. Predicates are evaluated against the
+// file itself, except for deletions, where the file on disk is what counts.
+func (f *folder) shouldIgnore(file protocol.FileIntf) bool {
+	name := file.FileName()
+	if fs.IsTemporary(name) || fs.IsInternal(name) {
+		return true
+	}
+	attrs := fileAttributes(file)
+	if file.IsDeleted() {
+		attrs = ignore.LstatAttributes(f.mtimefs, name)
+	}
+	return f.ignores.MatchFile(name, attrs).IsIgnored()
+}
+
+// fileAttributes returns the attributes of a file from the database for
+// evaluating ignore predicates.
+func fileAttributes(file protocol.FileIntf) ignore.AttributesFunc {
+	return func() (ignore.FileAttributes, bool) {
+		attrs := ignore.FileAttributes{
+			Size:    file.FileSize(),
+			ModTime: file.ModTime(),
+		}
+		switch {
+		case file.IsDirectory():
+			attrs.Type = ignore.FileTypeDirectory
+		case file.IsSymlink():
+			attrs.Type = ignore.FileTypeSymlink
+		}
+		return attrs, true
+	}
+}
+
 func (f *folder) scanTimerFired() error {
 	err := f.scanSubdirs(nil)
 
@@ -1139,6 +1222,17 @@ func (f *folder) setError(err error) {
 	f.stateTracker.setError(err)
 }
 
+// resetScheduleTimer sets the schedule timer to fire at the next point in
+// time where the sync schedule window opens or closes, if any.
+func (f *folder) resetScheduleTimer() {
+	next := f.Schedule.NextChange(time.Now())
+	if next.IsZero() {
+		return
+	}
+	l.Debugf("%v next sync schedule change at %v", f, next)
+	f.scheduleTimer.Reset(time.Until(next))
+}
+
 func (f *folder) pullBasePause() time.Duration {
 	if f.PullerPauseS == 0 {
 		return defaultPullerPause
@@ -1203,6 +1297,116 @@ func (f *folder) ScheduleForceRescan(path string) {
 	}
 }
 
+// FetchPlaceholders requests the contents of the placeholders at or below
+// the given paths. Their local version is reset so that they become needed
+// again, which tells the puller to pull them instead of recreating the
+// placeholders.
+func (f *folder) FetchPlaceholders(names []string) error {
+	return f.doInSync(func() error {
+		return f.fetchPlaceholders(names)
+	})
+}
+
+func (f *folder) fetchPlaceholders(names []string) error {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+
+	var placeholders []string
+	for _, name := range names {
+		snap.WithPrefixedHaveTruncated(protocol.LocalDeviceID, name, func(intf protocol.FileIntf) bool {
+			if intf.(db.FileInfoTruncated).IsPlaceholder() {
+				placeholders = append(placeholders, intf.FileName())
+			}
+			return true
+		})
+	}
+	if len(placeholders) == 0 {
+		return ErrPlaceholderMissing
+	}
+
+	batch := db.NewFileInfoBatch(func(files []protocol.FileInfo) error {
+		f.updateLocals(files)
+		return nil
+	})
+	for _, name := range placeholders {
+		fi, ok := snap.Get(protocol.LocalDeviceID, name)
+		if !ok || fi.Version.IsEmpty() {
+			continue
+		}
+		l.Debugln(f, "fetching placeholder", name)
+		fi.Version = protocol.Vector{}
+		batch.Append(fi)
+		if err := batch.FlushIfFull(); err != nil {
+			return err
+		}
+	}
+	if err := batch.Flush(); err != nil {
+		return err
+	}
+
+	f.SchedulePull()
+	return nil
+}
+
+// ResolveConflict keeps the given side of the conflict, as with
+// model.ResolveConflict. It runs in the folder loop so that the puller
+// doesn't touch the files meanwhile, and scans the result before
+// returning.
+func (f *folder) ResolveConflict(conflict, keep string) error {
+	return f.doInSync(func() error {
+		return f.resolveConflict(conflict, keep)
+	})
+}
+
+func (f *folder) resolveConflict(conflict, keep string) error {
+	cr, ok, err := f.fset.Conflict(conflict)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrConflictMissing
+	}
+
+	discard := func(name string) error {
+		var err error
+		if f.versioner != nil {
+			err = f.versioner.Archive(name)
+		} else {
+			err = f.mtimefs.Remove(name)
+		}
+		if fs.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	switch keep {
+	case ConflictKeepOriginal:
+		err = discard(conflict)
+	case ConflictKeepConflict:
+		if _, err = f.mtimefs.Lstat(conflict); err != nil {
+			break
+		}
+		if err = discard(cr.Original); err == nil {
+			err = f.mtimefs.Rename(conflict, cr.Original)
+		}
+	default:
+		return fmt.Errorf("unknown side of the conflict to keep: %q", keep)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := f.fset.RemoveConflict(conflict); err != nil {
+		return err
+	}
+
+	return f.scanSubdirs([]string{cr.Original, conflict})
+}
+
 func (f *folder) updateLocalsFromScanning(fs []protocol.FileInfo) {
 	f.updateLocals(fs)
 
@@ -1217,6 +1421,7 @@ func (f *folder) updateLocalsFromPulling(fs []protocol.FileInfo) {
 
 func (f *folder) updateLocals(fs []protocol.FileInfo) {
 	f.fset.Update(protocol.LocalDeviceID, fs)
+	f.rememberMergeBases(fs)
 
 	filenames := make([]string, len(fs))
 	f.forcedRescanPathsMut.Lock()
@@ -1237,6 +1442,65 @@ func (f *folder) updateLocals(fs []protocol.FileInfo) {
 	})
 }
 
+// rememberMergeBases retains the current versions of files that conflicting
+// changes may later be merged into.
+func (f *folder) rememberMergeBases(files []protocol.FileInfo) {
+	if !f.MergeConflicts {
+		return
+	}
+	for _, file := range files {
+		if file.IsDeleted() {
+			if err := f.fset.RemoveMergeBases(file.Name); err != nil {
+				l.Debugf("%v forgetting merge bases for %v: %v", f, file.Name, err)
+			}
+			continue
+		}
+		if file.Type != protocol.FileInfoTypeFile || file.IsInvalid() || !f.ShouldMerge(file.Name, file.Size) {
+			continue
+		}
+		data, err := f.readFileVerified(file)
+		if err != nil {
+			l.Debugf("%v not remembering merge base for %v: %v", f, file.Name, err)
+			continue
+		}
+		base := db.MergeBase{
+			Version:  file.Version,
+			Modified: file.ModTime(),
+			Size:     file.Size,
+		}
+		if merge.IsText(data) {
+			base.Data = data
+		}
+		if err := f.fset.AddMergeBase(file.Name, base); err != nil {
+			l.Debugf("%v remembering merge base for %v: %v", f, file.Name, err)
+		}
+	}
+}
+
+// readFileVerified returns the contents of the file on disk, provided they
+// match the given file info.
+func (f *folder) readFileVerified(file protocol.FileInfo) ([]byte, error) {
+	fd, err := f.mtimefs.Open(file.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	data, err := io.ReadAll(io.LimitReader(fd, file.Size+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != file.Size {
+		return nil, errModified
+	}
+	for _, block := range file.Blocks {
+		end := block.Offset + int64(block.Size)
+		if end > int64(len(data)) || !scanner.Validate(data[block.Offset:end], block.Hash, 0) {
+			return nil, errModified
+		}
+	}
+	return data, nil
+}
+
 func (f *folder) emitDiskChangeEvents(fs []protocol.FileInfo, typeOfEvent events.EventType) {
 	for _, file := range fs {
 		if file.IsInvalid() {
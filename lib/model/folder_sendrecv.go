@@ -24,6 +24,7 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/merge"
 	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
@@ -70,6 +71,7 @@ var (
 	errDirNotEmpty            = errors.New(errDirPrefix + "is not empty; the contents are probably ignored on that remote device, but not locally")
 	errNotAvailable           = errors.New("no connected device has the required version of this file")
 	errModified               = errors.New("file modified but not rescanned; will try again later")
+	errNoMergeBase            = errors.New("common version not available")
 	errUnexpectedDirOnFileDel = errors.New("encountered directory when trying to remove file/symlink")
 	errIncompatibleSymlink    = errors.New("incompatible symlink entry; rescan with newer Syncthing on source")
 	contextRemovingOldItem    = "removing item to be replaced"
@@ -339,7 +341,7 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 		file := intf.(protocol.FileInfo)
 
 		switch {
-		case f.ignores.ShouldIgnore(file.Name):
+		case f.shouldIgnore(file):
 			file.SetIgnored()
 			l.Debugln(f, "Handling ignored file", file)
 			dbUpdateChan <- dbUpdateJob{file, dbUpdateInvalidate}
@@ -359,6 +361,11 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 				changed--
 			}
 
+		case f.localWinsConflict(file, snap):
+			// Our version wins the conflict, so instead of pulling the
+			// remote one we make sure ours supersedes it.
+			f.keepLocalVersion(file, snap, dbUpdateChan)
+
 		case file.IsDeleted():
 			if file.IsDirectory() {
 				// Perform directory deletions at the end, as we may have
@@ -384,12 +391,17 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 
 		case file.Type == protocol.FileInfoTypeFile:
 			curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
-			if hasCurFile && file.BlocksEqual(curFile) {
+			switch {
+			case f.shouldCreatePlaceholder(file, curFile, hasCurFile):
+				if f.checkParent(file.Name, scanChan) {
+					f.handlePlaceholder(file, curFile, hasCurFile, dbUpdateChan, scanChan)
+				}
+			case hasCurFile && !curFile.IsPlaceholder() && file.BlocksEqual(curFile):
 				// We are supposed to copy the entire file, and then fetch nothing. We
 				// are only updating metadata, so we don't actually *need* to make the
 				// copy.
 				f.shortcutFile(file, dbUpdateChan)
-			} else {
+			default:
 				// Queue files for processing after directories and symlinks.
 				f.queue.Push(file.Name, file.Size, file.ModTime())
 			}
@@ -600,14 +612,9 @@ func (f *sendReceiveFolder) handleDir(file protocol.FileInfo, snap *db.Snapshot,
 
 		// Remove it to replace with the dir.
 		if !curFile.IsSymlink() && f.inConflict(curFile.Version, file.Version) {
-			// The new file has been changed in conflict with the existing one. We
-			// should file it away as a conflict instead of just removing or
-			// archiving.
+			// The new file has been changed in conflict with the existing one.
 			// Symlinks aren't checked for conflicts.
-
-			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
-			}, curFile.Name)
+			err = f.replaceInConflict(curFile, file, snap, scanChan)
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
 		}
@@ -782,14 +789,9 @@ func (f *sendReceiveFolder) handleSymlinkCheckExisting(file protocol.FileInfo, s
 	// Remove it to replace with the symlink. This also handles the
 	// "change symlink type" path.
 	if !curFile.IsDirectory() && !curFile.IsSymlink() && f.inConflict(curFile.Version, file.Version) {
-		// The new file has been changed in conflict with the existing one. We
-		// should file it away as a conflict instead of just removing or
-		// archiving.
+		// The new file has been changed in conflict with the existing one.
 		// Directories and symlinks aren't checked for conflicts.
-
-		return f.inWritableDir(func(name string) error {
-			return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
-		}, curFile.Name)
+		return f.replaceInConflict(curFile, file, snap, scanChan)
 	} else {
 		return f.deleteItemOnDisk(curFile, snap, scanChan)
 	}
@@ -895,7 +897,9 @@ func (f *sendReceiveFolder) deleteFileWithCurrent(file, cur protocol.FileInfo, h
 		return
 	}
 
-	if f.versioner != nil && !cur.IsSymlink() {
+	if cur.IsPlaceholder() {
+		err = f.removePlaceholder(file.Name)
+	} else if f.versioner != nil && !cur.IsSymlink() {
 		err = f.inWritableDir(f.versioner.Archive, file.Name)
 	} else {
 		err = f.inWritableDir(f.mtimefs.Remove, file.Name)
@@ -917,6 +921,105 @@ func (f *sendReceiveFolder) deleteFileWithCurrent(file, cur protocol.FileInfo, h
 	}
 }
 
+// shouldCreatePlaceholder returns whether an empty placeholder should be
+// created for the needed file instead of pulling its contents. Files whose
+// contents we already have are kept up to date as usual.
+func (f *sendReceiveFolder) shouldCreatePlaceholder(file, curFile protocol.FileInfo, hasCurFile bool) bool {
+	if !f.PlaceholderFiles || file.Size == 0 {
+		return false
+	}
+	switch {
+	case !hasCurFile || curFile.IsDeleted():
+		return true
+	case curFile.IsPlaceholder():
+		// Placeholders whose contents were requested have their version
+		// reset, see FetchPlaceholders.
+		return !curFile.Version.IsEmpty()
+	}
+	return false
+}
+
+// handlePlaceholder creates an empty placeholder standing in for the file,
+// recording its size and modification time on the side.
+func (f *sendReceiveFolder) handlePlaceholder(file, curFile protocol.FileInfo, hasCurFile bool, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
+	// Used in the defer closure below, updated by the function body. Take
+	// care not declare another err.
+	var err error
+
+	l.Debugln(f, "Creating placeholder", file.Name)
+
+	f.evLogger.Log(events.ItemStarted, map[string]string{
+		"folder": f.folderID,
+		"item":   file.Name,
+		"type":   "file",
+		"action": "update",
+	})
+
+	defer func() {
+		if err != nil {
+			f.newPullError(file.Name, errors.Wrap(err, "placeholder"))
+		}
+		f.evLogger.Log(events.ItemFinished, map[string]interface{}{
+			"folder": f.folderID,
+			"item":   file.Name,
+			"error":  events.Error(err),
+			"type":   "file",
+			"action": "update",
+		})
+	}()
+
+	if stat, serr := f.mtimefs.Lstat(file.Name); serr == nil {
+		if err = f.scanIfItemChanged(file.Name, stat, curFile, hasCurFile, scanChan); err != nil {
+			return
+		}
+	} else if !fs.IsNotExist(serr) {
+		err = serr
+		return
+	}
+
+	// The metadata goes first, so that the empty file is never taken for
+	// an actual empty file.
+	if err = fs.WritePlaceholder(f.mtimefs, file.Name, fs.Placeholder{Size: file.Size, ModTime: file.ModTime()}); err != nil {
+		return
+	}
+
+	tempName := fs.TempName(file.Name)
+	create := func(name string) error {
+		fd, err := f.mtimefs.Create(name)
+		if err != nil {
+			return err
+		}
+		return fd.Close()
+	}
+	if err = f.inWritableDir(create, tempName); err != nil {
+		return
+	}
+	if !f.IgnorePerms && !file.NoPermissions {
+		if err = f.mtimefs.Chmod(tempName, fs.FileMode(file.Permissions&0777)); err != nil {
+			return
+		}
+	}
+	if err = f.maybeAdjustOwnership(&file, tempName); err != nil {
+		return
+	}
+	if err = osutil.RenameOrCopy(f.CopyRangeMethod, f.mtimefs, f.mtimefs, tempName, file.Name); err != nil {
+		return
+	}
+	f.mtimefs.Chtimes(file.Name, file.ModTime(), file.ModTime()) // never fails
+
+	file.SetPlaceholder()
+	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleFile}
+}
+
+// removePlaceholder removes a placeholder along with its metadata. There
+// are no contents to archive.
+func (f *sendReceiveFolder) removePlaceholder(name string) error {
+	if err := f.inWritableDir(f.mtimefs.Remove, name); err != nil && !fs.IsNotExist(err) {
+		return err
+	}
+	return fs.RemovePlaceholder(f.mtimefs, name)
+}
+
 // renameFile attempts to rename an existing file to a destination
 // and set the right attributes on it.
 func (f *sendReceiveFolder) renameFile(cur, source, target protocol.FileInfo, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) error {
@@ -1617,15 +1720,17 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 			return err
 		}
 
-		if !curFile.IsDirectory() && !curFile.IsSymlink() && f.inConflict(curFile.Version, file.Version) {
-			// The new file has been changed in conflict with the existing one. We
-			// should file it away as a conflict instead of just removing or
-			// archiving.
-			// Directories and symlinks aren't checked for conflicts.
-
-			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
-			}, curFile.Name)
+		if !curFile.IsDirectory() && !curFile.IsSymlink() && !curFile.IsPlaceholder() && f.inConflict(curFile.Version, file.Version) {
+			// The new file has been changed in conflict with the existing one.
+			// Directories, symlinks and placeholders aren't checked for
+			// conflicts.
+			if merged, ok := f.mergeInConflict(curFile, file, tempName); ok {
+				// The temp file now holds both sets of changes.
+				file = merged
+				err = f.deleteItemOnDisk(curFile, snap, scanChan)
+			} else {
+				err = f.replaceInConflict(curFile, file, snap, scanChan)
+			}
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
 		}
@@ -1818,12 +1923,221 @@ func (f *sendReceiveFolder) inConflict(current, replacement protocol.Vector) boo
 	return false
 }
 
-func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan chan<- string) error {
+// resolveConflict decides what happens to the local version of an item that
+// is in conflict with a remote one, according to the conflict strategy.
+func (f *sendReceiveFolder) resolveConflict(cur, file protocol.FileInfo) conflictResolution {
+	switch f.ConflictStrategy {
+	case config.ConflictStrategyNewestWins:
+		if protocol.WinsConflict(file, cur) {
+			return conflictRemoteWins
+		}
+		return conflictLocalWins
+	case config.ConflictStrategyDeviceWins:
+		if f.ConflictWinner == protocol.EmptyDeviceID {
+			break
+		}
+		winner := f.ConflictWinner.Short()
+		switch {
+		case file.ModifiedBy == winner:
+			return conflictRemoteWins
+		case cur.ModifiedBy == winner:
+			return conflictLocalWins
+		}
+	}
+	return conflictKeepBoth
+}
+
+// localWinsConflict returns whether the needed file is in conflict with a
+// local file that wins according to the conflict strategy.
+func (f *sendReceiveFolder) localWinsConflict(file protocol.FileInfo, snap *db.Snapshot) bool {
+	if file.IsDeleted() || f.ConflictStrategy == config.ConflictStrategyCopy || f.ConflictStrategy == config.ConflictStrategyDirectory {
+		return false
+	}
+	cur, ok := snap.Get(protocol.LocalDeviceID, file.Name)
+	if !ok || cur.IsDeleted() || cur.IsInvalid() || cur.IsDirectory() || cur.IsSymlink() {
+		return false
+	}
+	return f.inConflict(cur.Version, file.Version) && f.resolveConflict(cur, file) == conflictLocalWins
+}
+
+// keepLocalVersion resolves a conflict in favour of the local file by
+// merging the version vector of the remote one into it, the way conflicts
+// with deletions are resolved.
+func (f *sendReceiveFolder) keepLocalVersion(file protocol.FileInfo, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob) {
+	cur, _ := snap.Get(protocol.LocalDeviceID, file.Name)
+	f.conflictDetected(cur, file, conflictLocalWins)
+	cur.Version = cur.Version.Merge(file.Version)
+	dbUpdateChan <- dbUpdateJob{cur, dbUpdateHandleFile}
+}
+
+// replaceInConflict gets the local version of an item out of the way of a
+// remote one it is in conflict with. Unless the remote version wins, the
+// local one is filed away as a conflict copy instead of just removing or
+// archiving it.
+func (f *sendReceiveFolder) replaceInConflict(cur, file protocol.FileInfo, snap *db.Snapshot, scanChan chan<- string) error {
+	res := f.resolveConflict(cur, file)
+	if res == conflictLocalWins {
+		// Conflicts the local version wins are handled before pulling, so
+		// this one came up while we were at it. Play it safe.
+		res = conflictKeepBoth
+	}
+	f.conflictDetected(cur, file, res)
+	if res == conflictRemoteWins {
+		return f.deleteItemOnDisk(cur, snap, scanChan)
+	}
+	return f.inWritableDir(func(name string) error {
+		return f.moveForConflict(name, cur, file, scanChan)
+	}, cur.Name)
+}
+
+func (f *sendReceiveFolder) conflictDetected(cur, file protocol.FileInfo, res conflictResolution) {
+	l.Debugf("%v conflict on %s resolved as %v (%v)", f, file.Name, res, f.ConflictStrategy)
+	f.evLogger.Log(events.ConflictDetected, map[string]interface{}{
+		"folder":           f.ID,
+		"item":             file.Name,
+		"strategy":         f.ConflictStrategy.String(),
+		"resolution":       res.String(),
+		"localVersion":     versionStrings(cur.Version),
+		"localModifiedBy":  cur.ModifiedBy.String(),
+		"remoteVersion":    versionStrings(file.Version),
+		"remoteModifiedBy": file.ModifiedBy.String(),
+	})
+}
+
+// mergeInConflict attempts a three-way merge of the local version cur of a
+// text file with the conflicting remote version file, which has been pulled
+// into tempName. On success the merged contents replace those of the temp
+// file and the returned file info describes them.
+func (f *sendReceiveFolder) mergeInConflict(cur, file protocol.FileInfo, tempName string) (protocol.FileInfo, bool) {
+	if !f.ShouldMerge(file.Name, file.Size) || !f.ShouldMerge(cur.Name, cur.Size) || f.resolveConflict(cur, file) != conflictKeepBoth {
+		return file, false
+	}
+
+	merged, outcome, err := f.mergeFiles(cur, file, tempName)
+	l.Debugf("%v merging %v: %v (%v)", f, file.Name, outcome, err)
+	f.evLogger.Log(events.ConflictMergeAttempted, map[string]interface{}{
+		"folder":  f.ID,
+		"item":    file.Name,
+		"outcome": outcome,
+		"error":   events.Error(err),
+	})
+	if outcome != mergeOutcomeMerged {
+		return file, false
+	}
+	f.conflictDetected(cur, file, conflictMerged)
+	return merged, true
+}
+
+func (f *sendReceiveFolder) mergeFiles(cur, file protocol.FileInfo, tempName string) (protocol.FileInfo, string, error) {
+	local, err := f.readFileVerified(cur)
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	}
+	remote, err := f.readTempFile(tempName, file.Size)
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	}
+	if !merge.IsText(local) || !merge.IsText(remote) {
+		return file, mergeOutcomeNotText, nil
+	}
+
+	base, ok, err := f.fset.MergeBase(file.Name, cur.Version, file.Version)
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	} else if !ok {
+		return file, mergeOutcomeNoBase, nil
+	}
+	data := base.Data
+	if !base.HasData() {
+		if data, err = f.archivedMergeBase(file.Name, base); err != nil {
+			return file, mergeOutcomeNoBase, err
+		}
+		if !merge.IsText(data) {
+			return file, mergeOutcomeNotText, nil
+		}
+	}
+
+	result, ok := merge.Merge3(data, local, remote)
+	if !ok {
+		return file, mergeOutcomeOverlapping, nil
+	}
+
+	fd, err := f.mtimefs.OpenFile(tempName, fs.OptWriteOnly|fs.OptTruncate, 0644)
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	}
+	_, err = fd.Write(result)
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	}
+
+	size := int64(len(result))
+	blockSize := protocol.BlockSize(size)
+	blocks, err := scanner.Blocks(f.ctx, bytes.NewReader(result), blockSize, size, nil, true)
+	if err != nil {
+		return file, mergeOutcomeFailed, err
+	}
+	now := time.Now()
+	file.Size = size
+	file.RawBlockSize = blockSize
+	file.Blocks = blocks
+	file.BlocksHash = protocol.BlocksHash(blocks)
+	file.ModifiedS = now.Unix()
+	file.ModifiedNs = now.Nanosecond()
+	file.ModifiedBy = f.shortID
+	file.Version = cur.Version.Merge(file.Version).Update(f.shortID)
+	return file, mergeOutcomeMerged, nil
+}
+
+func (f *sendReceiveFolder) readTempFile(name string, size int64) ([]byte, error) {
+	fd, err := f.mtimefs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return io.ReadAll(io.LimitReader(fd, size))
+}
+
+// archivedMergeBase finds the contents of a merge base that isn't retained in
+// the database among the versions archived by the versioner.
+func (f *sendReceiveFolder) archivedMergeBase(name string, base db.MergeBase) ([]byte, error) {
+	if f.versioner == nil {
+		return nil, errNoMergeBase
+	}
+	versions, err := f.versioner.GetVersions()
+	if err != nil {
+		return nil, err
+	}
+	modified := base.Modified.Truncate(time.Second)
+	for _, version := range versions[osutil.NormalizedFilename(name)] {
+		if version.Size != base.Size || !version.ModTime.Equal(modified) {
+			continue
+		}
+		fd, err := versioner.OpenVersion(f.versioner, name, version.VersionTime)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(io.LimitReader(fd, base.Size))
+		fd.Close()
+		return data, err
+	}
+	return nil, errNoMergeBase
+}
+
+// moveForConflict files away the local version cur, which is in conflict
+// with file, as a conflict copy and remembers it in the database.
+func (f *sendReceiveFolder) moveForConflict(name string, cur, file protocol.FileInfo, scanChan chan<- string) error {
 	if isConflict(name) {
 		l.Infoln("Conflict for", name, "which is already a conflict copy; not copying again.")
 		if err := f.mtimefs.Remove(name); err != nil && !fs.IsNotExist(err) {
 			return errors.Wrap(err, contextRemovingOldItem)
 		}
+		if err := f.fset.RemoveConflict(name); err != nil {
+			l.Debugln(f, "forgetting conflict", err)
+		}
 		return nil
 	}
 
@@ -1834,9 +2148,30 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		return nil
 	}
 
-	newName := conflictName(name, lastModBy)
+	base := name
+	if f.ConflictStrategy == config.ConflictStrategyDirectory {
+		base = filepath.Join(conflictsDir, name)
+		if err := f.mtimefs.MkdirAll(filepath.Dir(base), 0o755); err != nil {
+			return errors.Wrap(err, "creating conflicts directory")
+		}
+	}
+	newName := conflictName(base, file.ModifiedBy.String())
 	err := f.mtimefs.Rename(name, newName)
-	if fs.IsNotExist(err) {
+	if err == nil {
+		cr := db.ConflictRecord{
+			Time:           time.Now().Truncate(time.Second),
+			Original:       cur.Name,
+			Winner:         file.ModifiedBy,
+			Loser:          cur.ModifiedBy,
+			WinnerModified: file.ModTime(),
+			LoserModified:  cur.ModTime(),
+			WinnerSize:     file.Size,
+			LoserSize:      cur.Size,
+		}
+		if rerr := f.fset.AddConflict(newName, cr); rerr != nil {
+			l.Debugln(f, "recording conflict", rerr)
+		}
+	} else if fs.IsNotExist(err) {
 		// We were supposed to move a file away but it does not exist. Either
 		// the user has already moved it away, or the conflict was between a
 		// remote modification and a local delete. In either way it does not
@@ -1844,12 +2179,14 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 		err = nil
 	}
 	if f.MaxConflicts > -1 {
-		matches := existingConflicts(name, f.mtimefs)
+		matches := existingConflicts(base, f.mtimefs)
 		if len(matches) > f.MaxConflicts {
 			sort.Sort(sort.Reverse(sort.StringSlice(matches)))
 			for _, match := range matches[f.MaxConflicts:] {
 				if gerr := f.mtimefs.Remove(match); gerr != nil {
 					l.Debugln(f, "removing extra conflict", gerr)
+				} else if gerr := f.fset.RemoveConflict(match); gerr != nil {
+					l.Debugln(f, "forgetting extra conflict", gerr)
 				}
 			}
 		}
@@ -1897,6 +2234,9 @@ func (f *sendReceiveFolder) deleteItemOnDisk(item protocol.FileInfo, snap *db.Sn
 		// to potential children.
 		return f.deleteDirOnDisk(item.Name, snap, scanChan)
 
+	case item.IsPlaceholder():
+		return f.removePlaceholder(item.Name)
+
 	case !item.IsSymlink() && f.versioner != nil:
 		// If we should use versioning, let the versioner archive the
 		// file before we replace it. Archiving a non-existent file is not
@@ -1948,7 +2288,7 @@ func (f *sendReceiveFolder) deleteDirOnDiskHandleChildren(dir string, snap *db.S
 		if err != nil {
 			return err
 		}
-		switch match := f.ignores.Match(path); {
+		switch match := f.ignores.MatchFile(path, ignore.InfoAttributes(info)); {
 		case match.IsDeletable():
 			if info.IsDir() {
 				dirsToDelete = append(dirsToDelete, path)
@@ -2050,6 +2390,12 @@ func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, ite
 		return errModified
 	}
 
+	if item.IsPlaceholder() && stat.IsRegular() && stat.Size() == 0 {
+		// Still an empty placeholder, its size notwithstanding. Only
+		// contents make it a change, as with scanning.
+		return nil
+	}
+
 	// Check that the item on disk is what we expect it to be according
 	// to the database. If there's a mismatch here, there might be local
 	// changes that we don't know about yet and we should scan before
@@ -2169,6 +2515,52 @@ func (l fileErrorList) Swap(a, b int) {
 	l[a], l[b] = l[b], l[a]
 }
 
+// conflictsDir is where the directory conflict strategy puts conflict
+// copies, in the same relative location as the original file.
+const conflictsDir = ".stconflicts"
+
+type conflictResolution int
+
+const (
+	conflictKeepBoth   conflictResolution = iota // the local version becomes a conflict copy
+	conflictRemoteWins                           // the local version is replaced
+	conflictLocalWins                            // the remote version is superseded
+	conflictMerged                               // both versions are merged into a new one
+)
+
+// Outcomes of attempts to merge conflicting changes, as reported in
+// ConflictMergeAttempted events.
+const (
+	mergeOutcomeMerged      = "merged"
+	mergeOutcomeOverlapping = "overlapping"
+	mergeOutcomeNoBase      = "noBase"
+	mergeOutcomeNotText     = "notText"
+	mergeOutcomeFailed      = "failed"
+)
+
+func (r conflictResolution) String() string {
+	switch r {
+	case conflictKeepBoth:
+		return "keepBoth"
+	case conflictRemoteWins:
+		return "remoteWins"
+	case conflictLocalWins:
+		return "localWins"
+	case conflictMerged:
+		return "merged"
+	default:
+		return "unknown"
+	}
+}
+
+func versionStrings(v protocol.Vector) []string {
+	res := make([]string, len(v.Counters))
+	for i, c := range v.Counters {
+		res[i] = fmt.Sprintf("%v:%d", c.ID, c.Value)
+	}
+	return res
+}
+
 func conflictName(name, lastModBy string) string {
 	ext := filepath.Ext(name)
 	return name[:len(name)-len(ext)] + time.Now().Format(".sync-conflict-20060102-150405-") + lastModBy + ext
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
@@ -28,6 +30,7 @@ import (
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/versioner"
 )
 
 var blocks = []protocol.BlockInfo{
@@ -966,6 +969,213 @@ func TestSRConflictReplaceFileByLink(t *testing.T) {
 	}
 }
 
+// TestSRConflictStrategies checks that conflicts are resolved according to
+// the conflict strategy of the folder.
+func TestSRConflictStrategies(t *testing.T) {
+	cases := []struct {
+		strategy  config.ConflictStrategy
+		winner    protocol.DeviceID
+		remoteAge time.Duration // relative to the local file
+		expected  conflictResolution
+	}{
+		{config.ConflictStrategyCopy, protocol.EmptyDeviceID, time.Hour, conflictKeepBoth},
+		{config.ConflictStrategyDirectory, protocol.EmptyDeviceID, time.Hour, conflictKeepBoth},
+		{config.ConflictStrategyNewestWins, protocol.EmptyDeviceID, -time.Hour, conflictRemoteWins},
+		{config.ConflictStrategyNewestWins, protocol.EmptyDeviceID, time.Hour, conflictLocalWins},
+		{config.ConflictStrategyDeviceWins, device1, time.Hour, conflictRemoteWins},
+		{config.ConflictStrategyDeviceWins, myID, -time.Hour, conflictLocalWins},
+		{config.ConflictStrategyDeviceWins, device2, -time.Hour, conflictKeepBoth},
+		{config.ConflictStrategyDeviceWins, protocol.EmptyDeviceID, -time.Hour, conflictKeepBoth},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%v-%v", tc.strategy, tc.expected), func(t *testing.T) {
+			m, f, wcfgCancel := setupSendReceiveFolder(t)
+			defer cleanupSRFolder(f, m, wcfgCancel)
+			ffs := f.Filesystem(nil)
+			f.ConflictStrategy = tc.strategy
+			f.ConflictWinner = tc.winner
+
+			sub := m.evLogger.Subscribe(events.ConflictDetected)
+			defer sub.Unsubscribe()
+
+			name := "foo"
+
+			// create local file
+			cur := createEmptyFileInfo(t, name, ffs)
+			cur.Version = protocol.Vector{}.Update(myID.Short())
+			cur.ModifiedBy = myID.Short()
+			f.updateLocalsFromScanning([]protocol.FileInfo{cur})
+
+			// Simulate remote creating a dir with the same name
+			file := cur
+			file.Type = protocol.FileInfoTypeDirectory
+			file.ModifiedS = cur.ModTime().Add(-tc.remoteAge).Unix()
+			rem := device1.Short()
+			file.Version = protocol.Vector{}.Update(rem)
+			file.ModifiedBy = rem
+
+			if res := f.resolveConflict(cur, file); res != tc.expected {
+				t.Fatalf("Expected %v, got %v", tc.expected, res)
+			}
+
+			dbUpdateChan := make(chan dbUpdateJob, 1)
+			scanChan := make(chan string, 1)
+			snap := fsetSnapshot(t, f.fset)
+
+			if localWins := f.localWinsConflict(file, snap); localWins != (tc.expected == conflictLocalWins) {
+				t.Fatal("Unexpected local win", localWins)
+			} else if localWins {
+				f.keepLocalVersion(file, snap, dbUpdateChan)
+				job := <-dbUpdateChan
+				if job.file.Type != protocol.FileInfoTypeFile || !job.file.Version.GreaterEqual(file.Version) {
+					t.Error("Expected local file to supersede the remote one, got", job.file)
+				}
+			} else {
+				f.handleDir(file, snap, dbUpdateChan, scanChan)
+			}
+
+			base := name
+			if tc.strategy == config.ConflictStrategyDirectory {
+				base = filepath.Join(conflictsDir, name)
+			}
+			confls := existingConflicts(base, ffs)
+			switch tc.expected {
+			case conflictKeepBoth:
+				if len(confls) != 1 {
+					t.Fatal("Expected one conflict, got", len(confls))
+				} else if scan := <-scanChan; confls[0] != scan {
+					t.Fatal("Expected request to scan", confls[0], "got", scan)
+				}
+			case conflictRemoteWins:
+				if len(confls) != 0 {
+					t.Fatal("Expected no conflicts, got", confls)
+				}
+				if info, err := ffs.Lstat(name); err != nil || !info.IsDir() {
+					t.Fatal("Expected directory to replace file", err)
+				}
+			case conflictLocalWins:
+				if info, err := ffs.Lstat(name); err != nil || !info.IsRegular() {
+					t.Fatal("Expected file to be kept", err)
+				}
+			}
+
+			select {
+			case ev := <-sub.C():
+				data := ev.Data.(map[string]interface{})
+				if data["item"] != name || data["resolution"] != tc.expected.String() || data["remoteModifiedBy"] != rem.String() {
+					t.Error("Unexpected event data", data)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for conflict event")
+			}
+		})
+	}
+}
+
+func TestSRConflictMerge(t *testing.T) {
+	cases := []struct {
+		name     string
+		remote   string
+		base     string // "retained", "archived" or "none"
+		outcome  string
+		contents string
+	}{
+		{"merged", "one\ntwo\n3\n", "retained", mergeOutcomeMerged, "1\ntwo\n3\n"},
+		{"archived", "one\ntwo\n3\n", "archived", mergeOutcomeMerged, "1\ntwo\n3\n"},
+		{"overlapping", "ONE\ntwo\nthree\n", "retained", mergeOutcomeOverlapping, "ONE\ntwo\nthree\n"},
+		{"noBase", "one\ntwo\n3\n", "none", mergeOutcomeNoBase, "one\ntwo\n3\n"},
+		{"notText", "one\ntwo\n\x00\n", "retained", mergeOutcomeNotText, "one\ntwo\n\x00\n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, f, wcfgCancel := setupSendReceiveFolder(t)
+			defer cleanupSRFolder(f, m, wcfgCancel)
+			ffs := f.Filesystem(nil)
+			f.MergeConflicts = true
+
+			sub := m.evLogger.Subscribe(events.ConflictMergeAttempted)
+			defer sub.Unsubscribe()
+
+			name := "notes.txt"
+			get := func() protocol.FileInfo {
+				t.Helper()
+				snap := fsetSnapshot(t, f.fset)
+				defer snap.Release()
+				file, ok := snap.Get(protocol.LocalDeviceID, name)
+				if !ok {
+					t.Fatal("file is missing")
+				}
+				return file
+			}
+
+			// The common version, then changed locally
+			writeFile(t, ffs, name, []byte("one\ntwo\nthree\n"))
+			must(t, f.scanSubdirs(nil))
+			base := get()
+			switch tc.base {
+			case "archived":
+				f.Versioning = config.VersioningConfiguration{Type: "simple", Params: map[string]string{"keep": "5"}}
+				vers, err := versioner.New(f.FolderConfiguration)
+				must(t, err)
+				f.versioner = vers
+				must(t, vers.Archive(name))
+				must(t, f.fset.RemoveMergeBases(name))
+				must(t, f.fset.AddMergeBase(name, db.MergeBase{Version: base.Version, Modified: base.ModTime(), Size: base.Size}))
+			case "none":
+				must(t, f.fset.RemoveMergeBases(name))
+			}
+			writeFile(t, ffs, name, []byte("1\ntwo\nthree\n"))
+			must(t, f.scanSubdirs(nil))
+			cur := get()
+
+			// The conflicting remote change, already pulled
+			file := base
+			file.Version = base.Version.Update(device1.Short())
+			file.ModifiedBy = device1.Short()
+			file.Size = int64(len(tc.remote))
+			temp := fs.TempName(name)
+			writeFile(t, ffs, temp, []byte(tc.remote))
+
+			dbUpdateChan := make(chan dbUpdateJob, 1)
+			scanChan := make(chan string, 1)
+			snap := fsetSnapshot(t, f.fset)
+			defer snap.Release()
+			must(t, f.performFinish(file, cur, true, temp, snap, dbUpdateChan, scanChan))
+
+			job := <-dbUpdateChan
+			if data, err := os.ReadFile(filepath.Join(f.Path, name)); err != nil || string(data) != tc.contents {
+				t.Errorf("Expected contents %q, got %q, %v", tc.contents, data, err)
+			}
+			confls := existingConflicts(name, ffs)
+			if tc.outcome == mergeOutcomeMerged {
+				if len(confls) != 0 {
+					t.Error("Expected no conflicts, got", confls)
+				}
+				if !job.file.Version.GreaterEqual(cur.Version) || !job.file.Version.GreaterEqual(file.Version) || job.file.Version.Equal(cur.Version.Merge(file.Version)) {
+					t.Error("Expected a new version superseding both, got", job.file.Version)
+				}
+				if job.file.Size != int64(len(tc.contents)) || job.file.ModifiedBy != myID.Short() {
+					t.Error("Unexpected merged file", job.file)
+				}
+			} else if len(confls) != 1 {
+				t.Error("Expected one conflict, got", confls)
+			}
+
+			select {
+			case ev := <-sub.C():
+				data := ev.Data.(map[string]interface{})
+				if data["item"] != name || data["outcome"] != tc.outcome {
+					t.Error("Unexpected event data", data)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for merge event")
+			}
+		})
+	}
+}
+
 // TestDeleteBehindSymlink checks that we don't delete or schedule a scan
 // when trying to delete a file behind a symlink.
 func TestDeleteBehindSymlink(t *testing.T) {
@@ -1401,3 +1611,51 @@ func startCopier(f *sendReceiveFolder, pullChan chan<- pullBlockState, finisherC
 	}()
 	return copyChan, wg
 }
+
+func TestPullOutsideSchedule(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	// The initial scan has to have finished for pulls to happen
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	file := protocol.FileInfo{
+		Name:    "file",
+		Version: protocol.Vector{}.Update(device1.Short()),
+		Blocks:  []protocol.BlockInfo{{Size: 1, Hash: []byte("bogus")}},
+		Size:    1,
+	}
+	f.fset.Update(device1, []protocol.FileInfo{file})
+
+	// A window that opens in a couple of hours from now
+	now := time.Now()
+	f.Schedule = config.Schedule{Windows: []config.ScheduleWindow{{
+		Start: now.Add(2 * time.Hour).Format("15:04"),
+		End:   now.Add(3 * time.Hour).Format("15:04"),
+	}}}
+
+	if ok, err := f.folder.pull(); !ok || err != nil {
+		t.Fatal("Unexpected pull failure:", ok, err)
+	}
+	if state, _, _ := f.getState(); state != FolderSyncScheduled {
+		t.Fatalf("Expected state %v, got %v", FolderSyncScheduled, state)
+	}
+
+	// Being idle otherwise is reported as waiting for the window
+	f.setState(FolderScanning)
+	f.setState(FolderIdle)
+	if state, _, _ := f.getState(); state != FolderSyncScheduled {
+		t.Fatalf("Expected state %v after scanning, got %v", FolderSyncScheduled, state)
+	}
+
+	// Without the schedule the pull goes ahead
+	f.Schedule = config.Schedule{}
+	f.folder.pull()
+	if state, _, _ := f.getState(); state == FolderSyncScheduled {
+		t.Fatal("Expected folder to not wait for the sync window anymore")
+	}
+}
@@ -25,6 +25,7 @@ const (
 	FolderCleaning
 	FolderCleanWaiting
 	FolderError
+	FolderSyncScheduled
 )
 
 func (s folderState) String() string {
@@ -47,6 +48,8 @@ func (s folderState) String() string {
 		return "clean-waiting"
 	case FolderError:
 		return "error"
+	case FolderSyncScheduled:
+		return "sync-scheduled"
 	default:
 		return "unknown"
 	}
@@ -88,6 +91,10 @@ type stateTracker struct {
 	current folderState
 	err     error
 	changed time.Time
+	// idle is the state reported in place of FolderIdle. It differs from
+	// FolderIdle when there is nothing to do for reasons outside of the
+	// folder's control, i.e. when syncing is held back by the schedule.
+	idle folderState
 }
 
 func newStateTracker(id string, evLogger events.Logger) stateTracker {
@@ -107,6 +114,30 @@ func (s *stateTracker) setState(newState folderState) {
 	s.mut.Lock()
 	defer s.mut.Unlock()
 
+	if newState == FolderIdle {
+		newState = s.idle
+	}
+	s.setStateLocked(newState)
+}
+
+// setIdleState sets the state to report in place of FolderIdle, and
+// updates the current state if the folder is idle.
+func (s *stateTracker) setIdleState(idle folderState) {
+	if idle != FolderIdle && idle != FolderSyncScheduled {
+		panic("bug: invalid idle state " + idle.String())
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	prev := s.idle
+	s.idle = idle
+	if s.current == prev {
+		s.setStateLocked(idle)
+	}
+}
+
+func (s *stateTracker) setStateLocked(newState folderState) {
 	if newState == s.current {
 		return
 	}
@@ -157,7 +188,7 @@ func (s *stateTracker) setError(err error) {
 		eventData["error"] = err.Error()
 		s.current = FolderError
 	} else {
-		s.current = FolderIdle
+		s.current = s.idle
 	}
 
 	eventData["to"] = s.current.String()
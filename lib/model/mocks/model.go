@@ -3,12 +3,14 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore"
 	"github.com/syncthing/syncthing/lib/model"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/stats"
@@ -76,6 +78,19 @@ type Model struct {
 		result1 model.FolderCompletion
 		result2 error
 	}
+	ConflictsStub        func(string) ([]model.ConflictCopy, error)
+	conflictsMutex       sync.RWMutex
+	conflictsArgsForCall []struct {
+		arg1 string
+	}
+	conflictsReturns struct {
+		result1 []model.ConflictCopy
+		result2 error
+	}
+	conflictsReturnsOnCall map[int]struct {
+		result1 []model.ConflictCopy
+		result2 error
+	}
 	ConnectionStub        func(protocol.DeviceID) (protocol.Connection, bool)
 	connectionMutex       sync.RWMutex
 	connectionArgsForCall []struct {
@@ -213,6 +228,32 @@ type Model struct {
 	downloadProgressReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExplainIgnoreStub        func(string, string) (ignore.Explanation, error)
+	explainIgnoreMutex       sync.RWMutex
+	explainIgnoreArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	explainIgnoreReturns struct {
+		result1 ignore.Explanation
+		result2 error
+	}
+	explainIgnoreReturnsOnCall map[int]struct {
+		result1 ignore.Explanation
+		result2 error
+	}
+	FetchPlaceholdersStub        func(string, []string) error
+	fetchPlaceholdersMutex       sync.RWMutex
+	fetchPlaceholdersArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	fetchPlaceholdersReturns struct {
+		result1 error
+	}
+	fetchPlaceholdersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FolderErrorsStub        func(string) ([]model.FileError, error)
 	folderErrorsMutex       sync.RWMutex
 	folderErrorsArgsForCall []struct {
@@ -262,6 +303,19 @@ type Model struct {
 		result1 map[string][]versioner.FileVersion
 		result2 error
 	}
+	GetFolderVersionsUsageStub        func(string) (versioner.Usage, error)
+	getFolderVersionsUsageMutex       sync.RWMutex
+	getFolderVersionsUsageArgsForCall []struct {
+		arg1 string
+	}
+	getFolderVersionsUsageReturns struct {
+		result1 versioner.Usage
+		result2 error
+	}
+	getFolderVersionsUsageReturnsOnCall map[int]struct {
+		result1 versioner.Usage
+		result2 error
+	}
 	GetHelloStub        func(protocol.DeviceID) protocol.HelloIntf
 	getHelloMutex       sync.RWMutex
 	getHelloArgsForCall []struct {
@@ -401,6 +455,21 @@ type Model struct {
 	onHelloReturnsOnCall map[int]struct {
 		result1 error
 	}
+	OpenFolderVersionStub        func(string, string, time.Time) (io.ReadSeekCloser, error)
+	openFolderVersionMutex       sync.RWMutex
+	openFolderVersionArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}
+	openFolderVersionReturns struct {
+		result1 io.ReadSeekCloser
+		result2 error
+	}
+	openFolderVersionReturnsOnCall map[int]struct {
+		result1 io.ReadSeekCloser
+		result2 error
+	}
 	OverrideStub        func(string)
 	overrideMutex       sync.RWMutex
 	overrideArgsForCall []struct {
@@ -479,6 +548,19 @@ type Model struct {
 	resetFolderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResolveConflictStub        func(string, string, string) error
+	resolveConflictMutex       sync.RWMutex
+	resolveConflictArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	resolveConflictReturns struct {
+		result1 error
+	}
+	resolveConflictReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RestoreFolderVersionsStub        func(string, map[string]time.Time) (map[string]error, error)
 	restoreFolderVersionsMutex       sync.RWMutex
 	restoreFolderVersionsArgsForCall []struct {
@@ -574,6 +656,22 @@ type Model struct {
 		result2 time.Time
 		result3 error
 	}
+	TestIgnoresStub        func(string, []string, int, int) (model.IgnoreTestResult, error)
+	testIgnoresMutex       sync.RWMutex
+	testIgnoresArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 int
+		arg4 int
+	}
+	testIgnoresReturns struct {
+		result1 model.IgnoreTestResult
+		result2 error
+	}
+	testIgnoresReturnsOnCall map[int]struct {
+		result1 model.IgnoreTestResult
+		result2 error
+	}
 	UsageReportingStatsStub        func(*contract.Report, int, bool)
 	usageReportingStatsMutex       sync.RWMutex
 	usageReportingStatsArgsForCall []struct {
@@ -888,6 +986,70 @@ func (fake *Model) CompletionReturnsOnCall(i int, result1 model.FolderCompletion
 	}{result1, result2}
 }
 
+func (fake *Model) Conflicts(arg1 string) ([]model.ConflictCopy, error) {
+	fake.conflictsMutex.Lock()
+	ret, specificReturn := fake.conflictsReturnsOnCall[len(fake.conflictsArgsForCall)]
+	fake.conflictsArgsForCall = append(fake.conflictsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ConflictsStub
+	fakeReturns := fake.conflictsReturns
+	fake.recordInvocation("Conflicts", []interface{}{arg1})
+	fake.conflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ConflictsCallCount() int {
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
+	return len(fake.conflictsArgsForCall)
+}
+
+func (fake *Model) ConflictsCalls(stub func(string) ([]model.ConflictCopy, error)) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = stub
+}
+
+func (fake *Model) ConflictsArgsForCall(i int) string {
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
+	argsForCall := fake.conflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ConflictsReturns(result1 []model.ConflictCopy, result2 error) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = nil
+	fake.conflictsReturns = struct {
+		result1 []model.ConflictCopy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ConflictsReturnsOnCall(i int, result1 []model.ConflictCopy, result2 error) {
+	fake.conflictsMutex.Lock()
+	defer fake.conflictsMutex.Unlock()
+	fake.ConflictsStub = nil
+	if fake.conflictsReturnsOnCall == nil {
+		fake.conflictsReturnsOnCall = make(map[int]struct {
+			result1 []model.ConflictCopy
+			result2 error
+		})
+	}
+	fake.conflictsReturnsOnCall[i] = struct {
+		result1 []model.ConflictCopy
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Connection(arg1 protocol.DeviceID) (protocol.Connection, bool) {
 	fake.connectionMutex.Lock()
 	ret, specificReturn := fake.connectionReturnsOnCall[len(fake.connectionArgsForCall)]
@@ -1552,6 +1714,138 @@ func (fake *Model) DownloadProgressReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ExplainIgnore(arg1 string, arg2 string) (ignore.Explanation, error) {
+	fake.explainIgnoreMutex.Lock()
+	ret, specificReturn := fake.explainIgnoreReturnsOnCall[len(fake.explainIgnoreArgsForCall)]
+	fake.explainIgnoreArgsForCall = append(fake.explainIgnoreArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExplainIgnoreStub
+	fakeReturns := fake.explainIgnoreReturns
+	fake.recordInvocation("ExplainIgnore", []interface{}{arg1, arg2})
+	fake.explainIgnoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) ExplainIgnoreCallCount() int {
+	fake.explainIgnoreMutex.RLock()
+	defer fake.explainIgnoreMutex.RUnlock()
+	return len(fake.explainIgnoreArgsForCall)
+}
+
+func (fake *Model) ExplainIgnoreCalls(stub func(string, string) (ignore.Explanation, error)) {
+	fake.explainIgnoreMutex.Lock()
+	defer fake.explainIgnoreMutex.Unlock()
+	fake.ExplainIgnoreStub = stub
+}
+
+func (fake *Model) ExplainIgnoreArgsForCall(i int) (string, string) {
+	fake.explainIgnoreMutex.RLock()
+	defer fake.explainIgnoreMutex.RUnlock()
+	argsForCall := fake.explainIgnoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) ExplainIgnoreReturns(result1 ignore.Explanation, result2 error) {
+	fake.explainIgnoreMutex.Lock()
+	defer fake.explainIgnoreMutex.Unlock()
+	fake.ExplainIgnoreStub = nil
+	fake.explainIgnoreReturns = struct {
+		result1 ignore.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) ExplainIgnoreReturnsOnCall(i int, result1 ignore.Explanation, result2 error) {
+	fake.explainIgnoreMutex.Lock()
+	defer fake.explainIgnoreMutex.Unlock()
+	fake.ExplainIgnoreStub = nil
+	if fake.explainIgnoreReturnsOnCall == nil {
+		fake.explainIgnoreReturnsOnCall = make(map[int]struct {
+			result1 ignore.Explanation
+			result2 error
+		})
+	}
+	fake.explainIgnoreReturnsOnCall[i] = struct {
+		result1 ignore.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FetchPlaceholders(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.fetchPlaceholdersMutex.Lock()
+	ret, specificReturn := fake.fetchPlaceholdersReturnsOnCall[len(fake.fetchPlaceholdersArgsForCall)]
+	fake.fetchPlaceholdersArgsForCall = append(fake.fetchPlaceholdersArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.FetchPlaceholdersStub
+	fakeReturns := fake.fetchPlaceholdersReturns
+	fake.recordInvocation("FetchPlaceholders", []interface{}{arg1, arg2Copy})
+	fake.fetchPlaceholdersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) FetchPlaceholdersCallCount() int {
+	fake.fetchPlaceholdersMutex.RLock()
+	defer fake.fetchPlaceholdersMutex.RUnlock()
+	return len(fake.fetchPlaceholdersArgsForCall)
+}
+
+func (fake *Model) FetchPlaceholdersCalls(stub func(string, []string) error) {
+	fake.fetchPlaceholdersMutex.Lock()
+	defer fake.fetchPlaceholdersMutex.Unlock()
+	fake.FetchPlaceholdersStub = stub
+}
+
+func (fake *Model) FetchPlaceholdersArgsForCall(i int) (string, []string) {
+	fake.fetchPlaceholdersMutex.RLock()
+	defer fake.fetchPlaceholdersMutex.RUnlock()
+	argsForCall := fake.fetchPlaceholdersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Model) FetchPlaceholdersReturns(result1 error) {
+	fake.fetchPlaceholdersMutex.Lock()
+	defer fake.fetchPlaceholdersMutex.Unlock()
+	fake.FetchPlaceholdersStub = nil
+	fake.fetchPlaceholdersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) FetchPlaceholdersReturnsOnCall(i int, result1 error) {
+	fake.fetchPlaceholdersMutex.Lock()
+	defer fake.fetchPlaceholdersMutex.Unlock()
+	fake.FetchPlaceholdersStub = nil
+	if fake.fetchPlaceholdersReturnsOnCall == nil {
+		fake.fetchPlaceholdersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.fetchPlaceholdersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
 	fake.folderErrorsMutex.Lock()
 	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
@@ -1797,6 +2091,70 @@ func (fake *Model) GetFolderVersionsReturnsOnCall(i int, result1 map[string][]ve
 	}{result1, result2}
 }
 
+func (fake *Model) GetFolderVersionsUsage(arg1 string) (versioner.Usage, error) {
+	fake.getFolderVersionsUsageMutex.Lock()
+	ret, specificReturn := fake.getFolderVersionsUsageReturnsOnCall[len(fake.getFolderVersionsUsageArgsForCall)]
+	fake.getFolderVersionsUsageArgsForCall = append(fake.getFolderVersionsUsageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetFolderVersionsUsageStub
+	fakeReturns := fake.getFolderVersionsUsageReturns
+	fake.recordInvocation("GetFolderVersionsUsage", []interface{}{arg1})
+	fake.getFolderVersionsUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) GetFolderVersionsUsageCallCount() int {
+	fake.getFolderVersionsUsageMutex.RLock()
+	defer fake.getFolderVersionsUsageMutex.RUnlock()
+	return len(fake.getFolderVersionsUsageArgsForCall)
+}
+
+func (fake *Model) GetFolderVersionsUsageCalls(stub func(string) (versioner.Usage, error)) {
+	fake.getFolderVersionsUsageMutex.Lock()
+	defer fake.getFolderVersionsUsageMutex.Unlock()
+	fake.GetFolderVersionsUsageStub = stub
+}
+
+func (fake *Model) GetFolderVersionsUsageArgsForCall(i int) string {
+	fake.getFolderVersionsUsageMutex.RLock()
+	defer fake.getFolderVersionsUsageMutex.RUnlock()
+	argsForCall := fake.getFolderVersionsUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) GetFolderVersionsUsageReturns(result1 versioner.Usage, result2 error) {
+	fake.getFolderVersionsUsageMutex.Lock()
+	defer fake.getFolderVersionsUsageMutex.Unlock()
+	fake.GetFolderVersionsUsageStub = nil
+	fake.getFolderVersionsUsageReturns = struct {
+		result1 versioner.Usage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) GetFolderVersionsUsageReturnsOnCall(i int, result1 versioner.Usage, result2 error) {
+	fake.getFolderVersionsUsageMutex.Lock()
+	defer fake.getFolderVersionsUsageMutex.Unlock()
+	fake.GetFolderVersionsUsageStub = nil
+	if fake.getFolderVersionsUsageReturnsOnCall == nil {
+		fake.getFolderVersionsUsageReturnsOnCall = make(map[int]struct {
+			result1 versioner.Usage
+			result2 error
+		})
+	}
+	fake.getFolderVersionsUsageReturnsOnCall[i] = struct {
+		result1 versioner.Usage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) GetHello(arg1 protocol.DeviceID) protocol.HelloIntf {
 	fake.getHelloMutex.Lock()
 	ret, specificReturn := fake.getHelloReturnsOnCall[len(fake.getHelloArgsForCall)]
@@ -2447,6 +2805,72 @@ func (fake *Model) OnHelloReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) OpenFolderVersion(arg1 string, arg2 string, arg3 time.Time) (io.ReadSeekCloser, error) {
+	fake.openFolderVersionMutex.Lock()
+	ret, specificReturn := fake.openFolderVersionReturnsOnCall[len(fake.openFolderVersionArgsForCall)]
+	fake.openFolderVersionArgsForCall = append(fake.openFolderVersionArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.OpenFolderVersionStub
+	fakeReturns := fake.openFolderVersionReturns
+	fake.recordInvocation("OpenFolderVersion", []interface{}{arg1, arg2, arg3})
+	fake.openFolderVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) OpenFolderVersionCallCount() int {
+	fake.openFolderVersionMutex.RLock()
+	defer fake.openFolderVersionMutex.RUnlock()
+	return len(fake.openFolderVersionArgsForCall)
+}
+
+func (fake *Model) OpenFolderVersionCalls(stub func(string, string, time.Time) (io.ReadSeekCloser, error)) {
+	fake.openFolderVersionMutex.Lock()
+	defer fake.openFolderVersionMutex.Unlock()
+	fake.OpenFolderVersionStub = stub
+}
+
+func (fake *Model) OpenFolderVersionArgsForCall(i int) (string, string, time.Time) {
+	fake.openFolderVersionMutex.RLock()
+	defer fake.openFolderVersionMutex.RUnlock()
+	argsForCall := fake.openFolderVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) OpenFolderVersionReturns(result1 io.ReadSeekCloser, result2 error) {
+	fake.openFolderVersionMutex.Lock()
+	defer fake.openFolderVersionMutex.Unlock()
+	fake.OpenFolderVersionStub = nil
+	fake.openFolderVersionReturns = struct {
+		result1 io.ReadSeekCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) OpenFolderVersionReturnsOnCall(i int, result1 io.ReadSeekCloser, result2 error) {
+	fake.openFolderVersionMutex.Lock()
+	defer fake.openFolderVersionMutex.Unlock()
+	fake.OpenFolderVersionStub = nil
+	if fake.openFolderVersionReturnsOnCall == nil {
+		fake.openFolderVersionReturnsOnCall = make(map[int]struct {
+			result1 io.ReadSeekCloser
+			result2 error
+		})
+	}
+	fake.openFolderVersionReturnsOnCall[i] = struct {
+		result1 io.ReadSeekCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) Override(arg1 string) {
 	fake.overrideMutex.Lock()
 	fake.overrideArgsForCall = append(fake.overrideArgsForCall, struct {
@@ -2804,6 +3228,69 @@ func (fake *Model) ResetFolderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ResolveConflict(arg1 string, arg2 string, arg3 string) error {
+	fake.resolveConflictMutex.Lock()
+	ret, specificReturn := fake.resolveConflictReturnsOnCall[len(fake.resolveConflictArgsForCall)]
+	fake.resolveConflictArgsForCall = append(fake.resolveConflictArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolveConflictStub
+	fakeReturns := fake.resolveConflictReturns
+	fake.recordInvocation("ResolveConflict", []interface{}{arg1, arg2, arg3})
+	fake.resolveConflictMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ResolveConflictCallCount() int {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	return len(fake.resolveConflictArgsForCall)
+}
+
+func (fake *Model) ResolveConflictCalls(stub func(string, string, string) error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = stub
+}
+
+func (fake *Model) ResolveConflictArgsForCall(i int) (string, string, string) {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	argsForCall := fake.resolveConflictArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ResolveConflictReturns(result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	fake.resolveConflictReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResolveConflictReturnsOnCall(i int, result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	if fake.resolveConflictReturnsOnCall == nil {
+		fake.resolveConflictReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolveConflictReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) RestoreFolderVersions(arg1 string, arg2 map[string]time.Time) (map[string]error, error) {
 	fake.restoreFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.restoreFolderVersionsReturnsOnCall[len(fake.restoreFolderVersionsArgsForCall)]
@@ -3309,6 +3796,78 @@ func (fake *Model) StateReturnsOnCall(i int, result1 string, result2 time.Time,
 	}{result1, result2, result3}
 }
 
+func (fake *Model) TestIgnores(arg1 string, arg2 []string, arg3 int, arg4 int) (model.IgnoreTestResult, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.testIgnoresMutex.Lock()
+	ret, specificReturn := fake.testIgnoresReturnsOnCall[len(fake.testIgnoresArgsForCall)]
+	fake.testIgnoresArgsForCall = append(fake.testIgnoresArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 int
+		arg4 int
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.TestIgnoresStub
+	fakeReturns := fake.testIgnoresReturns
+	fake.recordInvocation("TestIgnores", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.testIgnoresMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) TestIgnoresCallCount() int {
+	fake.testIgnoresMutex.RLock()
+	defer fake.testIgnoresMutex.RUnlock()
+	return len(fake.testIgnoresArgsForCall)
+}
+
+func (fake *Model) TestIgnoresCalls(stub func(string, []string, int, int) (model.IgnoreTestResult, error)) {
+	fake.testIgnoresMutex.Lock()
+	defer fake.testIgnoresMutex.Unlock()
+	fake.TestIgnoresStub = stub
+}
+
+func (fake *Model) TestIgnoresArgsForCall(i int) (string, []string, int, int) {
+	fake.testIgnoresMutex.RLock()
+	defer fake.testIgnoresMutex.RUnlock()
+	argsForCall := fake.testIgnoresArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Model) TestIgnoresReturns(result1 model.IgnoreTestResult, result2 error) {
+	fake.testIgnoresMutex.Lock()
+	defer fake.testIgnoresMutex.Unlock()
+	fake.TestIgnoresStub = nil
+	fake.testIgnoresReturns = struct {
+		result1 model.IgnoreTestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) TestIgnoresReturnsOnCall(i int, result1 model.IgnoreTestResult, result2 error) {
+	fake.testIgnoresMutex.Lock()
+	defer fake.testIgnoresMutex.Unlock()
+	fake.TestIgnoresStub = nil
+	if fake.testIgnoresReturnsOnCall == nil {
+		fake.testIgnoresReturnsOnCall = make(map[int]struct {
+			result1 model.IgnoreTestResult
+			result2 error
+		})
+	}
+	fake.testIgnoresReturnsOnCall[i] = struct {
+		result1 model.IgnoreTestResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) UsageReportingStats(arg1 *contract.Report, arg2 int, arg3 bool) {
 	fake.usageReportingStatsMutex.Lock()
 	fake.usageReportingStatsArgsForCall = append(fake.usageReportingStatsArgsForCall, struct {
@@ -3419,6 +3978,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.clusterConfigMutex.RUnlock()
 	fake.completionMutex.RLock()
 	defer fake.completionMutex.RUnlock()
+	fake.conflictsMutex.RLock()
+	defer fake.conflictsMutex.RUnlock()
 	fake.connectionMutex.RLock()
 	defer fake.connectionMutex.RUnlock()
 	fake.connectionStatsMutex.RLock()
@@ -3441,6 +4002,10 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.dismissPendingFolderMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
 	defer fake.downloadProgressMutex.RUnlock()
+	fake.explainIgnoreMutex.RLock()
+	defer fake.explainIgnoreMutex.RUnlock()
+	fake.fetchPlaceholdersMutex.RLock()
+	defer fake.fetchPlaceholdersMutex.RUnlock()
 	fake.folderErrorsMutex.RLock()
 	defer fake.folderErrorsMutex.RUnlock()
 	fake.folderProgressBytesCompletedMutex.RLock()
@@ -3449,6 +4014,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.folderStatisticsMutex.RUnlock()
 	fake.getFolderVersionsMutex.RLock()
 	defer fake.getFolderVersionsMutex.RUnlock()
+	fake.getFolderVersionsUsageMutex.RLock()
+	defer fake.getFolderVersionsUsageMutex.RUnlock()
 	fake.getHelloMutex.RLock()
 	defer fake.getHelloMutex.RUnlock()
 	fake.getMtimeMappingMutex.RLock()
@@ -3469,6 +4036,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.numConnectionsMutex.RUnlock()
 	fake.onHelloMutex.RLock()
 	defer fake.onHelloMutex.RUnlock()
+	fake.openFolderVersionMutex.RLock()
+	defer fake.openFolderVersionMutex.RUnlock()
 	fake.overrideMutex.RLock()
 	defer fake.overrideMutex.RUnlock()
 	fake.pendingDevicesMutex.RLock()
@@ -3481,6 +4050,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.requestMutex.RUnlock()
 	fake.resetFolderMutex.RLock()
 	defer fake.resetFolderMutex.RUnlock()
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
 	fake.restoreFolderVersionsMutex.RLock()
 	defer fake.restoreFolderVersionsMutex.RUnlock()
 	fake.revertMutex.RLock()
@@ -3499,6 +4070,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.startDeadlockDetectorMutex.RUnlock()
 	fake.stateMutex.RLock()
 	defer fake.stateMutex.RUnlock()
+	fake.testIgnoresMutex.RLock()
+	defer fake.testIgnoresMutex.RUnlock()
 	fake.usageReportingStatsMutex.RLock()
 	defer fake.usageReportingStatsMutex.RUnlock()
 	fake.watchErrorMutex.RLock()
@@ -20,6 +20,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	stdsync "sync"
 	"time"
@@ -58,6 +59,8 @@ type service interface {
 	Errors() []FileError
 	WatchError() error
 	ScheduleForceRescan(path string)
+	FetchPlaceholders(names []string) error
+	ResolveConflict(conflict, keep string) error
 	GetStatistics() (stats.FolderStatistics, error)
 
 	getState() (folderState, time.Time, error)
@@ -84,13 +87,21 @@ type Model interface {
 	Override(folder string)
 	Revert(folder string)
 	BringToFront(folder, file string)
+	FetchPlaceholders(folder string, paths []string) error
 	LoadIgnores(folder string) ([]string, []string, error)
 	CurrentIgnores(folder string) ([]string, []string, error)
+	ExplainIgnore(folder, file string) (ignore.Explanation, error)
+	TestIgnores(folder string, content []string, page, perpage int) (IgnoreTestResult, error)
 	SetIgnores(folder string, content []string) error
 
 	GetFolderVersions(folder string) (map[string][]versioner.FileVersion, error)
+	GetFolderVersionsUsage(folder string) (versioner.Usage, error)
+	OpenFolderVersion(folder, file string, versionTime time.Time) (io.ReadSeekCloser, error)
 	RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error)
 
+	Conflicts(folder string) ([]ConflictCopy, error)
+	ResolveConflict(folder, conflict, keep string) error
+
 	DBSnapshot(folder string) (*db.Snapshot, error)
 	NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfoTruncated, []db.FileInfoTruncated, []db.FileInfoTruncated, error)
 	RemoteNeedFolderFiles(folder string, device protocol.DeviceID, page, perpage int) ([]db.FileInfoTruncated, error)
@@ -178,13 +189,15 @@ var (
 )
 
 var (
-	errDeviceUnknown    = errors.New("unknown device")
-	errDevicePaused     = errors.New("device is paused")
-	errDeviceRemoved    = errors.New("device has been removed")
-	ErrFolderPaused     = errors.New("folder is paused")
-	ErrFolderNotRunning = errors.New("folder is not running")
-	ErrFolderMissing    = errors.New("no such folder")
-	errNoVersioner      = errors.New("folder has no versioner")
+	errDeviceUnknown      = errors.New("unknown device")
+	errDevicePaused       = errors.New("device is paused")
+	errDeviceRemoved      = errors.New("device has been removed")
+	ErrFolderPaused       = errors.New("folder is paused")
+	ErrFolderNotRunning   = errors.New("folder is not running")
+	ErrFolderMissing      = errors.New("no such folder")
+	errNoVersioner        = errors.New("folder has no versioner")
+	ErrConflictMissing    = errors.New("no such conflict")
+	ErrPlaceholderMissing = errors.New("no such placeholder")
 	// errors about why a connection is closed
 	errReplacingConnection                = errors.New("replacing connection")
 	errStopped                            = errors.New("Syncthing is being stopped")
@@ -332,7 +345,7 @@ func (m *model) StartDeadlockDetector(timeout time.Duration) {
 
 // Need to hold lock on m.fmut when calling this.
 func (m *model) addAndStartFolderLocked(cfg config.FolderConfiguration, fset *db.FileSet, cacheIgnoredFiles bool) {
-	ignores := ignore.New(cfg.Filesystem(nil), ignore.WithCache(cacheIgnoredFiles))
+	ignores := ignore.New(cfg.Filesystem(nil), append(ignoreOptions(cfg), ignore.WithCache(cacheIgnoredFiles))...)
 	if cfg.Type != config.FolderTypeReceiveEncrypted {
 		if err := ignores.Load(".stignore"); err != nil && !fs.IsNotExist(err) {
 			l.Warnln("Loading ignores:", err)
@@ -342,6 +355,15 @@ func (m *model) addAndStartFolderLocked(cfg config.FolderConfiguration, fset *db
 	m.addAndStartFolderLockedWithIgnores(cfg, fset, ignores)
 }
 
+// ignoreOptions returns the options for the ignore matcher of the folder.
+func ignoreOptions(cfg config.FolderConfiguration) []ignore.Option {
+	opts := []ignore.Option{ignore.WithNestedIgnores(cfg.NestedIgnoreFiles), ignore.WithGitignore(cfg.GitignoreFiles)}
+	if cfg.SelectiveSync {
+		opts = append(opts, ignore.WithSelection(cfg.SelectedPaths))
+	}
+	return opts
+}
+
 // Only needed for testing, use addAndStartFolderLocked instead.
 func (m *model) addAndStartFolderLockedWithIgnores(cfg config.FolderConfiguration, fset *db.FileSet, ignores *ignore.Matcher) {
 	m.folderCfgs[cfg.ID] = cfg
@@ -486,6 +508,7 @@ func (m *model) removeFolder(cfg config.FolderConfiguration) {
 	}
 
 	m.cleanupFolderLocked(cfg)
+	unregisterFolderMetrics(cfg.ID)
 	for _, r := range m.indexHandlers {
 		r.Remove(cfg.ID)
 	}
@@ -2111,6 +2134,127 @@ func (m *model) CurrentIgnores(folder string) ([]string, []string, error) {
 	return ignores.Lines(), ignores.Patterns(), nil
 }
 
+// ExplainIgnore tells whether the file is ignored and by which rule,
+// according to the currently loaded ignore patterns.
+func (m *model) ExplainIgnore(folder, file string) (ignore.Explanation, error) {
+	m.fmut.RLock()
+	cfg, cfgOk := m.folderCfgs[folder]
+	ignores, ignoresOk := m.folderIgnores[folder]
+	m.fmut.RUnlock()
+
+	if !cfgOk {
+		return ignore.Explanation{}, fmt.Errorf("folder %s does not exist", folder)
+	}
+
+	if !ignoresOk {
+		return ignore.Explanation{}, nil
+	}
+
+	file = osutil.NativeFilename(file)
+	return ignores.Explain(file, ignore.LstatAttributes(cfg.Filesystem(nil), file)), nil
+}
+
+// An IgnoreChange is a file that would change between ignored and not
+// ignored with other ignore patterns, with the rules deciding about it
+// currently and with the proposed patterns.
+type IgnoreChange struct {
+	Name     string                `json:"name"`
+	Type     protocol.FileInfoType `json:"type"`
+	Current  ignore.Explanation    `json:"current"`
+	Proposed ignore.Explanation    `json:"proposed"`
+}
+
+// An IgnoreTestResult is one page of the files that would change, in the
+// order of the database, along with how many there are in total.
+type IgnoreTestResult struct {
+	Ignored        []IgnoreChange `json:"ignored"`
+	Unignored      []IgnoreChange `json:"unignored"`
+	IgnoredCount   int            `json:"ignoredCount"`
+	UnignoredCount int            `json:"unignoredCount"`
+}
+
+// TestIgnores returns the files that would become ignored or unignored if
+// the folder's .stignore had the given content, without changing anything.
+// Only files in the database are considered. The changes are paged
+// together, as they come.
+func (m *model) TestIgnores(folder string, content []string, page, perpage int) (IgnoreTestResult, error) {
+	m.fmut.RLock()
+	cfg, cfgOk := m.folderCfgs[folder]
+	files, filesOk := m.folderFiles[folder]
+	current, ignoresOk := m.folderIgnores[folder]
+	m.fmut.RUnlock()
+
+	result := IgnoreTestResult{
+		Ignored:   []IgnoreChange{},
+		Unignored: []IgnoreChange{},
+	}
+
+	if !cfgOk || !filesOk {
+		return result, ErrFolderMissing
+	}
+
+	if cfg.Type == config.FolderTypeReceiveEncrypted {
+		return result, nil
+	}
+
+	if !ignoresOk {
+		current = ignore.New(cfg.Filesystem(nil))
+	}
+	proposed := ignore.New(cfg.Filesystem(nil), ignoreOptions(cfg)...)
+	if err := proposed.Parse(strings.NewReader(strings.Join(content, "\n")), ".stignore"); err != nil {
+		return result, err
+	}
+
+	snap, err := files.Snapshot()
+	if err != nil {
+		return result, err
+	}
+	defer snap.Release()
+	p := newPager(page, perpage)
+	snap.WithGlobalTruncated(func(fi protocol.FileIntf) bool {
+		f := fi.(db.FileInfoTruncated)
+		if f.IsDeleted() || fs.IsInternal(f.Name) || fs.IsTemporary(f.Name) {
+			return true
+		}
+		if f.IsDirectory() {
+			// Directories come before their contents.
+			if err := proposed.LoadNested(f.Name); err != nil {
+				l.Debugf("Loading nested ignores in %v for testing: %v", f.Name, err)
+			}
+		}
+
+		attrs := fileAttributes(f)
+		cur, prop := current.Explain(f.Name, attrs), proposed.Explain(f.Name, attrs)
+		if cur.Ignored == prop.Ignored {
+			return true
+		}
+		if prop.Ignored {
+			result.IgnoredCount++
+		} else {
+			result.UnignoredCount++
+		}
+		// Keep going past the page, to count the rest.
+		if p.skip() || p.get == 0 {
+			return true
+		}
+		p.done()
+		change := IgnoreChange{
+			Name:     f.Name,
+			Type:     f.Type,
+			Current:  cur,
+			Proposed: prop,
+		}
+		if prop.Ignored {
+			result.Ignored = append(result.Ignored, change)
+		} else {
+			result.Unignored = append(result.Unignored, change)
+		}
+		return true
+	})
+
+	return result, nil
+}
+
 func (m *model) SetIgnores(folder string, content []string) error {
 	cfg, ok := m.cfg.Folder(folder)
 	if !ok {
@@ -2181,9 +2325,10 @@ func (m *model) GetHello(id protocol.DeviceID) protocol.HelloIntf {
 		}
 	}
 	return &protocol.Hello{
-		DeviceName:    name,
-		ClientName:    m.clientName,
-		ClientVersion: m.clientVersion,
+		DeviceName:            name,
+		ClientName:            m.clientName,
+		ClientVersion:         m.clientVersion,
+		CompressionAlgorithms: protocol.SupportedCompressionAlgorithms,
 	}
 }
 
@@ -2670,6 +2815,36 @@ func (m *model) GetFolderVersions(folder string) (map[string][]versioner.FileVer
 	return ver.GetVersions()
 }
 
+func (m *model) GetFolderVersionsUsage(folder string) (versioner.Usage, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	ver := m.folderVersioners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return versioner.Usage{}, err
+	}
+	if ver == nil {
+		return versioner.Usage{}, errNoVersioner
+	}
+
+	return versioner.ArchiveUsage(ver)
+}
+
+func (m *model) OpenFolderVersion(folder, file string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	ver := m.folderVersioners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if ver == nil {
+		return nil, errNoVersioner
+	}
+
+	return versioner.OpenVersion(ver, file, versionTime)
+}
+
 func (m *model) RestoreFolderVersions(folder string, versions map[string]time.Time) (map[string]error, error) {
 	m.fmut.RLock()
 	err := m.checkFolderRunningLocked(folder)
@@ -2699,6 +2874,91 @@ func (m *model) RestoreFolderVersions(folder string, versions map[string]time.Ti
 	return restoreErrors, nil
 }
 
+// A ConflictCopy is a conflict copy created while pulling, along with the
+// two versions that were in conflict. The winner is the version that
+// remained under the original name.
+type ConflictCopy struct {
+	Original       string    `json:"original"`
+	Conflict       string    `json:"conflict"`
+	Created        time.Time `json:"created"`
+	Winner         string    `json:"winner"`
+	Loser          string    `json:"loser"`
+	WinnerModified time.Time `json:"winnerModified"`
+	LoserModified  time.Time `json:"loserModified"`
+	WinnerSize     int64     `json:"winnerSize"`
+	LoserSize      int64     `json:"loserSize"`
+}
+
+// The sides of a conflict that ResolveConflict can keep.
+const (
+	ConflictKeepOriginal = "original"
+	ConflictKeepConflict = "conflict"
+)
+
+// Conflicts returns the conflict copies in the folder that still exist.
+func (m *model) Conflicts(folder string) ([]ConflictCopy, error) {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	fcfg := m.folderCfgs[folder]
+	fset := m.folderFiles[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := fset.Conflicts()
+	if err != nil {
+		return nil, err
+	}
+
+	ffs := fcfg.Filesystem(nil)
+	res := make([]ConflictCopy, 0, len(records))
+	for name, cr := range records {
+		if _, err := ffs.Lstat(name); fs.IsNotExist(err) {
+			// Someone took care of it already.
+			if err := fset.RemoveConflict(name); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		res = append(res, ConflictCopy{
+			Original:       cr.Original,
+			Conflict:       name,
+			Created:        cr.Time,
+			Winner:         cr.Winner.String(),
+			Loser:          cr.Loser.String(),
+			WinnerModified: cr.WinnerModified,
+			LoserModified:  cr.LoserModified,
+			WinnerSize:     cr.WinnerSize,
+			LoserSize:      cr.LoserSize,
+		})
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].Conflict < res[b].Conflict
+	})
+	return res, nil
+}
+
+// ResolveConflict resolves a conflict by keeping either the original file
+// or the conflict copy in its place. The other one is archived if the
+// folder has a versioner and removed otherwise.
+func (m *model) ResolveConflict(folder, conflict, keep string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	switch keep {
+	case ConflictKeepOriginal, ConflictKeepConflict:
+	default:
+		return fmt.Errorf("unknown side of the conflict to keep: %q", keep)
+	}
+	return runner.ResolveConflict(osutil.NativeFilename(conflict), keep)
+}
+
 func (m *model) Availability(folder string, file protocol.FileInfo, block protocol.BlockInfo) ([]Availability, error) {
 	// The slightly unusual locking sequence here is because we need to hold
 	// pmut for the duration (as the value returned from foldersFiles can
@@ -2766,6 +3026,24 @@ func (m *model) BringToFront(folder, file string) {
 	}
 }
 
+// FetchPlaceholders requests the contents of the placeholders at or below
+// the given paths to be pulled.
+func (m *model) FetchPlaceholders(folder string, paths []string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = osutil.NativeFilename(path)
+	}
+	return runner.FetchPlaceholders(names)
+}
+
 func (m *model) ResetFolder(folder string) error {
 	m.fmut.RLock()
 	defer m.fmut.RUnlock()
@@ -1546,6 +1546,157 @@ func TestIgnores(t *testing.T) {
 	changeIgnores(t, m, []string{})
 }
 
+func TestIgnoresDryRun(t *testing.T) {
+	w, fcfg, wcfgCancel := tmpDefaultWrapper(t)
+	defer wcfgCancel()
+	ffs := fcfg.Filesystem(nil)
+	must(t, ffs.MkdirAll("dir", 0755))
+	writeFile(t, ffs, "foo", []byte("foo"))
+	writeFile(t, ffs, "dir/bar", []byte("bar"))
+	writeFile(t, ffs, "dir/baz.log", []byte("baz"))
+	writeFile(t, ffs, "logs.inc", []byte("*.log\n"))
+
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Path)
+	must(t, m.ScanFolder("default"))
+	// foo is in the database as ignored.
+	must(t, m.SetIgnores("default", []string{"foo"}))
+	must(t, m.ScanFolder("default"))
+
+	before, _, _ := m.CurrentIgnores("default")
+
+	res, err := m.TestIgnores("default", []string{"#include logs.inc"}, 1, 10)
+	must(t, err)
+	if len(res.Ignored) != 1 || res.Ignored[0].Name != filepath.Join("dir", "baz.log") {
+		t.Fatalf("unexpected ignored files %+v", res.Ignored)
+	}
+	if p := res.Ignored[0].Proposed; p.File != "logs.inc" || p.Line != 1 || p.Rule != "*.log" {
+		t.Errorf("unexpected rule for newly ignored file %+v", p)
+	}
+	if len(res.Unignored) != 1 || res.Unignored[0].Name != "foo" {
+		t.Fatalf("unexpected unignored files %+v", res.Unignored)
+	}
+	if c := res.Unignored[0].Current; c.File != ".stignore" || c.Line != 1 || c.Rule != "foo" {
+		t.Errorf("unexpected rule for unignored file %+v", c)
+	}
+	if res.IgnoredCount != 1 || res.UnignoredCount != 1 {
+		t.Errorf("unexpected counts %+v", res)
+	}
+
+	// Paging goes through the changes in database order, counting all of
+	// them on every page.
+	var paged []string
+	for page := 1; page <= 3; page++ {
+		res, err := m.TestIgnores("default", []string{"#include logs.inc"}, page, 1)
+		must(t, err)
+		if res.IgnoredCount != 1 || res.UnignoredCount != 1 {
+			t.Errorf("page %d: unexpected counts %+v", page, res)
+		}
+		for _, c := range append(res.Ignored, res.Unignored...) {
+			paged = append(paged, c.Name)
+		}
+		if n := len(res.Ignored) + len(res.Unignored); (page <= 2 && n != 1) || (page > 2 && n != 0) {
+			t.Errorf("page %d: unexpected changes %+v", page, res)
+		}
+	}
+	if len(paged) != 2 || paged[0] == paged[1] {
+		t.Errorf("unexpected paged changes %v", paged)
+	}
+
+	if after, _, _ := m.CurrentIgnores("default"); fmt.Sprint(before) != fmt.Sprint(after) {
+		t.Error("testing changed the ignores", before, after)
+	}
+
+	if _, err := m.TestIgnores("default", []string{"a**[b"}, 1, 10); !ignore.IsParseError(err) {
+		t.Error("expected parse error, got", err)
+	}
+	if _, err := m.TestIgnores("doesnotexist", nil, 1, 10); err == nil {
+		t.Error("expected error for nonexistent folder")
+	}
+}
+
+func TestConflictInventory(t *testing.T) {
+	w, fcfg, wcfgCancel := tmpDefaultWrapper(t)
+	defer wcfgCancel()
+	ffs := fcfg.Filesystem(nil)
+
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Path)
+	f := m.folderRunners["default"].(*sendReceiveFolder)
+
+	// Create conflict copies the way the puller does
+
+	cur := protocol.FileInfo{Name: "foo", Size: 5, ModifiedBy: myID.Short()}
+	file := protocol.FileInfo{Name: "foo", Size: 6, ModifiedBy: device1.Short()}
+	newConflict := func(local, remote string) string {
+		t.Helper()
+		writeFile(t, ffs, "foo", []byte(local))
+		scanChan := make(chan string, 1)
+		must(t, f.moveForConflict("foo", cur, file, scanChan))
+		writeFile(t, ffs, "foo", []byte(remote))
+		return <-scanChan
+	}
+
+	conflict := newConflict("local", "remote")
+
+	confls, err := m.Conflicts("default")
+	must(t, err)
+	if len(confls) != 1 {
+		t.Fatal("Expected one conflict, got", confls)
+	}
+	if c := confls[0]; c.Original != "foo" || c.Conflict != conflict || c.Winner != device1.Short().String() || c.Loser != myID.Short().String() || c.WinnerSize != 6 || c.LoserSize != 5 {
+		t.Error("Unexpected conflict", c)
+	}
+
+	if err := m.ResolveConflict("default", conflict, "both"); err == nil {
+		t.Error("Expected error for unknown side")
+	}
+	if err := m.ResolveConflict("default", "nonexistent", ConflictKeepOriginal); err != ErrConflictMissing {
+		t.Error("Expected missing conflict, got", err)
+	}
+
+	// Keeping the conflict copy puts it in place of the original
+
+	must(t, m.ResolveConflict("default", conflict, ConflictKeepConflict))
+	if bs, err := os.ReadFile(filepath.Join(fcfg.Path, "foo")); err != nil || string(bs) != "local" {
+		t.Errorf("Expected conflict copy in place of the original, got %q, %v", bs, err)
+	}
+	if _, err := ffs.Lstat(conflict); !fs.IsNotExist(err) {
+		t.Error("Expected conflict copy to be gone, got", err)
+	}
+	// The result has been scanned by the time we return.
+	if fi, ok, err := m.CurrentFolderFile("default", "foo"); err != nil || !ok || fi.Size != 5 {
+		t.Errorf("Expected kept conflict copy to be scanned, got %v, %v, %v", fi, ok, err)
+	}
+
+	// Keeping the original removes the conflict copy
+
+	// Conflict names have a resolution of one second
+	time.Sleep(time.Second)
+	conflict = newConflict("local", "remote")
+	must(t, m.ResolveConflict("default", conflict, ConflictKeepOriginal))
+	if bs, err := os.ReadFile(filepath.Join(fcfg.Path, "foo")); err != nil || string(bs) != "remote" {
+		t.Errorf("Expected original to be kept, got %q, %v", bs, err)
+	}
+	if _, err := ffs.Lstat(conflict); !fs.IsNotExist(err) {
+		t.Error("Expected conflict copy to be gone, got", err)
+	}
+
+	// Conflict copies removed by other means are forgotten
+
+	time.Sleep(time.Second)
+	conflict = newConflict("local", "remote")
+	must(t, ffs.Remove(conflict))
+	confls, err = m.Conflicts("default")
+	must(t, err)
+	if len(confls) != 0 {
+		t.Error("Expected no conflicts, got", confls)
+	}
+	if _, ok, err := f.fset.Conflict(conflict); err != nil || ok {
+		t.Error("Expected removed conflict copy to be forgotten", ok, err)
+	}
+}
+
 func TestEmptyIgnores(t *testing.T) {
 	// Assure a clean start state
 	mustRemove(t, defaultFs.RemoveAll(config.DefaultMarkerName))
@@ -3186,7 +3337,7 @@ func TestConnCloseOnRestart(t *testing.T) {
 
 	br := &testutils.BlockingRW{}
 	nw := &testutils.NoopRW{}
-	m.AddConnection(protocol.NewConnection(device1, br, nw, testutils.NoopCloser{}, m, new(protocolmocks.ConnectionInfo), protocol.CompressionNever, nil), protocol.Hello{})
+	m.AddConnection(protocol.NewConnection(device1, br, nw, testutils.NoopCloser{}, m, new(protocolmocks.ConnectionInfo), protocol.CompressionNever, protocol.CompressionAlgorithmLZ4, nil), protocol.Hello{})
 	m.pmut.RLock()
 	if len(m.closed) != 1 {
 		t.Fatalf("Expected just one conn (len(m.conn) == %v)", len(m.conn))
@@ -423,6 +423,190 @@ func pullInvalidIgnored(t *testing.T, ft config.FolderType) {
 	}
 }
 
+// TestSelectiveSync checks that only selected paths are pulled, that the
+// others aren't announced as deleted, and that selecting them later pulls
+// them in.
+func TestSelectiveSync(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfig(t.TempDir())
+	fss := fcfg.Filesystem(nil)
+	fcfg.SelectiveSync = true
+	fcfg.Select("docs")
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fss.URI())
+
+	fc := addFakeConn(m, device1, fcfg.ID)
+	fc.folder = "default"
+
+	contents := []byte("test file contents\n")
+	selected := filepath.Join("docs", "a")
+	unselected := filepath.Join("other", "b")
+	fc.addFile(selected, 0644, protocol.FileInfoTypeFile, contents)
+	fc.addFile(unselected, 0644, protocol.FileInfoTypeFile, contents)
+
+	var mut sync.Mutex
+	var seen map[string]protocol.FileInfo
+	waitFor := func(expected map[string]bool) {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for {
+			mut.Lock()
+			done := true
+			for name, valid := range expected {
+				if f, ok := seen[name]; !ok || f.IsInvalid() == valid {
+					done = false
+				}
+			}
+			mut.Unlock()
+			if done {
+				return
+			}
+			select {
+			case <-timeout:
+				mut.Lock()
+				defer mut.Unlock()
+				t.Fatalf("Timed out waiting for %v, got %v", expected, seen)
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	reset := func() {
+		mut.Lock()
+		seen = make(map[string]protocol.FileInfo)
+		mut.Unlock()
+	}
+	reset()
+	fc.setIndexFn(func(_ context.Context, folder string, fs []protocol.FileInfo) error {
+		mut.Lock()
+		defer mut.Unlock()
+		for _, f := range fs {
+			if f.IsDeleted() && !f.Version.IsEmpty() {
+				t.Errorf("File %v was announced as deleted", f.Name)
+			}
+			seen[f.Name] = f
+		}
+		return nil
+	})
+
+	fc.sendIndexUpdate()
+	waitFor(map[string]bool{selected: true, unselected: false})
+	if _, err := fss.Lstat(selected); err != nil {
+		t.Error("Selected file wasn't pulled:", err)
+	}
+	if _, err := fss.Lstat(filepath.Dir(unselected)); !fs.IsNotExist(err) {
+		t.Error("Unselected directory exists:", err)
+	}
+
+	// Select the other directory too
+	reset()
+	fcfg.Select("other")
+	setFolder(t, w, fcfg)
+	waitFor(map[string]bool{unselected: true})
+	if _, err := fss.Lstat(unselected); err != nil {
+		t.Error("Newly selected file wasn't pulled:", err)
+	}
+	if f, ok := m.testCurrentFolderFile(fcfg.ID, unselected); !ok || f.IsDeleted() || f.IsInvalid() {
+		t.Error("Unexpected newly selected file", f)
+	}
+
+	// Deselecting leaves the file in place, without announcing a deletion
+	reset()
+	fcfg.Deselect("other")
+	setFolder(t, w, fcfg)
+	waitFor(map[string]bool{unselected: false})
+	if _, err := fss.Lstat(unselected); err != nil {
+		t.Error("Deselected file was removed:", err)
+	}
+}
+
+func TestPlaceholderFiles(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfig(t.TempDir())
+	fss := fcfg.Filesystem(nil)
+	fcfg.PlaceholderFiles = true
+	setFolder(t, w, fcfg)
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, fss.URI())
+
+	fc := addFakeConn(m, device1, fcfg.ID)
+	fc.folder = "default"
+
+	contents := []byte("test file contents\n")
+	name := filepath.Join("dir", "file")
+	fc.addFile(name, 0644, protocol.FileInfoTypeFile, contents)
+
+	announced := make(chan protocol.FileInfo, 10)
+	fc.setIndexFn(func(_ context.Context, folder string, fs []protocol.FileInfo) error {
+		for _, f := range fs {
+			if f.Name == name {
+				announced <- f
+			}
+		}
+		return nil
+	})
+	waitFor := func(valid bool) {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case f := <-announced:
+				if f.IsInvalid() != valid {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("Timed out waiting for %v to be announced (valid: %v)", name, valid)
+			}
+		}
+	}
+
+	// The file is created as a placeholder, which isn't announced as ours
+	fc.sendIndexUpdate()
+	waitFor(false)
+	if info, err := fss.Lstat(name); err != nil {
+		t.Fatal(err)
+	} else if !fs.IsPlaceholder(fss, name, info) {
+		t.Errorf("Expected a placeholder, got size %v, mtime %v", info.Size(), info.ModTime())
+	}
+	if p, ok := fs.ReadPlaceholder(fss, name); !ok || p.Size != int64(len(contents)) {
+		t.Errorf("Unexpected placeholder metadata %v (%v)", p, ok)
+	}
+	if f, ok := m.testCurrentFolderFile(fcfg.ID, name); !ok || !f.IsPlaceholder() || f.Size != int64(len(contents)) {
+		t.Error("Unexpected placeholder file", f)
+	}
+
+	// Scanning doesn't pick it up as a change
+	must(t, m.ScanFolder(fcfg.ID))
+	if f, ok := m.testCurrentFolderFile(fcfg.ID, name); !ok || !f.IsPlaceholder() {
+		t.Error("Placeholder was changed by scanning", f)
+	}
+	select {
+	case f := <-announced:
+		t.Error("Unexpected announcement after scan", f)
+	default:
+	}
+
+	// Fetching the contents
+	if err := m.FetchPlaceholders(fcfg.ID, []string{"nonexistent"}); err != ErrPlaceholderMissing {
+		t.Error("Expected ErrPlaceholderMissing, got", err)
+	}
+	must(t, m.FetchPlaceholders(fcfg.ID, []string{"dir"}))
+	waitFor(true)
+	if bs, err := os.ReadFile(filepath.Join(fss.URI(), name)); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(bs, contents) {
+		t.Errorf("Unexpected contents %q", bs)
+	}
+	if _, ok := fs.ReadPlaceholder(fss, name); ok {
+		t.Error("Placeholder metadata wasn't removed")
+	}
+	if f, ok := m.testCurrentFolderFile(fcfg.ID, name); !ok || f.IsPlaceholder() || f.IsInvalid() {
+		t.Error("Unexpected fetched file", f)
+	}
+}
+
 func TestIssue4841(t *testing.T) {
 	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
 	defer wcfgCancel()
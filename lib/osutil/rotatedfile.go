@@ -0,0 +1,114 @@
+// Copyright (C) 2014 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package osutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// RotatedFile keeps a set of rotating files. There will be the base file
+// plus up to maxFiles rotated ones, each ~ maxSize bytes large.
+type RotatedFile struct {
+	name        string
+	create      CreateFunc
+	maxSize     int64 // bytes
+	maxFiles    int
+	warn        func(error)
+	currentFile io.WriteCloser
+	currentSize int64
+}
+
+// CreateFunc opens the named file for appending, creating it if necessary.
+type CreateFunc func(name string) (io.WriteCloser, error)
+
+// NewRotatedFile opens the base file using create. Problems rotating the
+// files later on don't keep writes from happening; they are passed to warn.
+func NewRotatedFile(name string, create CreateFunc, maxSize int64, maxFiles int, warn func(error)) (*RotatedFile, error) {
+	var size int64
+	if info, err := os.Lstat(name); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		size = 0
+	} else {
+		size = info.Size()
+	}
+	writer, err := create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &RotatedFile{
+		name:        name,
+		create:      create,
+		maxSize:     maxSize,
+		maxFiles:    maxFiles,
+		warn:        warn,
+		currentFile: writer,
+		currentSize: size,
+	}, nil
+}
+
+func (r *RotatedFile) Write(bs []byte) (int, error) {
+	// Check if we're about to exceed the max size, and if so close this
+	// file so we'll start on a new one. A file never starts out empty
+	// though, however large the write.
+	if r.currentSize > 0 && r.currentSize+int64(len(bs)) > r.maxSize {
+		r.currentFile.Close()
+		r.currentSize = 0
+		r.rotate()
+		f, err := r.create(r.name)
+		if err != nil {
+			return 0, err
+		}
+		r.currentFile = f
+	}
+
+	n, err := r.currentFile.Write(bs)
+	r.currentSize += int64(n)
+	return n, err
+}
+
+func (r *RotatedFile) Close() error {
+	return r.currentFile.Close()
+}
+
+func (r *RotatedFile) rotate() {
+	if r.maxFiles <= 0 {
+		if err := os.Remove(r.name); err != nil && !os.IsNotExist(err) {
+			r.warn(err)
+		}
+		return
+	}
+
+	// The files are named "name", "name.0", "name.1", ...
+	// "name.(r.maxFiles-1)". Increase the numbers on the
+	// suffixed ones.
+	for i := r.maxFiles - 1; i > 0; i-- {
+		from := numberedFile(r.name, i-1)
+		to := numberedFile(r.name, i)
+		err := os.Rename(from, to)
+		if err != nil && !os.IsNotExist(err) {
+			r.warn(err)
+		}
+	}
+
+	// Rename the base to base.0
+	err := os.Rename(r.name, numberedFile(r.name, 0))
+	if err != nil && !os.IsNotExist(err) {
+		r.warn(err)
+	}
+}
+
+// numberedFile adds the number between the file name and the extension.
+func numberedFile(name string, num int) string {
+	ext := filepath.Ext(name) // contains the dot
+	withoutExt := name[:len(name)-len(ext)]
+	return fmt.Sprintf("%s.%d%s", withoutExt, num, ext)
+}
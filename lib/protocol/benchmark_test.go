@@ -4,8 +4,10 @@ package protocol
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/binary"
+	"fmt"
 	"net"
 	"testing"
 
@@ -60,9 +62,9 @@ func benchmarkRequestsTLS(b *testing.B, conn0, conn1 net.Conn) {
 
 func benchmarkRequestsConnPair(b *testing.B, conn0, conn1 net.Conn) {
 	// Start up Connections on them
-	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutils.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil)
+	c0 := NewConnection(LocalDeviceID, conn0, conn0, testutils.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, CompressionAlgorithmLZ4, nil)
 	c0.Start()
-	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutils.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, nil)
+	c1 := NewConnection(LocalDeviceID, conn1, conn1, testutils.NoopCloser{}, new(fakeModel), new(mockedConnectionInfo), CompressionMetadata, CompressionAlgorithmLZ4, nil)
 	c1.Start()
 
 	// Satisfy the assertions in the protocol by sending an initial cluster config
@@ -103,6 +105,88 @@ func benchmarkRequestsConnPair(b *testing.B, conn0, conn1 net.Conn) {
 	}
 }
 
+func BenchmarkCompressLZ4(b *testing.B) {
+	benchmarkCompress(b, lz4Compress)
+}
+
+func BenchmarkCompressZstd(b *testing.B) {
+	benchmarkCompress(b, zstdCompress)
+}
+
+func BenchmarkDecompressLZ4(b *testing.B) {
+	benchmarkDecompress(b, lz4Compress, lz4Decompress)
+}
+
+func BenchmarkDecompressZstd(b *testing.B) {
+	benchmarkDecompress(b, zstdCompress, zstdDecompress)
+}
+
+func benchmarkCompress(b *testing.B, compress func(src, buf []byte) (int, error)) {
+	data := benchmarkIndexMessage(b)
+	buf := make([]byte, 4+len(data))
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	var n int
+	var err error
+	for i := 0; i < b.N; i++ {
+		if n, err = compress(data, buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(n)/float64(len(data)), "ratio")
+}
+
+func benchmarkDecompress(b *testing.B, compress func(src, buf []byte) (int, error), decompress func(src []byte) ([]byte, error)) {
+	data := benchmarkIndexMessage(b)
+	buf := make([]byte, 4+len(data))
+	n, err := compress(data, buf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		res, err := decompress(buf[:n])
+		if err != nil {
+			b.Fatal(err)
+		}
+		BufferPool.Put(res)
+	}
+}
+
+// benchmarkIndexMessage returns a marshalled index message, which is the
+// typical kind of message that gets compressed.
+func benchmarkIndexMessage(b *testing.B) []byte {
+	idx := Index{Folder: "default"}
+	for i := 0; i < 1000; i++ {
+		fi := FileInfo{
+			Name:         fmt.Sprintf("some/directory/structure/file-%04d.txt", i),
+			Size:         int64(i) * 1024,
+			ModifiedS:    1600000000 + int64(i),
+			Permissions:  0o644,
+			Version:      Vector{}.Update(LocalDeviceID.Short()),
+			Sequence:     int64(i),
+			RawBlockSize: 128 << KiB,
+		}
+		for j := 0; j < 4; j++ {
+			hash := sha256.Sum256([]byte(fmt.Sprintf("%d-%d", i, j)))
+			fi.Blocks = append(fi.Blocks, BlockInfo{Offset: int64(j) << 17, Size: 128 << KiB, Hash: hash[:]})
+		}
+		idx.Files = append(idx.Files, fi)
+	}
+	bs, err := idx.Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return bs
+}
+
 // returns the two endpoints of a TCP connection over lo0
 func getTCPConnectionPair() (net.Conn, net.Conn, error) {
 	lst, err := net.Listen("tcp", "127.0.0.1:0")
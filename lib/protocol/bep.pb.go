@@ -72,16 +72,19 @@ type MessageCompression int32
 const (
 	MessageCompressionNone MessageCompression = 0
 	MessageCompressionLZ4  MessageCompression = 1
+	MessageCompressionZstd MessageCompression = 2
 )
 
 var MessageCompression_name = map[int32]string{
 	0: "MESSAGE_COMPRESSION_NONE",
 	1: "MESSAGE_COMPRESSION_LZ4",
+	2: "MESSAGE_COMPRESSION_ZSTD",
 }
 
 var MessageCompression_value = map[string]int32{
 	"MESSAGE_COMPRESSION_NONE": 0,
 	"MESSAGE_COMPRESSION_LZ4":  1,
+	"MESSAGE_COMPRESSION_ZSTD": 2,
 }
 
 func (x MessageCompression) String() string {
@@ -120,6 +123,33 @@ func (Compression) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_311ef540e10d9705, []int{2}
 }
 
+// The algorithm used for compressed messages. Devices advertise the ones
+// they support in the Hello message; LZ4 is always supported.
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithmLZ4  CompressionAlgorithm = 0
+	CompressionAlgorithmZstd CompressionAlgorithm = 1
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "COMPRESSION_ALGORITHM_LZ4",
+	1: "COMPRESSION_ALGORITHM_ZSTD",
+}
+
+var CompressionAlgorithm_value = map[string]int32{
+	"COMPRESSION_ALGORITHM_LZ4":  0,
+	"COMPRESSION_ALGORITHM_ZSTD": 1,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{3}
+}
+
 type FileInfoType int32
 
 const (
@@ -151,7 +181,7 @@ func (x FileInfoType) String() string {
 }
 
 func (FileInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{3}
+	return fileDescriptor_311ef540e10d9705, []int{4}
 }
 
 type ErrorCode int32
@@ -182,7 +212,7 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 
 type FileDownloadProgressUpdateType int32
@@ -207,13 +237,14 @@ func (x FileDownloadProgressUpdateType) String() string {
 }
 
 func (FileDownloadProgressUpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{6}
 }
 
 type Hello struct {
-	DeviceName    string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"deviceName" xml:"deviceName"`
-	ClientName    string `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"clientName" xml:"clientName"`
-	ClientVersion string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
+	DeviceName            string                 `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"deviceName" xml:"deviceName"`
+	ClientName            string                 `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"clientName" xml:"clientName"`
+	ClientVersion         string                 `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
+	CompressionAlgorithms []CompressionAlgorithm `protobuf:"varint,4,rep,packed,name=compression_algorithms,json=compressionAlgorithms,proto3,enum=protocol.CompressionAlgorithm" json:"compressionAlgorithms" xml:"compressionAlgorithm"`
 }
 
 func (m *Hello) Reset()         { *m = Hello{} }
@@ -1027,6 +1058,7 @@ func init() {
 	proto.RegisterEnum("protocol.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("protocol.MessageCompression", MessageCompression_name, MessageCompression_value)
 	proto.RegisterEnum("protocol.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("protocol.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterEnum("protocol.FileInfoType", FileInfoType_name, FileInfoType_value)
 	proto.RegisterEnum("protocol.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("protocol.FileDownloadProgressUpdateType", FileDownloadProgressUpdateType_name, FileDownloadProgressUpdateType_value)
@@ -1055,190 +1087,197 @@ func init() {
 func init() { proto.RegisterFile("lib/protocol/bep.proto", fileDescriptor_311ef540e10d9705) }
 
 var fileDescriptor_311ef540e10d9705 = []byte{
-	// 2920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x6c, 0x1b, 0xc7,
-	0xd5, 0xd7, 0x92, 0x94, 0x44, 0x8d, 0x64, 0x87, 0x1a, 0xff, 0x63, 0x68, 0x5b, 0xcb, 0x6f, 0xe2,
-	0x7c, 0x9f, 0xa2, 0x7c, 0xb1, 0x13, 0x27, 0xf9, 0xbe, 0x34, 0x4e, 0x1d, 0x88, 0x22, 0x25, 0x33,
-	0x91, 0x49, 0x65, 0x28, 0xdb, 0xb5, 0xd1, 0x82, 0x58, 0x71, 0x47, 0xd4, 0xc2, 0xe4, 0x2e, 0xbb,
-	0x4b, 0xea, 0x4f, 0xd0, 0x4b, 0x5b, 0xa0, 0x08, 0x74, 0x28, 0x8a, 0x9c, 0x8a, 0xa2, 0x42, 0x83,
-	0x02, 0x45, 0x6f, 0x05, 0x7a, 0xe8, 0xa1, 0x39, 0xf5, 0xe8, 0xa3, 0x11, 0xa0, 0x40, 0xd1, 0xc3,
-	0x02, 0xb1, 0x2f, 0x2d, 0x8f, 0x3c, 0xf6, 0x54, 0xcc, 0x9b, 0xd9, 0xd9, 0x59, 0xfd, 0x09, 0x94,
-	0xe4, 0xd0, 0xdb, 0xbe, 0xdf, 0xfb, 0xb3, 0xc3, 0x37, 0xef, 0xfd, 0xe6, 0xcd, 0x12, 0x5d, 0xec,
-	0x38, 0x1b, 0x37, 0x7a, 0xbe, 0xd7, 0xf7, 0x5a, 0x5e, 0xe7, 0xc6, 0x06, 0xeb, 0x5d, 0x07, 0x01,
-	0x67, 0x23, 0xac, 0x30, 0xc5, 0x76, 0xfb, 0x02, 0x2c, 0xbc, 0xe4, 0xb3, 0x9e, 0x17, 0x08, 0xf3,
-	0x8d, 0xc1, 0xe6, 0x8d, 0xb6, 0xd7, 0xf6, 0x40, 0x80, 0x27, 0x61, 0x44, 0x9e, 0x19, 0x68, 0xfc,
-	0x0e, 0xeb, 0x74, 0x3c, 0xbc, 0x84, 0xa6, 0x6d, 0xb6, 0xed, 0xb4, 0x58, 0xd3, 0xb5, 0xba, 0x2c,
-	0x6f, 0x14, 0x8d, 0xf9, 0xa9, 0x12, 0x19, 0x86, 0x26, 0x12, 0x70, 0xcd, 0xea, 0xb2, 0x51, 0x68,
-	0xe6, 0x76, 0xbb, 0x9d, 0x77, 0x49, 0x0c, 0x11, 0xaa, 0xe9, 0x79, 0x90, 0x56, 0xc7, 0x61, 0x6e,
-	0x5f, 0x04, 0x49, 0xc5, 0x41, 0x04, 0x9c, 0x08, 0x12, 0x43, 0x84, 0x6a, 0x7a, 0x5c, 0x47, 0x67,
-	0x65, 0x90, 0x6d, 0xe6, 0x07, 0x8e, 0xe7, 0xe6, 0xd3, 0x10, 0x67, 0x7e, 0x18, 0x9a, 0x67, 0x84,
-	0xe6, 0xbe, 0x50, 0x8c, 0x42, 0xf3, 0x9c, 0x16, 0x4a, 0xa2, 0x84, 0x26, 0xad, 0xc8, 0x1f, 0x0d,
-	0x34, 0x71, 0x87, 0x59, 0x36, 0xf3, 0xf1, 0x22, 0xca, 0xf4, 0xf7, 0x7a, 0xe2, 0xe7, 0x9d, 0xbd,
-	0x79, 0xe1, 0x7a, 0x94, 0xb8, 0xeb, 0x77, 0x59, 0x10, 0x58, 0x6d, 0xb6, 0xbe, 0xd7, 0x63, 0xa5,
-	0x8b, 0xc3, 0xd0, 0x04, 0xb3, 0x51, 0x68, 0x22, 0x88, 0xcf, 0x05, 0x42, 0x01, 0xc3, 0x36, 0x9a,
-	0x6e, 0x79, 0xdd, 0x9e, 0xcf, 0x02, 0x58, 0x5b, 0x0a, 0x22, 0x5d, 0x39, 0x12, 0x69, 0x29, 0xb6,
-	0x29, 0x5d, 0x1b, 0x86, 0xa6, 0xee, 0x34, 0x0a, 0xcd, 0x59, 0xb1, 0xee, 0x18, 0x23, 0x54, 0xb7,
-	0x20, 0xdf, 0x47, 0x67, 0x96, 0x3a, 0x83, 0xa0, 0xcf, 0xfc, 0x25, 0xcf, 0xdd, 0x74, 0xda, 0xf8,
-	0x43, 0x34, 0xb9, 0xe9, 0x75, 0x6c, 0xe6, 0x07, 0x79, 0xa3, 0x98, 0x9e, 0x9f, 0xbe, 0x99, 0x8b,
-	0x5f, 0xb9, 0x0c, 0x8a, 0x92, 0xf9, 0x24, 0x34, 0xc7, 0x86, 0xa1, 0x19, 0x19, 0x8e, 0x42, 0x73,
-	0x06, 0x5e, 0x23, 0x64, 0x42, 0x23, 0x05, 0xf9, 0x3c, 0x83, 0x26, 0x84, 0x13, 0xbe, 0x8e, 0x52,
-	0x8e, 0x2d, 0xb7, 0x7b, 0xee, 0x59, 0x68, 0xa6, 0xaa, 0xe5, 0x61, 0x68, 0xa6, 0x1c, 0x7b, 0x14,
-	0x9a, 0x59, 0xf0, 0x76, 0x6c, 0xf2, 0xe9, 0xd3, 0x6b, 0xa9, 0x6a, 0x99, 0xa6, 0x1c, 0x1b, 0x5f,
-	0x47, 0xe3, 0x1d, 0x6b, 0x83, 0x75, 0xe4, 0xe6, 0xe6, 0x87, 0xa1, 0x29, 0x80, 0x51, 0x68, 0x4e,
-	0x83, 0x3d, 0x48, 0x84, 0x0a, 0x14, 0xdf, 0x42, 0x53, 0x3e, 0xb3, 0xec, 0xa6, 0xe7, 0x76, 0xf6,
-	0x60, 0x23, 0xb3, 0xa5, 0xb9, 0x61, 0x68, 0x66, 0x39, 0x58, 0x77, 0x3b, 0x7b, 0xa3, 0xd0, 0x3c,
-	0x0b, 0x6e, 0x11, 0x40, 0xa8, 0xd2, 0xe1, 0x26, 0xc2, 0x4e, 0xdb, 0xf5, 0x7c, 0xd6, 0xec, 0x31,
-	0xbf, 0xeb, 0x40, 0x6a, 0x82, 0x7c, 0x06, 0xa2, 0xbc, 0x3e, 0x0c, 0xcd, 0x59, 0xa1, 0x5d, 0x8b,
-	0x95, 0xa3, 0xd0, 0xbc, 0x24, 0x56, 0x7d, 0x58, 0x43, 0xe8, 0x51, 0x6b, 0xfc, 0x21, 0x3a, 0x23,
-	0x5f, 0x60, 0xb3, 0x0e, 0xeb, 0xb3, 0xfc, 0x38, 0xc4, 0xfe, 0xef, 0x61, 0x68, 0xce, 0x08, 0x45,
-	0x19, 0xf0, 0x51, 0x68, 0x62, 0x2d, 0xac, 0x00, 0x09, 0x4d, 0xd8, 0x60, 0x1b, 0x9d, 0xb7, 0x9d,
-	0xc0, 0xda, 0xe8, 0xb0, 0x66, 0x9f, 0x75, 0x7b, 0x4d, 0xc7, 0xb5, 0xd9, 0x2e, 0x0b, 0xf2, 0x13,
-	0x10, 0xf3, 0xe6, 0x30, 0x34, 0xb1, 0xd4, 0xaf, 0xb3, 0x6e, 0xaf, 0x2a, 0xb4, 0xa3, 0xd0, 0xcc,
-	0x8b, 0x9e, 0x3a, 0xa2, 0x22, 0xf4, 0x18, 0x7b, 0x7c, 0x13, 0x4d, 0xf4, 0xac, 0x41, 0xc0, 0xec,
-	0xfc, 0x24, 0xc4, 0x2d, 0x0c, 0x43, 0x53, 0x22, 0x6a, 0xc3, 0x85, 0x48, 0xa8, 0xc4, 0x79, 0xf1,
-	0x88, 0x2e, 0x0d, 0xf2, 0xb9, 0xc3, 0xc5, 0x53, 0x06, 0x45, 0x5c, 0x3c, 0xd2, 0x50, 0xc5, 0x12,
-	0x32, 0xa1, 0x91, 0x82, 0xfc, 0x65, 0x02, 0x4d, 0x08, 0x27, 0x5c, 0x52, 0xc5, 0x33, 0x53, 0xba,
-	0xc9, 0x03, 0xfc, 0x3d, 0x34, 0xb3, 0x42, 0x57, 0x2d, 0x9f, 0x54, 0x4c, 0x9f, 0x3c, 0xbd, 0x66,
-	0x68, 0x05, 0xb5, 0x80, 0x32, 0x1a, 0x59, 0x40, 0xef, 0xb9, 0x82, 0x26, 0x44, 0xef, 0xb9, 0x40,
-	0x10, 0x80, 0xe1, 0xf7, 0xd0, 0x94, 0x65, 0xdb, 0xbc, 0x47, 0x58, 0x90, 0x4f, 0x17, 0xd3, 0xbc,
-	0x66, 0x87, 0xa1, 0x19, 0x83, 0xa3, 0xd0, 0x3c, 0x03, 0x5e, 0x12, 0x21, 0x34, 0xd6, 0xe1, 0x1f,
-	0x24, 0x3b, 0x37, 0x73, 0x98, 0x03, 0xbe, 0x5d, 0xcb, 0xf2, 0x4a, 0x6f, 0x31, 0x5f, 0x52, 0xdf,
-	0xb8, 0x68, 0x28, 0x5e, 0xe9, 0x1c, 0x94, 0xc4, 0x27, 0x2a, 0x3d, 0x02, 0x08, 0x55, 0x3a, 0xbc,
-	0x82, 0x66, 0xba, 0xd6, 0x6e, 0x33, 0x60, 0x3f, 0x1c, 0x30, 0xb7, 0xc5, 0xa0, 0x66, 0xd2, 0x62,
-	0x15, 0x5d, 0x6b, 0xb7, 0x21, 0x61, 0xb5, 0x0a, 0x0d, 0x23, 0x54, 0xb7, 0xc0, 0x25, 0x84, 0x1c,
-	0xb7, 0xef, 0x7b, 0xf6, 0xa0, 0xc5, 0x7c, 0x59, 0x22, 0xc0, 0xc0, 0x31, 0xaa, 0x18, 0x38, 0x86,
-	0x08, 0xd5, 0xf4, 0xb8, 0x8d, 0xb2, 0x50, 0xbb, 0x4d, 0xc7, 0xce, 0x67, 0x8b, 0xc6, 0x7c, 0xa6,
-	0xb4, 0x2a, 0x37, 0x77, 0x12, 0xaa, 0x10, 0xf6, 0x36, 0x7a, 0xe4, 0x35, 0x03, 0xd6, 0x55, 0x5b,
-	0x65, 0x5f, 0xca, 0x9c, 0x37, 0x22, 0xb3, 0x5f, 0xc5, 0x8f, 0x34, 0xb2, 0xc7, 0x3f, 0x42, 0x85,
-	0xe0, 0xb1, 0xc3, 0x3b, 0x45, 0xbc, 0xbb, 0xef, 0x78, 0x6e, 0xd3, 0x67, 0x5d, 0x6f, 0xdb, 0xea,
-	0x04, 0xf9, 0x29, 0x58, 0xfc, 0xed, 0x61, 0x68, 0xe6, 0xb9, 0x55, 0x55, 0x33, 0xa2, 0xd2, 0x66,
-	0x14, 0x9a, 0x73, 0xf0, 0xc6, 0x93, 0x0c, 0x08, 0x3d, 0xd1, 0x17, 0xef, 0xa2, 0x17, 0x99, 0xdb,
-	0xf2, 0xf7, 0x7a, 0xf0, 0xda, 0x9e, 0x15, 0x04, 0x3b, 0x9e, 0x6f, 0x37, 0xfb, 0xde, 0x63, 0xe6,
-	0xe6, 0x11, 0x14, 0xf5, 0x7b, 0xc3, 0xd0, 0xbc, 0x14, 0x1b, 0xad, 0x49, 0x9b, 0x75, 0x6e, 0x32,
-	0x0a, 0xcd, 0xab, 0xf0, 0xee, 0x13, 0xf4, 0x84, 0x9e, 0xe4, 0x49, 0x7e, 0x62, 0xa0, 0x71, 0x48,
-	0x06, 0xef, 0x66, 0x41, 0xca, 0x92, 0x82, 0xa1, 0x9b, 0x05, 0x72, 0x84, 0xbe, 0x25, 0x8e, 0x2b,
-	0x68, 0x7c, 0xd3, 0xe9, 0xb0, 0x20, 0x9f, 0x82, 0x5e, 0xc6, 0xda, 0x41, 0xe0, 0x74, 0x58, 0xd5,
-	0xdd, 0xf4, 0x4a, 0x97, 0x65, 0x37, 0x0b, 0x43, 0xd5, 0x4b, 0x5c, 0x22, 0x54, 0x80, 0xe4, 0x13,
-	0x03, 0x4d, 0xc3, 0x22, 0xee, 0xf5, 0x6c, 0xab, 0xcf, 0xfe, 0x93, 0x4b, 0xf9, 0xf3, 0x34, 0xca,
-	0x46, 0x0e, 0x8a, 0x10, 0x8c, 0x53, 0x10, 0xc2, 0x02, 0xca, 0x04, 0xce, 0xc7, 0x0c, 0x0e, 0x96,
-	0xb4, 0xb0, 0xe5, 0xb2, 0xb2, 0xe5, 0x02, 0xa1, 0x80, 0xe1, 0xf7, 0x11, 0xea, 0x7a, 0xb6, 0xb3,
-	0xe9, 0x30, 0xbb, 0x19, 0x40, 0x83, 0xa6, 0x4b, 0x45, 0xce, 0x1e, 0x11, 0xda, 0x18, 0x85, 0xe6,
-	0x0b, 0xa2, 0xbd, 0x22, 0x84, 0xd0, 0x58, 0xcb, 0xf9, 0x43, 0x05, 0xd8, 0xd8, 0xcb, 0xcf, 0x40,
-	0x67, 0xbc, 0x17, 0x75, 0x46, 0x63, 0xcb, 0xf3, 0xfb, 0xd0, 0x0e, 0xea, 0x35, 0xa5, 0x3d, 0xd5,
-	0x6a, 0x31, 0x44, 0x78, 0x27, 0x48, 0x63, 0xaa, 0x99, 0xe2, 0x55, 0x34, 0x19, 0x0d, 0x3c, 0xbc,
-	0xf2, 0x13, 0x24, 0x7d, 0x9f, 0xb5, 0xfa, 0x9e, 0x5f, 0x2a, 0x46, 0x24, 0xbd, 0xad, 0x06, 0x20,
-	0xd1, 0x70, 0xdb, 0xd1, 0xe8, 0x13, 0x69, 0xf0, 0xbb, 0x28, 0xab, 0xc8, 0x04, 0xc1, 0x6f, 0x05,
-	0x32, 0x0a, 0x62, 0x26, 0x11, 0x64, 0x14, 0x28, 0x1a, 0x51, 0x3a, 0xfc, 0x01, 0x9a, 0xd8, 0xe8,
-	0x78, 0xad, 0xc7, 0xd1, 0x69, 0x71, 0x2e, 0x5e, 0x48, 0x89, 0xe3, 0xb0, 0xaf, 0x57, 0xe5, 0x5a,
-	0xa4, 0xa9, 0x3a, 0xfe, 0x41, 0x24, 0x54, 0xc2, 0x7c, 0x9a, 0x0b, 0xf6, 0xba, 0x1d, 0xc7, 0x7d,
-	0xdc, 0xec, 0x5b, 0x7e, 0x9b, 0xf5, 0xf3, 0xb3, 0xf1, 0x34, 0x27, 0x35, 0xeb, 0xa0, 0x50, 0xd3,
-	0x5c, 0x02, 0x25, 0x34, 0x69, 0xc5, 0x67, 0x4c, 0x11, 0xba, 0xb9, 0x65, 0x05, 0x5b, 0x79, 0x0c,
-	0x7d, 0x0a, 0x0c, 0x27, 0xe0, 0x3b, 0x56, 0xb0, 0xa5, 0xd2, 0x1e, 0x43, 0x84, 0x6a, 0x7a, 0x7c,
-	0x1b, 0x4d, 0xc9, 0xde, 0x64, 0x76, 0xfe, 0x1c, 0x84, 0x80, 0x52, 0x50, 0xa0, 0x2a, 0x05, 0x85,
-	0x10, 0x1a, 0x6b, 0x71, 0x49, 0xce, 0x91, 0x62, 0xfa, 0xbb, 0x78, 0xb4, 0xec, 0x4f, 0x31, 0x48,
-	0x2e, 0xa3, 0xe9, 0xc3, 0x53, 0xcd, 0x19, 0xc1, 0xf8, 0xbd, 0xc4, 0x3c, 0x23, 0x18, 0xbf, 0xa7,
-	0x4f, 0x32, 0xba, 0x05, 0xfe, 0x40, 0x2b, 0x4b, 0x37, 0xc8, 0x4f, 0x17, 0x8d, 0xf9, 0xf1, 0xd2,
-	0x2b, 0x7a, 0x1d, 0xd6, 0x82, 0x23, 0x75, 0x58, 0x0b, 0xc8, 0xbf, 0x42, 0x33, 0xed, 0xb8, 0x7d,
-	0xaa, 0x99, 0xe1, 0x4d, 0x24, 0xb2, 0xd4, 0x84, 0xae, 0x3a, 0x03, 0xa1, 0x56, 0x9e, 0x85, 0xe6,
-	0x0c, 0xb5, 0x76, 0x60, 0xeb, 0x1b, 0xce, 0xc7, 0x8c, 0x27, 0x6a, 0x23, 0x12, 0x54, 0xa2, 0x14,
-	0x12, 0x05, 0xfe, 0xf4, 0xe9, 0xb5, 0x84, 0x1b, 0x8d, 0x9d, 0xf0, 0x7d, 0x94, 0xed, 0x75, 0xac,
-	0xfe, 0xa6, 0xe7, 0x77, 0xf3, 0x67, 0xa1, 0xd8, 0xb5, 0x1c, 0xae, 0x49, 0x4d, 0xd9, 0xea, 0x5b,
-	0x25, 0x22, 0xcb, 0x4c, 0xd9, 0xab, 0xca, 0x8d, 0x00, 0x42, 0x95, 0x0e, 0x97, 0xd1, 0x74, 0xc7,
-	0x6b, 0x59, 0x9d, 0xe6, 0x66, 0xc7, 0x6a, 0x07, 0xf9, 0x7f, 0x4c, 0x42, 0x52, 0xa1, 0x3a, 0x00,
-	0x5f, 0xe6, 0xb0, 0x4a, 0x46, 0x0c, 0x11, 0xaa, 0xe9, 0xf1, 0x1d, 0x34, 0x23, 0xdb, 0x48, 0xd4,
-	0xd8, 0x3f, 0x27, 0xa1, 0x42, 0x60, 0x6f, 0xa4, 0x42, 0x56, 0xd9, 0xac, 0xde, 0x7d, 0xa2, 0xcc,
-	0x74, 0x0b, 0xfc, 0x7f, 0x7c, 0xf0, 0xe2, 0xc3, 0xa1, 0x2d, 0xa7, 0xc0, 0x2b, 0x62, 0xc4, 0x02,
-	0x48, 0x75, 0xaf, 0x94, 0x61, 0xc6, 0x82, 0x27, 0x4c, 0xd1, 0xa4, 0xe3, 0x6e, 0x5b, 0x1d, 0x27,
-	0x9a, 0xf2, 0xde, 0x79, 0x16, 0x9a, 0x88, 0x5a, 0x3b, 0x55, 0x81, 0x8a, 0x43, 0x17, 0x1e, 0xb5,
-	0x43, 0x17, 0x64, 0x7e, 0xe8, 0x6a, 0x96, 0x34, 0xb2, 0xe3, 0x9d, 0xe8, 0x7a, 0x89, 0x41, 0x3a,
-	0x0b, 0xa1, 0xa1, 0x13, 0x5d, 0x2f, 0x39, 0x44, 0x8b, 0x4e, 0x4c, 0xa0, 0x84, 0x26, 0xad, 0xde,
-	0xcd, 0xfc, 0xf2, 0x33, 0x73, 0x8c, 0x7c, 0x69, 0xa0, 0x29, 0xc5, 0x0a, 0x9c, 0x90, 0x21, 0x65,
-	0x69, 0xc8, 0x18, 0x34, 0xc0, 0x96, 0x48, 0x95, 0x68, 0x80, 0x2d, 0xc8, 0x11, 0x60, 0xfc, 0xc0,
-	0xf1, 0x36, 0x37, 0x03, 0xd6, 0x07, 0xaa, 0x4f, 0x8b, 0x03, 0x47, 0x20, 0xea, 0xc0, 0x11, 0x22,
-	0xa1, 0x12, 0xc7, 0x6f, 0x48, 0xc2, 0x4f, 0x41, 0x69, 0x5e, 0x3d, 0x9e, 0xf0, 0xa3, 0xca, 0x16,
-	0xbc, 0x7f, 0x0b, 0x4d, 0xed, 0x30, 0xeb, 0xb1, 0xd8, 0x4a, 0xd1, 0x65, 0x40, 0x85, 0x1c, 0x94,
-	0xdb, 0x28, 0x0a, 0x2a, 0x02, 0x08, 0x55, 0x3a, 0xf9, 0x1b, 0x1f, 0xa1, 0x09, 0xc1, 0xc0, 0x78,
-	0x0d, 0x65, 0x5b, 0xde, 0xc0, 0xed, 0xc7, 0xf7, 0xb0, 0x59, 0x7d, 0x80, 0x04, 0x4d, 0xe9, 0xbf,
-	0xa2, 0x9a, 0x8d, 0x4c, 0xd5, 0x1e, 0x49, 0x80, 0x4f, 0x7e, 0x52, 0x45, 0x7e, 0x6a, 0xa0, 0x49,
-	0xe9, 0x88, 0xef, 0xa8, 0x79, 0x3a, 0x53, 0x7a, 0xe7, 0xd0, 0xc1, 0xf2, 0xd5, 0x77, 0x33, 0xfd,
-	0x50, 0x91, 0xd7, 0xb4, 0x6d, 0xab, 0x33, 0x10, 0x89, 0xca, 0x88, 0x6b, 0x1a, 0x00, 0x8a, 0xa7,
-	0x41, 0x22, 0x54, 0xa0, 0xe4, 0x77, 0x06, 0x9a, 0xd1, 0xfb, 0x8e, 0x33, 0xdc, 0xc0, 0x75, 0x76,
-	0x61, 0x31, 0x89, 0x83, 0xfd, 0x9e, 0xeb, 0xec, 0x42, 0x67, 0x16, 0x9e, 0x84, 0xa6, 0xc1, 0x37,
-	0x80, 0xdb, 0xa9, 0x0d, 0xe0, 0x02, 0xa1, 0x80, 0xe1, 0x8f, 0xd0, 0xe4, 0x8e, 0xe3, 0xda, 0xde,
-	0x4e, 0x00, 0xcb, 0x98, 0xd6, 0x87, 0xed, 0x07, 0x42, 0x01, 0x91, 0x8a, 0x32, 0x52, 0x64, 0xad,
-	0xd2, 0x25, 0x65, 0x42, 0x23, 0x0d, 0xf9, 0x59, 0x0a, 0x65, 0xa3, 0x15, 0xf0, 0x13, 0xdd, 0xdb,
-	0x71, 0x99, 0xaf, 0x7f, 0xb2, 0x00, 0x1a, 0x07, 0x54, 0xce, 0xdc, 0x82, 0x9d, 0x14, 0x42, 0x68,
-	0xac, 0xe5, 0x01, 0xda, 0xbe, 0x37, 0xe8, 0xe9, 0x9f, 0x2b, 0x20, 0x00, 0xa0, 0x89, 0x00, 0x0a,
-	0x21, 0x34, 0xd6, 0xe2, 0x5b, 0x28, 0x3d, 0x70, 0x6c, 0xa8, 0xf6, 0xf1, 0xd2, 0x2b, 0xcf, 0x42,
-	0x33, 0x7d, 0x0f, 0xf6, 0x88, 0xa3, 0xa3, 0xd0, 0x9c, 0x12, 0x29, 0x71, 0x6c, 0x8d, 0x13, 0xb9,
-	0x05, 0xe5, 0x7a, 0xee, 0xdc, 0x76, 0x6c, 0x28, 0x49, 0xe9, 0xbc, 0x22, 0x9c, 0xdb, 0x9a, 0x73,
-	0x3b, 0xe9, 0xbc, 0xc2, 0x9d, 0x39, 0xf6, 0x6b, 0x03, 0x4d, 0x6b, 0x39, 0xfc, 0xf6, 0xb9, 0x58,
-	0x45, 0x67, 0x45, 0x00, 0x27, 0x68, 0xc2, 0x0f, 0x84, 0x7c, 0xc8, 0xbb, 0x30, 0x68, 0xaa, 0xc1,
-	0x0a, 0xc7, 0xd5, 0x5d, 0x58, 0x07, 0x09, 0x4d, 0xd8, 0x90, 0x1f, 0x67, 0xd0, 0x24, 0xe5, 0xf3,
-	0x44, 0xd0, 0xc7, 0x6f, 0xab, 0xaa, 0x1e, 0x2f, 0xbd, 0x7c, 0x52, 0x19, 0xc7, 0xbf, 0x31, 0xba,
-	0x18, 0xc6, 0xf3, 0x68, 0xea, 0xd4, 0xf3, 0x68, 0x34, 0x3b, 0xa6, 0x4f, 0x31, 0x3b, 0xc6, 0xf4,
-	0x93, 0xf9, 0xda, 0xf4, 0x33, 0x7e, 0x7a, 0xfa, 0x89, 0x18, 0x71, 0xe2, 0x14, 0x8c, 0x58, 0x47,
-	0x67, 0x37, 0x7d, 0xaf, 0x0b, 0x9f, 0x0f, 0x3c, 0xdf, 0xf2, 0xf7, 0x24, 0xfb, 0x03, 0x45, 0x73,
-	0xcd, 0x7a, 0xa4, 0x50, 0x14, 0x9d, 0x40, 0x09, 0x4d, 0x5a, 0x25, 0xb9, 0x2f, 0xfb, 0xf5, 0xb8,
-	0x0f, 0xdf, 0x46, 0x59, 0x31, 0x0c, 0xb8, 0x1e, 0x4c, 0xa4, 0xe3, 0xa5, 0x97, 0x78, 0x93, 0x02,
-	0x56, 0xf3, 0x54, 0x93, 0x4a, 0x59, 0xfd, 0xec, 0xc8, 0x80, 0xfc, 0xc1, 0x40, 0x59, 0xca, 0x82,
-	0x9e, 0xe7, 0x06, 0xec, 0x9b, 0x16, 0xc1, 0x02, 0xca, 0xd8, 0x56, 0xdf, 0x82, 0x12, 0x90, 0xd9,
-	0xe3, 0xb2, 0xca, 0x1e, 0x17, 0x08, 0x05, 0x0c, 0xbf, 0x8f, 0x32, 0x2d, 0xcf, 0x16, 0x9b, 0x7f,
-	0x56, 0x1f, 0x5a, 0x2b, 0xbe, 0xef, 0xf9, 0x4b, 0x9e, 0x2d, 0x27, 0x32, 0x6e, 0xa4, 0x02, 0x70,
-	0x81, 0x50, 0xc0, 0xc8, 0xef, 0x0d, 0x94, 0x2b, 0x7b, 0x3b, 0x6e, 0xc7, 0xb3, 0xec, 0x35, 0xdf,
-	0x6b, 0xf3, 0x9b, 0xfd, 0x37, 0xba, 0x16, 0x35, 0xd1, 0xe4, 0x00, 0x2e, 0x55, 0xd1, 0xc5, 0xe8,
-	0x5a, 0x72, 0x42, 0x3c, 0xfc, 0x12, 0x71, 0x03, 0x8b, 0xbf, 0xc1, 0x48, 0x67, 0x15, 0x5f, 0xc8,
-	0x84, 0x46, 0x0a, 0xf2, 0xdb, 0x34, 0x2a, 0x9c, 0x1c, 0x08, 0x77, 0xd1, 0xb4, 0xb0, 0x6c, 0x6a,
-	0x5f, 0x3b, 0xe7, 0x4f, 0xb3, 0x06, 0x98, 0x5b, 0x61, 0x5e, 0x1a, 0x28, 0x59, 0xcd, 0x4b, 0x31,
-	0x44, 0xa8, 0xa6, 0xff, 0x5a, 0x9f, 0x70, 0xb4, 0x5b, 0x4e, 0xfa, 0xdb, 0xdf, 0x72, 0x1a, 0xe8,
-	0x8c, 0x28, 0xd1, 0xe8, 0x5b, 0x5b, 0xa6, 0x98, 0x9e, 0x1f, 0x2f, 0x5d, 0xe7, 0x9c, 0xb5, 0x21,
-	0x86, 0x92, 0xe8, 0x2b, 0xdb, 0x6c, 0x5c, 0xac, 0x02, 0x8c, 0xaa, 0x2d, 0x37, 0x46, 0x13, 0xb6,
-	0x78, 0x39, 0x31, 0x04, 0x8b, 0x56, 0xff, 0x9f, 0x53, 0x0e, 0xbd, 0xda, 0x90, 0x4b, 0x26, 0x50,
-	0x66, 0xcd, 0x71, 0xdb, 0xe4, 0x16, 0x1a, 0x5f, 0xea, 0x78, 0x01, 0x30, 0x8e, 0xcf, 0xac, 0xc0,
-	0x73, 0xf5, 0x52, 0x12, 0x88, 0xda, 0x6a, 0x21, 0x12, 0x2a, 0xf1, 0x85, 0xcf, 0xd3, 0x68, 0x5a,
-	0xfb, 0x38, 0x8d, 0xbf, 0x8b, 0x2e, 0xdf, 0xad, 0x34, 0x1a, 0x8b, 0x2b, 0x95, 0xe6, 0xfa, 0xc3,
-	0xb5, 0x4a, 0x73, 0x69, 0xf5, 0x5e, 0x63, 0xbd, 0x42, 0x9b, 0x4b, 0xf5, 0xda, 0x72, 0x75, 0x25,
-	0x37, 0x56, 0xb8, 0xb2, 0x7f, 0x50, 0xcc, 0x6b, 0x1e, 0xc9, 0xcf, 0xc8, 0xff, 0x8b, 0x70, 0xc2,
-	0xbd, 0x5a, 0x2b, 0x57, 0xbe, 0x97, 0x33, 0x0a, 0xe7, 0xf7, 0x0f, 0x8a, 0x39, 0xcd, 0x4b, 0x7c,
-	0x9d, 0xf8, 0x0e, 0x7a, 0xf1, 0xa8, 0x75, 0xf3, 0xde, 0x5a, 0x79, 0x71, 0xbd, 0x92, 0x4b, 0x15,
-	0x0a, 0xfb, 0x07, 0xc5, 0x8b, 0x87, 0x9d, 0x64, 0x09, 0xbe, 0x8e, 0xce, 0x27, 0x5c, 0x69, 0xe5,
-	0xa3, 0x7b, 0x95, 0xc6, 0x7a, 0x2e, 0x5d, 0xb8, 0xb8, 0x7f, 0x50, 0xc4, 0x9a, 0x57, 0x74, 0x4c,
-	0xdc, 0x44, 0x17, 0x0e, 0x79, 0x34, 0xd6, 0xea, 0xb5, 0x46, 0x25, 0x97, 0x29, 0x5c, 0xda, 0x3f,
-	0x28, 0x9e, 0x4b, 0xb8, 0x48, 0x56, 0x59, 0x42, 0x73, 0x09, 0x9f, 0x72, 0xfd, 0x41, 0x6d, 0xb5,
-	0xbe, 0x58, 0x6e, 0xae, 0xd1, 0xfa, 0x0a, 0xad, 0x34, 0x1a, 0xb9, 0xf1, 0x82, 0xb9, 0x7f, 0x50,
-	0xbc, 0xac, 0x39, 0x1f, 0xe9, 0xf0, 0x05, 0x34, 0x9b, 0x08, 0xb2, 0x56, 0xad, 0xad, 0xe4, 0x26,
-	0x0a, 0xe7, 0xf6, 0x0f, 0x8a, 0x2f, 0x68, 0x7e, 0x7c, 0x2f, 0x8f, 0xe4, 0x6f, 0x69, 0xb5, 0xde,
-	0xa8, 0xe4, 0x26, 0x8f, 0xe4, 0x0f, 0x36, 0x7c, 0xe1, 0x37, 0x06, 0xc2, 0x47, 0xff, 0x0f, 0xc0,
-	0xef, 0xa0, 0x7c, 0x14, 0x64, 0xa9, 0x7e, 0x77, 0x8d, 0xaf, 0xb3, 0x5a, 0xaf, 0x35, 0x6b, 0xf5,
-	0x5a, 0x25, 0x37, 0x96, 0xc8, 0xaa, 0xe6, 0x55, 0xf3, 0x5c, 0x86, 0xeb, 0xe8, 0xd2, 0x71, 0x9e,
-	0xab, 0x8f, 0xde, 0xca, 0x19, 0x85, 0x9b, 0xfb, 0x07, 0xc5, 0x0b, 0x47, 0x1d, 0x57, 0x1f, 0xbd,
-	0xf5, 0xc5, 0xcf, 0x5f, 0x3e, 0x5e, 0xb1, 0xc0, 0xc7, 0x08, 0x7d, 0x69, 0x6f, 0xa0, 0xf3, 0x7a,
-	0xe0, 0xbb, 0x95, 0xf5, 0xc5, 0xf2, 0xe2, 0xfa, 0x62, 0x6e, 0x4c, 0xec, 0x81, 0x66, 0x7a, 0x97,
-	0xf5, 0x2d, 0xa0, 0xdd, 0x57, 0xd1, 0x6c, 0xe2, 0x57, 0x54, 0xee, 0x57, 0x68, 0x54, 0x51, 0xfa,
-	0xfa, 0xd9, 0x36, 0xf3, 0xf1, 0x6b, 0x08, 0xeb, 0xc6, 0x8b, 0xab, 0x0f, 0x16, 0x1f, 0x36, 0x72,
-	0xa9, 0xc2, 0x85, 0xfd, 0x83, 0xe2, 0xac, 0x66, 0xbd, 0xd8, 0xd9, 0xb1, 0xf6, 0x82, 0x85, 0x3f,
-	0xa5, 0xd0, 0x8c, 0x7e, 0xa5, 0xc6, 0xaf, 0xa1, 0x73, 0xcb, 0xd5, 0x55, 0x5e, 0x89, 0xcb, 0x75,
-	0xb1, 0x03, 0x5c, 0xcc, 0x8d, 0x89, 0xd7, 0xe9, 0xa6, 0xfc, 0x19, 0xff, 0x3f, 0xca, 0x1f, 0x32,
-	0x2f, 0x57, 0x69, 0x65, 0x69, 0xbd, 0x4e, 0x1f, 0xe6, 0x8c, 0xc2, 0x8b, 0x3c, 0x61, 0xba, 0x4f,
-	0xd9, 0xf1, 0x81, 0x82, 0xf6, 0xf0, 0x6d, 0x74, 0xf9, 0x90, 0x63, 0xe3, 0xe1, 0xdd, 0xd5, 0x6a,
-	0xed, 0x43, 0xf1, 0xbe, 0x54, 0xe1, 0xea, 0xfe, 0x41, 0xf1, 0x92, 0xee, 0xdb, 0x10, 0x5f, 0x29,
-	0x38, 0x94, 0x35, 0xf0, 0x1d, 0x54, 0x3c, 0xc1, 0x3f, 0x5e, 0x40, 0xba, 0x40, 0xf6, 0x0f, 0x8a,
-	0x57, 0x8e, 0x09, 0xa2, 0xd6, 0x91, 0x35, 0xf0, 0x9b, 0xe8, 0xe2, 0xf1, 0x91, 0xa2, 0xbe, 0x38,
-	0xc6, 0x7f, 0xe1, 0xaf, 0x06, 0x9a, 0x52, 0xa7, 0x1e, 0x4f, 0x5a, 0x85, 0xd2, 0x3a, 0x27, 0x89,
-	0x72, 0xa5, 0x59, 0xab, 0x37, 0x41, 0x8a, 0x92, 0xa6, 0xec, 0x6a, 0x1e, 0x3c, 0xf2, 0x1a, 0xd7,
-	0xcc, 0x57, 0x2a, 0xb5, 0x0a, 0xad, 0x2e, 0x45, 0x3b, 0xaa, 0xac, 0x57, 0x98, 0xcb, 0x7c, 0xa7,
-	0x85, 0xdf, 0x42, 0x97, 0x92, 0xc1, 0x1b, 0xf7, 0x96, 0xee, 0x44, 0x59, 0x82, 0x05, 0x6a, 0x2f,
-	0x68, 0x0c, 0x5a, 0x5b, 0xb0, 0x31, 0x6f, 0x27, 0xbc, 0xaa, 0xb5, 0xfb, 0x8b, 0xab, 0xd5, 0xb2,
-	0xf0, 0x4a, 0x17, 0xf2, 0xfb, 0x07, 0xc5, 0xf3, 0xca, 0x4b, 0x5e, 0x64, 0xb9, 0xdb, 0xc2, 0x17,
-	0x06, 0x9a, 0xfb, 0xea, 0xc3, 0x0b, 0x3f, 0x40, 0xaf, 0x40, 0xbe, 0x8e, 0x50, 0x81, 0xe4, 0x2d,
-	0x91, 0xc3, 0xc5, 0xb5, 0xb5, 0x4a, 0xad, 0x9c, 0x1b, 0x2b, 0xcc, 0xef, 0x1f, 0x14, 0xaf, 0x7d,
-	0x75, 0xc8, 0xc5, 0x5e, 0x8f, 0xb9, 0xf6, 0x29, 0x03, 0x2f, 0xd7, 0xe9, 0x4a, 0x65, 0x3d, 0x67,
-	0x9c, 0x26, 0xf0, 0xb2, 0xe7, 0xb7, 0x59, 0xbf, 0x74, 0xf7, 0xc9, 0x97, 0x73, 0x63, 0x4f, 0xbf,
-	0x9c, 0x1b, 0x7b, 0xf2, 0x6c, 0xce, 0x78, 0xfa, 0x6c, 0xce, 0xf8, 0xc5, 0xf3, 0xb9, 0xb1, 0xcf,
-	0x9e, 0xcf, 0x19, 0x4f, 0x9f, 0xcf, 0x8d, 0xfd, 0xed, 0xf9, 0xdc, 0xd8, 0xa3, 0x57, 0xdb, 0x4e,
-	0x7f, 0x6b, 0xb0, 0x71, 0xbd, 0xe5, 0x75, 0x6f, 0x04, 0x7b, 0x6e, 0xab, 0xbf, 0xe5, 0xb8, 0x6d,
-	0xed, 0x49, 0xff, 0x5f, 0x78, 0x63, 0x02, 0x9e, 0xde, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xc7, 0x92, 0x60, 0x1e, 0x2e, 0x1e, 0x00, 0x00,
+	// 3039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x94, 0x44, 0x8d, 0x64, 0x85, 0x1a, 0x7f, 0xad, 0x69, 0x5b, 0xcb, 0xff, 0xc4,
+	0xf9, 0x57, 0x51, 0x1a, 0x3b, 0x51, 0x9c, 0xc4, 0x4d, 0x52, 0x07, 0xa2, 0x48, 0x49, 0x4c, 0x24,
+	0x52, 0x19, 0x52, 0x76, 0x6c, 0xb4, 0x20, 0x56, 0xdc, 0x11, 0xb5, 0x30, 0xb9, 0xcb, 0xee, 0x92,
+	0xfa, 0x08, 0x7a, 0x69, 0x0b, 0x14, 0x81, 0x0e, 0x41, 0x91, 0x53, 0x51, 0x54, 0x40, 0x50, 0xa0,
+	0xe8, 0xad, 0x40, 0x0f, 0x3d, 0x34, 0xa7, 0xde, 0xea, 0xa3, 0x11, 0xa0, 0x40, 0xd1, 0xc3, 0x02,
+	0xb1, 0x2f, 0x2d, 0x8f, 0xcc, 0xad, 0xa7, 0x62, 0xde, 0xec, 0xc7, 0xac, 0x44, 0x05, 0x4a, 0x72,
+	0xe8, 0x6d, 0xdf, 0xef, 0xfd, 0xde, 0xdb, 0xe1, 0x9b, 0xf7, 0xde, 0xbc, 0x1d, 0xa2, 0x4b, 0x2d,
+	0x73, 0xeb, 0x56, 0xc7, 0xb1, 0xbb, 0x76, 0xc3, 0x6e, 0xdd, 0xda, 0x62, 0x9d, 0x9b, 0x20, 0xe0,
+	0x74, 0x80, 0x65, 0x27, 0xd8, 0x7e, 0x57, 0x80, 0xd9, 0xe7, 0x1d, 0xd6, 0xb1, 0x5d, 0x41, 0xdf,
+	0xea, 0x6d, 0xdf, 0x6a, 0xda, 0x4d, 0x1b, 0x04, 0x78, 0x12, 0x24, 0xf2, 0x55, 0x02, 0x8d, 0xae,
+	0xb2, 0x56, 0xcb, 0xc6, 0x4b, 0x68, 0xd2, 0x60, 0xbb, 0x66, 0x83, 0xd5, 0x2d, 0xbd, 0xcd, 0x54,
+	0x25, 0xa7, 0xcc, 0x4d, 0xe4, 0x49, 0xdf, 0xd3, 0x90, 0x80, 0xcb, 0x7a, 0x9b, 0x0d, 0x3c, 0x2d,
+	0xb3, 0xdf, 0x6e, 0xbd, 0x45, 0x22, 0x88, 0x50, 0x49, 0xcf, 0x9d, 0x34, 0x5a, 0x26, 0xb3, 0xba,
+	0xc2, 0x49, 0x22, 0x72, 0x22, 0xe0, 0x98, 0x93, 0x08, 0x22, 0x54, 0xd2, 0xe3, 0x0a, 0x9a, 0xf6,
+	0x9d, 0xec, 0x32, 0xc7, 0x35, 0x6d, 0x4b, 0x4d, 0x82, 0x9f, 0xb9, 0xbe, 0xa7, 0x9d, 0x13, 0x9a,
+	0x7b, 0x42, 0x31, 0xf0, 0xb4, 0xf3, 0x92, 0x2b, 0x1f, 0x25, 0x34, 0xce, 0xc2, 0x9f, 0x28, 0xe8,
+	0x52, 0xc3, 0x6e, 0x77, 0x1c, 0xe6, 0x72, 0xb9, 0xae, 0xb7, 0x9a, 0xb6, 0x63, 0x76, 0x77, 0xda,
+	0xae, 0x9a, 0xca, 0x25, 0xe7, 0xa6, 0x17, 0x66, 0x6f, 0x06, 0x01, 0xbc, 0xb9, 0x14, 0xf1, 0x16,
+	0x03, 0x5a, 0xfe, 0xcd, 0xbe, 0xa7, 0x5d, 0x6c, 0x0c, 0xd1, 0xb8, 0x03, 0x4f, 0xcb, 0x8a, 0x15,
+	0x0c, 0xd1, 0x12, 0x3a, 0xdc, 0x88, 0xfc, 0x49, 0x41, 0x63, 0xab, 0x4c, 0x37, 0x98, 0x83, 0x17,
+	0x51, 0xaa, 0x7b, 0xd0, 0x11, 0xf1, 0x9e, 0x5e, 0xb8, 0x18, 0x2d, 0x64, 0x9d, 0xb9, 0xae, 0xde,
+	0x64, 0xb5, 0x83, 0x0e, 0xcb, 0x5f, 0xea, 0x7b, 0x1a, 0xd0, 0x06, 0x9e, 0x86, 0xe0, 0x75, 0x5c,
+	0x20, 0x14, 0x30, 0x6c, 0xa0, 0x49, 0xe9, 0x35, 0x10, 0xf4, 0xe9, 0x85, 0x6b, 0x27, 0x3c, 0x49,
+	0xbf, 0x2c, 0x7f, 0xa3, 0xef, 0x69, 0xb2, 0xd1, 0xc0, 0xd3, 0x66, 0x8e, 0xff, 0x0c, 0x42, 0x65,
+	0x06, 0xf9, 0x11, 0x3a, 0xb7, 0xd4, 0xea, 0xb9, 0x5d, 0xe6, 0x2c, 0xd9, 0xd6, 0xb6, 0xd9, 0xc4,
+	0xef, 0xa3, 0xf1, 0x6d, 0xbb, 0x65, 0x30, 0xc7, 0x55, 0x95, 0x5c, 0x72, 0x6e, 0x72, 0x21, 0x13,
+	0xbd, 0x72, 0x19, 0x14, 0x79, 0xed, 0xb1, 0xa7, 0x8d, 0xf4, 0x3d, 0x2d, 0x20, 0x0e, 0x3c, 0x6d,
+	0x0a, 0x5e, 0x23, 0x64, 0x42, 0x03, 0x05, 0xf9, 0x3c, 0x85, 0xc6, 0x84, 0x11, 0xbe, 0x89, 0x12,
+	0xa6, 0xe1, 0xe7, 0xdf, 0xec, 0x53, 0x4f, 0x4b, 0x94, 0x0a, 0x7d, 0x4f, 0x4b, 0x98, 0xc6, 0xc0,
+	0xd3, 0xd2, 0x60, 0x6d, 0x1a, 0xe4, 0xd3, 0x27, 0x37, 0x12, 0xa5, 0x02, 0x4d, 0x98, 0x06, 0xbe,
+	0x89, 0x46, 0x5b, 0xfa, 0x16, 0x6b, 0xf9, 0xd9, 0xa6, 0xf6, 0x3d, 0x4d, 0x00, 0x03, 0x4f, 0x9b,
+	0x04, 0x3e, 0x48, 0x84, 0x0a, 0x14, 0xbf, 0x8d, 0x26, 0x1c, 0xa6, 0x1b, 0x75, 0xdb, 0x6a, 0x1d,
+	0x40, 0x66, 0xa5, 0xf3, 0xb3, 0x7d, 0x4f, 0x4b, 0x73, 0xb0, 0x62, 0xb5, 0x0e, 0x06, 0x9e, 0x36,
+	0x0d, 0x66, 0x01, 0x40, 0x68, 0xa8, 0xc3, 0x75, 0x84, 0xcd, 0xa6, 0x65, 0x3b, 0xac, 0xde, 0x61,
+	0x4e, 0xdb, 0x84, 0xd0, 0xf0, 0x2c, 0xe2, 0x5e, 0x5e, 0xe9, 0x7b, 0xda, 0x8c, 0xd0, 0x6e, 0x44,
+	0xca, 0x81, 0xa7, 0x5d, 0x16, 0xab, 0x3e, 0xae, 0x21, 0xf4, 0x24, 0x1b, 0xbf, 0x8f, 0xce, 0xf9,
+	0x2f, 0x30, 0x58, 0x8b, 0x75, 0x99, 0x3a, 0x0a, 0xbe, 0xff, 0xbf, 0xef, 0x69, 0x53, 0x42, 0x51,
+	0x00, 0x7c, 0xe0, 0x69, 0x58, 0x72, 0x2b, 0x40, 0x42, 0x63, 0x1c, 0x6c, 0xa0, 0x0b, 0x86, 0xe9,
+	0xea, 0x5b, 0x2d, 0x56, 0xef, 0xb2, 0x76, 0xa7, 0x6e, 0x5a, 0x06, 0xdb, 0x67, 0xae, 0x3a, 0x06,
+	0x3e, 0x17, 0xfa, 0x9e, 0x86, 0x7d, 0x7d, 0x8d, 0xb5, 0x3b, 0x25, 0xa1, 0x1d, 0x78, 0x9a, 0x2a,
+	0x8a, 0xfc, 0x84, 0x8a, 0xd0, 0x21, 0x7c, 0xbc, 0x80, 0xc6, 0x3a, 0x7a, 0xcf, 0x65, 0x86, 0x3a,
+	0x0e, 0x7e, 0xb3, 0x7d, 0x4f, 0xf3, 0x91, 0x70, 0xc3, 0x85, 0x48, 0xa8, 0x8f, 0xf3, 0xe4, 0x11,
+	0x6d, 0xc3, 0x55, 0x33, 0xc7, 0x93, 0xa7, 0x00, 0x8a, 0x28, 0x79, 0x7c, 0x62, 0xe8, 0x4b, 0xc8,
+	0x84, 0x06, 0x0a, 0xf2, 0xd7, 0x31, 0x34, 0x26, 0x8c, 0x70, 0x3e, 0x4c, 0x9e, 0xa9, 0xfc, 0x02,
+	0x77, 0xf0, 0x4f, 0x4f, 0x4b, 0x0b, 0x5d, 0xa9, 0x70, 0x5a, 0x32, 0x7d, 0xfc, 0xe4, 0x86, 0x22,
+	0x25, 0xd4, 0x3c, 0x4a, 0x49, 0xdd, 0x0b, 0x6a, 0xcf, 0xd2, 0xdb, 0x51, 0xed, 0x59, 0xd0, 0xb1,
+	0x00, 0xc3, 0xef, 0xa0, 0x09, 0xdd, 0x30, 0x78, 0x8d, 0x30, 0x57, 0x4d, 0xe6, 0x92, 0x3c, 0x67,
+	0xfb, 0x9e, 0x16, 0x81, 0x03, 0x4f, 0x3b, 0x07, 0x56, 0x3e, 0x42, 0x68, 0xa4, 0xc3, 0x3f, 0x8e,
+	0x57, 0x6e, 0xea, 0x78, 0x0f, 0xf8, 0x6e, 0x25, 0xcb, 0x33, 0xbd, 0xc1, 0x1c, 0xbf, 0x17, 0x8f,
+	0x8a, 0x82, 0xe2, 0x99, 0xce, 0x41, 0xbf, 0x13, 0x8b, 0x4c, 0x0f, 0x00, 0x42, 0x43, 0x1d, 0x5e,
+	0x41, 0x53, 0x6d, 0x7d, 0xbf, 0xee, 0xb2, 0x9f, 0xf4, 0x98, 0xd5, 0x60, 0x90, 0x33, 0x49, 0xb1,
+	0x8a, 0xb6, 0xbe, 0x5f, 0xf5, 0xe1, 0x70, 0x15, 0x12, 0x46, 0xa8, 0xcc, 0xc0, 0x79, 0x84, 0x4c,
+	0xab, 0xeb, 0xd8, 0x46, 0xaf, 0xc1, 0x1c, 0x3f, 0x45, 0xe0, 0x48, 0x88, 0xd0, 0xf0, 0x48, 0x88,
+	0x20, 0x42, 0x25, 0x3d, 0x6e, 0xa2, 0x34, 0xe4, 0x6e, 0xdd, 0x34, 0xd4, 0x74, 0x4e, 0x99, 0x4b,
+	0xe5, 0xd7, 0xfc, 0xcd, 0x1d, 0x87, 0x2c, 0x84, 0xbd, 0x0d, 0x1e, 0x79, 0xce, 0x00, 0xbb, 0x64,
+	0x84, 0xd1, 0xf7, 0x65, 0xde, 0x37, 0x02, 0xda, 0x6f, 0xa2, 0x47, 0x1a, 0xf0, 0xf1, 0x4f, 0x51,
+	0xd6, 0x7d, 0x64, 0x76, 0xea, 0xc1, 0xbb, 0xbb, 0xfc, 0xbc, 0x70, 0x58, 0xdb, 0xde, 0xd5, 0x5b,
+	0xae, 0x3a, 0x01, 0x8b, 0xbf, 0xdb, 0xf7, 0x34, 0x95, 0xb3, 0x4a, 0x12, 0x89, 0xfa, 0x9c, 0x81,
+	0xa7, 0xcd, 0xc2, 0x1b, 0x4f, 0x23, 0x10, 0x7a, 0xaa, 0x2d, 0xde, 0x47, 0x57, 0x98, 0xd5, 0x70,
+	0x0e, 0x3a, 0xf0, 0xda, 0x8e, 0xee, 0xba, 0x7b, 0xb6, 0x63, 0xd4, 0xbb, 0xf6, 0x23, 0x66, 0xa9,
+	0x08, 0x92, 0xfa, 0x9d, 0xbe, 0xa7, 0x5d, 0x8e, 0x48, 0x1b, 0x3e, 0xa7, 0xc6, 0x29, 0x03, 0x4f,
+	0xbb, 0x0e, 0xef, 0x3e, 0x45, 0x4f, 0xe8, 0x69, 0x96, 0xe4, 0xe7, 0x0a, 0x1a, 0x85, 0x60, 0xf0,
+	0x6a, 0x16, 0x4d, 0xd9, 0x6f, 0xc1, 0x50, 0xcd, 0x02, 0x39, 0xd1, 0xbe, 0x7d, 0x1c, 0x17, 0xd1,
+	0xe8, 0xb6, 0xd9, 0x62, 0xae, 0x9a, 0x80, 0x5a, 0xc6, 0xd2, 0x41, 0x60, 0xb6, 0x58, 0xc9, 0xda,
+	0xb6, 0xf3, 0x57, 0xfd, 0x6a, 0x16, 0xc4, 0xb0, 0x96, 0xb8, 0x44, 0xa8, 0x00, 0xc9, 0xc7, 0x0a,
+	0x9a, 0x84, 0x45, 0x6c, 0x76, 0x0c, 0xbd, 0xcb, 0xfe, 0x97, 0x4b, 0xf9, 0xcb, 0x24, 0x4a, 0x07,
+	0x06, 0x61, 0x43, 0x50, 0xce, 0xd0, 0x10, 0xe6, 0x51, 0xca, 0x35, 0x3f, 0x62, 0x70, 0xb0, 0x24,
+	0x05, 0x97, 0xcb, 0x21, 0x97, 0x0b, 0x84, 0x02, 0x86, 0xdf, 0x45, 0xa8, 0x6d, 0x1b, 0xe6, 0xb6,
+	0xc9, 0x8c, 0xba, 0x0b, 0x05, 0x9a, 0xcc, 0xe7, 0x78, 0xf7, 0x08, 0xd0, 0xea, 0xc0, 0xd3, 0x9e,
+	0x13, 0xe5, 0x15, 0x20, 0x84, 0x46, 0x5a, 0xde, 0x3f, 0x42, 0x07, 0x5b, 0x07, 0xea, 0x14, 0x54,
+	0xc6, 0x3b, 0x41, 0x65, 0x54, 0x77, 0x6c, 0xa7, 0x0b, 0xe5, 0x10, 0xbe, 0x26, 0x7f, 0x10, 0x96,
+	0x5a, 0x04, 0x11, 0x5e, 0x09, 0x3e, 0x99, 0x4a, 0x54, 0xbc, 0x86, 0xc6, 0x83, 0x09, 0x8c, 0x67,
+	0x7e, 0xac, 0x49, 0xdf, 0x63, 0x8d, 0xae, 0xed, 0xe4, 0x73, 0x41, 0x93, 0xde, 0x0d, 0x27, 0x32,
+	0x51, 0x70, 0xbb, 0xc1, 0x2c, 0x16, 0x68, 0xf0, 0x5b, 0x28, 0x1d, 0x36, 0x13, 0x04, 0xbf, 0x15,
+	0x9a, 0x91, 0x1b, 0x75, 0x12, 0xd1, 0x8c, 0xdc, 0xb0, 0x8d, 0x84, 0x3a, 0xfc, 0x1e, 0x1a, 0xdb,
+	0x6a, 0xd9, 0x8d, 0x47, 0xc1, 0x69, 0x71, 0x3e, 0x5a, 0x48, 0x9e, 0xe3, 0xb0, 0xaf, 0xd7, 0xfd,
+	0xb5, 0xf8, 0xd4, 0xf0, 0xf8, 0x07, 0x91, 0x50, 0x1f, 0xe6, 0xe3, 0xa5, 0x7b, 0xd0, 0x6e, 0x99,
+	0xd6, 0xa3, 0x7a, 0x57, 0x77, 0x9a, 0xac, 0xab, 0xce, 0x44, 0xe3, 0xa5, 0xaf, 0xa9, 0x81, 0x22,
+	0x1c, 0x2f, 0x63, 0x28, 0xa1, 0x71, 0x16, 0x1f, 0x7a, 0x85, 0xeb, 0xfa, 0x8e, 0xee, 0xee, 0xa8,
+	0x18, 0xea, 0x14, 0x3a, 0x9c, 0x80, 0x57, 0x75, 0x77, 0x27, 0x0c, 0x7b, 0x04, 0x11, 0x2a, 0xe9,
+	0xf1, 0x5d, 0x34, 0xe1, 0xd7, 0x26, 0x33, 0xd4, 0xf3, 0xe0, 0x02, 0x52, 0x21, 0x04, 0xc3, 0x54,
+	0x08, 0x11, 0x42, 0x23, 0x2d, 0xce, 0xfb, 0x73, 0xa4, 0x98, 0xfe, 0x2e, 0x9d, 0x4c, 0xfb, 0x33,
+	0x0c, 0x92, 0xcb, 0x68, 0xf2, 0xf8, 0x54, 0x73, 0x4e, 0x74, 0xfc, 0x4e, 0x6c, 0x9e, 0x11, 0x1d,
+	0xbf, 0x23, 0x4f, 0x32, 0x32, 0x03, 0xbf, 0x27, 0xa5, 0xa5, 0xe5, 0xaa, 0x93, 0x39, 0x65, 0x6e,
+	0x34, 0xff, 0xa2, 0x9c, 0x87, 0x65, 0xf7, 0x44, 0x1e, 0x96, 0x5d, 0xf2, 0x1f, 0x4f, 0x4b, 0x9a,
+	0x56, 0x97, 0x4a, 0x34, 0xbc, 0x8d, 0x44, 0x94, 0xea, 0x50, 0x55, 0xe7, 0xc0, 0xd5, 0xca, 0x53,
+	0x4f, 0x9b, 0xa2, 0xfa, 0x1e, 0x6c, 0x7d, 0xd5, 0xfc, 0x88, 0xf1, 0x40, 0x6d, 0x05, 0x42, 0x18,
+	0xa8, 0x10, 0x09, 0x1c, 0x7f, 0xfa, 0xe4, 0x46, 0xcc, 0x8c, 0x46, 0x46, 0xf8, 0x1e, 0x4a, 0x77,
+	0x5a, 0x7a, 0x77, 0xdb, 0x76, 0xda, 0xea, 0x34, 0x24, 0xbb, 0x14, 0xc3, 0x0d, 0x5f, 0x53, 0xd0,
+	0xbb, 0x7a, 0x9e, 0xf8, 0x69, 0x16, 0xf2, 0xc3, 0xcc, 0x0d, 0x00, 0x42, 0x43, 0x1d, 0x2e, 0xa0,
+	0xc9, 0x96, 0xdd, 0xd0, 0x5b, 0xf5, 0xed, 0x96, 0xde, 0x74, 0xd5, 0x7f, 0x8d, 0x43, 0x50, 0x21,
+	0x3b, 0x00, 0x5f, 0xe6, 0x70, 0x18, 0x8c, 0x08, 0x22, 0x54, 0xd2, 0xe3, 0x55, 0x34, 0xe5, 0x97,
+	0x91, 0xc8, 0xb1, 0x7f, 0x8f, 0x43, 0x86, 0xc0, 0xde, 0xf8, 0x0a, 0x3f, 0xcb, 0x66, 0xe4, 0xea,
+	0x13, 0x69, 0x26, 0x33, 0xf0, 0x1b, 0x7c, 0xf0, 0xe2, 0xc3, 0xa1, 0xe1, 0x4f, 0x81, 0xd7, 0xc4,
+	0x88, 0x05, 0x50, 0x58, 0xbd, 0xbe, 0x0c, 0x33, 0x16, 0x3c, 0x61, 0x8a, 0xc6, 0x4d, 0x6b, 0x57,
+	0x6f, 0x99, 0xc1, 0x94, 0x77, 0xe7, 0xa9, 0xa7, 0x21, 0xaa, 0xef, 0x95, 0x04, 0x2a, 0x0e, 0x5d,
+	0x78, 0x94, 0x0e, 0x5d, 0x90, 0xf9, 0xa1, 0x2b, 0x31, 0x69, 0xc0, 0xe3, 0x95, 0x68, 0xd9, 0xb1,
+	0x41, 0x3a, 0x0d, 0xae, 0xa1, 0x12, 0x2d, 0x3b, 0x3e, 0x44, 0x8b, 0x4a, 0x8c, 0xa1, 0x84, 0xc6,
+	0x59, 0x6f, 0xa5, 0x7e, 0xfd, 0x99, 0x36, 0x42, 0xbe, 0x54, 0xd0, 0x44, 0xd8, 0x15, 0x78, 0x43,
+	0x86, 0x90, 0x25, 0x21, 0x62, 0x50, 0x00, 0x3b, 0x22, 0x54, 0xa2, 0x00, 0x76, 0x20, 0x46, 0x80,
+	0xf1, 0x03, 0xc7, 0xde, 0xde, 0x76, 0x59, 0x17, 0x5a, 0x7d, 0x52, 0x1c, 0x38, 0x02, 0x09, 0x0f,
+	0x1c, 0x21, 0x12, 0xea, 0xe3, 0xf8, 0x55, 0xbf, 0xe1, 0x27, 0x20, 0x35, 0xaf, 0x0f, 0x6f, 0xf8,
+	0x41, 0x66, 0x83, 0x8a, 0xcf, 0x65, 0x7b, 0x4c, 0x7f, 0x24, 0xb6, 0x52, 0x54, 0x19, 0xb4, 0x42,
+	0x0e, 0xfa, 0xdb, 0x28, 0x12, 0x2a, 0x00, 0x08, 0x0d, 0x75, 0xfe, 0x6f, 0x7c, 0x88, 0xc6, 0x44,
+	0x07, 0xc6, 0x1b, 0x28, 0xdd, 0xb0, 0x7b, 0x56, 0x37, 0xfa, 0x0e, 0x9b, 0x91, 0x07, 0x48, 0xd0,
+	0xe4, 0xff, 0x2f, 0xc8, 0xd9, 0x80, 0x1a, 0xee, 0x91, 0x0f, 0xf0, 0xc9, 0xcf, 0x57, 0x91, 0x5f,
+	0x28, 0x68, 0xdc, 0x37, 0xc4, 0xab, 0xe1, 0x3c, 0x9d, 0xca, 0xdf, 0x39, 0x76, 0xb0, 0x7c, 0xfd,
+	0xb7, 0x99, 0x7c, 0xa8, 0xf8, 0x9f, 0x69, 0xbb, 0x7a, 0xab, 0x27, 0x02, 0x95, 0x12, 0x9f, 0x69,
+	0x00, 0x84, 0x7d, 0x1a, 0x24, 0x42, 0x05, 0x4a, 0x7e, 0xaf, 0xa0, 0x29, 0xb9, 0xee, 0x78, 0x87,
+	0xeb, 0x59, 0xe6, 0x3e, 0x2c, 0x26, 0x76, 0xb0, 0x6f, 0x5a, 0xe6, 0x3e, 0x54, 0x66, 0xf6, 0xb1,
+	0xa7, 0x29, 0x7c, 0x03, 0x38, 0x2f, 0xdc, 0x00, 0x2e, 0x10, 0x0a, 0x18, 0xfe, 0x00, 0x8d, 0xef,
+	0x99, 0x96, 0x61, 0xef, 0xb9, 0xb0, 0x8c, 0x49, 0x79, 0xd8, 0xbe, 0x2f, 0x14, 0xe0, 0x29, 0xe7,
+	0x7b, 0x0a, 0xd8, 0x61, 0xb8, 0x7c, 0x99, 0xd0, 0x40, 0x43, 0x7e, 0x99, 0x40, 0xe9, 0x60, 0x05,
+	0xfc, 0x44, 0xb7, 0xf7, 0x2c, 0xe6, 0xc8, 0x77, 0x28, 0xd0, 0xc6, 0x01, 0xf5, 0x67, 0x6e, 0xd1,
+	0x9d, 0x42, 0x84, 0xd0, 0x48, 0xcb, 0x1d, 0x34, 0x1d, 0xbb, 0xd7, 0x91, 0xef, 0x4f, 0xc0, 0x01,
+	0xa0, 0x31, 0x07, 0x21, 0x42, 0x68, 0xa4, 0xc5, 0x6f, 0xa3, 0x64, 0xcf, 0x34, 0x20, 0xdb, 0x47,
+	0xf3, 0x2f, 0x3e, 0xf5, 0xb4, 0xe4, 0x26, 0xec, 0x11, 0x47, 0x07, 0x9e, 0x36, 0x21, 0x42, 0x62,
+	0x1a, 0x52, 0x4f, 0xe4, 0x0c, 0xca, 0xf5, 0xdc, 0xb8, 0x69, 0x1a, 0x6a, 0x2a, 0x32, 0x5e, 0x11,
+	0xc6, 0x4d, 0xc9, 0xb8, 0x19, 0x37, 0x5e, 0xe1, 0xc6, 0x1c, 0xfb, 0xad, 0x82, 0x26, 0xa5, 0x18,
+	0x7e, 0xf7, 0x58, 0xac, 0xa1, 0x69, 0xe1, 0xc0, 0x74, 0xeb, 0xf0, 0x03, 0xd5, 0x44, 0xf4, 0x2d,
+	0x0c, 0x9a, 0x92, 0xbb, 0xc2, 0xf1, 0xf0, 0x5b, 0x58, 0x06, 0x09, 0x8d, 0x71, 0xc8, 0xcf, 0x52,
+	0x68, 0x9c, 0xf2, 0x79, 0xc2, 0xed, 0xe2, 0xd7, 0xc3, 0xac, 0x1e, 0xcd, 0xbf, 0x70, 0x5a, 0x1a,
+	0x47, 0xbf, 0x31, 0xf8, 0x30, 0x8c, 0xe6, 0xd1, 0xc4, 0x99, 0xe7, 0xd1, 0x60, 0x76, 0x4c, 0x9e,
+	0x61, 0x76, 0x8c, 0xda, 0x4f, 0xea, 0x1b, 0xb7, 0x9f, 0xd1, 0xb3, 0xb7, 0x9f, 0xa0, 0x23, 0x8e,
+	0x9d, 0xa1, 0x23, 0x56, 0xd0, 0xf4, 0xb6, 0x63, 0xb7, 0xe1, 0xfa, 0xc0, 0x76, 0x74, 0xe7, 0x40,
+	0x1d, 0x8f, 0x5a, 0x34, 0xd7, 0xd4, 0x02, 0x45, 0xd8, 0xa2, 0x63, 0x28, 0xa1, 0x71, 0x56, 0xbc,
+	0xf7, 0xa5, 0xbf, 0x59, 0xef, 0xc3, 0x77, 0x51, 0x5a, 0x0c, 0x03, 0x96, 0x0d, 0x13, 0xe9, 0x68,
+	0xfe, 0x79, 0x5e, 0xa4, 0x80, 0x95, 0xed, 0xb0, 0x48, 0x7d, 0x39, 0xfc, 0xd9, 0x01, 0x81, 0xfc,
+	0x51, 0x41, 0x69, 0xca, 0xdc, 0x8e, 0x6d, 0xb9, 0xec, 0xdb, 0x26, 0xc1, 0x3c, 0x4a, 0x19, 0x7a,
+	0x57, 0x57, 0x13, 0x51, 0xf4, 0xb8, 0x1c, 0x46, 0x8f, 0x0b, 0x84, 0x02, 0x86, 0xdf, 0x45, 0xa9,
+	0x86, 0x6d, 0x88, 0xcd, 0x9f, 0x96, 0x87, 0xd6, 0xa2, 0xe3, 0xd8, 0xce, 0x92, 0x6d, 0xf8, 0x13,
+	0x19, 0x27, 0x85, 0x0e, 0xb8, 0x40, 0x28, 0x60, 0xe4, 0x0f, 0x0a, 0xca, 0x14, 0xec, 0x3d, 0xab,
+	0x65, 0xeb, 0xc6, 0x86, 0x63, 0x37, 0xf9, 0x97, 0xfd, 0xb7, 0xfa, 0x2c, 0xaa, 0xa3, 0xf1, 0x1e,
+	0x7c, 0x54, 0x05, 0x1f, 0x46, 0x37, 0xe2, 0x13, 0xe2, 0xf1, 0x97, 0x88, 0x2f, 0xb0, 0xe8, 0x0e,
+	0xc6, 0x37, 0x0e, 0xfd, 0x0b, 0x99, 0xd0, 0x40, 0x41, 0x7e, 0x97, 0x44, 0xd9, 0xd3, 0x1d, 0xe1,
+	0x36, 0x9a, 0x14, 0xcc, 0xba, 0x74, 0xdb, 0x39, 0x77, 0x96, 0x35, 0xc0, 0xdc, 0x0a, 0xf3, 0x52,
+	0x2f, 0x94, 0xc3, 0x79, 0x29, 0x82, 0x08, 0x95, 0xf4, 0xdf, 0xe8, 0x0a, 0x47, 0xfa, 0xca, 0x49,
+	0x7e, 0xf7, 0xaf, 0x9c, 0x2a, 0x3a, 0x27, 0x52, 0x34, 0xb8, 0x6b, 0xe3, 0x37, 0xcc, 0xa3, 0xf9,
+	0x9b, 0xbc, 0x67, 0x6d, 0x89, 0xa1, 0x24, 0xb8, 0x65, 0x9b, 0x89, 0x92, 0x55, 0x80, 0x41, 0xb6,
+	0x65, 0x46, 0x68, 0x8c, 0x8b, 0x97, 0x63, 0x43, 0xb0, 0x28, 0xf5, 0xef, 0x9d, 0x71, 0xe8, 0x95,
+	0x86, 0x5c, 0x32, 0x86, 0x52, 0x1b, 0xa6, 0xd5, 0x24, 0x6f, 0xa3, 0xd1, 0xa5, 0x96, 0xed, 0x42,
+	0xc7, 0x71, 0x98, 0xee, 0xda, 0x96, 0x9c, 0x4a, 0x02, 0x09, 0xb7, 0x5a, 0x88, 0x84, 0xfa, 0xf8,
+	0xfc, 0xe7, 0x49, 0x34, 0x29, 0x5d, 0x4e, 0xe3, 0x1f, 0xa2, 0xab, 0xeb, 0xc5, 0x6a, 0x75, 0x71,
+	0xa5, 0x58, 0xaf, 0x3d, 0xd8, 0x28, 0xd6, 0x97, 0xd6, 0x36, 0xab, 0xb5, 0x22, 0xad, 0x2f, 0x55,
+	0xca, 0xcb, 0xa5, 0x95, 0xcc, 0x48, 0xf6, 0xda, 0xe1, 0x51, 0x4e, 0x95, 0x2c, 0xe2, 0xd7, 0xc8,
+	0xdf, 0x47, 0x38, 0x66, 0x5e, 0x2a, 0x17, 0x8a, 0x1f, 0x66, 0x94, 0xec, 0x85, 0xc3, 0xa3, 0x5c,
+	0x46, 0xb2, 0x12, 0xb7, 0x13, 0x3f, 0x40, 0x57, 0x4e, 0xb2, 0xeb, 0x9b, 0x1b, 0x85, 0xc5, 0x5a,
+	0x31, 0x93, 0xc8, 0x66, 0x0f, 0x8f, 0x72, 0x97, 0x8e, 0x1b, 0xf9, 0x29, 0xf8, 0x0a, 0xba, 0x10,
+	0x33, 0xa5, 0xc5, 0x0f, 0x36, 0x8b, 0xd5, 0x5a, 0x26, 0x99, 0xbd, 0x74, 0x78, 0x94, 0xc3, 0x92,
+	0x55, 0x70, 0x4c, 0x2c, 0xa0, 0x8b, 0xc7, 0x2c, 0xaa, 0x1b, 0x95, 0x72, 0xb5, 0x98, 0x49, 0x65,
+	0x2f, 0x1f, 0x1e, 0xe5, 0xce, 0xc7, 0x4c, 0xfc, 0xae, 0xb2, 0x84, 0x66, 0x63, 0x36, 0x85, 0xca,
+	0xfd, 0xf2, 0x5a, 0x65, 0xb1, 0x50, 0xdf, 0xa0, 0x95, 0x15, 0x5a, 0xac, 0x56, 0x33, 0xa3, 0x59,
+	0xed, 0xf0, 0x28, 0x77, 0x55, 0x32, 0x3e, 0x51, 0xe1, 0xf3, 0x68, 0x26, 0xe6, 0x64, 0xa3, 0x54,
+	0x5e, 0xc9, 0x8c, 0x65, 0xcf, 0x1f, 0x1e, 0xe5, 0x9e, 0x93, 0xec, 0xf8, 0x5e, 0x9e, 0x88, 0xdf,
+	0xd2, 0x5a, 0xa5, 0x5a, 0xcc, 0x8c, 0x9f, 0x88, 0x1f, 0x6c, 0xf8, 0xfc, 0x57, 0x0a, 0xc2, 0x27,
+	0xff, 0x0f, 0xc0, 0x77, 0x90, 0x1a, 0x38, 0x59, 0xaa, 0xac, 0x6f, 0xf0, 0x75, 0x96, 0x2a, 0xe5,
+	0x7a, 0xb9, 0x52, 0x2e, 0x66, 0x46, 0x62, 0x51, 0x95, 0xac, 0xca, 0xb6, 0xc5, 0xff, 0xac, 0xb9,
+	0x3c, 0xcc, 0x72, 0xed, 0xe1, 0xed, 0x8c, 0x92, 0x5d, 0x38, 0x3c, 0xca, 0x5d, 0x3c, 0x69, 0xb8,
+	0xf6, 0xf0, 0xf6, 0x17, 0x9f, 0xbc, 0x30, 0x5c, 0x81, 0xe9, 0xf0, 0xa5, 0x3c, 0xac, 0xd6, 0x0a,
+	0x99, 0x44, 0xf6, 0xf6, 0xf0, 0xa5, 0x3c, 0x74, 0xbb, 0xc6, 0x17, 0x9f, 0xbc, 0x70, 0x8a, 0x66,
+	0x9e, 0x8f, 0x26, 0xf2, 0xcf, 0x7d, 0x15, 0x5d, 0x90, 0x7d, 0xaf, 0x17, 0x6b, 0x8b, 0x85, 0xc5,
+	0xda, 0x62, 0x66, 0x44, 0xec, 0xab, 0x44, 0x5d, 0x67, 0x5d, 0x1d, 0x5a, 0xf9, 0x4b, 0x68, 0x26,
+	0x16, 0x99, 0xe2, 0xbd, 0x22, 0x0d, 0xb2, 0x54, 0x8e, 0x09, 0xdb, 0x65, 0x0e, 0x7e, 0x19, 0x61,
+	0x99, 0xbc, 0xb8, 0x76, 0x7f, 0xf1, 0x41, 0x35, 0x93, 0xc8, 0x5e, 0x3c, 0x3c, 0xca, 0xcd, 0xc4,
+	0xfe, 0x61, 0xda, 0xd3, 0x0f, 0xdc, 0xf9, 0xbf, 0x29, 0xe8, 0xc2, 0xb0, 0xff, 0x9d, 0xf0, 0x26,
+	0xba, 0x12, 0xf7, 0xb3, 0x52, 0xa1, 0xa5, 0xda, 0xea, 0x3a, 0x84, 0x77, 0x24, 0xfb, 0xc6, 0xe1,
+	0x51, 0xee, 0xf2, 0x30, 0x43, 0x11, 0xe0, 0xd3, 0x54, 0xf8, 0x43, 0x94, 0x1d, 0xee, 0x16, 0x82,
+	0xac, 0x64, 0xef, 0xf0, 0x82, 0x1d, 0x66, 0xec, 0x87, 0xf9, 0x54, 0xdd, 0xfc, 0x9f, 0x13, 0x68,
+	0x4a, 0xbe, 0x70, 0xc0, 0x2f, 0xa3, 0xf3, 0xcb, 0xa5, 0x35, 0x5e, 0xa7, 0xcb, 0x15, 0x91, 0x9f,
+	0x5c, 0xcc, 0x8c, 0x88, 0xc0, 0xc9, 0x54, 0xfe, 0x8c, 0xdf, 0x44, 0xea, 0x31, 0x7a, 0xa1, 0x44,
+	0x8b, 0x4b, 0xb5, 0x0a, 0x7d, 0x90, 0x51, 0xb2, 0x57, 0x78, 0x3a, 0xc9, 0x36, 0x05, 0xd3, 0x81,
+	0x06, 0x7d, 0x80, 0xef, 0xa2, 0xab, 0xc7, 0x0c, 0xab, 0x0f, 0xd6, 0xd7, 0x4a, 0xe5, 0xf7, 0xc5,
+	0xfb, 0x12, 0xd9, 0xeb, 0x3c, 0x56, 0xb2, 0x6d, 0x55, 0xdc, 0xe1, 0x70, 0x28, 0xad, 0xe0, 0x55,
+	0x94, 0x3b, 0xc5, 0x3e, 0x5a, 0x40, 0x32, 0x4b, 0x0e, 0x8f, 0x72, 0xd7, 0x86, 0x38, 0x09, 0xd7,
+	0x91, 0x56, 0xf0, 0x6b, 0xe8, 0xd2, 0x70, 0x4f, 0x41, 0xd7, 0x18, 0x62, 0x3f, 0xff, 0x77, 0x05,
+	0x4d, 0x84, 0x33, 0x01, 0x0f, 0x5a, 0x91, 0xd2, 0x0a, 0x6f, 0xa1, 0x85, 0x62, 0xbd, 0x5c, 0xa9,
+	0x83, 0x14, 0x04, 0x2d, 0xe4, 0x95, 0x6d, 0x78, 0xe4, 0x1d, 0x40, 0xa2, 0xaf, 0x14, 0xcb, 0x45,
+	0x5a, 0x5a, 0x0a, 0x72, 0x33, 0x64, 0xaf, 0x30, 0x8b, 0x39, 0x66, 0x03, 0xdf, 0x46, 0x97, 0xe3,
+	0xce, 0xab, 0x9b, 0x4b, 0xab, 0x41, 0x94, 0x60, 0x81, 0xd2, 0x0b, 0xaa, 0xbd, 0xc6, 0x0e, 0x6c,
+	0xcc, 0xeb, 0x31, 0xab, 0x52, 0xf9, 0xde, 0xe2, 0x5a, 0xa9, 0x20, 0xac, 0x92, 0x59, 0xf5, 0xf0,
+	0x28, 0x77, 0x21, 0xb4, 0xf2, 0x3f, 0xf3, 0xb9, 0xd9, 0xfc, 0x17, 0x0a, 0x9a, 0xfd, 0xfa, 0xa3,
+	0x1d, 0xdf, 0x47, 0x2f, 0x42, 0xbc, 0x4e, 0x34, 0x4a, 0xbf, 0xab, 0x8b, 0x18, 0x2e, 0x6e, 0x6c,
+	0x14, 0xcb, 0x85, 0xcc, 0x48, 0x76, 0xee, 0xf0, 0x28, 0x77, 0xe3, 0xeb, 0x5d, 0x2e, 0x76, 0x3a,
+	0xcc, 0x32, 0xce, 0xe8, 0x78, 0xb9, 0x42, 0x57, 0x8a, 0xb5, 0x8c, 0x72, 0x16, 0xc7, 0xcb, 0x36,
+	0xbf, 0xef, 0xcb, 0xaf, 0x3f, 0xfe, 0x72, 0x76, 0xe4, 0xc9, 0x97, 0xb3, 0x23, 0x8f, 0x9f, 0xce,
+	0x2a, 0x4f, 0x9e, 0xce, 0x2a, 0xbf, 0x7a, 0x36, 0x3b, 0xf2, 0xd9, 0xb3, 0x59, 0xe5, 0xc9, 0xb3,
+	0xd9, 0x91, 0x7f, 0x3c, 0x9b, 0x1d, 0x79, 0xf8, 0x52, 0xd3, 0xec, 0xee, 0xf4, 0xb6, 0x6e, 0x36,
+	0xec, 0xf6, 0x2d, 0xf7, 0xc0, 0x6a, 0x74, 0x77, 0x4c, 0xab, 0x29, 0x3d, 0xc9, 0x7f, 0xe3, 0x6f,
+	0x8d, 0xc1, 0xd3, 0x6b, 0xff, 0x1d, 0x00, 0x32, 0xbb, 0x77, 0x9d, 0xdd, 0x1f, 0x00, 0x00,
 }
 
 func (m *Hello) Marshal() (dAtA []byte, err error) {
@@ -1261,6 +1300,24 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompressionAlgorithms) > 0 {
+		dAtA2 := make([]byte, len(m.CompressionAlgorithms)*10)
+		var j1 int
+		for _, num := range m.CompressionAlgorithms {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBep(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ClientVersion) > 0 {
 		i -= len(m.ClientVersion)
 		copy(dAtA[i:], m.ClientVersion)
@@ -2374,6 +2431,13 @@ func (m *Hello) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBep(uint64(l))
 	}
+	if len(m.CompressionAlgorithms) > 0 {
+		l = 0
+		for _, e := range m.CompressionAlgorithms {
+			l += sovBep(uint64(e))
+		}
+		n += 1 + sovBep(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2966,6 +3030,75 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v CompressionAlgorithm
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CompressionAlgorithm(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompressionAlgorithms = append(m.CompressionAlgorithms, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBep
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBep
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.CompressionAlgorithms) == 0 {
+					m.CompressionAlgorithms = make([]CompressionAlgorithm, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CompressionAlgorithm
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBep
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CompressionAlgorithm(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CompressionAlgorithms = append(m.CompressionAlgorithms, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgorithms", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
@@ -90,6 +90,10 @@ func (f FileInfo) IsReceiveOnlyChanged() bool {
 	return f.LocalFlags&FlagLocalReceiveOnly != 0
 }
 
+func (f FileInfo) IsPlaceholder() bool {
+	return f.LocalFlags&FlagLocalPlaceholder != 0
+}
+
 func (f FileInfo) IsDirectory() bool {
 	return f.Type == FileInfoTypeDirectory
 }
@@ -336,6 +340,14 @@ func (f *FileInfo) SetUnsupported() {
 	f.setLocalFlags(FlagLocalUnsupported)
 }
 
+// SetPlaceholder marks the file as present on disk only as a placeholder.
+// Unlike the other local flags, size and blocks are retained so that the
+// contents can be fetched later.
+func (f *FileInfo) SetPlaceholder() {
+	f.RawInvalid = false
+	f.LocalFlags = FlagLocalPlaceholder
+}
+
 func (f *FileInfo) SetDeleted(by ShortID) {
 	f.ModifiedBy = by
 	f.Deleted = true
@@ -25,6 +25,36 @@ var compressionUnmarshal = map[string]Compression{
 	"always":   CompressionAlways,
 }
 
+var compressionAlgorithmMarshal = map[CompressionAlgorithm]string{
+	CompressionAlgorithmLZ4:  "lz4",
+	CompressionAlgorithmZstd: "zstd",
+}
+
+var compressionAlgorithmUnmarshal = map[string]CompressionAlgorithm{
+	"lz4":  CompressionAlgorithmLZ4,
+	"zstd": CompressionAlgorithmZstd,
+}
+
+// SupportedCompressionAlgorithms are the algorithms we can decompress, as
+// advertised in our Hello message.
+var SupportedCompressionAlgorithms = []CompressionAlgorithm{
+	CompressionAlgorithmLZ4,
+	CompressionAlgorithmZstd,
+}
+
+// NegotiateCompressionAlgorithm returns the algorithm to use for messages
+// sent to a device, given our preferred algorithm and the ones advertised
+// by the device. Devices that don't advertise any algorithms predate the
+// negotiation and only support LZ4.
+func NegotiateCompressionAlgorithm(preferred CompressionAlgorithm, remote []CompressionAlgorithm) CompressionAlgorithm {
+	for _, algo := range remote {
+		if algo == preferred {
+			return preferred
+		}
+	}
+	return CompressionAlgorithmLZ4
+}
+
 func (c Compression) GoString() string {
 	return fmt.Sprintf("%q", c.String())
 }
@@ -37,3 +67,16 @@ func (c *Compression) UnmarshalText(bs []byte) error {
 	*c = compressionUnmarshal[string(bs)]
 	return nil
 }
+
+func (c CompressionAlgorithm) GoString() string {
+	return fmt.Sprintf("%q", c.String())
+}
+
+func (c CompressionAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(compressionAlgorithmMarshal[c]), nil
+}
+
+func (c *CompressionAlgorithm) UnmarshalText(bs []byte) error {
+	*c = compressionAlgorithmUnmarshal[string(bs)]
+	return nil
+}
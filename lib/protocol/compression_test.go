@@ -47,3 +47,56 @@ func TestCompressionMarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCompressionAlgorithmMarshal(t *testing.T) {
+	for _, tc := range []struct {
+		s string
+		c CompressionAlgorithm
+	}{
+		{"lz4", CompressionAlgorithmLZ4},
+		{"zstd", CompressionAlgorithmZstd},
+	} {
+		bs, err := tc.c.MarshalText()
+		if err != nil {
+			t.Error(err)
+		}
+		if s := string(bs); s != tc.s {
+			t.Errorf("%d marshalled to %q, not %q", tc.c, s, tc.s)
+		}
+		var c CompressionAlgorithm
+		if err := c.UnmarshalText([]byte(tc.s)); err != nil {
+			t.Error(err)
+		}
+		if c != tc.c {
+			t.Errorf("%s unmarshalled to %d, not %d", tc.s, c, tc.c)
+		}
+	}
+
+	// Unknown algorithms fall back to LZ4
+	c := CompressionAlgorithmZstd
+	if err := c.UnmarshalText([]byte("whatever")); err != nil {
+		t.Error(err)
+	}
+	if c != CompressionAlgorithmLZ4 {
+		t.Errorf("whatever unmarshalled to %d, not %d", c, CompressionAlgorithmLZ4)
+	}
+}
+
+func TestNegotiateCompressionAlgorithm(t *testing.T) {
+	cases := []struct {
+		preferred CompressionAlgorithm
+		remote    []CompressionAlgorithm
+		expected  CompressionAlgorithm
+	}{
+		{CompressionAlgorithmZstd, SupportedCompressionAlgorithms, CompressionAlgorithmZstd},
+		{CompressionAlgorithmLZ4, SupportedCompressionAlgorithms, CompressionAlgorithmLZ4},
+		// Old peers don't advertise anything
+		{CompressionAlgorithmZstd, nil, CompressionAlgorithmLZ4},
+		{CompressionAlgorithmZstd, []CompressionAlgorithm{CompressionAlgorithmLZ4}, CompressionAlgorithmLZ4},
+	}
+	for _, tc := range cases {
+		if res := NegotiateCompressionAlgorithm(tc.preferred, tc.remote); res != tc.expected {
+			t.Errorf("NegotiateCompressionAlgorithm(%v, %v) = %v, expected %v", tc.preferred, tc.remote, res, tc.expected)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	lz4 "github.com/pierrec/lz4/v4"
 	"github.com/pkg/errors"
 )
@@ -67,6 +68,13 @@ var (
 	errNotCompressible = errors.New("not compressible")
 )
 
+// The zstd encoder and decoder are safe for concurrent use and shared
+// between all connections. Options are valid so there are no errors.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxMessageLen))
+)
+
 func init() {
 	for blockSize := MinBlockSize; blockSize <= MaxBlockSize; blockSize *= 2 {
 		BlockSizes = append(BlockSizes, blockSize)
@@ -100,16 +108,17 @@ const (
 	FlagLocalIgnored     = 1 << 1 // Matches local ignore patterns
 	FlagLocalMustRescan  = 1 << 2 // Doesn't match content on disk, must be rechecked fully
 	FlagLocalReceiveOnly = 1 << 3 // Change detected on receive only folder
+	FlagLocalPlaceholder = 1 << 4 // Only a placeholder on disk, contents not fetched
 
 	// Flags that should result in the Invalid bit on outgoing updates
-	LocalInvalidFlags = FlagLocalUnsupported | FlagLocalIgnored | FlagLocalMustRescan | FlagLocalReceiveOnly
+	LocalInvalidFlags = FlagLocalUnsupported | FlagLocalIgnored | FlagLocalMustRescan | FlagLocalReceiveOnly | FlagLocalPlaceholder
 
 	// Flags that should result in a file being in conflict with its
 	// successor, due to us not having an up to date picture of its state on
 	// disk.
 	LocalConflictFlags = FlagLocalUnsupported | FlagLocalIgnored | FlagLocalReceiveOnly
 
-	LocalAllFlags = FlagLocalUnsupported | FlagLocalIgnored | FlagLocalMustRescan | FlagLocalReceiveOnly
+	LocalAllFlags = FlagLocalUnsupported | FlagLocalIgnored | FlagLocalMustRescan | FlagLocalReceiveOnly | FlagLocalPlaceholder
 )
 
 var (
@@ -195,6 +204,7 @@ type rawConnection struct {
 	closeOnce             sync.Once
 	sendCloseOnce         sync.Once
 	compression           Compression
+	compressionAlgorithm  CompressionAlgorithm
 
 	loopWG sync.WaitGroup // Need to ensure no leftover routines in testing
 }
@@ -230,7 +240,7 @@ const (
 // Should not be modified in production code, just for testing.
 var CloseTimeout = 10 * time.Second
 
-func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, passwords map[string]string) Connection {
+func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, compressAlgo CompressionAlgorithm, passwords map[string]string) Connection {
 	// Encryption / decryption is first (outermost) before conversion to
 	// native path formats.
 	nm := makeNative(receiver)
@@ -238,14 +248,14 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 
 	// We do the wire format conversion first (outermost) so that the
 	// metadata is in wire format when it reaches the encryption step.
-	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress)
+	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress, compressAlgo)
 	ec := encryptedConnection{ConnectionInfo: rc, conn: rc, folderKeys: em.folderKeys}
 	wc := wireFormatConnection{ec}
 
 	return wc
 }
 
-func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression) *rawConnection {
+func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, compressAlgo CompressionAlgorithm) *rawConnection {
 	cr := &countingReader{Reader: reader}
 	cw := &countingWriter{Writer: writer}
 
@@ -264,6 +274,7 @@ func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, clo
 		dispatcherLoopStopped: make(chan struct{}),
 		closed:                make(chan struct{}),
 		compression:           compress,
+		compressionAlgorithm:  compressAlgo,
 		loopWG:                sync.WaitGroup{},
 	}
 }
@@ -530,6 +541,14 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 		}
 		buf = decomp
 
+	case MessageCompressionZstd:
+		decomp, err := zstdDecompress(buf)
+		BufferPool.Put(buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing message")
+		}
+		buf = decomp
+
 	default:
 		return nil, fmt.Errorf("unknown message compression %d", hdr.Compression)
 	}
@@ -794,6 +813,11 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 		Type:        typeOf(msg),
 		Compression: MessageCompressionLZ4,
 	}
+	compress := lz4Compress
+	if c.compressionAlgorithm == CompressionAlgorithmZstd {
+		hdr.Compression = MessageCompressionZstd
+		compress = zstdCompress
+	}
 	hdrSize := hdr.ProtoSize()
 	if hdrSize > 1<<16-1 {
 		panic("impossibly large header")
@@ -807,7 +831,7 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 	buf := BufferPool.Get(maxCompressed)
 	defer BufferPool.Put(buf)
 
-	compressedSize, err := lz4Compress(marshaled, buf[cOverhead:])
+	compressedSize, err := compress(marshaled, buf[cOverhead:])
 	totSize := compressedSize + cOverhead
 	if err != nil {
 		return false, nil
@@ -1039,6 +1063,45 @@ func lz4Decompress(src []byte) ([]byte, error) {
 	return buf[:n], nil
 }
 
+func zstdCompress(src, buf []byte) (int, error) {
+	// Like LZ4, the compressed frame is prefixed by the size of the
+	// uncompressed data so that the receiver can get a buffer of the right
+	// size up front.
+	binary.BigEndian.PutUint32(buf, uint32(len(src)))
+
+	// EncodeAll appends to the given slice, reallocating it if the result
+	// doesn't fit in the buffer.
+	out := zstdEncoder.EncodeAll(src, buf[4:4])
+	if len(out) > len(buf)-4 {
+		return -1, errNotCompressible
+	}
+
+	return len(out) + 4, nil
+}
+
+func zstdDecompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, errors.New("short zstd message")
+	}
+	size := binary.BigEndian.Uint32(src)
+	if size > MaxMessageLen {
+		return nil, fmt.Errorf("decompressed size %d exceeds maximum %d", size, MaxMessageLen)
+	}
+	buf := BufferPool.Get(int(size))
+
+	out, err := zstdDecoder.DecodeAll(src[4:], buf[:0])
+	if err != nil {
+		BufferPool.Put(buf)
+		return nil, err
+	}
+	if len(out) != int(size) {
+		BufferPool.Put(buf)
+		return nil, fmt.Errorf("decompressed size %d does not match expected %d", len(out), size)
+	}
+
+	return out, nil
+}
+
 func newProtocolError(err error, msgContext string) error {
 	return fmt.Errorf("protocol error on %v: %w", msgContext, err)
 }
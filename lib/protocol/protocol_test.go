@@ -32,10 +32,10 @@ func TestPing(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil))
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -58,10 +58,10 @@ func TestClose(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, nil)
+	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -103,7 +103,7 @@ func TestCloseOnBlockingSend(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -154,10 +154,10 @@ func TestCloseRace(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, CompressionAlgorithmLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, nil)
+	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, CompressionAlgorithmLZ4, nil)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -194,7 +194,7 @@ func TestClusterConfigFirst(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -246,7 +246,7 @@ func TestCloseTimeout(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -441,37 +441,46 @@ func testMarshal(t *testing.T, prefix string, m1, m2 message) bool {
 }
 
 func TestWriteCompressed(t *testing.T) {
-	for _, random := range []bool{false, true} {
-		buf := new(bytes.Buffer)
-		c := &rawConnection{
-			cr:          &countingReader{Reader: buf},
-			cw:          &countingWriter{Writer: buf},
-			compression: CompressionAlways,
+	for _, algo := range []CompressionAlgorithm{CompressionAlgorithmLZ4, CompressionAlgorithmZstd} {
+		for _, random := range []bool{false, true} {
+			testWriteCompressed(t, algo, random)
 		}
+	}
+}
 
-		msg := &Response{Data: make([]byte, 10240)}
-		if random {
-			// This should make the message uncompressible.
-			rand.Read(msg.Data)
-		}
+func testWriteCompressed(t *testing.T, algo CompressionAlgorithm, random bool) {
+	t.Helper()
 
-		if err := c.writeMessage(msg); err != nil {
-			t.Fatal(err)
-		}
-		got, err := c.readMessage(make([]byte, 4))
-		if err != nil {
-			t.Fatal(err)
-		}
-		if !bytes.Equal(got.(*Response).Data, msg.Data) {
-			t.Error("received the wrong message")
-		}
+	buf := new(bytes.Buffer)
+	c := &rawConnection{
+		cr:                   &countingReader{Reader: buf},
+		cw:                   &countingWriter{Writer: buf},
+		compression:          CompressionAlways,
+		compressionAlgorithm: algo,
+	}
 
-		hdr := Header{Type: typeOf(msg)}
-		size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
-		if c.cr.tot > size {
-			t.Errorf("compression enlarged message from %d to %d",
-				size, c.cr.tot)
-		}
+	msg := &Response{Data: make([]byte, 10240)}
+	if random {
+		// This should make the message uncompressible.
+		rand.Read(msg.Data)
+	}
+
+	if err := c.writeMessage(msg); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.readMessage(make([]byte, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.(*Response).Data, msg.Data) {
+		t.Error("received the wrong message")
+	}
+
+	hdr := Header{Type: typeOf(msg)}
+	size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
+	if c.cr.tot > size {
+		t.Errorf("compression enlarged message from %d to %d",
+			size, c.cr.tot)
 	}
 }
 
@@ -506,6 +515,40 @@ func TestLZ4Compression(t *testing.T) {
 	}
 }
 
+func TestZstdCompression(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		dataLen := 150 + rand.Intn(150)
+		data := make([]byte, dataLen)
+		_, err := io.ReadFull(rand.Reader, data[100:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		comp := make([]byte, 4+dataLen)
+		compLen, err := zstdCompress(data, comp)
+		if err != nil {
+			t.Errorf("compressing %d bytes: %v", dataLen, err)
+			continue
+		}
+
+		res, err := zstdDecompress(comp[:compLen])
+		if err != nil {
+			t.Errorf("decompressing %d bytes to %d: %v", compLen, dataLen, err)
+			continue
+		}
+		if !bytes.Equal(data, res) {
+			t.Error("Incorrect decompressed data")
+		}
+	}
+
+	// Data that doesn't fit in the buffer once compressed is rejected
+	data := make([]byte, 1000)
+	rand.Read(data)
+	if _, err := zstdCompress(data, make([]byte, 500)); err != errNotCompressible {
+		t.Error("Expected uncompressible data to be rejected, got", err)
+	}
+}
+
 func TestLZ4CompressionUpdate(t *testing.T) {
 	uncompressed := []byte("this is some arbitrary yet fairly compressible data")
 
@@ -899,7 +942,7 @@ func TestClusterConfigAfterClose(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -923,7 +966,7 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	// the model callbacks (ClusterConfig).
 	m := newTestModel()
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, CompressionAlgorithmLZ4, nil))
 	m.ccFn = func(devID DeviceID, cc ClusterConfig) {
 		c.Close(errManual)
 	}
@@ -230,6 +230,14 @@ func (w *walker) scan(ctx context.Context, toHashChan chan<- protocol.FileInfo,
 				l.Debugf("%v: Skip walking %v as it is below a symlink", w, sub)
 				continue
 			}
+			// The ignore files of the directories above apply too.
+			if csub, err := fs.Canonicalize(sub); err == nil {
+				for dir := filepath.Dir(csub); dir != "."; dir = filepath.Dir(dir) {
+					if err := w.Matcher.LoadNested(dir); err != nil {
+						handleError(ctx, "loading ignores", dir, err, finishedChan)
+					}
+				}
+			}
 			w.Filesystem.Walk(sub, hashFiles)
 		}
 	}
@@ -274,7 +282,11 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 			return skip
 		}
 
-		if w.Matcher.Match(path).IsIgnored() {
+		var attrs ignore.AttributesFunc
+		if err == nil {
+			attrs = ignore.InfoAttributes(info)
+		}
+		if w.Matcher.MatchFile(path, attrs).IsIgnored() {
 			l.Debugln(w, "ignored (patterns):", path)
 			// Only descend if matcher says so and the current file is not a symlink.
 			if err != nil || w.Matcher.SkipIgnoredDirs() || info.IsSymlink() {
@@ -300,6 +312,14 @@ func (w *walker) walkAndHashFiles(ctx context.Context, toHashChan chan<- protoco
 			return nil
 		}
 
+		if info.IsDir() {
+			if err := w.Matcher.LoadNested(path); err != nil {
+				// Don't pick up anything that might be meant to be ignored.
+				handleError(ctx, "loading ignores", path, err, finishedChan)
+				return skip
+			}
+		}
+
 		if ignoredParent == "" {
 			// parent isn't ignored, nothing special
 			return w.handleItem(ctx, path, info, toHashChan, finishedChan, skip)
@@ -368,6 +388,15 @@ func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo,
 func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo) error {
 	curFile, hasCurFile := w.CurrentFiler.CurrentFile(relPath)
 
+	// Placeholders stand in for contents that were never fetched, which
+	// is not a change to announce. That goes for placeholders that were
+	// merely touched as well; announcing them would replace the real
+	// contents everywhere with nothing.
+	if fs.IsPlaceholder(w.Filesystem, relPath, info) || (hasCurFile && curFile.IsPlaceholder() && info.IsRegular() && info.Size() == 0) {
+		l.Debugln(w, "placeholder:", relPath)
+		return nil
+	}
+
 	blockSize := protocol.BlockSize(info.Size())
 
 	if hasCurFile {
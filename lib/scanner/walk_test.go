@@ -19,6 +19,7 @@ import (
 	"sort"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 	"github.com/syncthing/syncthing/lib/build"
@@ -979,6 +980,165 @@ func TestIncludedSubdir(t *testing.T) {
 	}
 }
 
+func TestWalkNestedIgnores(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, "?content=true")
+
+	files := map[string]string{
+		".stignore":         "*.tmp\n",
+		"a.log":             "",
+		"a.tmp":             "",
+		"sub/.stignore":     "*.log\n!keep.tmp\n",
+		"sub/b.log":         "",
+		"sub/b.tmp":         "",
+		"sub/keep.tmp":      "",
+		"sub/deeper/c.log":  "",
+		"other/d.log":       "",
+		"other/sub/e.log":   "",
+		"other/sub/f.other": "",
+	}
+	for _, dir := range []string{"sub/deeper", "other/sub"} {
+		if err := fss.MkdirAll(dir, 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, content := range files {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fd.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+	}
+
+	pats := ignore.New(fss, ignore.WithNestedIgnores(true))
+	if err := pats.Load(".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	var found []string
+	for _, f := range walkDir(fss, ".", nil, pats, 0) {
+		if !f.IsDirectory() {
+			found = append(found, filepath.ToSlash(f.Name))
+		}
+	}
+	// The root .stignore is internal, nested ones are synced like any
+	// other file.
+	expected := []string{
+		"a.log",
+		"other/d.log",
+		"other/sub/e.log",
+		"other/sub/f.other",
+		"sub/.stignore",
+		"sub/keep.tmp",
+	}
+	if fmt.Sprint(found) != fmt.Sprint(expected) {
+		t.Errorf("found %v, expected %v", found, expected)
+	}
+}
+
+func TestWalkIgnorePredicates(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, "TestWalkIgnorePredicates?content=true")
+
+	files := map[string]string{
+		"small":     "x",
+		"large":     "xxxxxxxxxxxxxxxxxxxx",
+		"old":       "x",
+		"dir/small": "x",
+		"dir/large": "xxxxxxxxxxxxxxxxxxxx",
+	}
+	if err := fss.MkdirAll("dir", 0777); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fd.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+	}
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	if err := fss.Chtimes("old", old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	pats := ignore.New(fss)
+	if err := pats.Parse(bytes.NewBufferString("(?size>10)\n(?mtime<30d)\n"), ".stignore"); err != nil {
+		t.Fatal(err)
+	}
+
+	var found []string
+	for _, f := range walkDir(fss, ".", nil, pats, 0) {
+		found = append(found, filepath.ToSlash(f.Name))
+	}
+	expected := []string{"dir", "dir/small", "small"}
+	if fmt.Sprint(found) != fmt.Sprint(expected) {
+		t.Errorf("found %v, expected %v", found, expected)
+	}
+}
+
+func TestWalkPlaceholders(t *testing.T) {
+	fss := fs.NewFilesystem(fs.FilesystemTypeFake, "TestWalkPlaceholders?content=true")
+
+	modTime := time.Unix(1234567890, 0)
+	for _, name := range []string{"placeholder", "empty", "touched"} {
+		fd, err := fss.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fd.Close()
+		if err := fss.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{"placeholder", "touched"} {
+		if err := fs.WritePlaceholder(fss, name, fs.Placeholder{Size: 1000, ModTime: modTime}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	later := modTime.Add(time.Hour)
+	if err := fss.Chtimes("touched", later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	var found []string
+	for _, f := range walkDir(fss, ".", nil, nil, 0) {
+		found = append(found, filepath.ToSlash(f.Name))
+	}
+	expected := []string{"empty", "touched"}
+	if fmt.Sprint(found) != fmt.Sprint(expected) {
+		t.Errorf("found %v, expected %v", found, expected)
+	}
+
+	// Once it's in the database as a placeholder, a touched placeholder is
+	// still one, unless it gained contents.
+	current := make(fakeCurrentFiler)
+	for _, name := range []string{"placeholder", "touched", "written"} {
+		f := protocol.FileInfo{Name: name, Type: protocol.FileInfoTypeFile, Size: 1000, ModifiedS: modTime.Unix(), Version: protocol.Vector{}.Update(1)}
+		f.SetPlaceholder()
+		current[name] = f
+	}
+	fd, err := fss.Create("written")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Write([]byte("data"))
+	fd.Close()
+
+	found = nil
+	for _, f := range walkDir(fss, ".", current, nil, 0) {
+		found = append(found, filepath.ToSlash(f.Name))
+	}
+	expected = []string{"empty", "written"}
+	if fmt.Sprint(found) != fmt.Sprint(expected) {
+		t.Errorf("found %v, expected %v", found, expected)
+	}
+}
+
 // Verify returns nil or an error describing the mismatch between the block
 // list and actual reader contents
 func verify(r io.Reader, blocksize int, blocks []protocol.BlockInfo) error {
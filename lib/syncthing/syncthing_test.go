@@ -8,12 +8,14 @@ package syncthing
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db/backend"
 	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/locations"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/svcutil"
 	"github.com/syncthing/syncthing/lib/tlsutil"
@@ -109,3 +111,57 @@ func TestStartupFail(t *testing.T) {
 		t.Error("Expected error due to db being closed, got", err)
 	}
 }
+
+func TestDBBackendSelection(t *testing.T) {
+	dir := t.TempDir()
+	for loc, name := range map[locations.LocationEnum]string{
+		locations.ConfigFile:     "config.xml",
+		locations.Database:       "index-v0.14.0.db",
+		locations.DatabasePebble: "index-pebble.db",
+	} {
+		loc, prev := loc, locations.Get(loc)
+		if err := locations.Set(loc, filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { locations.Set(loc, prev) })
+	}
+
+	// Without a config file it's the default.
+	if typ, err := ConfiguredDBBackend(); err != nil || typ != config.DatabaseBackendLevelDB {
+		t.Fatal("Unexpected backend", typ, err)
+	}
+
+	raw := config.New(protocol.LocalDeviceID)
+	raw.Options.DatabaseBackend = config.DatabaseBackendPebble
+	if err := config.Wrap(locations.Get(locations.ConfigFile), raw, protocol.LocalDeviceID, events.NoopLogger).Save(); err != nil {
+		t.Fatal(err)
+	}
+	typ, err := ConfiguredDBBackend()
+	if err != nil || typ != config.DatabaseBackendPebble {
+		t.Fatal("Unexpected backend", typ, err)
+	}
+
+	// A fresh start is fine.
+	if err := CheckDBBackend(typ); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+
+	// Only a database for the other backend is not.
+	if err := os.Mkdir(DBLocation(config.DatabaseBackendLevelDB), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckDBBackend(typ); err == nil {
+		t.Error("Should have gotten an error")
+	}
+	if err := CheckDBBackend(config.DatabaseBackendLevelDB); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+
+	// With both, the configured one is used.
+	if err := os.Mkdir(DBLocation(config.DatabaseBackendPebble), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckDBBackend(typ); err != nil {
+		t.Error("Unexpected error:", err)
+	}
+}
@@ -149,6 +149,50 @@ func copyFile(src, dst string) error {
 	return nil
 }
 
-func OpenDBBackend(path string, tuning config.Tuning) (backend.Backend, error) {
-	return backend.Open(path, backend.Tuning(tuning))
+func OpenDBBackend(path string, typ config.DatabaseBackend, tuning config.Tuning) (backend.Backend, error) {
+	return backend.Open(path, backend.Type(typ), backend.Tuning(tuning))
+}
+
+// DBLocation returns the location of the database for the given backend.
+func DBLocation(typ config.DatabaseBackend) string {
+	if typ == config.DatabaseBackendPebble {
+		return locations.Get(locations.DatabasePebble)
+	}
+	return locations.Get(locations.Database)
+}
+
+// ConfiguredDBBackend returns the database backend set in the config file,
+// for use before the config is loaded properly. Without a config file it's
+// the default backend.
+func ConfiguredDBBackend() (config.DatabaseBackend, error) {
+	fd, err := os.Open(locations.Get(locations.ConfigFile))
+	if os.IsNotExist(err) {
+		return config.New(protocol.EmptyDeviceID).Options.DatabaseBackend, nil
+	} else if err != nil {
+		return 0, err
+	}
+	defer fd.Close()
+	cfg, _, err := config.ReadXML(fd, protocol.EmptyDeviceID)
+	if err != nil {
+		return 0, err
+	}
+	return cfg.Options.DatabaseBackend, nil
+}
+
+// CheckDBBackend returns an error when there is no database for the given
+// backend but there is one for the other backend. Creating a new, empty
+// database in that case would silently discard the existing one, most
+// likely because the backend option was changed without migrating.
+func CheckDBBackend(typ config.DatabaseBackend) error {
+	other := config.DatabaseBackendPebble
+	if typ == config.DatabaseBackendPebble {
+		other = config.DatabaseBackendLevelDB
+	}
+	if _, err := os.Stat(DBLocation(typ)); !os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(DBLocation(other)); err != nil {
+		return nil
+	}
+	return fmt.Errorf("the database backend is set to %v, but there is only a %v database (%s); use the migrate-database command or set the backend option back to %v", typ, other, DBLocation(other), other)
 }
@@ -145,6 +145,14 @@ func (*verboseService) formatEvent(ev events.Event) string {
 		device := data["device"]
 		return fmt.Sprintf("Device %v was resumed", device)
 
+	case events.DeviceScheduleChanged:
+		data := ev.Data.(map[string]interface{})
+		device := data["device"]
+		if data["allowed"].(bool) {
+			return fmt.Sprintf("Device %v entered its scheduled window", device)
+		}
+		return fmt.Sprintf("Device %v left its scheduled window", device)
+
 	case events.ClusterConfigReceived:
 		data := ev.Data.(model.ClusterConfigReceivedEventData)
 		return fmt.Sprintf("Received ClusterConfig from device %v", data.Device)
@@ -0,0 +1,726 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/scanner"
+	"github.com/syncthing/syncthing/lib/sha256"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func init() {
+	// Register the constructor for this type of versioner
+	factories["dedup"] = newDedup
+}
+
+const (
+	dedupBlocksDir    = "blocks"
+	dedupManifestsDir = "manifests"
+)
+
+// The dedup versioner stores each archived version as a manifest listing
+// the hashes of its blocks, as computed by the scanner. The blocks
+// themselves are stored once in a content addressed block store in the
+// versions directory, so that unchanged blocks are shared between all
+// versions of a file (and between files). Blocks no longer referenced by
+// any manifest are garbage collected by Clean.
+type dedup struct {
+	folderFs     fs.Filesystem
+	versionsFs   fs.Filesystem
+	manifestsFs  fs.Filesystem
+	blocksFs     fs.Filesystem
+	keep         int
+	cleanoutDays int
+	maxSize      int64
+
+	// Held while archiving, restoring and cleaning, so that the garbage
+	// collector never removes a block that is about to be referenced.
+	mut sync.Mutex
+}
+
+// dedupManifest describes one archived version of a file.
+type dedupManifest struct {
+	ModTime     time.Time `json:"modTime"`
+	Size        int64     `json:"size"`
+	Permissions uint32    `json:"permissions"`
+	BlockSize   int       `json:"blockSize"`
+	Blocks      []string  `json:"blocks"` // hex encoded SHA-256 hashes
+}
+
+func newDedup(cfg config.FolderConfiguration) Versioner {
+	keep, _ := strconv.Atoi(cfg.Versioning.Params["keep"])
+	cleanoutDays, _ := strconv.Atoi(cfg.Versioning.Params["cleanoutDays"])
+	// On error we default to 0, "keep all versions" and "do not clean out"
+	maxSize, err := config.ParseSize(cfg.Versioning.Params["maxSize"])
+	if err != nil || maxSize.Percentage() {
+		l.Warnf("Folder %s: ignoring invalid maximum version archive size %q", cfg.Description(), cfg.Versioning.Params["maxSize"])
+		maxSize = config.Size{}
+	}
+
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	v := &dedup{
+		folderFs:     cfg.Filesystem(nil),
+		versionsFs:   versionsFs,
+		manifestsFs:  fs.NewFilesystem(versionsFs.Type(), filepath.Join(versionsFs.URI(), dedupManifestsDir)),
+		blocksFs:     fs.NewFilesystem(versionsFs.Type(), filepath.Join(versionsFs.URI(), dedupBlocksDir)),
+		keep:         keep,
+		cleanoutDays: cleanoutDays,
+		maxSize:      int64(maxSize.BaseValue()),
+		mut:          sync.NewMutex(),
+	}
+
+	l.Debugf("instantiated %#v", v)
+	return v
+}
+
+func (v *dedup) String() string {
+	return fmt.Sprintf("dedup@%p", v)
+}
+
+// Archive stores the named file in the block store and removes it. If this
+// function returns nil, the named file does not exist any more (has been
+// archived).
+func (v *dedup) Archive(filePath string) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	return v.archiveLocked(filePath, "")
+}
+
+// archiveLocked archives the file and cleans out old versions beyond the
+// configured number to keep, except for the version manifest named spare.
+func (v *dedup) archiveLocked(filePath, spare string) error {
+	filePath = osutil.NativeFilename(filePath)
+	info, err := v.folderFs.Lstat(filePath)
+	if fs.IsNotExist(err) {
+		l.Debugln("not archiving nonexistent file", filePath)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.IsSymlink() {
+		panic("bug: attempting to version a symlink")
+	}
+
+	if _, err := v.versionsFs.Stat("."); fs.IsNotExist(err) {
+		l.Debugln("creating versions dir")
+		if err := v.versionsFs.MkdirAll(".", 0755); err != nil {
+			return err
+		}
+		_ = v.versionsFs.Hide(".")
+	} else if err != nil {
+		return err
+	}
+
+	manifest, err := v.storeBlocks(filePath, info)
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	name := TagFilename(filePath, time.Now().Format(TimeFormat))
+	l.Debugln("archiving", filePath, "as", name)
+	if err := writeFileAtomic(v.manifestsFs, name, bs); err != nil {
+		return err
+	}
+	if err := v.folderFs.Remove(filePath); err != nil {
+		return err
+	}
+
+	if v.keep <= 0 {
+		return nil
+	}
+	// Versions are sorted by timestamp in the file name, oldest first.
+	versions := findAllVersions(v.manifestsFs, filePath)
+	for i, version := range versions {
+		if version == spare {
+			versions = append(versions[:i], versions[i+1:]...)
+			break
+		}
+	}
+	if len(versions) > v.keep {
+		for _, toRemove := range versions[:len(versions)-v.keep] {
+			l.Debugln("cleaning out", toRemove)
+			if err := v.manifestsFs.Remove(toRemove); err != nil {
+				l.Warnln("removing old version:", err)
+			}
+		}
+	}
+	return nil
+}
+
+// storeBlocks hashes the file and writes the blocks not already present to
+// the block store, returning the manifest describing the file.
+func (v *dedup) storeBlocks(filePath string, info fs.FileInfo) (dedupManifest, error) {
+	fd, err := v.folderFs.Open(filePath)
+	if err != nil {
+		return dedupManifest{}, err
+	}
+	defer fd.Close()
+
+	blockSize := protocol.BlockSize(info.Size())
+	blocks, err := scanner.Blocks(context.TODO(), fd, blockSize, info.Size(), nil, false)
+	if err != nil {
+		return dedupManifest{}, err
+	}
+
+	manifest := dedupManifest{
+		ModTime:     info.ModTime(),
+		Size:        info.Size(),
+		Permissions: uint32(info.Mode() & 0777),
+		BlockSize:   blockSize,
+		Blocks:      make([]string, len(blocks)),
+	}
+	buf := make([]byte, blockSize)
+	for i, block := range blocks {
+		hash := hex.EncodeToString(block.Hash)
+		manifest.Blocks[i] = hash
+
+		name, err := dedupBlockName(hash)
+		if err != nil {
+			return dedupManifest{}, err
+		}
+		if _, err := v.blocksFs.Lstat(name); err == nil {
+			continue
+		} else if !fs.IsNotExist(err) {
+			return dedupManifest{}, err
+		}
+
+		data := buf[:block.Size]
+		if _, err := fd.ReadAt(data, block.Offset); err != nil && err != io.EOF {
+			return dedupManifest{}, err
+		}
+		if !scanner.Validate(data, block.Hash, 0) {
+			return dedupManifest{}, fmt.Errorf("%s: file changed while archiving", filePath)
+		}
+		if err := writeFileAtomic(v.blocksFs, name, data); err != nil {
+			return dedupManifest{}, err
+		}
+	}
+	return manifest, nil
+}
+
+func (v *dedup) GetVersions() (map[string][]FileVersion, error) {
+	files := make(map[string][]FileVersion)
+	if _, err := v.manifestsFs.Lstat("."); fs.IsNotExist(err) {
+		return files, nil
+	}
+
+	err := v.manifestsFs.Walk(".", func(path string, f fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !f.IsRegular() || fs.IsTemporary(path) {
+			return nil
+		}
+
+		name, tag := UntagFilename(osutil.NormalizedFilename(path))
+		if name == "" || tag == "" {
+			return nil
+		}
+		versionTime, err := time.ParseInLocation(TimeFormat, tag, time.Local)
+		if err != nil {
+			// Can't parse it, welp, continue
+			return nil
+		}
+		manifest, err := v.readManifest(path)
+		if err != nil {
+			l.Debugln("reading manifest", path, err)
+			return nil
+		}
+
+		files[name] = append(files[name], FileVersion{
+			VersionTime: versionTime,
+			ModTime:     manifest.ModTime.Truncate(time.Second),
+			Size:        manifest.Size,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (v *dedup) Restore(filePath string, versionTime time.Time) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	filePath = osutil.NativeFilename(filePath)
+	tag := versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)
+	manifestName := TagFilename(filePath, tag)
+	manifest, err := v.readManifest(manifestName)
+	if fs.IsNotExist(err) {
+		return errNotFound
+	} else if err != nil {
+		return err
+	}
+
+	// If something already exists where we are restoring to, archive the
+	// existing file, remove it if it's a symlink, or fail if it's a
+	// directory.
+	if info, err := v.folderFs.Lstat(filePath); err == nil {
+		switch {
+		case info.IsDir():
+			return ErrDirectory
+		case info.IsSymlink():
+			if err := v.folderFs.Remove(filePath); err != nil {
+				return fmt.Errorf("removing existing symlink: %w", err)
+			}
+		case info.IsRegular():
+			// The version being restored must survive cleaning out old
+			// versions, as it is removed below once restored.
+			if err := v.archiveLocked(filePath, manifestName); err != nil {
+				return fmt.Errorf("archiving existing file: %w", err)
+			}
+		default:
+			panic("bug: unknown item type")
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	_ = v.folderFs.MkdirAll(filepath.Dir(filePath), 0755)
+	tempName := fs.TempName(filePath)
+	if err := v.assemble(tempName, manifest); err != nil {
+		_ = v.folderFs.Remove(tempName)
+		return err
+	}
+	if err := v.folderFs.Rename(tempName, filePath); err != nil {
+		_ = v.folderFs.Remove(tempName)
+		return err
+	}
+
+	// A restored version is no longer a version, same as for the other
+	// versioners. Its blocks are left for the garbage collector.
+	return v.manifestsFs.Remove(manifestName)
+}
+
+// OpenVersion returns a reader assembling the version from the block store.
+func (v *dedup) OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	filePath = osutil.NativeFilename(filePath)
+	tag := versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)
+	manifest, err := v.readManifest(TagFilename(filePath, tag))
+	if fs.IsNotExist(err) {
+		return nil, errNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &dedupVersionReader{blocksFs: v.blocksFs, manifest: manifest, cur: -1}, nil
+}
+
+// assemble writes the file described by the manifest to the given name in
+// the folder, verifying each block on the way.
+func (v *dedup) assemble(name string, manifest dedupManifest) error {
+	fd, err := v.folderFs.Create(name)
+	if err != nil {
+		return err
+	}
+	for _, hash := range manifest.Blocks {
+		blockName, err := dedupBlockName(hash)
+		if err != nil {
+			fd.Close()
+			return err
+		}
+		data, err := readVersionFile(v.blocksFs, blockName)
+		if err != nil {
+			fd.Close()
+			return fmt.Errorf("reading block %s: %w", hash, err)
+		}
+		expected, err := hex.DecodeString(hash)
+		if err != nil || !scanner.Validate(data, expected, 0) {
+			fd.Close()
+			return fmt.Errorf("block %s is corrupt", hash)
+		}
+		if _, err := fd.Write(data); err != nil {
+			fd.Close()
+			return err
+		}
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	if err := v.folderFs.Chmod(name, fs.FileMode(manifest.Permissions)); err != nil {
+		return err
+	}
+	return v.folderFs.Chtimes(name, manifest.ModTime, manifest.ModTime)
+}
+
+// Clean removes versions older than the configured number of days and the
+// oldest versions beyond the maximum size, and then garbage collects blocks
+// that are no longer referenced by any version.
+func (v *dedup) Clean(ctx context.Context) error {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+
+	if err := cleanByDay(ctx, v.manifestsFs, v.cleanoutDays); err != nil {
+		return err
+	}
+	if err := v.cleanBySize(ctx); err != nil {
+		return err
+	}
+	return v.collectGarbage(ctx)
+}
+
+func (v *dedup) quotas() Usage {
+	return Usage{
+		MaxVersions: v.keep,
+		MaxAgeS:     int64(v.cleanoutDays) * 24 * 60 * 60,
+		MaxSize:     v.maxSize,
+	}
+}
+
+// archiveSize returns the space taken by the manifests and the block
+// store, which is less than the sum of the version sizes when versions
+// share blocks.
+func (v *dedup) archiveSize() (int64, error) {
+	var size int64
+	for _, filesystem := range []fs.Filesystem{v.manifestsFs, v.blocksFs} {
+		if _, err := filesystem.Lstat("."); fs.IsNotExist(err) {
+			continue
+		}
+		err := filesystem.Walk(".", func(_ string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsRegular() {
+				size += info.Size()
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return size, nil
+}
+
+// cleanBySize removes the oldest versions until the manifests and the
+// blocks they reference fit within the maximum size. A block frees up space
+// only once the last version referencing it is gone.
+func (v *dedup) cleanBySize(ctx context.Context) error {
+	if v.maxSize <= 0 {
+		return nil
+	}
+	if _, err := v.manifestsFs.Lstat("."); fs.IsNotExist(err) {
+		return nil
+	}
+
+	type version struct {
+		path        string
+		versionTime time.Time
+		size        int64
+		blocks      map[string]struct{}
+	}
+	var versions []version
+	refs := make(map[string]int)
+	var total int64
+	err := v.manifestsFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if !info.IsRegular() || fs.IsTemporary(path) {
+			return nil
+		}
+		total += info.Size()
+
+		_, tag := UntagFilename(osutil.NormalizedFilename(path))
+		versionTime, err := time.ParseInLocation(TimeFormat, tag, time.Local)
+		if err != nil {
+			// Not a version we know how to handle, leave it alone.
+			return nil
+		}
+		bs, err := readVersionFile(v.manifestsFs, path)
+		if err != nil {
+			return err
+		}
+		var manifest dedupManifest
+		if err := json.Unmarshal(bs, &manifest); err != nil {
+			// Keeps no blocks alive, see collectGarbage.
+			return nil
+		}
+		blocks := make(map[string]struct{}, len(manifest.Blocks))
+		for _, hash := range manifest.Blocks {
+			if _, ok := blocks[hash]; !ok {
+				blocks[hash] = struct{}{}
+				refs[hash]++
+			}
+		}
+		versions = append(versions, version{path, versionTime, info.Size(), blocks})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	blockSizes := make(map[string]int64, len(refs))
+	for hash := range refs {
+		name, err := dedupBlockName(hash)
+		if err != nil {
+			continue
+		}
+		if info, err := v.blocksFs.Lstat(name); err == nil {
+			blockSizes[hash] = info.Size()
+			total += info.Size()
+		}
+	}
+
+	sort.SliceStable(versions, func(a, b int) bool {
+		return versions[a].versionTime.Before(versions[b].versionTime)
+	})
+	for _, version := range versions {
+		if total <= v.maxSize {
+			break
+		}
+		l.Debugln("cleaning out", version.path)
+		if err := v.manifestsFs.Remove(version.path); err != nil {
+			l.Warnln("removing old version:", err)
+			continue
+		}
+		total -= version.size
+		for hash := range version.blocks {
+			if refs[hash]--; refs[hash] == 0 {
+				total -= blockSizes[hash]
+			}
+		}
+	}
+	return nil
+}
+
+func (v *dedup) collectGarbage(ctx context.Context) error {
+	if _, err := v.blocksFs.Lstat("."); fs.IsNotExist(err) {
+		return nil
+	}
+
+	referenced := make(map[string]struct{})
+	if _, err := v.manifestsFs.Lstat("."); err == nil {
+		err := v.manifestsFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if !info.IsRegular() || fs.IsTemporary(path) {
+				return nil
+			}
+			bs, err := readVersionFile(v.manifestsFs, path)
+			if err != nil {
+				return err
+			}
+			var manifest dedupManifest
+			if err := json.Unmarshal(bs, &manifest); err != nil {
+				// A broken manifest can't be restored anyway, so it
+				// shouldn't keep its blocks alive.
+				l.Warnf("Ignoring corrupt version manifest %s: %v", path, err)
+				return nil
+			}
+			for _, hash := range manifest.Blocks {
+				referenced[hash] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else if !fs.IsNotExist(err) {
+		return err
+	}
+
+	dirTracker := make(emptyDirTracker)
+	removed := 0
+	err := v.blocksFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if info.IsDir() && !info.IsSymlink() {
+			dirTracker.addDir(path)
+			return nil
+		}
+		if _, ok := referenced[filepath.Base(path)]; ok {
+			dirTracker.addFile(path)
+			return nil
+		}
+		// Unreferenced blocks and any leftover temporary files
+		removed++
+		return v.blocksFs.Remove(path)
+	})
+	if err != nil {
+		return err
+	}
+	dirTracker.deleteEmptyDirs(v.blocksFs)
+
+	l.Debugf("%v: removed %d unreferenced blocks", v, removed)
+	return nil
+}
+
+func (v *dedup) readManifest(name string) (dedupManifest, error) {
+	var manifest dedupManifest
+	bs, err := readVersionFile(v.manifestsFs, name)
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(bs, &manifest); err != nil {
+		return manifest, err
+	}
+	return manifest, manifest.validate()
+}
+
+// validate checks that the manifest describes a file that its blocks can
+// actually make up, so that reading it can't go wrong in odd ways.
+func (m dedupManifest) validate() error {
+	if m.BlockSize <= 0 {
+		return fmt.Errorf("invalid block size %d in manifest", m.BlockSize)
+	}
+	if m.Size < 0 || (m.Size+int64(m.BlockSize)-1)/int64(m.BlockSize) > int64(len(m.Blocks)) {
+		return fmt.Errorf("%d blocks in manifest don't make up size %d", len(m.Blocks), m.Size)
+	}
+	return nil
+}
+
+// dedupBlockName returns the name of the block with the given hash in the
+// block store. Blocks are spread over subdirectories by the first byte of
+// the hash, to keep directory sizes manageable. Hashes that can't be valid,
+// as found in corrupt manifests, are an error.
+func dedupBlockName(hash string) (string, error) {
+	if len(hash) != 2*sha256.Size {
+		return "", fmt.Errorf("invalid block hash %q", hash)
+	}
+	return filepath.Join(hash[:2], hash), nil
+}
+
+func readVersionFile(filesystem fs.Filesystem, name string) ([]byte, error) {
+	fd, err := filesystem.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return io.ReadAll(fd)
+}
+
+// writeFileAtomic writes the data to a temporary file and renames it into
+// place, creating parent directories as required.
+func writeFileAtomic(filesystem fs.Filesystem, name string, data []byte) error {
+	if err := filesystem.MkdirAll(filepath.Dir(name), 0755); err != nil && !fs.IsExist(err) {
+		return err
+	}
+	tempName := fs.TempName(name)
+	fd, err := filesystem.Create(tempName)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		_ = filesystem.Remove(tempName)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		_ = filesystem.Remove(tempName)
+		return err
+	}
+	return filesystem.Rename(tempName, name)
+}
+
+// dedupVersionReader reads an archived version from the block store, one
+// block at a time.
+type dedupVersionReader struct {
+	blocksFs fs.Filesystem
+	manifest dedupManifest
+	offset   int64
+	cur      int    // index of the block in data, or -1
+	data     []byte // contents of the current block
+}
+
+func (r *dedupVersionReader) Read(p []byte) (int, error) {
+	if r.offset >= r.manifest.Size {
+		return 0, io.EOF
+	}
+	idx := int(r.offset / int64(r.manifest.BlockSize))
+	if idx != r.cur {
+		if err := r.load(idx); err != nil {
+			return 0, err
+		}
+	}
+	within := int(r.offset % int64(r.manifest.BlockSize))
+	if within >= len(r.data) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	n := copy(p, r.data[within:])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *dedupVersionReader) load(idx int) error {
+	if idx >= len(r.manifest.Blocks) {
+		return io.ErrUnexpectedEOF
+	}
+	hash := r.manifest.Blocks[idx]
+	blockName, err := dedupBlockName(hash)
+	if err != nil {
+		return err
+	}
+	data, err := readVersionFile(r.blocksFs, blockName)
+	if err != nil {
+		return fmt.Errorf("reading block %s: %w", hash, err)
+	}
+	expected, err := hex.DecodeString(hash)
+	if err != nil || !scanner.Validate(data, expected, 0) {
+		return fmt.Errorf("block %s is corrupt", hash)
+	}
+	r.cur, r.data = idx, data
+	return nil
+}
+
+func (r *dedupVersionReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.manifest.Size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+func (r *dedupVersionReader) Close() error {
+	r.data = nil
+	return nil
+}
@@ -0,0 +1,369 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+func TestDedupArchiveRestore(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type: "dedup",
+		},
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	// Two versions of a three block file that only differ in the last block
+	blockA := strings.Repeat("a", protocol.MinBlockSize)
+	blockB := strings.Repeat("b", protocol.MinBlockSize)
+	first := blockA + blockB + "first"
+	second := blockA + blockB + "second"
+
+	writeFile(t, folderFs, "file", first)
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := folderFs.Lstat("file"); !fs.IsNotExist(err) {
+		t.Fatal("file should have been archived, got", err)
+	}
+
+	// The version time is part of the name, with second precision
+	time.Sleep(time.Second)
+
+	writeFile(t, folderFs, "file", second)
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The shared blocks are stored once
+	if n := countFiles(t, v.blocksFs); n != 4 {
+		t.Errorf("expected 4 blocks in store, got %d", n)
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileVersions := versions["file"]
+	if len(fileVersions) != 2 {
+		t.Fatalf("expected two versions, got %d", len(fileVersions))
+	}
+	sort.Slice(fileVersions, func(a, b int) bool {
+		return fileVersions[a].VersionTime.Before(fileVersions[b].VersionTime)
+	})
+	if fileVersions[0].Size != int64(len(first)) {
+		t.Errorf("expected size %d, got %d", len(first), fileVersions[0].Size)
+	}
+
+	// Versions can be read straight from the block store
+	fd, err := OpenVersion(v, "file", fileVersions[1].VersionTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fd.Seek(int64(len(blockA)), io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := io.ReadAll(fd)
+	fd.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != blockB+"second" {
+		t.Errorf("read content mismatch, got %d bytes", len(bs))
+	}
+
+	// Restore the first version on top of a new file, which should then be
+	// archived in turn.
+	time.Sleep(time.Second)
+	writeFile(t, folderFs, "file", "current")
+	if err := v.Restore("file", fileVersions[0].VersionTime); err != nil {
+		t.Fatal(err)
+	}
+	if content := readFile(t, folderFs, "file"); content != first {
+		t.Errorf("restored content mismatch, got %d bytes", len(content))
+	}
+
+	versions, err = v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 2 {
+		t.Fatalf("expected two versions after restore, got %d", len(versions["file"]))
+	}
+
+	// The blocks only used by the restored version are garbage collected,
+	// those of the remaining versions are kept.
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := countFiles(t, v.blocksFs); n != 4 {
+		t.Errorf("expected 4 blocks after clean, got %d", n)
+	}
+	for _, version := range versions["file"] {
+		if version.Size == int64(len(second)) {
+			if err := v.Restore("file", version.VersionTime); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if content := readFile(t, folderFs, "file"); content != second {
+		t.Errorf("restored content mismatch, got %d bytes", len(content))
+	}
+}
+
+func TestDedupKeepAndCollectGarbage(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type:   "dedup",
+			Params: map[string]string{"keep": "1"},
+		},
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	writeFile(t, folderFs, "file", "first")
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Second)
+	writeFile(t, folderFs, "file", "second")
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 1 {
+		t.Fatalf("expected one version, got %d", len(versions["file"]))
+	}
+	if n := countFiles(t, v.blocksFs); n != 2 {
+		t.Errorf("expected 2 blocks before clean, got %d", n)
+	}
+
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := countFiles(t, v.blocksFs); n != 1 {
+		t.Errorf("expected 1 block after clean, got %d", n)
+	}
+}
+
+func TestDedupRestoreOverExistingWithKeep(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type:   "dedup",
+			Params: map[string]string{"keep": "1"},
+		},
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	writeFile(t, folderFs, "file", "first")
+	if err := v.Archive("file"); err != nil {
+		t.Fatal(err)
+	}
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 1 {
+		t.Fatalf("expected one version, got %d", len(versions["file"]))
+	}
+
+	// Archiving the current file would clean out the only version if that
+	// weren't the one being restored.
+	time.Sleep(time.Second)
+	writeFile(t, folderFs, "file", "current")
+	if err := v.Restore("file", versions["file"][0].VersionTime); err != nil {
+		t.Fatal(err)
+	}
+	if content := readFile(t, folderFs, "file"); content != "first" {
+		t.Errorf("restored content mismatch, got %q", content)
+	}
+	versions, err = v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions["file"]) != 1 || versions["file"][0].Size != int64(len("current")) {
+		t.Errorf("expected the previously current file as the only version, got %v", versions["file"])
+	}
+}
+
+func TestDedupCorruptManifest(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type: "dedup",
+		},
+	}
+	v := newDedup(cfg).(*dedup)
+
+	versionTime := time.Now().Truncate(time.Second)
+	name := TagFilename("file", versionTime.Format(TimeFormat))
+	manifest := `{"size":5,"blockSize":131072,"blocks":["a"]}`
+	if err := writeFileAtomic(v.manifestsFs, name, []byte(manifest)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := v.Restore("file", versionTime); err == nil {
+		t.Error("expected error restoring from corrupt manifest")
+	}
+	fd, err := OpenVersion(v, "file", versionTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	if _, err := io.ReadAll(fd); err == nil {
+		t.Error("expected error reading from corrupt manifest")
+	}
+}
+
+func TestDedupInvalidManifest(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type: "dedup",
+		},
+	}
+	v := newDedup(cfg).(*dedup)
+
+	hash := strings.Repeat("ab", sha256.Size)
+	versionTime := time.Now().Truncate(time.Second)
+	for _, manifest := range []string{
+		`{"size":5,"blockSize":0,"blocks":["` + hash + `"]}`,
+		`{"size":5,"blockSize":-1,"blocks":["` + hash + `"]}`,
+		`{"size":200000,"blockSize":131072,"blocks":["` + hash + `"]}`,
+		`{"size":-1,"blockSize":131072,"blocks":[]}`,
+	} {
+		name := TagFilename("file", versionTime.Format(TimeFormat))
+		if err := writeFileAtomic(v.manifestsFs, name, []byte(manifest)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := v.Restore("file", versionTime); err == nil {
+			t.Errorf("%s: expected error restoring", manifest)
+		}
+		fd, err := OpenVersion(v, "file", versionTime)
+		if err == nil {
+			_, err = io.ReadAll(fd)
+			fd.Close()
+		}
+		if err == nil {
+			t.Errorf("%s: expected error reading", manifest)
+		}
+	}
+}
+
+func countFiles(t *testing.T, filesystem fs.Filesystem) int {
+	t.Helper()
+	n := 0
+	err := filesystem.Walk(".", func(_ string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsRegular() {
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestDedupUsageAndMaxSize(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type:   "dedup",
+			Params: map[string]string{"keep": "5"},
+		},
+	}
+	folderFs := cfg.Filesystem(nil)
+	v := newDedup(cfg).(*dedup)
+
+	// Two files sharing their only block, and later a third one that
+	// doesn't.
+	shared := strings.Repeat("a", protocol.MinBlockSize)
+	writeFile(t, folderFs, "one", shared)
+	writeFile(t, folderFs, "two", shared)
+	for _, name := range []string{"one", "two"} {
+		if err := v.Archive(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(time.Second)
+	writeFile(t, folderFs, "three", "three")
+	if err := v.Archive("three"); err != nil {
+		t.Fatal(err)
+	}
+
+	size, err := v.archiveSize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage, err := ArchiveUsage(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Usage{Files: 3, Versions: 3, Size: size, MaxVersions: 5}
+	if usage != expected {
+		t.Errorf("unexpected usage %+v, expected %+v", usage, expected)
+	}
+	if logical := int64(2*protocol.MinBlockSize + 5); size >= logical {
+		t.Errorf("archive size %d should be less than the versions' %d", size, logical)
+	}
+
+	// Removing one of the two oldest versions frees just its manifest.
+	v.maxSize = size - 1
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if usage, err = ArchiveUsage(v); err != nil {
+		t.Fatal(err)
+	}
+	if usage.Versions != 2 || usage.Size > v.maxSize || countFiles(t, v.blocksFs) != 2 {
+		t.Errorf("unexpected usage after clean %+v", usage)
+	}
+
+	// The shared block goes with the other one.
+	v.maxSize = int64(protocol.MinBlockSize)
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	versions, err := v.GetVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || len(versions["three"]) != 1 || countFiles(t, v.blocksFs) != 1 {
+		t.Errorf("expected only the newest version to remain, got %v", versions)
+	}
+}
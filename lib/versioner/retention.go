@@ -0,0 +1,230 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+)
+
+func init() {
+	// Register the constructor for this type of versioner
+	factories["retention"] = newRetention
+}
+
+// The retention versioner stores versions like the simple versioner, and
+// applies a retention policy combining a maximum number of versions per
+// file, a maximum age and a maximum total size of the archive. Versions are
+// evicted oldest first until all limits are satisfied.
+type retention struct {
+	folderFs        fs.Filesystem
+	versionsFs      fs.Filesystem
+	policy          retentionPolicy
+	copyRangeMethod fs.CopyRangeMethod
+}
+
+// retentionPolicy holds the limits of the retention versioner; zero means
+// no limit.
+type retentionPolicy struct {
+	maxVersions int
+	maxAge      time.Duration
+	maxSize     int64
+}
+
+// archivedVersion is a version in the archive, as seen by the retention
+// policy.
+type archivedVersion struct {
+	path        string // of the version in the versions filesystem
+	name        string // of the original file
+	versionTime time.Time
+	size        int64
+}
+
+func newRetention(cfg config.FolderConfiguration) Versioner {
+	params := cfg.Versioning.Params
+	// On error we default to 0, "no limit"
+	maxVersions, _ := strconv.Atoi(params["maxVersions"])
+	maxAge, _ := strconv.ParseInt(params["maxAge"], 10, 64)
+	maxSize, err := config.ParseSize(params["maxSize"])
+	if err != nil || maxSize.Percentage() {
+		l.Warnf("Folder %s: ignoring invalid maximum version archive size %q", cfg.Description(), params["maxSize"])
+		maxSize = config.Size{}
+	}
+
+	v := &retention{
+		folderFs:   cfg.Filesystem(nil),
+		versionsFs: versionerFsFromFolderCfg(cfg),
+		policy: retentionPolicy{
+			maxVersions: maxVersions,
+			maxAge:      time.Duration(maxAge) * time.Second,
+			maxSize:     int64(maxSize.BaseValue()),
+		},
+		copyRangeMethod: cfg.CopyRangeMethod,
+	}
+
+	l.Debugf("instantiated %#v", v)
+	return v
+}
+
+func (v *retention) String() string {
+	return fmt.Sprintf("retention@%p", v)
+}
+
+// Archive moves the named file away to a version archive. If this function
+// returns nil, the named file does not exist any more (has been archived).
+func (v *retention) Archive(filePath string) error {
+	if err := archiveFile(v.copyRangeMethod, v.folderFs, v.versionsFs, filePath, TagFilename); err != nil {
+		return err
+	}
+
+	// The number of versions of this file is cheap to enforce right away;
+	// age and size are taken care of in Clean.
+	if v.policy.maxVersions <= 0 {
+		return nil
+	}
+	// Versions are sorted by timestamp in the file name, oldest first.
+	versions := findAllVersions(v.versionsFs, filePath)
+	if len(versions) > v.policy.maxVersions {
+		for _, toRemove := range versions[:len(versions)-v.policy.maxVersions] {
+			l.Debugln("cleaning out", toRemove)
+			if err := v.versionsFs.Remove(toRemove); err != nil {
+				l.Warnln("removing old version:", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (v *retention) GetVersions() (map[string][]FileVersion, error) {
+	return retrieveVersions(v.versionsFs)
+}
+
+func (v *retention) Restore(filepath string, versionTime time.Time) error {
+	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
+}
+
+func (v *retention) OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	return openVersion(v.versionsFs, filePath, versionTime, TagFilename)
+}
+
+func (v *retention) Clean(ctx context.Context) error {
+	l.Debugln("Versioner clean: Cleaning", v.versionsFs)
+
+	if _, err := v.versionsFs.Stat("."); fs.IsNotExist(err) {
+		// There is no need to clean a nonexistent dir.
+		return nil
+	}
+
+	var versions []archivedVersion
+	dirTracker := make(emptyDirTracker)
+	err := v.versionsFs.Walk(".", func(path string, f fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if f.IsDir() && !f.IsSymlink() {
+			dirTracker.addDir(path)
+			return nil
+		}
+
+		name, tag := UntagFilename(osutil.NormalizedFilename(path))
+		versionTime, err := time.ParseInLocation(TimeFormat, tag, time.Local)
+		if name == "" || err != nil {
+			// Not a version we know how to handle, leave it alone.
+			dirTracker.addFile(path)
+			return nil
+		}
+		versions = append(versions, archivedVersion{
+			path:        path,
+			name:        name,
+			versionTime: versionTime,
+			size:        f.Size(),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	evict := v.policy.evictions(versions, time.Now())
+	for _, version := range versions {
+		if _, ok := evict[version.path]; !ok {
+			dirTracker.addFile(version.path)
+			continue
+		}
+		l.Debugln("cleaning out", version.path)
+		if err := v.versionsFs.Remove(version.path); err != nil {
+			l.Warnln("removing old version:", err)
+			dirTracker.addFile(version.path)
+		}
+	}
+	dirTracker.deleteEmptyDirs(v.versionsFs)
+
+	l.Debugln("Cleaner: Finished cleaning", v.versionsFs)
+	return nil
+}
+
+func (v *retention) quotas() Usage {
+	return Usage{
+		MaxVersions: v.policy.maxVersions,
+		MaxAgeS:     int64(v.policy.maxAge / time.Second),
+		MaxSize:     v.policy.maxSize,
+	}
+}
+
+// evictions returns the set of version paths to remove for the policy to be
+// satisfied. The number of versions per file and the age are checked
+// first, then the oldest of the remaining versions are evicted until the
+// total size is within the limit.
+func (p retentionPolicy) evictions(versions []archivedVersion, now time.Time) map[string]struct{} {
+	sorted := make([]archivedVersion, len(versions))
+	copy(sorted, versions)
+	// Newest first, so that versions beyond the count are the oldest ones.
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].versionTime.After(sorted[b].versionTime)
+	})
+
+	evict := make(map[string]struct{})
+	counts := make(map[string]int)
+	var total int64
+	for _, version := range sorted {
+		counts[version.name]++
+		switch {
+		case p.maxVersions > 0 && counts[version.name] > p.maxVersions:
+			evict[version.path] = struct{}{}
+		case p.maxAge > 0 && now.Sub(version.versionTime) > p.maxAge:
+			evict[version.path] = struct{}{}
+		default:
+			total += version.size
+		}
+	}
+
+	if p.maxSize <= 0 {
+		return evict
+	}
+	for i := len(sorted) - 1; i >= 0 && total > p.maxSize; i-- {
+		if _, ok := evict[sorted[i].path]; ok {
+			continue
+		}
+		evict[sorted[i].path] = struct{}{}
+		total -= sorted[i].size
+	}
+	return evict
+}
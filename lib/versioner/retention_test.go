@@ -0,0 +1,115 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package versioner
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/d4l3k/messagediff"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+func TestRetentionEvictions(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.Local)
+	day := 24 * time.Hour
+	versions := []archivedVersion{
+		{path: "a~1", name: "a", versionTime: now.Add(-9 * day), size: 100},
+		{path: "a~2", name: "a", versionTime: now.Add(-5 * day), size: 100},
+		{path: "a~3", name: "a", versionTime: now.Add(-3 * day), size: 100},
+		{path: "a~4", name: "a", versionTime: now.Add(-1 * day), size: 100},
+		{path: "b~1", name: "b", versionTime: now.Add(-4 * day), size: 300},
+		{path: "b~2", name: "b", versionTime: now.Add(-2 * day), size: 300},
+	}
+
+	cases := []struct {
+		policy   retentionPolicy
+		expected []string
+	}{
+		{retentionPolicy{}, nil},
+		{retentionPolicy{maxVersions: 2}, []string{"a~1", "a~2"}},
+		{retentionPolicy{maxAge: 4*day + time.Hour}, []string{"a~1", "a~2"}},
+		// Oldest first, regardless of file, until within the size
+		{retentionPolicy{maxSize: 700}, []string{"a~1", "a~2", "b~1"}},
+		// The count frees up space first, so less needs to be evicted by size
+		{retentionPolicy{maxVersions: 3, maxSize: 800}, []string{"a~1", "a~2"}},
+		{retentionPolicy{maxVersions: 1, maxAge: 2 * day, maxSize: 100}, []string{"a~1", "a~2", "a~3", "b~1", "b~2"}},
+	}
+
+	for i, tc := range cases {
+		var res []string
+		for path := range tc.policy.evictions(versions, now) {
+			res = append(res, path)
+		}
+		sort.Strings(res)
+		if diff, equal := messagediff.PrettyDiff(tc.expected, res); !equal {
+			t.Errorf("case %d: unexpected evictions:\n%s", i, diff)
+		}
+	}
+}
+
+func TestRetentionClean(t *testing.T) {
+	cfg := config.FolderConfiguration{
+		FilesystemType: fs.FilesystemTypeBasic,
+		Path:           t.TempDir(),
+		Versioning: config.VersioningConfiguration{
+			Type: "retention",
+			Params: map[string]string{
+				"maxVersions": "5",
+				"maxSize":     "10 B",
+			},
+		},
+	}
+	v, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	versionsFs := versionerFsFromFolderCfg(cfg)
+	if err := versionsFs.MkdirAll(".", 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	for i, name := range []string{"file~20060102-150405", "file~20060102-150406", "other~20060102-150407.txt"} {
+		writeFile(t, versionsFs, name, "12345")
+		// Clean relies on the names, but the modification time shouldn't
+		// matter.
+		mtime := now.Add(time.Duration(-i) * time.Hour)
+		if err := versionsFs.Chtimes(name, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usage, err := ArchiveUsage(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Usage{Files: 2, Versions: 3, Size: 15, MaxVersions: 5, MaxSize: 10}
+	if usage != expected {
+		t.Errorf("unexpected usage before clean: %+v", usage)
+	}
+
+	if err := v.Clean(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := versionsFs.Lstat("file~20060102-150405"); !fs.IsNotExist(err) {
+		t.Error("oldest version should have been removed")
+	}
+	usage, err = ArchiveUsage(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = Usage{Files: 2, Versions: 2, Size: 10, MaxVersions: 5, MaxSize: 10}
+	if usage != expected {
+		t.Errorf("unexpected usage after clean: %+v", usage)
+	}
+}
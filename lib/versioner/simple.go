@@ -8,6 +8,7 @@ package versioner
 
 import (
 	"context"
+	"io"
 	"strconv"
 	"time"
 
@@ -80,6 +81,10 @@ func (v simple) Restore(filepath string, versionTime time.Time) error {
 	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
 }
 
+func (v simple) OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	return openVersion(v.versionsFs, filePath, versionTime, TagFilename)
+}
+
 func (v simple) Clean(ctx context.Context) error {
 	return cleanByDay(ctx, v.versionsFs, v.cleanoutDays)
 }
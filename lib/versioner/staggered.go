@@ -9,6 +9,7 @@ package versioner
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
 	"time"
@@ -205,6 +206,10 @@ func (v *staggered) Restore(filepath string, versionTime time.Time) error {
 	return restoreFile(v.copyRangeMethod, v.versionsFs, v.folderFs, filepath, versionTime, TagFilename)
 }
 
+func (v *staggered) OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	return openVersion(v.versionsFs, filePath, versionTime, TagFilename)
+}
+
 func (v *staggered) String() string {
 	return fmt.Sprintf("Staggered/@%p", v)
 }
@@ -9,6 +9,7 @@ package versioner
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"time"
 
@@ -63,6 +64,12 @@ func (t *trashcan) GetVersions() (map[string][]FileVersion, error) {
 	return retrieveVersions(t.versionsFs)
 }
 
+func (t *trashcan) OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	return openVersion(t.versionsFs, filePath, versionTime, func(name, tag string) string {
+		return name
+	})
+}
+
 func (t *trashcan) Restore(filepath string, versionTime time.Time) error {
 	// If we have an untagged file A and want to restore it on top of existing file A, we can't first archive the
 	// existing A as we'd overwrite the old A version, therefore when we archive existing file, we archive it with a
@@ -8,6 +8,7 @@ package versioner
 
 import (
 	"context"
+	"io"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -255,6 +256,23 @@ func restoreFile(method fs.CopyRangeMethod, src, dst fs.Filesystem, filePath str
 	return err
 }
 
+// openVersion opens the version of the file with the given version time,
+// finding it the same way as restoreFile does.
+func openVersion(versionsFs fs.Filesystem, filePath string, versionTime time.Time, tagger fileTagger) (io.ReadSeekCloser, error) {
+	filePath = osutil.NativeFilename(filePath)
+	tag := versionTime.In(time.Local).Truncate(time.Second).Format(TimeFormat)
+	taggedFilePath := tagger(filePath, tag)
+
+	if info, err := versionsFs.Lstat(taggedFilePath); err == nil && info.IsRegular() {
+		return versionsFs.Open(taggedFilePath)
+	}
+	// Check for untagged file
+	if info, err := versionsFs.Lstat(filePath); err == nil && info.IsRegular() && info.ModTime().Truncate(time.Second).Equal(versionTime) {
+		return versionsFs.Open(filePath)
+	}
+	return nil, errNotFound
+}
+
 func versionerFsFromFolderCfg(cfg config.FolderConfiguration) (versionsFs fs.Filesystem) {
 	folderFs := cfg.Filesystem(nil)
 	if cfg.Versioning.FSPath == "" {
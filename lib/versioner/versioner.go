@@ -12,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
@@ -30,11 +31,41 @@ type FileVersion struct {
 	Size        int64     `json:"size"`
 }
 
+// A VersionOpener can open archived versions for reading.
+type VersionOpener interface {
+	OpenVersion(filePath string, versionTime time.Time) (io.ReadSeekCloser, error)
+}
+
+// Usage describes the versions in an archive and the quotas the versioner
+// applies to it. Zero quotas mean no limit.
+type Usage struct {
+	Files       int   `json:"files"`
+	Versions    int   `json:"versions"`
+	Size        int64 `json:"size"`
+	MaxVersions int   `json:"maxVersions"`
+	MaxAgeS     int64 `json:"maxAgeS"`
+	MaxSize     int64 `json:"maxSize"`
+}
+
+// quotaer is implemented by versioners that limit the size of their archive.
+type quotaer interface {
+	quotas() Usage
+}
+
+// archiveSizer is implemented by versioners whose archive takes up a
+// different amount of space than the versions in it add up to.
+type archiveSizer interface {
+	archiveSize() (int64, error)
+}
+
 type factory func(cfg config.FolderConfiguration) Versioner
 
 var factories = make(map[string]factory)
 
-var ErrRestorationNotSupported = errors.New("version restoration not supported with the current versioner")
+var (
+	ErrRestorationNotSupported = errors.New("version restoration not supported with the current versioner")
+	ErrOpenNotSupported        = errors.New("opening versions not supported with the current versioner")
+)
 
 const (
 	TimeFormat = "20060102-150405"
@@ -53,6 +84,48 @@ func New(cfg config.FolderConfiguration) (Versioner, error) {
 	}, nil
 }
 
+// ArchiveUsage returns the current usage of the versioner's archive.
+func ArchiveUsage(v Versioner) (Usage, error) {
+	var usage Usage
+	if w, ok := v.(*versionerWithErrorContext); ok {
+		v = w.Versioner
+	}
+	if q, ok := v.(quotaer); ok {
+		usage = q.quotas()
+	}
+
+	versions, err := v.GetVersions()
+	if err != nil {
+		return Usage{}, err
+	}
+	usage.Files = len(versions)
+	for _, fileVersions := range versions {
+		usage.Versions += len(fileVersions)
+		for _, version := range fileVersions {
+			usage.Size += version.Size
+		}
+	}
+	if s, ok := v.(archiveSizer); ok {
+		if usage.Size, err = s.archiveSize(); err != nil {
+			return Usage{}, err
+		}
+	}
+	return usage, nil
+}
+
+// OpenVersion opens the given version of the file for reading, if the
+// versioner supports it.
+func OpenVersion(v Versioner, filePath string, versionTime time.Time) (io.ReadSeekCloser, error) {
+	if w, ok := v.(*versionerWithErrorContext); ok {
+		v = w.Versioner
+	}
+	opener, ok := v.(VersionOpener)
+	if !ok {
+		return nil, ErrOpenNotSupported
+	}
+	return opener.OpenVersion(filePath, versionTime)
+}
+
 type versionerWithErrorContext struct {
 	Versioner
 	vtype string
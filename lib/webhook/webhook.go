@@ -0,0 +1,376 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package webhook POSTs events to the HTTP endpoints given in the config.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/svcutil"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The number of events waiting for delivery per webhook, beyond which new
+// events are dropped.
+const queueSize = 1000
+
+const (
+	HeaderEvent     = "X-Syncthing-Event"
+	HeaderWebhook   = "X-Syncthing-Webhook"
+	HeaderSignature = "X-Syncthing-Signature"
+)
+
+// The Service delivers events to all enabled webhooks, each from its own
+// subscription and queue so that a slow endpoint doesn't hold up others.
+type Service interface {
+	suture.Service
+	Status() []Status
+}
+
+// Status describes how deliveries to a webhook are going.
+type Status struct {
+	ID           string    `json:"id"`
+	URL          string    `json:"url"`
+	Enabled      bool      `json:"enabled"`
+	Queued       int       `json:"queued"`
+	Delivered    int       `json:"delivered"`
+	Failed       int       `json:"failed"`  // given up on after all retries
+	Dropped      int       `json:"dropped"` // not queued, as the queue was full
+	LastAttempt  time.Time `json:"lastAttempt"`
+	LastDelivery time.Time `json:"lastDelivery"`
+	LastStatus   int       `json:"lastStatus"`
+	LastError    string    `json:"lastError,omitempty"`
+}
+
+type service struct {
+	*suture.Supervisor
+	cfg      config.Wrapper
+	evLogger events.Logger
+
+	hooks map[string]*webhook
+	mut   sync.Mutex
+}
+
+func New(cfg config.Wrapper, evLogger events.Logger) Service {
+	s := &service{
+		Supervisor: suture.New("webhook.Service", svcutil.SpecWithDebugLogger(l)),
+		cfg:        cfg,
+		evLogger:   evLogger,
+		hooks:      make(map[string]*webhook),
+		mut:        sync.NewMutex(),
+	}
+	s.Add(svcutil.AsService(s.serve, s.String()))
+	return s
+}
+
+func (s *service) serve(ctx context.Context) error {
+	s.cfg.Subscribe(s)
+	s.CommitConfiguration(config.Configuration{}, s.cfg.RawCopy())
+	<-ctx.Done()
+	s.cfg.Unsubscribe(s)
+	return nil
+}
+
+func (s *service) CommitConfiguration(_, to config.Configuration) bool {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	toHooks := make(map[string]config.WebhookConfiguration, len(to.Webhooks))
+	for _, cfg := range to.Webhooks {
+		if cfg.Enabled {
+			toHooks[cfg.ID] = cfg
+		}
+	}
+
+	// Stop hooks that were removed or changed, keeping the statistics of
+	// the latter for when they are started again below.
+	kept := make(map[string]Status)
+	for id, hook := range s.hooks {
+		if cfg, ok := toHooks[id]; ok && reflect.DeepEqual(cfg, hook.cfg) {
+			continue
+		}
+		if err := s.RemoveAndWait(hook.token, 0); err != nil {
+			l.Warnf("Stopping webhook %s: %v", id, err)
+		}
+		kept[id] = hook.statusCopy()
+		delete(s.hooks, id)
+	}
+
+	for id, cfg := range toHooks {
+		if _, ok := s.hooks[id]; !ok {
+			s.startLocked(cfg, kept[id])
+		}
+	}
+
+	return true
+}
+
+func (s *service) startLocked(cfg config.WebhookConfiguration, status Status) {
+	hook := newWebhook(cfg, s.evLogger, status)
+	hook.token = s.Add(hook)
+	s.hooks[cfg.ID] = hook
+	l.Debugln("started", hook)
+}
+
+// Status returns the status of all configured webhooks, in config order.
+func (s *service) Status() []Status {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	webhooks := s.cfg.RawCopy().Webhooks
+	res := make([]Status, 0, len(webhooks))
+	for _, cfg := range webhooks {
+		if hook, ok := s.hooks[cfg.ID]; ok {
+			res = append(res, hook.statusCopy())
+			continue
+		}
+		res = append(res, Status{ID: cfg.ID, URL: cfg.URL, Enabled: cfg.Enabled})
+	}
+	return res
+}
+
+func (s *service) String() string {
+	return fmt.Sprintf("webhook.Service@%p", s)
+}
+
+type webhook struct {
+	cfg      config.WebhookConfiguration
+	evLogger events.Logger
+	client   *http.Client
+	folders  map[string]struct{}
+	devices  map[string]struct{}
+	queue    chan events.Event
+	token    suture.ServiceToken
+
+	status Status
+	mut    sync.Mutex
+}
+
+func newWebhook(cfg config.WebhookConfiguration, evLogger events.Logger, status Status) *webhook {
+	h := &webhook{
+		cfg:      cfg,
+		evLogger: evLogger,
+		client:   &http.Client{Timeout: cfg.Timeout()},
+		queue:    make(chan events.Event, queueSize),
+		status:   status,
+		mut:      sync.NewMutex(),
+	}
+	h.status.ID = cfg.ID
+	h.status.URL = cfg.URL
+	h.status.Enabled = true
+	if len(cfg.Folders) > 0 {
+		h.folders = make(map[string]struct{}, len(cfg.Folders))
+		for _, folder := range cfg.Folders {
+			h.folders[folder] = struct{}{}
+		}
+	}
+	if len(cfg.Devices) > 0 {
+		h.devices = make(map[string]struct{}, len(cfg.Devices))
+		for _, dev := range cfg.Devices {
+			h.devices[normalizeDeviceID(dev)] = struct{}{}
+		}
+	}
+	return h
+}
+
+func (h *webhook) Serve(ctx context.Context) error {
+	sub := h.evLogger.Subscribe(h.cfg.EventMask())
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.deliverQueued(ctx)
+	}()
+	defer func() { <-done }()
+
+	for {
+		select {
+		case ev, ok := <-sub.C():
+			if !ok {
+				return nil
+			}
+			if !h.wants(ev) {
+				continue
+			}
+			select {
+			case h.queue <- ev:
+			default:
+				l.Debugln(h, "queue full, dropping event", ev.GlobalID)
+				h.mut.Lock()
+				h.status.Dropped++
+				h.mut.Unlock()
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (h *webhook) wants(ev events.Event) bool {
+	if h.folders != nil {
+		if _, ok := h.folders[ev.Folder()]; !ok {
+			return false
+		}
+	}
+	if h.devices != nil {
+		if _, ok := h.devices[normalizeDeviceID(ev.Device())]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *webhook) deliverQueued(ctx context.Context) {
+	for {
+		select {
+		case ev := <-h.queue:
+			h.deliver(ctx, ev)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver POSTs the event, retrying with increasing intervals until it is
+// accepted or we run out of retries.
+func (h *webhook) deliver(ctx context.Context, ev events.Event) {
+	body, err := json.Marshal(redacted(ev))
+	if err != nil {
+		l.Debugln(h, "marshalling event:", err)
+		return
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(h.cfg.RetryInterval(attempt)):
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		code, err := h.post(ctx, ev, body)
+		h.mut.Lock()
+		h.status.LastAttempt = time.Now()
+		h.status.LastStatus = code
+		if err == nil {
+			h.status.LastDelivery = h.status.LastAttempt
+			h.status.LastError = ""
+			h.status.Delivered++
+			h.mut.Unlock()
+			return
+		}
+		h.status.LastError = err.Error()
+		h.mut.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+		if attempt >= int(h.cfg.MaxRetries) || !retryable(code) {
+			l.Infof("Webhook %s: giving up on event %d (%v) after %d attempts: %v", h.cfg.ID, ev.GlobalID, ev.Type, attempt+1, err)
+			h.mut.Lock()
+			h.status.Failed++
+			h.mut.Unlock()
+			return
+		}
+		l.Debugf("%v: attempt %d for event %d failed: %v", h, attempt+1, ev.GlobalID, err)
+	}
+}
+
+// post sends the event once and returns the HTTP status code, if any.
+func (h *webhook) post(ctx context.Context, ev events.Event, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "syncthing/"+build.Version)
+	req.Header.Set(HeaderEvent, ev.Type.String())
+	req.Header.Set(HeaderWebhook, h.cfg.ID)
+	if h.cfg.Secret != "" {
+		req.Header.Set(HeaderSignature, Signature(h.cfg.Secret, body))
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+func (h *webhook) statusCopy() Status {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	status := h.status
+	status.Queued = len(h.queue)
+	return status
+}
+
+func (h *webhook) String() string {
+	return fmt.Sprintf("webhook/%s@%p", h.cfg.ID, h)
+}
+
+// redacted returns the event without any secrets in its data, as it is
+// sent to a third party. That applies to ConfigSaved, whose data is the
+// whole configuration.
+func redacted(ev events.Event) events.Event {
+	if cfg, ok := ev.Data.(config.Configuration); ok {
+		ev.Data = cfg.Redacted()
+	}
+	return ev
+}
+
+// Signature returns the value of the signature header for the given
+// payload: the hex encoded HMAC-SHA256 of the body, keyed with the secret.
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryable returns false for responses that won't get better by trying
+// again, i.e. client errors other than timeouts and rate limiting.
+func retryable(code int) bool {
+	switch {
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+		return true
+	case code >= 400 && code < 500:
+		return false
+	}
+	return true
+}
+
+func normalizeDeviceID(id string) string {
+	if dev, err := protocol.DeviceIDFromString(id); err == nil {
+		return dev.String()
+	}
+	return id
+}
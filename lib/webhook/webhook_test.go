@@ -0,0 +1,281 @@
+// Copyright (C) 2026 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// testSink is a local webhook endpoint recording what it receives. It
+// responds with the given status codes in order, and 200 once they are
+// used up.
+type testSink struct {
+	*httptest.Server
+	received chan sinkRequest
+	statuses chan int
+}
+
+type sinkRequest struct {
+	header http.Header
+	body   []byte
+	event  events.Event
+}
+
+func newTestSink(t *testing.T, statuses ...int) *testSink {
+	s := &testSink{
+		received: make(chan sinkRequest, 16),
+		statuses: make(chan int, len(statuses)),
+	}
+	for _, status := range statuses {
+		s.statuses <- status
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		req := sinkRequest{header: r.Header, body: body}
+		if err := json.Unmarshal(body, &req.event); err != nil {
+			t.Error(err)
+		}
+		select {
+		case status := <-s.statuses:
+			w.WriteHeader(status)
+		default:
+		}
+		s.received <- req
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testSink) next(t *testing.T) sinkRequest {
+	t.Helper()
+	select {
+	case req := <-s.received:
+		return req
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for webhook delivery")
+	}
+	return sinkRequest{}
+}
+
+func (s *testSink) expectNothing(t *testing.T) {
+	t.Helper()
+	select {
+	case req := <-s.received:
+		t.Fatalf("unexpected delivery of %v event", req.event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func testWebhook(id, url string) config.WebhookConfiguration {
+	return config.WebhookConfiguration{
+		ID:                id,
+		URL:               url,
+		Enabled:           true,
+		TimeoutS:          10,
+		RetryIntervalS:    1,
+		MaxRetryIntervalS: 1,
+	}
+}
+
+func startService(t *testing.T, webhooks ...config.WebhookConfiguration) (Service, config.Wrapper, events.Logger) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	evLogger := events.NewLogger()
+	go evLogger.Serve(ctx)
+
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Webhooks = webhooks
+	w := config.Wrap("/dev/null", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	go w.Serve(ctx)
+
+	svc := New(w, evLogger)
+	go svc.Serve(ctx)
+
+	// Wait for the webhooks to be subscribed.
+	for i := 0; i < 100; i++ {
+		running := 0
+		for _, status := range svc.Status() {
+			if status.Enabled {
+				running++
+			}
+		}
+		if running == len(webhooks) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	return svc, w, evLogger
+}
+
+func TestDelivery(t *testing.T) {
+	sink := newTestSink(t)
+	hook := testWebhook("hook", sink.URL)
+	hook.Events = []string{"StateChanged", "DeviceConnected"}
+	hook.Folders = []string{"default"}
+	hook.Secret = "s3cret"
+	svc, _, evLogger := startService(t, hook)
+
+	// Wrong folder, then wrong event type, then one we want.
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "other", "to": "idle"})
+	evLogger.Log(events.LocalIndexUpdated, map[string]interface{}{"folder": "default"})
+	evLogger.Log(events.DeviceConnected, map[string]string{"id": protocol.LocalDeviceID.String()})
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default", "to": "idle"})
+
+	req := sink.next(t)
+	if req.event.Type != events.StateChanged || req.event.Folder() != "default" {
+		t.Errorf("unexpected event %v for folder %q", req.event.Type, req.event.Folder())
+	}
+	if ev := req.header.Get(HeaderEvent); ev != "StateChanged" {
+		t.Errorf("unexpected event header %q", ev)
+	}
+	if id := req.header.Get(HeaderWebhook); id != "hook" {
+		t.Errorf("unexpected webhook header %q", id)
+	}
+	if sig := req.header.Get(HeaderSignature); sig != Signature("s3cret", req.body) {
+		t.Errorf("unexpected signature %q", sig)
+	}
+	sink.expectNothing(t)
+
+	status := svc.Status()
+	if len(status) != 1 || status[0].Delivered != 1 || status[0].LastStatus != http.StatusOK {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestDeviceFilter(t *testing.T) {
+	sink := newTestSink(t)
+	hook := testWebhook("hook", sink.URL)
+	// Any accepted way of writing the ID works
+	hook.Devices = []string{"p56ioi7mzjnu2iqgdreydm2mgtmgl3bxnpq6w5btbbz4tjxzwicq"}
+	dev, err := protocol.DeviceIDFromString("P56IOI7-MZJNU2Y-IQGDREY-DM2MGTI-MGL3BXN-PQ6W5BM-TBBZ4TJ-XZWICQ2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, evLogger := startService(t, hook)
+
+	evLogger.Log(events.DeviceConnected, map[string]string{"id": protocol.LocalDeviceID.String()})
+	evLogger.Log(events.DeviceConnected, map[string]string{"id": dev.String()})
+	if req := sink.next(t); req.event.Device() != dev.String() {
+		t.Errorf("unexpected event for device %q", req.event.Device())
+	}
+	sink.expectNothing(t)
+}
+
+func TestRetry(t *testing.T) {
+	// The first attempt fails and is retried, the second event gets a
+	// client error and isn't.
+	sink := newTestSink(t, http.StatusServiceUnavailable, http.StatusOK, http.StatusBadRequest)
+	hook := testWebhook("hook", sink.URL)
+	hook.MaxRetries = 3
+	svc, _, evLogger := startService(t, hook)
+
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	first := sink.next(t)
+	if retry := sink.next(t); retry.event.GlobalID != first.event.GlobalID {
+		t.Errorf("expected a retry of event %d, got %d", first.event.GlobalID, retry.event.GlobalID)
+	}
+
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	sink.next(t)
+	sink.expectNothing(t)
+
+	status := svc.Status()
+	if len(status) != 1 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if status[0].Delivered != 1 || status[0].Failed != 1 || status[0].LastStatus != http.StatusBadRequest || status[0].LastError == "" {
+		t.Errorf("unexpected status %+v", status[0])
+	}
+}
+
+func TestConfigChange(t *testing.T) {
+	sink := newTestSink(t)
+	svc, w, evLogger := startService(t, testWebhook("hook", sink.URL))
+
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	sink.next(t)
+
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.Webhooks[0].Enabled = false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "default"})
+	sink.expectNothing(t)
+
+	status := svc.Status()
+	if len(status) != 1 || status[0].Enabled || status[0].ID != "hook" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestConfigSavedRedacted(t *testing.T) {
+	sink := newTestSink(t)
+	defaultHook := testWebhook("default", sink.URL)
+	hook := testWebhook("hook", sink.URL)
+	hook.Events = []string{"ConfigSaved"}
+	hook.Secret = "webhook-secret"
+	_, _, evLogger := startService(t, defaultHook, hook)
+
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.GUI.APIKey = "admin-api-key"
+	cfg.GUI.Password = "password-hash"
+	cfg.GUI.Users = []config.GUIUser{{Name: "user", Password: "user-password-hash"}}
+	cfg.GUI.APIKeys = []config.GUIAPIKey{{Name: "monitoring", Key: "named-api-key"}}
+	cfg.OIDC.ClientSecret = "oidc-client-secret"
+	cfg.Webhooks = []config.WebhookConfiguration{hook}
+	cfg.Folders = []config.FolderConfiguration{{
+		ID:      "default",
+		Devices: []config.FolderDeviceConfiguration{{DeviceID: protocol.LocalDeviceID, EncryptionPassword: "encryption-password"}},
+	}}
+	evLogger.Log(events.ConfigSaved, cfg)
+
+	// Only delivered to the webhook that asks for it, without secrets.
+	req := sink.next(t)
+	if id := req.header.Get(HeaderWebhook); id != "hook" {
+		t.Errorf("unexpected delivery to %q", id)
+	}
+	for _, secret := range []string{"admin-api-key", "password-hash", "user-password-hash", "named-api-key", "oidc-client-secret", "webhook-secret", "encryption-password"} {
+		if bytes.Contains(req.body, []byte(secret)) {
+			t.Errorf("delivered ConfigSaved event contains %q", secret)
+		}
+	}
+	sink.expectNothing(t)
+
+	// The original event data is untouched.
+	if cfg.GUI.APIKey != "admin-api-key" || cfg.Webhooks[0].Secret != "webhook-secret" {
+		t.Error("redacting modified the event data")
+	}
+}
+
+func TestSignature(t *testing.T) {
+	// echo -n '{"id":1}' | openssl dgst -sha256 -hmac secret
+	expected := "sha256=03def589620c813f198fd03d7967e292b163ef0435ebf43071ce0e9519763cb7"
+	if sig := Signature("secret", []byte(`{"id":1}`)); sig != expected {
+		t.Errorf("unexpected signature %s", sig)
+	}
+}